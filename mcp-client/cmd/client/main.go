@@ -6,9 +6,11 @@ import (
 	"encoding/json"
 	"flag"
 	"fmt"
+	"io"
 	"log/slog"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/bchamber/taskman/mcp-client/internal/client"
 	"github.com/bchamber/taskman/mcp-client/internal/config"
@@ -20,8 +22,11 @@ func main() {
 	var (
 		serverURL   = flag.String("server", "", "MCP server URL (overrides MCP_SERVER_URL)")
 		logLevel    = flag.String("log-level", "", "Log level: debug, info, warn, error (overrides LOG_LEVEL)")
+		logFormat   = flag.String("log-format", "", "Log format: text, json (overrides LOG_FORMAT)")
 		interactive = flag.Bool("interactive", false, "Run in interactive mode")
 		intent      = flag.String("intent", "", "JSON intent to process")
+		idleTimeout = flag.Duration("idle-timeout", 0, "End interactive mode after this long without input (0 disables)")
+		maxSession  = flag.Duration("max-session", 0, "End interactive mode after this total duration (0 disables)")
 	)
 	flag.Parse()
 
@@ -33,9 +38,12 @@ func main() {
 	if *logLevel != "" {
 		cfg.LogLevel = *logLevel
 	}
+	if *logFormat != "" {
+		cfg.LogFormat = *logFormat
+	}
 
 	// Setup logger
-	logger := setupLogger(cfg.LogLevel)
+	logger := setupLogger(os.Stderr, cfg.LogLevel, cfg.LogFormat)
 
 	// Create MCP client
 	mcpClient := client.NewMCPClient(cfg.MCPServerURL, logger)
@@ -47,7 +55,9 @@ func main() {
 
 	// Handle different modes
 	if *interactive {
-		runInteractiveMode(ctx, intentHandler, logger)
+		limits := sessionLimits{idleTimeout: *idleTimeout, maxSession: *maxSession}
+		reason := runInteractiveMode(ctx, intentHandler, os.Stdin, os.Stdout, limits, logger)
+		logger.Info("Interactive session ended", "reason", reason)
 	} else if *intent != "" {
 		runSingleIntent(ctx, intentHandler, *intent, logger)
 	} else {
@@ -100,7 +110,8 @@ func main() {
 	}
 }
 
-func setupLogger(level string) *slog.Logger {
+// setupLogger writes JSON lines for the "json" format and text otherwise
+func setupLogger(w io.Writer, level, format string) *slog.Logger {
 	var logLevel slog.Level
 	switch strings.ToLower(level) {
 	case "debug":
@@ -119,39 +130,92 @@ func setupLogger(level string) *slog.Logger {
 		Level: logLevel,
 	}
 
-	return slog.New(slog.NewTextHandler(os.Stderr, opts))
+	if strings.ToLower(format) == "json" {
+		return slog.New(slog.NewJSONHandler(w, opts))
+	}
+	return slog.New(slog.NewTextHandler(w, opts))
+}
+
+// intentProcessor handles a single JSON intent; *handlers.IntentHandler satisfies it
+type intentProcessor interface {
+	ProcessIntent(ctx context.Context, intentJSON string) (interface{}, error)
 }
 
-func runInteractiveMode(ctx context.Context, handler *handlers.IntentHandler, logger *slog.Logger) {
-	fmt.Println("MCP Client Interactive Mode")
-	fmt.Println("Enter JSON intents (press Ctrl+D to exit):")
-	fmt.Println()
+// sessionLimits bounds an interactive session; zero values disable a limit
+type sessionLimits struct {
+	idleTimeout time.Duration
+	maxSession  time.Duration
+}
+
+// runInteractiveMode reads intents from in until EOF or a session limit is hit,
+// and returns why the session ended
+func runInteractiveMode(ctx context.Context, handler intentProcessor, in io.Reader, out io.Writer, limits sessionLimits, logger *slog.Logger) string {
+	fmt.Fprintln(out, "MCP Client Interactive Mode")
+	fmt.Fprintln(out, "Enter JSON intents (press Ctrl+D to exit):")
+	fmt.Fprintln(out)
+
+	// Read input in the background so the loop can also wait on the session timers
+	lines := make(chan string)
+	go func() {
+		defer close(lines)
+		scanner := bufio.NewScanner(in)
+		for scanner.Scan() {
+			lines <- scanner.Text()
+		}
+	}()
+
+	var idleTimer, sessionTimer <-chan time.Time
+	if limits.maxSession > 0 {
+		sessionTimer = time.After(limits.maxSession)
+	}
 
-	scanner := bufio.NewScanner(os.Stdin)
 	for {
-		fmt.Print("> ")
-		if !scanner.Scan() {
-			break
+		fmt.Fprint(out, "> ")
+
+		var idle *time.Timer
+		if limits.idleTimeout > 0 {
+			idle = time.NewTimer(limits.idleTimeout)
+			idleTimer = idle.C
+		}
+
+		var line string
+		var ok bool
+		select {
+		case line, ok = <-lines:
+		case <-idleTimer:
+			reason := fmt.Sprintf("no input for %s (idle timeout)", limits.idleTimeout)
+			fmt.Fprintf(out, "\nSession ended: %s\n", reason)
+			return reason
+		case <-sessionTimer:
+			reason := fmt.Sprintf("session reached maximum duration of %s", limits.maxSession)
+			fmt.Fprintf(out, "\nSession ended: %s\n", reason)
+			return reason
+		}
+		if idle != nil {
+			idle.Stop()
+		}
+		if !ok {
+			return "end of input"
 		}
 
-		line := strings.TrimSpace(scanner.Text())
+		line = strings.TrimSpace(line)
 		if line == "" {
 			continue
 		}
 
 		result, err := handler.ProcessIntent(ctx, line)
 		if err != nil {
-			fmt.Printf("Error: %v\n", err)
+			fmt.Fprintf(out, "Error: %v\n", err)
 			continue
 		}
 
 		output, err := json.MarshalIndent(result, "", "  ")
 		if err != nil {
-			fmt.Printf("Error formatting result: %v\n", err)
+			fmt.Fprintf(out, "Error formatting result: %v\n", err)
 			continue
 		}
 
-		fmt.Printf("Result:\n%s\n\n", output)
+		fmt.Fprintf(out, "Result:\n%s\n\n", output)
 	}
 }
 
@@ -234,18 +298,22 @@ Commands:
 Flags:
   -server <url>                  MCP server URL (default: $MCP_SERVER_URL or http://localhost:3000)
   -log-level <level>             Log level: debug, info, warn, error (default: info)
+  -log-format <format>           Log format: text, json (default: text)
   -intent '<json>'               Process a single JSON intent
   -interactive                   Run in interactive mode
+  -idle-timeout <duration>       End interactive mode after this long without input (default: off)
+  -max-session <duration>        End interactive mode after this total duration (default: off)
 
 Examples:
   %s list-tools
   %s execute-tool get_task_overview
   %s execute-tool create_task_with_context '{"task_name": "Test", "description": "Test task"}'
   %s -intent '{"method": "tools/list"}'
-  %s -interactive
+  %s -interactive -idle-timeout 10m -max-session 2h
 
 Environment Variables:
   MCP_SERVER_URL                 Default MCP server URL
   LOG_LEVEL                      Default log level
+  LOG_FORMAT                     Default log format
 `, os.Args[0], os.Args[0], os.Args[0], os.Args[0], os.Args[0], os.Args[0], os.Args[0], os.Args[0])
 }
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"strings"
+	"testing"
+	"time"
+)
+
+type fakeProcessor struct {
+	intents []string
+}
+
+func (f *fakeProcessor) ProcessIntent(ctx context.Context, intentJSON string) (interface{}, error) {
+	f.intents = append(f.intents, intentJSON)
+	return map[string]string{"ok": "true"}, nil
+}
+
+func TestRunInteractiveMode_IdleTimeout(t *testing.T) {
+	in, writer := io.Pipe()
+	defer writer.Close()
+
+	// Send one intent, then go quiet without closing the input
+	go writer.Write([]byte(`{"method": "tools/list"}` + "\n"))
+
+	processor := &fakeProcessor{}
+	var out bytes.Buffer
+	done := make(chan string, 1)
+	go func() {
+		done <- runInteractiveMode(context.Background(), processor, in, &out, sessionLimits{idleTimeout: 50 * time.Millisecond}, slog.Default())
+	}()
+
+	select {
+	case reason := <-done:
+		if !strings.Contains(reason, "idle timeout") {
+			t.Errorf("Expected idle timeout reason, got %q", reason)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatal("Expected interactive loop to exit after the idle timeout")
+	}
+
+	if len(processor.intents) != 1 {
+		t.Errorf("Expected 1 intent processed before going idle, got %d", len(processor.intents))
+	}
+	if !strings.Contains(out.String(), "Session ended: no input for 50ms (idle timeout)") {
+		t.Errorf("Expected idle reason to be printed, got: %s", out.String())
+	}
+}
+
+func TestRunInteractiveMode_MaxSession(t *testing.T) {
+	in, writer := io.Pipe()
+	defer writer.Close()
+
+	// Keep sending input so the idle timeout never fires
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		for {
+			select {
+			case <-stop:
+				return
+			case <-time.After(10 * time.Millisecond):
+				writer.Write([]byte("\n"))
+			}
+		}
+	}()
+
+	var out bytes.Buffer
+	reason := runInteractiveMode(context.Background(), &fakeProcessor{}, in, &out, sessionLimits{idleTimeout: time.Second, maxSession: 100 * time.Millisecond}, slog.Default())
+
+	if !strings.Contains(reason, "maximum duration of 100ms") {
+		t.Errorf("Expected max session reason, got %q", reason)
+	}
+}
+
+func TestRunInteractiveMode_EndOfInput(t *testing.T) {
+	var out bytes.Buffer
+	reason := runInteractiveMode(context.Background(), &fakeProcessor{}, strings.NewReader(""), &out, sessionLimits{}, slog.Default())
+
+	if reason != "end of input" {
+		t.Errorf("Expected end of input, got %q", reason)
+	}
+}
+
+func TestSetupLogger_JSONFormat(t *testing.T) {
+	var buf bytes.Buffer
+	setupLogger(&buf, "info", "json").Info("Interactive session ended", "reason", "idle timeout")
+
+	var record map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("Expected a JSON log line, got %q: %v", buf.String(), err)
+	}
+	if record["msg"] != "Interactive session ended" || record["reason"] != "idle timeout" || record["level"] != "INFO" {
+		t.Errorf("Unexpected JSON record: %v", record)
+	}
+
+	buf.Reset()
+	setupLogger(&buf, "info", "text").Info("Interactive session ended")
+	if !strings.Contains(buf.String(), `msg="Interactive session ended"`) {
+		t.Errorf("Expected a text log line, got %q", buf.String())
+	}
+}
@@ -8,6 +8,7 @@ import (
 type Config struct {
 	MCPServerURL string
 	LogLevel     string
+	LogFormat    string // "text", "json"
 }
 
 // LoadConfig loads configuration from environment variables
@@ -15,6 +16,7 @@ func LoadConfig() Config {
 	return Config{
 		MCPServerURL: getEnv("MCP_SERVER_URL", "http://localhost:3000"),
 		LogLevel:     getEnv("LOG_LEVEL", "info"),
+		LogFormat:    getEnv("LOG_FORMAT", "text"),
 	}
 }
 
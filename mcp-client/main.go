@@ -3,11 +3,23 @@ package main
 import (
 	"os"
 	"os/exec"
+	"path/filepath"
 )
 
+// clientBinaryName is the name the Makefile gives the built client
+const clientBinaryName = "mcp-client"
+
+// clientBinaryEnv names an explicit path to the client binary
+const clientBinaryEnv = "MCP_CLIENT_BINARY"
+
 func main() {
+	self, err := os.Executable()
+	if err != nil {
+		self = os.Args[0]
+	}
+
 	// Delegate to the actual client binary
-	cmd := exec.Command("go", append([]string{"run", "./cmd/client"}, os.Args[1:]...)...)
+	cmd := clientCommand(self, os.Args[1:])
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 	cmd.Stdin = os.Stdin
@@ -19,3 +31,58 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// clientCommand runs the prebuilt client when one can be found, falling back
+// to `go run ./cmd/client` for development checkouts without a build
+func clientCommand(self string, args []string) *exec.Cmd {
+	if binary := findClientBinary(self); binary != "" {
+		return exec.Command(binary, args...)
+	}
+	return exec.Command("go", append([]string{"run", "./cmd/client"}, args...)...)
+}
+
+// findClientBinary looks for the client in $MCP_CLIENT_BINARY, then in bin/
+// and the directory next to self, then on PATH. self is never returned, since
+// the wrapper may itself be installed as mcp-client.
+func findClientBinary(self string) string {
+	var candidates []string
+	if path := os.Getenv(clientBinaryEnv); path != "" {
+		candidates = append(candidates, path)
+	}
+	if self != "" {
+		dir := filepath.Dir(self)
+		candidates = append(candidates,
+			filepath.Join(dir, "bin", clientBinaryName),
+			filepath.Join(dir, clientBinaryName),
+		)
+	}
+	if path, err := exec.LookPath(clientBinaryName); err == nil {
+		candidates = append(candidates, path)
+	}
+
+	for _, candidate := range candidates {
+		if isExecutable(candidate) && !sameFile(candidate, self) {
+			return candidate
+		}
+	}
+	return ""
+}
+
+// isExecutable reports whether path is a regular file with an execute bit set
+func isExecutable(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && info.Mode().IsRegular() && info.Mode().Perm()&0o111 != 0
+}
+
+// sameFile reports whether a and b refer to the same file, following symlinks
+func sameFile(a, b string) bool {
+	infoA, err := os.Stat(a)
+	if err != nil {
+		return false
+	}
+	infoB, err := os.Stat(b)
+	if err != nil {
+		return false
+	}
+	return os.SameFile(infoA, infoB)
+}
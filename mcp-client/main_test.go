@@ -0,0 +1,80 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// writeStub creates an executable shell script that echoes its arguments
+func writeStub(t *testing.T, path string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatalf("Failed to create stub directory: %v", err)
+	}
+	if err := os.WriteFile(path, []byte("#!/bin/sh\necho stub \"$@\"\n"), 0o755); err != nil {
+		t.Fatalf("Failed to write stub binary: %v", err)
+	}
+}
+
+func TestClientCommand_PrefersPrebuiltBinary(t *testing.T) {
+	t.Setenv(clientBinaryEnv, "")
+	t.Setenv("PATH", t.TempDir())
+
+	dir := t.TempDir()
+	self := filepath.Join(dir, "wrapper")
+	writeStub(t, self)
+	stub := filepath.Join(dir, "bin", clientBinaryName)
+	writeStub(t, stub)
+
+	cmd := clientCommand(self, []string{"list-tools", "-log-level", "debug"})
+	if cmd.Path != stub {
+		t.Fatalf("Expected stub binary %s to be executed, got %s %v", stub, cmd.Path, cmd.Args)
+	}
+
+	out, err := cmd.Output()
+	if err != nil {
+		t.Fatalf("Failed to run stub: %v", err)
+	}
+	if got := strings.TrimSpace(string(out)); got != "stub list-tools -log-level debug" {
+		t.Errorf("Expected all args forwarded, got %q", got)
+	}
+}
+
+func TestClientCommand_FallsBackToGoRun(t *testing.T) {
+	t.Setenv(clientBinaryEnv, "")
+	t.Setenv("PATH", t.TempDir())
+
+	// The wrapper installed as mcp-client must not run itself
+	dir := t.TempDir()
+	self := filepath.Join(dir, clientBinaryName)
+	writeStub(t, self)
+
+	cmd := clientCommand(self, []string{"list-tools"})
+	if cmd.Args[0] != "go" {
+		t.Fatalf("Expected go run fallback, got %s", cmd.Path)
+	}
+	if got := strings.Join(cmd.Args[1:], " "); got != "run ./cmd/client list-tools" {
+		t.Errorf("Expected go run ./cmd/client with args, got %q", got)
+	}
+}
+
+func TestFindClientBinary_EnvAndPath(t *testing.T) {
+	self := filepath.Join(t.TempDir(), "wrapper")
+
+	onPath := t.TempDir()
+	writeStub(t, filepath.Join(onPath, clientBinaryName))
+	t.Setenv("PATH", onPath)
+	t.Setenv(clientBinaryEnv, "")
+	if got := findClientBinary(self); got != filepath.Join(onPath, clientBinaryName) {
+		t.Errorf("Expected binary found on PATH, got %q", got)
+	}
+
+	explicit := filepath.Join(t.TempDir(), "custom-client")
+	writeStub(t, explicit)
+	t.Setenv(clientBinaryEnv, explicit)
+	if got := findClientBinary(self); got != explicit {
+		t.Errorf("Expected %s to take precedence, got %q", clientBinaryEnv, got)
+	}
+}
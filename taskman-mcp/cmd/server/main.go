@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"io"
 	"log/slog"
 	"os"
 	"os/signal"
@@ -14,9 +15,15 @@ import (
 func main() {
 	// Load configuration
 	cfg := config.Load()
+	if err := cfg.Validate(); err != nil {
+		slog.Error("Invalid configuration", "error", err)
+		os.Exit(1)
+	}
 
-	// Set up structured logging
-	setupLogging(cfg.LogLevel)
+	// Set up structured logging; the level can change on SIGHUP
+	logLevel := new(slog.LevelVar)
+	logLevel.Set(parseLogLevel(cfg.LogLevel))
+	setupLogging(logLevel, cfg.LogLevel, cfg.LogFormat)
 
 	slog.Info("Starting Taskman MCP Server",
 		"server_name", cfg.ServerName,
@@ -41,6 +48,21 @@ func main() {
 		cancel()
 	}()
 
+	// Reload configuration on SIGHUP
+	go func() {
+		hupCh := make(chan os.Signal, 1)
+		signal.Notify(hupCh, syscall.SIGHUP)
+		for {
+			select {
+			case <-hupCh:
+				reloadConfig(mcpServer, logLevel)
+			case <-ctx.Done():
+				signal.Stop(hupCh)
+				return
+			}
+		}
+	}()
+
 	// Run server
 	if err := mcpServer.Run(ctx); err != nil {
 		slog.Error("Server failed", "error", err)
@@ -50,27 +72,56 @@ func main() {
 	slog.Info("Server stopped gracefully")
 }
 
-func setupLogging(level string) {
-	var logLevel slog.Level
+// reloadConfig re-reads the configuration and publishes the reloadable
+// subset to the server, logging what changed and what needs a restart. An
+// invalid configuration is rejected and the current one stays in effect.
+func reloadConfig(mcpServer *server.Server, logLevel *slog.LevelVar) {
+	slog.Info("SIGHUP received, reloading configuration")
+
+	next := config.Load()
+	if err := next.Validate(); err != nil {
+		slog.Error("Invalid configuration, keeping the current one", "error", err)
+		return
+	}
+	changed, ignored := mcpServer.ReloadConfig(next)
+	logLevel.Set(parseLogLevel(next.LogLevel))
+
+	if len(ignored) > 0 {
+		slog.Warn("Ignoring changed settings that require a restart", "fields", ignored)
+	}
+	slog.Info("Configuration reloaded", "changed", changed, "log_level", next.LogLevel)
+}
+
+func parseLogLevel(level string) slog.Level {
 	switch level {
 	case "DEBUG":
-		logLevel = slog.LevelDebug
+		return slog.LevelDebug
 	case "INFO":
-		logLevel = slog.LevelInfo
+		return slog.LevelInfo
 	case "WARN":
-		logLevel = slog.LevelWarn
+		return slog.LevelWarn
 	case "ERROR":
-		logLevel = slog.LevelError
+		return slog.LevelError
 	default:
-		logLevel = slog.LevelInfo
+		return slog.LevelInfo
 	}
+}
 
-	handler := slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{
-		Level: logLevel,
-	})
-
-	logger := slog.New(handler)
+func setupLogging(logLevel *slog.LevelVar, level, format string) {
+	logger := slog.New(newLogHandler(os.Stderr, logLevel, format))
 	slog.SetDefault(logger)
 
-	slog.Info("Logging initialized", "level", level)
+	slog.Info("Logging initialized", "level", level, "format", format)
+}
+
+// newLogHandler returns a JSON handler for the "json" format and a text
+// handler otherwise; both follow logLevel as it changes
+func newLogHandler(w io.Writer, logLevel *slog.LevelVar, format string) slog.Handler {
+	opts := &slog.HandlerOptions{
+		Level: logLevel,
+	}
+	if format == "json" {
+		return slog.NewJSONHandler(w, opts)
+	}
+	return slog.NewTextHandler(w, opts)
 }
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/bchamber/taskman-mcp/internal/config"
+	"github.com/bchamber/taskman-mcp/internal/server"
+)
+
+func TestReloadConfig_AppliesLogLevel(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "taskman.env")
+	if err := os.WriteFile(path, []byte("TASKMAN_LOG_LEVEL=INFO\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	t.Setenv(config.ConfigFileEnv, path)
+	t.Setenv("TASKMAN_LOG_LEVEL", "")
+	t.Setenv("TASKMAN_MCP_HTTP_PORT", "")
+
+	cfg := config.Load()
+	logLevel := new(slog.LevelVar)
+	logLevel.Set(parseLogLevel(cfg.LogLevel))
+
+	contents := "TASKMAN_LOG_LEVEL=DEBUG\nTASKMAN_MCP_HTTP_PORT=9999\n"
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("Failed to rewrite config file: %v", err)
+	}
+
+	mcpServer := server.NewServer(cfg)
+	reloadConfig(mcpServer, logLevel)
+
+	if logLevel.Level() != slog.LevelDebug {
+		t.Errorf("Expected log level DEBUG after reload, got %v", logLevel.Level())
+	}
+	current := mcpServer.Config()
+	if current.LogLevel != "DEBUG" {
+		t.Errorf("Expected LogLevel DEBUG, got %s", current.LogLevel)
+	}
+	if current.HTTPPort != "8081" {
+		t.Errorf("Expected HTTP port to require a restart, got %s", current.HTTPPort)
+	}
+	if cfg.LogLevel != "INFO" {
+		t.Errorf("Expected the startup config to be left untouched, got %s", cfg.LogLevel)
+	}
+}
+
+func TestReloadConfig_KeepsCurrentOnInvalidConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "taskman.env")
+	if err := os.WriteFile(path, []byte("TASKMAN_LOG_LEVEL=INFO\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	t.Setenv(config.ConfigFileEnv, path)
+	t.Setenv("TASKMAN_LOG_LEVEL", "")
+	t.Setenv("TASKMAN_LOG_FORMAT", "")
+
+	cfg := config.Load()
+	logLevel := new(slog.LevelVar)
+	logLevel.Set(parseLogLevel(cfg.LogLevel))
+	mcpServer := server.NewServer(cfg)
+
+	contents := "TASKMAN_LOG_LEVEL=DEBUG\nTASKMAN_LOG_FORMAT=yaml\n"
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("Failed to rewrite config file: %v", err)
+	}
+	reloadConfig(mcpServer, logLevel)
+
+	if logLevel.Level() != slog.LevelInfo {
+		t.Errorf("Expected log level to stay INFO, got %v", logLevel.Level())
+	}
+	if mcpServer.Config() != cfg {
+		t.Errorf("Expected the current configuration to stay in effect, got %+v", mcpServer.Config())
+	}
+}
+
+func TestNewLogHandler_Format(t *testing.T) {
+	logLevel := new(slog.LevelVar)
+
+	var buf bytes.Buffer
+	slog.New(newLogHandler(&buf, logLevel, "json")).Info("Logging initialized", "level", "INFO")
+	var record map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &record); err != nil {
+		t.Fatalf("Expected a JSON log line, got %q: %v", buf.String(), err)
+	}
+	if record["msg"] != "Logging initialized" || record["level"] != "INFO" {
+		t.Errorf("Unexpected JSON record: %v", record)
+	}
+
+	buf.Reset()
+	slog.New(newLogHandler(&buf, logLevel, "text")).Info("Logging initialized", "level", "INFO")
+	if !strings.Contains(buf.String(), `msg="Logging initialized"`) {
+		t.Errorf("Expected a text log line, got %q", buf.String())
+	}
+
+	// Both formats follow level changes
+	buf.Reset()
+	logLevel.Set(slog.LevelWarn)
+	slog.New(newLogHandler(&buf, logLevel, "json")).Info("suppressed")
+	if buf.Len() != 0 {
+		t.Errorf("Expected INFO suppressed at WARN level, got %q", buf.String())
+	}
+}
@@ -0,0 +1,101 @@
+package calendar
+
+import (
+	"time"
+
+	"github.com/bchamber/taskman-mcp/internal/config"
+)
+
+// dateLayout is the format used for configured holidays
+const dateLayout = "2006-01-02"
+
+// Calendar answers due-date questions, optionally counting only business days
+// (weekdays that are not configured holidays)
+type Calendar struct {
+	businessDaysOnly bool
+	holidays         map[string]bool
+}
+
+// New creates a calendar. Holidays are dates in YYYY-MM-DD form and are only
+// consulted when businessDaysOnly is set.
+func New(businessDaysOnly bool, holidays []string) *Calendar {
+	holidaySet := make(map[string]bool, len(holidays))
+	for _, holiday := range holidays {
+		holidaySet[holiday] = true
+	}
+	return &Calendar{
+		businessDaysOnly: businessDaysOnly,
+		holidays:         holidaySet,
+	}
+}
+
+// FromConfig creates a calendar from the business-day settings
+func FromConfig(cfg *config.Config) *Calendar {
+	if cfg == nil {
+		cfg = config.Default()
+	}
+	return New(cfg.BusinessDaysOnly, cfg.Holidays)
+}
+
+// BusinessDaysOnly reports whether weekends and holidays are skipped
+func (c *Calendar) BusinessDaysOnly() bool {
+	return c.businessDaysOnly
+}
+
+// IsWorkingDay reports whether t falls on a day work is expected. Every day
+// is a working day unless business days only is enabled.
+func (c *Calendar) IsWorkingDay(t time.Time) bool {
+	if !c.businessDaysOnly {
+		return true
+	}
+	if t.Weekday() == time.Saturday || t.Weekday() == time.Sunday {
+		return false
+	}
+	return !c.holidays[t.Format(dateLayout)]
+}
+
+// EffectiveDue returns when a due date effectively needs attention: a task
+// due on a non-working day must be finished by the previous working day
+func (c *Calendar) EffectiveDue(due time.Time) time.Time {
+	for i := 0; i < 366 && !c.IsWorkingDay(due); i++ {
+		due = due.AddDate(0, 0, -1)
+	}
+	return due
+}
+
+// AddWorkingDays returns the time that is the given number of working days after from
+func (c *Calendar) AddWorkingDays(from time.Time, days int) time.Time {
+	if !c.businessDaysOnly {
+		return from.Add(time.Duration(days) * 24 * time.Hour)
+	}
+
+	result := from
+	for added := 0; added < days; {
+		result = result.AddDate(0, 0, 1)
+		if c.IsWorkingDay(result) {
+			added++
+		}
+	}
+	return result
+}
+
+// WorkingDaysBetween counts working days after from's date up to and
+// including to's date. It returns a negative count when to is before from.
+func (c *Calendar) WorkingDaysBetween(from, to time.Time) int {
+	fromDay := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, from.Location())
+	toDay := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, from.Location())
+
+	sign := 1
+	if toDay.Before(fromDay) {
+		fromDay, toDay = toDay, fromDay
+		sign = -1
+	}
+
+	count := 0
+	for day := fromDay.AddDate(0, 0, 1); !day.After(toDay); day = day.AddDate(0, 0, 1) {
+		if c.IsWorkingDay(day) {
+			count++
+		}
+	}
+	return sign * count
+}
@@ -0,0 +1,81 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bchamber/taskman-mcp/internal/config"
+)
+
+func date(s string) time.Time {
+	t, _ := time.Parse("2006-01-02", s)
+	return t
+}
+
+func TestCalendar_EffectiveDueOnWeekend(t *testing.T) {
+	saturday := date("2024-06-15")
+	friday := date("2024-06-14")
+
+	businessCal := New(true, nil)
+	if got := businessCal.EffectiveDue(saturday); !got.Equal(friday) {
+		t.Errorf("Expected Saturday due date to need attention Friday, got %s", got.Format("2006-01-02 Mon"))
+	}
+	if got := businessCal.EffectiveDue(friday); !got.Equal(friday) {
+		t.Errorf("Expected weekday due date to be unchanged, got %s", got.Format("2006-01-02"))
+	}
+
+	// Monday holiday after a weekend rolls back to Friday
+	holidayCal := New(true, []string{"2024-06-17"})
+	if got := holidayCal.EffectiveDue(date("2024-06-17")); !got.Equal(friday) {
+		t.Errorf("Expected holiday Monday to roll back to Friday, got %s", got.Format("2006-01-02"))
+	}
+
+	calendarDays := New(false, []string{"2024-06-17"})
+	if got := calendarDays.EffectiveDue(saturday); !got.Equal(saturday) {
+		t.Errorf("Expected no adjustment when business days are off, got %s", got.Format("2006-01-02"))
+	}
+}
+
+func TestCalendar_WorkingDaysAcrossWeekend(t *testing.T) {
+	friday := date("2024-06-14")
+	monday := date("2024-06-17")
+	tuesday := date("2024-06-18")
+
+	businessCal := New(true, nil)
+	if got := businessCal.WorkingDaysBetween(friday, monday); got != 1 {
+		t.Errorf("Expected 1 working day from Friday to Monday, got %d", got)
+	}
+	if got := businessCal.WorkingDaysBetween(monday, friday); got != -1 {
+		t.Errorf("Expected -1 working day from Monday back to Friday, got %d", got)
+	}
+	if got := businessCal.AddWorkingDays(friday, 2); !got.Equal(tuesday) {
+		t.Errorf("Expected 2 working days after Friday to be Tuesday, got %s", got.Format("2006-01-02"))
+	}
+
+	holidayCal := New(true, []string{"2024-06-17"})
+	if got := holidayCal.WorkingDaysBetween(friday, tuesday); got != 1 {
+		t.Errorf("Expected 1 working day across weekend and holiday, got %d", got)
+	}
+
+	calendarDays := New(false, nil)
+	if got := calendarDays.WorkingDaysBetween(friday, monday); got != 3 {
+		t.Errorf("Expected 3 calendar days from Friday to Monday, got %d", got)
+	}
+	if got := calendarDays.AddWorkingDays(friday, 2); !got.Equal(date("2024-06-16")) {
+		t.Errorf("Expected 2 calendar days after Friday to be Sunday, got %s", got.Format("2006-01-02"))
+	}
+}
+
+func TestFromConfig(t *testing.T) {
+	if FromConfig(nil).BusinessDaysOnly() {
+		t.Error("Expected business days to be off by default")
+	}
+
+	cfg := config.Default()
+	cfg.BusinessDaysOnly = true
+	cfg.Holidays = []string{"2024-12-25"}
+	cal := FromConfig(cfg)
+	if cal.IsWorkingDay(date("2024-12-25")) {
+		t.Error("Expected configured holiday to be a non-working day")
+	}
+}
@@ -9,11 +9,41 @@ import (
 	"log/slog"
 	"net/http"
 	"time"
+
+	"github.com/bchamber/taskman-mcp/internal/logging"
 )
 
 type APIClient struct {
 	baseURL    string
 	httpClient *http.Client
+
+	// Outbound request logging
+	logRequests    bool
+	logBodyMaxSize int
+
+	// Per-endpoint call metrics
+	metrics *endpointMetrics
+
+	// Tenant for requests whose context names none; "" is single-tenant
+	defaultTenant string
+
+	// List pagination following; "" or PaginationNone reads the first page only
+	paginationStyle string
+	maxPages        int
+
+	// Retries of transient failures; 0 makes a single attempt
+	maxRetries   int
+	retryBackoff time.Duration
+
+	// Fails requests fast while the API keeps failing; nil disables
+	breaker *circuitBreaker
+
+	// GET response cache; nil disables
+	cache *responseCache
+
+	// Credentials sent on every request; no header when authToken is ""
+	authToken  string
+	authHeader string
 }
 
 type APIError struct {
@@ -34,11 +64,57 @@ func NewAPIClient(baseURL string, timeout time.Duration) *APIClient {
 		httpClient: &http.Client{
 			Timeout: timeout,
 		},
+		metrics: newEndpointMetrics(),
 	}
 }
 
+// SetAuth sends token on every request: as a bearer token in the
+// Authorization header when header is "", otherwise as the value of header,
+// e.g. X-API-Key. An empty token sends no credentials.
+func (c *APIClient) SetAuth(token, header string) {
+	c.authToken = token
+	c.authHeader = header
+}
+
+// authenticate adds the configured credentials to req
+func (c *APIClient) authenticate(req *http.Request) {
+	switch {
+	case c.authToken == "":
+	case c.authHeader == "":
+		req.Header.Set("Authorization", "Bearer "+c.authToken)
+	default:
+		req.Header.Set(c.authHeader, c.authToken)
+	}
+}
+
+// EnableRequestLogging turns on Debug-level logging of every outbound request
+// (method, path, status, duration, redacted headers and truncated bodies)
+func (c *APIClient) EnableRequestLogging(maxBodyBytes int) {
+	c.logRequests = true
+	c.logBodyMaxSize = maxBodyBytes
+}
+
+// SetMetricsRecorder forwards per-endpoint call counts, error counts and
+// latencies to recorder in addition to the client's own EndpointStats
+func (c *APIClient) SetMetricsRecorder(recorder MetricsRecorder) {
+	c.metrics.mutex.Lock()
+	defer c.metrics.mutex.Unlock()
+	c.metrics.recorder = recorder
+}
+
+// EndpointStats returns call metrics keyed by "METHOD /normalized/path"
+func (c *APIClient) EndpointStats() map[string]EndpointStats {
+	return c.metrics.snapshot()
+}
+
+// Get fetches path, following list pagination when it is enabled. Pages
+// beyond the cap are dropped with a warning; use GetList to detect that.
 func (c *APIClient) Get(ctx context.Context, path string) ([]byte, error) {
-	return c.makeRequest(ctx, "GET", path, nil)
+	body, truncated, err := c.GetList(ctx, path)
+	if truncated {
+		slog.Warn("List response has more pages than the page cap", "path", path, "max_pages", c.maxPages)
+	}
+	return body, err
 }
 
 func (c *APIClient) Post(ctx context.Context, path string, body interface{}) ([]byte, error) {
@@ -54,16 +130,44 @@ func (c *APIClient) Delete(ctx context.Context, path string) ([]byte, error) {
 }
 
 func (c *APIClient) makeRequest(ctx context.Context, method, path string, body interface{}) ([]byte, error) {
+	respBody, _, err := c.doRequest(ctx, method, path, body)
+	// Even a failed write may have been applied
+	if c.cache != nil && method != "GET" {
+		c.cache.invalidate(path)
+	}
+	return respBody, err
+}
+
+// attemptRequest performs one request, returning the response body and
+// headers. The request is bounded by both ctx and the client timeout, so a
+// context deadline shorter than the timeout cancels it early.
+func (c *APIClient) attemptRequest(ctx context.Context, method, path string, body interface{}) ([]byte, http.Header, error) {
+	// A budget already spent fails without contacting the API
+	if err := ctx.Err(); err != nil {
+		return nil, nil, fmt.Errorf("request not sent: %w", err)
+	}
+
 	url := c.baseURL + path
 
+	// Scope task and project data to the caller's tenant
+	tenantKey := c.tenantFor(ctx)
+	if tenantKey != "" {
+		url = c.baseURL + scopePath(path, tenantKey)
+		if body != nil {
+			body = stampTenant(body, tenantKey)
+		}
+	}
+
 	slog.Info("Making API request", "method", method, "url", url)
 
 	var reqBody io.Reader
+	var jsonBody []byte
 	if body != nil {
-		jsonBody, err := json.Marshal(body)
+		var err error
+		jsonBody, err = json.Marshal(body)
 		if err != nil {
 			slog.Error("Failed to marshal request body", "error", err)
-			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+			return nil, nil, fmt.Errorf("failed to marshal request body: %w", err)
 		}
 		reqBody = bytes.NewReader(jsonBody)
 		slog.Debug("Request body", "body", string(jsonBody))
@@ -72,24 +176,49 @@ func (c *APIClient) makeRequest(ctx context.Context, method, path string, body i
 	req, err := http.NewRequestWithContext(ctx, method, url, reqBody)
 	if err != nil {
 		slog.Error("Failed to create HTTP request", "error", err)
-		return nil, fmt.Errorf("failed to create request: %w", err)
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	// Set explicitly, the transport leaves decompression to decodeBody
+	req.Header.Set("Accept-Encoding", "gzip")
+	c.authenticate(req)
+
+	// Fail fast while the API is known to be down
+	if c.breaker != nil {
+		if err := c.breaker.allow(); err != nil {
+			slog.Warn("API request rejected by circuit breaker", "method", method, "path", path)
+			return nil, nil, err
+		}
+	}
 
+	start := time.Now()
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
+		c.recordOutcome(ctx, true)
+		c.metrics.record(method, path, time.Since(start), true)
 		slog.Error("HTTP request failed", "error", err)
-		return nil, fmt.Errorf("request failed: %w", err)
+		return nil, nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
+	c.recordOutcome(ctx, err != nil || resp.StatusCode >= 500)
+	c.metrics.record(method, path, time.Since(start), err != nil || resp.StatusCode >= 400)
 	if err != nil {
 		slog.Error("Failed to read response body", "error", err)
-		return nil, fmt.Errorf("failed to read response: %w", err)
+		return nil, nil, fmt.Errorf("failed to read response: %w", err)
+	}
+	respBody, err = decodeBody(resp.Header, respBody)
+	if err != nil {
+		slog.Error("Failed to decode response body", "error", err, "content_encoding", resp.Header.Get("Content-Encoding"))
+		return nil, nil, err
+	}
+
+	if c.logRequests {
+		c.logExchange(req, path, resp.StatusCode, time.Since(start), jsonBody, respBody)
 	}
 
 	slog.Info("API request completed",
@@ -102,13 +231,51 @@ func (c *APIClient) makeRequest(ctx context.Context, method, path string, body i
 			"status_code", resp.StatusCode,
 			"response", string(respBody),
 		)
-		return nil, &APIError{
+		return nil, nil, &APIError{
 			StatusCode: resp.StatusCode,
 			Message:    http.StatusText(resp.StatusCode),
 			Response:   string(respBody),
 		}
 	}
 
+	if tenantKey != "" && method == "GET" && isTenantScopedPath(path) && !c.isPageEnvelope(respBody) {
+		respBody, err = filterTenant(respBody, tenantKey)
+		if err != nil {
+			slog.Warn("Hid record belonging to another tenant", "path", path, "tenant", tenantKey)
+			return nil, nil, err
+		}
+	}
+
 	slog.Debug("Response body", "body", string(respBody))
-	return respBody, nil
+	return respBody, resp.Header, nil
+}
+
+// recordOutcome reports a sent request's outcome to the circuit breaker.
+// Requests ended by their own context say nothing about the API's health.
+func (c *APIClient) recordOutcome(ctx context.Context, failed bool) {
+	if c.breaker == nil {
+		return
+	}
+	if failed && ctx.Err() != nil {
+		c.breaker.release()
+		return
+	}
+	c.breaker.record(failed)
+}
+
+// logExchange writes a Debug-level record of an outbound request and its response
+func (c *APIClient) logExchange(req *http.Request, path string, status int, duration time.Duration, reqBody, respBody []byte) {
+	attrs := []any{
+		"method", req.Method,
+		"path", path,
+		"status", status,
+		"duration_ms", duration.Milliseconds(),
+		"headers", logging.RedactHeaders(req.Header, c.authHeader),
+	}
+	if len(reqBody) > 0 {
+		attrs = append(attrs, "request_body", logging.JSONPayload(reqBody, c.logBodyMaxSize))
+	}
+	attrs = append(attrs, "response_body", logging.JSONPayload(respBody, c.logBodyMaxSize))
+
+	slog.Debug("API request logged", attrs...)
 }
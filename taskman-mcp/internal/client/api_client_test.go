@@ -1,11 +1,17 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
+
+	"github.com/bchamber/taskman-mcp/internal/tenant"
 )
 
 func TestNewAPIClient(t *testing.T) {
@@ -202,3 +208,247 @@ func TestAPIError_Error(t *testing.T) {
 		t.Errorf("Expected error message %s, got %s", expectedMessage, err.Error())
 	}
 }
+
+func TestAPIClient_RequestLogging(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"message": "success"}`))
+	}))
+	defer server.Close()
+
+	var buf bytes.Buffer
+	original := slog.Default()
+	slog.SetDefault(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	defer slog.SetDefault(original)
+
+	client := NewAPIClient(server.URL, 5*time.Second)
+
+	// Disabled by default
+	if _, err := client.Get(context.Background(), "/api/v1/tasks"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if strings.Contains(buf.String(), "API request logged") {
+		t.Error("Expected no request log line when logging is disabled")
+	}
+
+	buf.Reset()
+	client.EnableRequestLogging(1024)
+	if _, err := client.Get(context.Background(), "/api/v1/tasks"); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	var logLine string
+	for _, line := range strings.Split(buf.String(), "\n") {
+		if strings.Contains(line, "API request logged") {
+			logLine = line
+			break
+		}
+	}
+	if logLine == "" {
+		t.Fatalf("Expected request log line, got: %s", buf.String())
+	}
+	for _, expected := range []string{"level=DEBUG", "method=GET", "path=/api/v1/tasks", "status=200", "duration_ms="} {
+		if !strings.Contains(logLine, expected) {
+			t.Errorf("Expected log line to contain %q, got: %s", expected, logLine)
+		}
+	}
+}
+
+func TestAPIClient_Auth(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		want   func(r *http.Request) bool
+	}{
+		{"bearer token", "", func(r *http.Request) bool { return r.Header.Get("Authorization") == "Bearer s3cret-token" }},
+		{"api key header", "X-Taskman-Key", func(r *http.Request) bool { return r.Header.Get("X-Taskman-Key") == "s3cret-token" }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if !tt.want(r) {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				w.Write([]byte(`{"ok": true}`))
+			}))
+			defer server.Close()
+
+			var buf bytes.Buffer
+			original := slog.Default()
+			slog.SetDefault(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+			defer slog.SetDefault(original)
+
+			client := NewAPIClient(server.URL, 5*time.Second)
+			client.EnableRequestLogging(1024)
+
+			_, err := client.Get(context.Background(), "/api/v1/tasks")
+			if apiErr, ok := err.(*APIError); !ok || apiErr.StatusCode != http.StatusUnauthorized {
+				t.Fatalf("Expected 401 without credentials, got %v", err)
+			}
+
+			client.SetAuth("s3cret-token", tt.header)
+			if _, err := client.Get(context.Background(), "/api/v1/tasks"); err != nil {
+				t.Errorf("Expected GET to pass with credentials, got %v", err)
+			}
+			if _, err := client.Post(context.Background(), "/api/v1/tasks", map[string]string{}); err != nil {
+				t.Errorf("Expected POST to pass with credentials, got %v", err)
+			}
+			if _, err := client.Put(context.Background(), "/api/v1/tasks/t1", map[string]string{}); err != nil {
+				t.Errorf("Expected PUT to pass with credentials, got %v", err)
+			}
+
+			if strings.Contains(buf.String(), "s3cret-token") {
+				t.Errorf("Expected the token to be redacted from logs, got:\n%s", buf.String())
+			}
+			if !strings.Contains(buf.String(), "[REDACTED]") {
+				t.Errorf("Expected the credential header to be logged as redacted, got:\n%s", buf.String())
+			}
+		})
+	}
+}
+
+type fakeRecorder struct {
+	counters  map[string]int
+	latencies map[string]int
+}
+
+func (f *fakeRecorder) IncrementCounter(metricName string) {
+	f.counters[metricName]++
+}
+
+func (f *fakeRecorder) RecordLatency(metricName string, duration time.Duration) {
+	f.latencies[metricName]++
+}
+
+func TestAPIClient_EndpointMetrics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/tasks/missing" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	recorder := &fakeRecorder{counters: map[string]int{}, latencies: map[string]int{}}
+	client := NewAPIClient(server.URL, 5*time.Second)
+	client.SetMetricsRecorder(recorder)
+
+	client.Get(context.Background(), "/api/v1/tasks/task-1")
+	client.Get(context.Background(), "/api/v1/tasks/task-2")
+	client.Get(context.Background(), "/api/v1/tasks/missing")
+	client.Get(context.Background(), "/api/v1/tasks/task-1/notes")
+	client.Get(context.Background(), "/api/v1/tasks?status=Blocked")
+
+	stats := client.EndpointStats()
+	task := stats["GET /api/v1/tasks/{id}"]
+	if task.Calls != 3 || task.Errors != 1 {
+		t.Errorf("Expected 3 calls and 1 error for GET /api/v1/tasks/{id}, got %+v", task)
+	}
+	var bucketed int64
+	for _, count := range task.LatencyBuckets {
+		bucketed += count
+	}
+	if bucketed != 3 {
+		t.Errorf("Expected 3 latency observations, got %d", bucketed)
+	}
+	if stats["GET /api/v1/tasks/{id}/notes"].Calls != 1 {
+		t.Errorf("Expected notes endpoint to be tracked separately, got %+v", stats)
+	}
+	if stats["GET /api/v1/tasks"].Calls != 1 {
+		t.Errorf("Expected query string to be dropped from the endpoint, got %+v", stats)
+	}
+
+	if recorder.counters["api_client_get_tasks_id_calls"] != 3 || recorder.counters["api_client_get_tasks_id_errors"] != 1 {
+		t.Errorf("Expected recorder counters for normalized endpoint, got %v", recorder.counters)
+	}
+	if recorder.latencies["api_client_get_tasks_id"] != 3 {
+		t.Errorf("Expected recorder latencies for normalized endpoint, got %v", recorder.latencies)
+	}
+}
+
+func TestEndpointTemplate(t *testing.T) {
+	tests := map[string]string{
+		"/api/v1/tasks":                 "/api/v1/tasks",
+		"/api/v1/tasks/":                "/api/v1/tasks",
+		"/api/v1/tasks/abc-123":         "/api/v1/tasks/{id}",
+		"/api/v1/tasks/abc-123/notes":   "/api/v1/tasks/{id}/notes",
+		"/api/v1/tasks/abc/notes/n1":    "/api/v1/tasks/{id}/notes/{id}",
+		"/api/v1/projects/p1/tasks?x=1": "/api/v1/projects/{id}/tasks",
+		"/health":                       "/health",
+	}
+
+	for path, want := range tests {
+		if got := endpointTemplate(path); got != want {
+			t.Errorf("endpointTemplate(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
+
+func TestAPIClient_TenantScoping(t *testing.T) {
+	var queries []string
+	var posted map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		switch {
+		case r.Method == "POST":
+			json.NewDecoder(r.Body).Decode(&posted)
+			w.Write([]byte(`{"task_id":"t9","tenant":"team-a"}`))
+		case r.URL.Path == "/api/v1/tasks/t2":
+			w.Write([]byte(`{"task_id":"t2","tenant":"team-b"}`))
+		case r.URL.Path == "/api/v1/tasks/t1/notes":
+			w.Write([]byte(`[{"note_id":"n1"}]`))
+		default:
+			w.Write([]byte(`[{"task_id":"t1","tenant":"team-a"},{"task_id":"t2","tenant":"team-b"},{"task_id":"t3"}]`))
+		}
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(server.URL, 5*time.Second)
+	client.SetTenant("team-a")
+	ctx := context.Background()
+
+	body, err := client.Get(ctx, "/api/v1/tasks?status=Blocked")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if string(body) != `[{"task_id":"t1","tenant":"team-a"}]` {
+		t.Errorf("Expected only team-a records, got %s", body)
+	}
+	if queries[0] != "status=Blocked&tenant=team-a" {
+		t.Errorf("Expected tenant filter appended to query, got %q", queries[0])
+	}
+
+	if _, err := client.Get(ctx, "/api/v1/tasks/t2"); err == nil {
+		t.Error("Expected another tenant's task to be reported as not found")
+	} else if apiErr, ok := err.(*APIError); !ok || apiErr.StatusCode != http.StatusNotFound {
+		t.Errorf("Expected 404 APIError, got %v", err)
+	}
+
+	// Notes carry no tenant and are reached through an already-scoped task
+	if body, err := client.Get(ctx, "/api/v1/tasks/t1/notes"); err != nil || string(body) != `[{"note_id":"n1"}]` {
+		t.Errorf("Expected notes untouched, got %s (%v)", body, err)
+	}
+
+	payload := map[string]interface{}{"task_name": "New"}
+	if _, err := client.Post(ctx, "/api/v1/tasks", payload); err != nil {
+		t.Fatalf("Post failed: %v", err)
+	}
+	if posted["tenant"] != "team-a" {
+		t.Errorf("Expected tenant stamped on payload, got %v", posted)
+	}
+	if _, stamped := payload["tenant"]; stamped {
+		t.Error("Expected caller's payload map not to be modified")
+	}
+
+	// The context tenant overrides the client default
+	queries = nil
+	if _, err := client.Get(tenant.WithTenant(ctx, "team-b"), "/api/v1/tasks"); err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	if queries[0] != "tenant=team-b" {
+		t.Errorf("Expected context tenant to win, got %q", queries[0])
+	}
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// ErrAPIUnavailable is returned without contacting the API while the
+// circuit breaker is open
+var ErrAPIUnavailable = errors.New("API temporarily unavailable")
+
+// Circuit breaker states
+const (
+	circuitClosed   = "closed"
+	circuitOpen     = "open"
+	circuitHalfOpen = "half-open"
+)
+
+// circuitBreaker stops requests to an API that keeps failing. After
+// threshold consecutive failures it opens and rejects requests for cooldown,
+// then half-opens to let a single probe through: success closes it again,
+// failure reopens it for another cooldown.
+type circuitBreaker struct {
+	mutex     sync.Mutex
+	threshold int
+	cooldown  time.Duration
+	state     string
+	failures  int
+	openedAt  time.Time
+	probing   bool
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{threshold: threshold, cooldown: cooldown, state: circuitClosed}
+}
+
+// SetCircuitBreaker makes the client fail fast with ErrAPIUnavailable for
+// cooldown after threshold consecutive failed requests. A threshold of 0
+// disables the breaker.
+func (c *APIClient) SetCircuitBreaker(threshold int, cooldown time.Duration) {
+	if threshold <= 0 {
+		c.breaker = nil
+		return
+	}
+	c.breaker = newCircuitBreaker(threshold, cooldown)
+}
+
+// allow reports whether a request may be sent, returning the error to fail
+// fast with when it may not
+func (b *circuitBreaker) allow() error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	switch b.state {
+	case circuitOpen:
+		remaining := b.cooldown - time.Since(b.openedAt)
+		if remaining > 0 {
+			return fmt.Errorf("%w: %d consecutive requests failed, retrying in %s",
+				ErrAPIUnavailable, b.failures, remaining.Round(time.Millisecond))
+		}
+		b.state = circuitHalfOpen
+		slog.Info("Circuit breaker half-open, probing API")
+		fallthrough
+	case circuitHalfOpen:
+		if b.probing {
+			return fmt.Errorf("%w: waiting for a probe request to confirm recovery", ErrAPIUnavailable)
+		}
+		b.probing = true
+	}
+	return nil
+}
+
+// record updates the breaker with the outcome of an allowed request
+func (b *circuitBreaker) record(failed bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.probing = false
+	if !failed {
+		if b.state != circuitClosed {
+			slog.Info("Circuit breaker closed, API recovered")
+		}
+		b.state = circuitClosed
+		b.failures = 0
+		return
+	}
+
+	b.failures++
+	if b.state == circuitHalfOpen || b.failures >= b.threshold {
+		if b.state != circuitOpen {
+			slog.Warn("Circuit breaker opened, failing API requests fast", "consecutive_failures", b.failures, "cooldown", b.cooldown)
+		}
+		b.state = circuitOpen
+		b.openedAt = time.Now()
+	}
+}
+
+// release ends an allowed request whose outcome says nothing about the API's
+// health, such as one cancelled by its caller
+func (b *circuitBreaker) release() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	b.probing = false
+}
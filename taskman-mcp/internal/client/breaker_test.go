@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAPIClient_CircuitBreaker(t *testing.T) {
+	var down atomic.Bool
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		if down.Load() {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Write([]byte(`{"ok": true}`))
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(server.URL, 5*time.Second)
+	client.SetCircuitBreaker(3, 100*time.Millisecond)
+	get := func() error {
+		_, err := client.Get(context.Background(), "/api/v1/tasks")
+		return err
+	}
+
+	// Consecutive failures open the breaker
+	down.Store(true)
+	for i := 0; i < 3; i++ {
+		if err := get(); err == nil || errors.Is(err, ErrAPIUnavailable) {
+			t.Fatalf("Expected request %d to reach the failing API, got %v", i+1, err)
+		}
+	}
+
+	// While open, requests fail fast without reaching the API
+	start := time.Now()
+	err := get()
+	if !errors.Is(err, ErrAPIUnavailable) {
+		t.Fatalf("Expected ErrAPIUnavailable while open, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 50*time.Millisecond {
+		t.Errorf("Expected an open breaker to fail fast, took %v", elapsed)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("Expected no request sent while open, got %d calls", calls.Load())
+	}
+
+	// A failed probe after the cooldown reopens it straight away
+	time.Sleep(120 * time.Millisecond)
+	if err := get(); err == nil || errors.Is(err, ErrAPIUnavailable) {
+		t.Fatalf("Expected the probe to reach the API, got %v", err)
+	}
+	if err := get(); !errors.Is(err, ErrAPIUnavailable) {
+		t.Fatalf("Expected a failed probe to reopen the breaker, got %v", err)
+	}
+
+	// A successful probe closes it
+	down.Store(false)
+	time.Sleep(120 * time.Millisecond)
+	for i := 0; i < 3; i++ {
+		if err := get(); err != nil {
+			t.Fatalf("Expected request %d to succeed after recovery, got %v", i+1, err)
+		}
+	}
+	if calls.Load() != 7 {
+		t.Errorf("Expected 7 requests to reach the API, got %d", calls.Load())
+	}
+}
+
+func TestAPIClient_CircuitBreakerIgnoresClientErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(server.URL, 5*time.Second)
+	client.SetCircuitBreaker(2, time.Minute)
+
+	for i := 0; i < 5; i++ {
+		if _, err := client.Get(context.Background(), "/api/v1/tasks/missing"); errors.Is(err, ErrAPIUnavailable) {
+			t.Fatalf("Expected 404s not to open the breaker, got %v on request %d", err, i+1)
+		}
+	}
+}
+
+func TestCircuitBreaker_SingleProbe(t *testing.T) {
+	breaker := newCircuitBreaker(1, time.Millisecond)
+	breaker.record(true)
+	time.Sleep(5 * time.Millisecond)
+
+	if err := breaker.allow(); err != nil {
+		t.Fatalf("Expected a probe to be allowed after the cooldown, got %v", err)
+	}
+	if err := breaker.allow(); !errors.Is(err, ErrAPIUnavailable) {
+		t.Errorf("Expected other requests to wait for the probe, got %v", err)
+	}
+
+	// A probe cancelled by its caller lets the next request probe instead
+	breaker.release()
+	if err := breaker.allow(); err != nil {
+		t.Errorf("Expected a new probe after release, got %v", err)
+	}
+}
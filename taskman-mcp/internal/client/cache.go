@@ -0,0 +1,123 @@
+package client
+
+import (
+	"log/slog"
+	"strings"
+	"sync"
+	"time"
+)
+
+// relatedCollections lists, per API collection, the collections whose
+// responses embed its records and so go stale when it is written. Writes to
+// collections not listed here clear the whole cache.
+var relatedCollections = map[string][]string{
+	"tasks":    {"tasks", "projects"},
+	"projects": {"projects", "tasks"},
+}
+
+// responseCache keeps GET responses for a TTL, keyed by tenant and path.
+// Writes drop the entries of related collections; generation guards against
+// a read that started before a write caching what it fetched.
+type responseCache struct {
+	ttl time.Duration
+	now func() time.Time
+
+	mutex      sync.Mutex
+	entries    map[string]cachedResponse
+	generation uint64
+}
+
+type cachedResponse struct {
+	body      []byte
+	truncated bool
+	expiresAt time.Time
+}
+
+func newResponseCache(ttl time.Duration) *responseCache {
+	return &responseCache{ttl: ttl, now: time.Now, entries: make(map[string]cachedResponse)}
+}
+
+// SetResponseCache caches GET responses for ttl; POST, PUT and DELETE
+// requests drop the cached responses they may have changed. A ttl of 0
+// disables caching.
+func (c *APIClient) SetResponseCache(ttl time.Duration) {
+	if ttl <= 0 {
+		c.cache = nil
+		return
+	}
+	c.cache = newResponseCache(ttl)
+}
+
+// cacheKey scopes a path to a tenant so tenants never share responses
+func cacheKey(tenantKey, path string) string {
+	return tenantKey + "\x00" + path
+}
+
+// get returns an unexpired response for key
+func (rc *responseCache) get(key string) (cachedResponse, bool) {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	cached, ok := rc.entries[key]
+	if !ok {
+		return cachedResponse{}, false
+	}
+	if !rc.now().Before(cached.expiresAt) {
+		delete(rc.entries, key)
+		return cachedResponse{}, false
+	}
+	return cached, true
+}
+
+// begin returns the generation a read starts in, to pass to put
+func (rc *responseCache) begin() uint64 {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+	return rc.generation
+}
+
+// put stores a response unless a write happened since the read began
+func (rc *responseCache) put(key string, generation uint64, body []byte, truncated bool) {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	if generation != rc.generation {
+		return
+	}
+	rc.entries[key] = cachedResponse{body: body, truncated: truncated, expiresAt: rc.now().Add(rc.ttl)}
+}
+
+// invalidate drops the cached responses a write to path may have changed
+func (rc *responseCache) invalidate(path string) {
+	rc.mutex.Lock()
+	defer rc.mutex.Unlock()
+
+	rc.generation++
+	related, ok := relatedCollections[collectionOf(path)]
+	if !ok {
+		rc.entries = make(map[string]cachedResponse)
+		slog.Debug("Cleared API response cache", "path", path)
+		return
+	}
+	for key := range rc.entries {
+		_, cachedPath, _ := strings.Cut(key, "\x00")
+		for _, collection := range related {
+			if collectionOf(cachedPath) == collection {
+				delete(rc.entries, key)
+				break
+			}
+		}
+	}
+	slog.Debug("Invalidated cached API responses", "path", path, "collections", related)
+}
+
+// collectionOf returns the collection an API path belongs to, e.g. "tasks"
+// for /api/v1/tasks/task-1/notes
+func collectionOf(path string) string {
+	path, _, _ = strings.Cut(path, "?")
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	if len(segments) >= 3 && segments[0] == "api" {
+		return segments[2]
+	}
+	return segments[0]
+}
@@ -0,0 +1,153 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bchamber/taskman-mcp/internal/tenant"
+)
+
+// newCountingServer answers every request with [] and counts GETs per path
+func newCountingServer(t *testing.T) (*httptest.Server, func(path string) int) {
+	t.Helper()
+	var mutex sync.Mutex
+	gets := make(map[string]int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			mutex.Lock()
+			gets[r.URL.Path]++
+			mutex.Unlock()
+		}
+		w.Write([]byte(`[]`))
+	}))
+	return server, func(path string) int {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return gets[path]
+	}
+}
+
+func TestAPIClient_ResponseCache(t *testing.T) {
+	server, gets := newCountingServer(t)
+	defer server.Close()
+
+	client := NewAPIClient(server.URL, 5*time.Second)
+	client.SetResponseCache(time.Minute)
+	ctx := context.Background()
+
+	for _, path := range []string{"/api/v1/tasks", "/api/v1/projects/p1/tasks", "/api/v1/users", "/api/v1/tasks"} {
+		if _, err := client.Get(ctx, path); err != nil {
+			t.Fatalf("Get %s failed: %v", path, err)
+		}
+	}
+	if gets("/api/v1/tasks") != 1 {
+		t.Errorf("Expected the second GET within the TTL to hit the cache, got %d fetches", gets("/api/v1/tasks"))
+	}
+
+	// A task write drops cached tasks and project task lists but not users
+	if _, err := client.Post(ctx, "/api/v1/tasks", map[string]string{"task_name": "New"}); err != nil {
+		t.Fatalf("Post failed: %v", err)
+	}
+	for _, path := range []string{"/api/v1/tasks", "/api/v1/projects/p1/tasks", "/api/v1/users"} {
+		if _, err := client.Get(ctx, path); err != nil {
+			t.Fatalf("Get %s failed: %v", path, err)
+		}
+	}
+	if gets("/api/v1/tasks") != 2 || gets("/api/v1/projects/p1/tasks") != 2 {
+		t.Errorf("Expected the POST to invalidate tasks and project tasks, got %d and %d fetches",
+			gets("/api/v1/tasks"), gets("/api/v1/projects/p1/tasks"))
+	}
+	if gets("/api/v1/users") != 1 {
+		t.Errorf("Expected unrelated users to stay cached, got %d fetches", gets("/api/v1/users"))
+	}
+
+	// Health checks are never cached
+	client.Get(ctx, "/health")
+	client.Get(ctx, "/health")
+	if gets("/health") != 2 {
+		t.Errorf("Expected every health check to reach the API, got %d", gets("/health"))
+	}
+
+	// Tenants never share cached responses
+	client.Get(tenant.WithTenant(ctx, "acme"), "/api/v1/users")
+	if gets("/api/v1/users") != 2 {
+		t.Errorf("Expected another tenant to fetch its own response, got %d fetches", gets("/api/v1/users"))
+	}
+}
+
+func TestAPIClient_ResponseCacheExpires(t *testing.T) {
+	server, gets := newCountingServer(t)
+	defer server.Close()
+
+	client := NewAPIClient(server.URL, 5*time.Second)
+	client.SetResponseCache(time.Minute)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	client.cache.now = func() time.Time { return now }
+
+	client.Get(context.Background(), "/api/v1/projects")
+	now = now.Add(59 * time.Second)
+	client.Get(context.Background(), "/api/v1/projects")
+	now = now.Add(time.Second)
+	client.Get(context.Background(), "/api/v1/projects")
+
+	if gets("/api/v1/projects") != 2 {
+		t.Errorf("Expected a refetch once the TTL passed, got %d fetches", gets("/api/v1/projects"))
+	}
+}
+
+func TestAPIClient_ResponseCacheDisabled(t *testing.T) {
+	server, gets := newCountingServer(t)
+	defer server.Close()
+
+	client := NewAPIClient(server.URL, 5*time.Second)
+	client.SetResponseCache(0)
+	client.Get(context.Background(), "/api/v1/tasks")
+	client.Get(context.Background(), "/api/v1/tasks")
+
+	if gets("/api/v1/tasks") != 2 {
+		t.Errorf("Expected no caching with a zero TTL, got %d fetches", gets("/api/v1/tasks"))
+	}
+}
+
+func TestResponseCache_WriteDuringRead(t *testing.T) {
+	cache := newResponseCache(time.Minute)
+	key := cacheKey("", "/api/v1/tasks")
+
+	// A read that began before a write must not cache what it fetched
+	generation := cache.begin()
+	cache.invalidate("/api/v1/tasks/t1")
+	cache.put(key, generation, []byte(`[]`), false)
+	if _, ok := cache.get(key); ok {
+		t.Error("Expected a response fetched before a write not to be cached")
+	}
+
+	cache.put(key, cache.begin(), []byte(`[]`), false)
+	if _, ok := cache.get(key); !ok {
+		t.Error("Expected a response fetched after the write to be cached")
+	}
+
+	// Writes outside known collections clear everything
+	cache.invalidate("/api/v1/imports")
+	if _, ok := cache.get(key); ok {
+		t.Error("Expected an unknown collection write to clear the cache")
+	}
+}
+
+func TestCollectionOf(t *testing.T) {
+	tests := map[string]string{
+		"/api/v1/tasks":                 "tasks",
+		"/api/v1/tasks/t1/notes/n1":     "tasks",
+		"/api/v1/projects/p1/tasks?x=1": "projects",
+		"/api/v1/projects?tenant=acme":  "projects",
+		"/health":                       "health",
+	}
+	for path, want := range tests {
+		if got := collectionOf(path); got != want {
+			t.Errorf("collectionOf(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
@@ -0,0 +1,33 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// decodeBody decompresses a response body according to its Content-Encoding.
+// Bodies without an encoding, or with identity, are returned unchanged.
+func decodeBody(header http.Header, body []byte) ([]byte, error) {
+	encoding := strings.ToLower(strings.TrimSpace(header.Get("Content-Encoding")))
+	switch encoding {
+	case "", "identity":
+		return body, nil
+	case "gzip":
+		reader, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress gzip response: %w", err)
+		}
+		defer reader.Close()
+		decoded, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress gzip response: %w", err)
+		}
+		return decoded, nil
+	default:
+		return nil, fmt.Errorf("unsupported response Content-Encoding %q", encoding)
+	}
+}
@@ -0,0 +1,86 @@
+package client
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestAPIClient_DecompressesGzipResponses(t *testing.T) {
+	tasks := `[{"task_id": "t1", "task_name": "Ship it"}, {"task_id": "t2", "task_name": "Celebrate"}]`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Accept-Encoding") != "gzip" {
+			t.Errorf("Expected Accept-Encoding: gzip, got %q", r.Header.Get("Accept-Encoding"))
+		}
+		switch r.URL.Path {
+		case "/api/v1/tasks":
+			w.Header().Set("Content-Encoding", "gzip")
+			writer := gzip.NewWriter(w)
+			writer.Write([]byte(tasks))
+			writer.Close()
+		case "/api/v1/projects":
+			w.Write([]byte(`[{"project_id": "p1"}]`))
+		case "/api/v1/broken":
+			w.Header().Set("Content-Encoding", "gzip")
+			w.Write([]byte("not gzip"))
+		}
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(server.URL, 5*time.Second)
+
+	body, err := client.Get(context.Background(), "/api/v1/tasks")
+	if err != nil {
+		t.Fatalf("Get failed: %v", err)
+	}
+	var parsed []struct {
+		TaskID   string `json:"task_id"`
+		TaskName string `json:"task_name"`
+	}
+	if err := json.Unmarshal(body, &parsed); err != nil {
+		t.Fatalf("Expected decompressed JSON, got %q: %v", body, err)
+	}
+	if len(parsed) != 2 || parsed[1].TaskName != "Celebrate" {
+		t.Errorf("Unexpected tasks: %+v", parsed)
+	}
+
+	// Uncompressed responses pass through
+	body, err = client.Get(context.Background(), "/api/v1/projects")
+	if err != nil || string(body) != `[{"project_id": "p1"}]` {
+		t.Errorf("Expected plain response unchanged, got %q (%v)", body, err)
+	}
+
+	_, err = client.Get(context.Background(), "/api/v1/broken")
+	if err == nil || !strings.Contains(err.Error(), "failed to decompress gzip response") {
+		t.Errorf("Expected a clear decompression error, got %v", err)
+	}
+}
+
+func TestDecodeBody(t *testing.T) {
+	var compressed bytes.Buffer
+	writer := gzip.NewWriter(&compressed)
+	writer.Write([]byte(`{"ok": true}`))
+	writer.Close()
+
+	header := http.Header{}
+	header.Set("Content-Encoding", "GZIP")
+	if decoded, err := decodeBody(header, compressed.Bytes()); err != nil || string(decoded) != `{"ok": true}` {
+		t.Errorf("Expected case-insensitive gzip decoding, got %q (%v)", decoded, err)
+	}
+
+	header.Set("Content-Encoding", "identity")
+	if decoded, err := decodeBody(header, []byte("plain")); err != nil || string(decoded) != "plain" {
+		t.Errorf("Expected identity body unchanged, got %q (%v)", decoded, err)
+	}
+
+	header.Set("Content-Encoding", "br")
+	if _, err := decodeBody(header, []byte("x")); err == nil || !strings.Contains(err.Error(), "unsupported") {
+		t.Errorf("Expected an unsupported encoding error, got %v", err)
+	}
+}
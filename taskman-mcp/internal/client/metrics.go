@@ -0,0 +1,124 @@
+package client
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+// MetricsRecorder receives per-endpoint API call metrics; *monitoring.Monitor satisfies it
+type MetricsRecorder interface {
+	IncrementCounter(metricName string)
+	RecordLatency(metricName string, duration time.Duration)
+}
+
+// latencyBuckets are the upper bounds of the per-endpoint latency histogram
+var latencyBuckets = []time.Duration{
+	10 * time.Millisecond,
+	50 * time.Millisecond,
+	100 * time.Millisecond,
+	250 * time.Millisecond,
+	500 * time.Millisecond,
+	time.Second,
+	2500 * time.Millisecond,
+	5 * time.Second,
+}
+
+// EndpointStats is a snapshot of call metrics for one normalized endpoint
+type EndpointStats struct {
+	Calls  int64
+	Errors int64
+	// LatencyBuckets counts calls at or under each latencyBuckets bound, with
+	// one extra trailing bucket for slower calls
+	LatencyBuckets []int64
+	TotalLatency   time.Duration
+}
+
+// endpointMetrics accumulates call metrics per "METHOD /path/{id}" key
+type endpointMetrics struct {
+	mutex     sync.Mutex
+	endpoints map[string]*EndpointStats
+	recorder  MetricsRecorder
+}
+
+func newEndpointMetrics() *endpointMetrics {
+	return &endpointMetrics{endpoints: make(map[string]*EndpointStats)}
+}
+
+// record adds one call to the endpoint's counters and histogram
+func (m *endpointMetrics) record(method, path string, duration time.Duration, failed bool) {
+	template := endpointTemplate(path)
+	key := method + " " + template
+
+	m.mutex.Lock()
+	stats, ok := m.endpoints[key]
+	if !ok {
+		stats = &EndpointStats{LatencyBuckets: make([]int64, len(latencyBuckets)+1)}
+		m.endpoints[key] = stats
+	}
+	stats.Calls++
+	if failed {
+		stats.Errors++
+	}
+	stats.TotalLatency += duration
+	bucket := len(latencyBuckets)
+	for i, bound := range latencyBuckets {
+		if duration <= bound {
+			bucket = i
+			break
+		}
+	}
+	stats.LatencyBuckets[bucket]++
+	recorder := m.recorder
+	m.mutex.Unlock()
+
+	if recorder != nil {
+		name := metricName(method, template)
+		recorder.IncrementCounter(name + "_calls")
+		if failed {
+			recorder.IncrementCounter(name + "_errors")
+		}
+		recorder.RecordLatency(name, duration)
+	}
+}
+
+// snapshot copies the current per-endpoint stats
+func (m *endpointMetrics) snapshot() map[string]EndpointStats {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	result := make(map[string]EndpointStats, len(m.endpoints))
+	for key, stats := range m.endpoints {
+		copied := *stats
+		copied.LatencyBuckets = append([]int64(nil), stats.LatencyBuckets...)
+		result[key] = copied
+	}
+	return result
+}
+
+// endpointTemplate replaces resource IDs in an API path with {id} so metrics
+// stay bounded, e.g. /api/v1/tasks/task-1/notes becomes /api/v1/tasks/{id}/notes
+func endpointTemplate(path string) string {
+	if i := strings.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	path = strings.TrimSuffix(path, "/")
+
+	segments := strings.Split(strings.TrimPrefix(path, "/"), "/")
+	start := 0
+	if len(segments) >= 2 && segments[0] == "api" {
+		start = 2
+	}
+	for i := start + 1; i < len(segments); i += 2 {
+		segments[i] = "{id}"
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+// metricName turns a method and endpoint template into a flat metric name,
+// e.g. GET /api/v1/tasks/{id} becomes api_client_get_tasks_id
+func metricName(method, template string) string {
+	name := strings.TrimPrefix(template, "/api/v1")
+	name = strings.NewReplacer("{", "", "}", "", "/", "_", "-", "_").Replace(name)
+	return "api_client_" + strings.ToLower(method) + name
+}
@@ -0,0 +1,205 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Pagination styles the API may use for list responses
+const (
+	PaginationNone      = "none"
+	PaginationLink      = "link"       // JSON array body, Link header with rel="next"
+	PaginationNextField = "next_field" // {"items": [...], "next": "..."} envelope
+)
+
+// envelopeItemFields are the envelope fields checked, in order, for a page's items
+var envelopeItemFields = []string{"items", "data", "results"}
+
+// SetPagination makes GET requests follow list pages in the given style,
+// fetching at most maxPages pages per request
+func (c *APIClient) SetPagination(style string, maxPages int) {
+	c.paginationStyle = style
+	c.maxPages = maxPages
+}
+
+// GetList fetches path and, when pagination is enabled, follows its next
+// pages up to the page cap, concatenating their items into one JSON array.
+// truncated reports that more pages existed beyond the cap. Responses that
+// are not paginated are returned unchanged. Responses are served from the
+// response cache when it is enabled.
+func (c *APIClient) GetList(ctx context.Context, path string) (body []byte, truncated bool, err error) {
+	// Health and other non-API endpoints are always fetched live
+	if c.cache == nil || !strings.HasPrefix(path, "/api/") {
+		return c.getList(ctx, path)
+	}
+
+	key := cacheKey(c.tenantFor(ctx), path)
+	if cached, ok := c.cache.get(key); ok {
+		slog.Debug("Served API response from cache", "path", path)
+		return cached.body, cached.truncated, nil
+	}
+	generation := c.cache.begin()
+	body, truncated, err = c.getList(ctx, path)
+	if err == nil {
+		c.cache.put(key, generation, body, truncated)
+	}
+	return body, truncated, err
+}
+
+// getList fetches path and its following pages without the response cache
+func (c *APIClient) getList(ctx context.Context, path string) (body []byte, truncated bool, err error) {
+	if c.paginationStyle == "" || c.paginationStyle == PaginationNone {
+		body, err = c.makeRequest(ctx, "GET", path, nil)
+		return body, false, err
+	}
+
+	respBody, header, err := c.doRequest(ctx, "GET", path, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	items, next, ok := c.parsePage(respBody, header)
+	if !ok || (next == "" && c.paginationStyle == PaginationLink) {
+		return respBody, false, nil
+	}
+
+	pages := 1
+	current := path
+	for next != "" {
+		if pages >= c.maxPages {
+			return c.joinPages(ctx, path, items, true)
+		}
+		nextPath, ok := c.relativePath(current, next)
+		if !ok {
+			slog.Warn("Not following pagination link to another host", "path", path, "next", next)
+			return c.joinPages(ctx, path, items, true)
+		}
+
+		current = nextPath
+		respBody, header, err = c.doRequest(ctx, "GET", nextPath, nil)
+		if err != nil {
+			return nil, false, err
+		}
+		var pageItems []json.RawMessage
+		pageItems, next, ok = c.parsePage(respBody, header)
+		if !ok {
+			slog.Warn("Stopped following pagination at an unrecognised page", "path", nextPath)
+			return c.joinPages(ctx, path, items, true)
+		}
+		items = append(items, pageItems...)
+		pages++
+	}
+
+	slog.Debug("Followed list pagination", "path", path, "pages", pages, "items", len(items))
+	return c.joinPages(ctx, path, items, false)
+}
+
+// parsePage extracts a page's items and next link in the configured style.
+// ok is false when the body is not a page of a list.
+func (c *APIClient) parsePage(body []byte, header http.Header) (items []json.RawMessage, next string, ok bool) {
+	trimmed := bytes.TrimSpace(body)
+	switch c.paginationStyle {
+	case PaginationLink:
+		if !bytes.HasPrefix(trimmed, []byte("[")) || json.Unmarshal(trimmed, &items) != nil {
+			return nil, "", false
+		}
+		return items, nextLink(header.Values("Link")), true
+	case PaginationNextField:
+		var envelope map[string]json.RawMessage
+		if !bytes.HasPrefix(trimmed, []byte("{")) || json.Unmarshal(trimmed, &envelope) != nil {
+			return nil, "", false
+		}
+		if _, hasNext := envelope["next"]; !hasNext {
+			return nil, "", false
+		}
+		for _, field := range envelopeItemFields {
+			if raw, found := envelope[field]; found {
+				if json.Unmarshal(raw, &items) != nil {
+					return nil, "", false
+				}
+				// A null next marks the last page
+				json.Unmarshal(envelope["next"], &next)
+				return items, next, true
+			}
+		}
+	}
+	return nil, "", false
+}
+
+// nextLink returns the rel="next" target of RFC 8288 Link header values
+func nextLink(values []string) string {
+	for _, value := range values {
+		for _, link := range strings.Split(value, ",") {
+			parts := strings.Split(link, ";")
+			target := strings.TrimSpace(parts[0])
+			if !strings.HasPrefix(target, "<") || !strings.HasSuffix(target, ">") {
+				continue
+			}
+			for _, param := range parts[1:] {
+				name, value, _ := strings.Cut(strings.TrimSpace(param), "=")
+				if strings.EqualFold(name, "rel") && strings.EqualFold(strings.Trim(value, `"`), "next") {
+					return target[1 : len(target)-1]
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// relativePath turns a next-page reference, resolved against the page it
+// came from, into a path on the API, refusing links to other hosts
+func (c *APIClient) relativePath(current, next string) (string, bool) {
+	base, err := url.Parse(c.baseURL)
+	if err != nil {
+		return "", false
+	}
+	page, err := url.Parse(c.baseURL + current)
+	if err != nil {
+		return "", false
+	}
+	ref, err := url.Parse(next)
+	if err != nil {
+		return "", false
+	}
+	target := page.ResolveReference(ref)
+	if target.Host != base.Host {
+		return "", false
+	}
+	path := strings.TrimPrefix(target.Path, strings.TrimSuffix(base.Path, "/"))
+	if target.RawQuery != "" {
+		path += "?" + target.RawQuery
+	}
+	return path, true
+}
+
+// isPageEnvelope reports whether body is a next_field page envelope, whose
+// items are filtered by tenant once the pages are joined
+func (c *APIClient) isPageEnvelope(body []byte) bool {
+	if c.paginationStyle != PaginationNextField {
+		return false
+	}
+	_, _, ok := c.parsePage(body, nil)
+	return ok
+}
+
+// joinPages encodes the collected items as one JSON array, filtering by
+// tenant items that arrived in page envelopes
+func (c *APIClient) joinPages(ctx context.Context, path string, items []json.RawMessage, truncated bool) ([]byte, bool, error) {
+	if items == nil {
+		items = []json.RawMessage{}
+	}
+	body, err := json.Marshal(items)
+	if err != nil {
+		return nil, false, err
+	}
+	if tenantKey := c.tenantFor(ctx); tenantKey != "" && c.paginationStyle == PaginationNextField && isTenantScopedPath(path) {
+		if body, err = filterTenant(body, tenantKey); err != nil {
+			return nil, false, err
+		}
+	}
+	return body, truncated, nil
+}
@@ -0,0 +1,169 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+// newPagedServer serves three pages of two tasks each in the given style
+func newPagedServer(t *testing.T, style string) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v1/tasks/t1" {
+			json.NewEncoder(w).Encode(map[string]string{"task_id": "t1"})
+			return
+		}
+
+		page := 1
+		if value := r.URL.Query().Get("page"); value != "" {
+			page, _ = strconv.Atoi(value)
+		}
+		items := []map[string]string{
+			{"task_id": fmt.Sprintf("t%d", page*2-1)},
+			{"task_id": fmt.Sprintf("t%d", page*2)},
+		}
+
+		var next any
+		if page < 3 {
+			next = fmt.Sprintf("/api/v1/tasks?page=%d", page+1)
+		}
+		switch style {
+		case PaginationLink:
+			if next != nil {
+				w.Header().Set("Link", fmt.Sprintf(`<%s%s>; rel="next", </api/v1/tasks?page=1>; rel="first"`, "http://"+r.Host, next))
+			}
+			json.NewEncoder(w).Encode(items)
+		case PaginationNextField:
+			json.NewEncoder(w).Encode(map[string]any{"items": items, "next": next})
+		}
+	}))
+}
+
+func taskIDs(t *testing.T, body []byte) []string {
+	t.Helper()
+	var tasks []struct {
+		TaskID string `json:"task_id"`
+	}
+	if err := json.Unmarshal(body, &tasks); err != nil {
+		t.Fatalf("Expected a JSON array, got %s: %v", body, err)
+	}
+	ids := make([]string, len(tasks))
+	for i, task := range tasks {
+		ids[i] = task.TaskID
+	}
+	return ids
+}
+
+func TestAPIClient_GetListFollowsPages(t *testing.T) {
+	for _, style := range []string{PaginationLink, PaginationNextField} {
+		t.Run(style, func(t *testing.T) {
+			server := newPagedServer(t, style)
+			defer server.Close()
+
+			client := NewAPIClient(server.URL, 5*time.Second)
+			client.SetPagination(style, 10)
+
+			body, truncated, err := client.GetList(context.Background(), "/api/v1/tasks")
+			if err != nil {
+				t.Fatalf("GetList failed: %v", err)
+			}
+			if truncated {
+				t.Error("Expected all pages to fit under the cap")
+			}
+			ids := taskIDs(t, body)
+			if fmt.Sprint(ids) != "[t1 t2 t3 t4 t5 t6]" {
+				t.Errorf("Expected items from all three pages in order, got %v", ids)
+			}
+
+			// Get follows pages the same way
+			body, err = client.Get(context.Background(), "/api/v1/tasks")
+			if err != nil {
+				t.Fatalf("Get failed: %v", err)
+			}
+			if len(taskIDs(t, body)) != 6 {
+				t.Errorf("Expected Get to collect all pages, got %s", body)
+			}
+
+			// Single records are not pages and pass through unchanged
+			body, err = client.Get(context.Background(), "/api/v1/tasks/t1")
+			if err != nil {
+				t.Fatalf("Get single record failed: %v", err)
+			}
+			var task map[string]string
+			if err := json.Unmarshal(body, &task); err != nil || task["task_id"] != "t1" {
+				t.Errorf("Expected single record unchanged, got %s", body)
+			}
+		})
+	}
+}
+
+func TestAPIClient_GetListPageCap(t *testing.T) {
+	server := newPagedServer(t, PaginationNextField)
+	defer server.Close()
+
+	client := NewAPIClient(server.URL, 5*time.Second)
+	client.SetPagination(PaginationNextField, 2)
+
+	body, truncated, err := client.GetList(context.Background(), "/api/v1/tasks")
+	if err != nil {
+		t.Fatalf("GetList failed: %v", err)
+	}
+	if !truncated {
+		t.Error("Expected truncation to be reported when pages exceed the cap")
+	}
+	if ids := taskIDs(t, body); fmt.Sprint(ids) != "[t1 t2 t3 t4]" {
+		t.Errorf("Expected only the first two pages, got %v", ids)
+	}
+}
+
+func TestAPIClient_GetListPaginationDisabled(t *testing.T) {
+	server := newPagedServer(t, PaginationLink)
+	defer server.Close()
+
+	client := NewAPIClient(server.URL, 5*time.Second)
+
+	body, truncated, err := client.GetList(context.Background(), "/api/v1/tasks")
+	if err != nil {
+		t.Fatalf("GetList failed: %v", err)
+	}
+	if truncated || len(taskIDs(t, body)) != 2 {
+		t.Errorf("Expected only the first page without pagination, got %s (truncated=%v)", body, truncated)
+	}
+}
+
+func TestNextLink(t *testing.T) {
+	tests := []struct {
+		values []string
+		want   string
+	}{
+		{[]string{`<https://api.example.com/tasks?page=2>; rel="next"`}, "https://api.example.com/tasks?page=2"},
+		{[]string{`</tasks?page=1>; rel="prev", </tasks?page=3>; rel=next`}, "/tasks?page=3"},
+		{[]string{`</tasks?page=1>; rel="first"`}, ""},
+		{nil, ""},
+	}
+	for _, tt := range tests {
+		if got := nextLink(tt.values); got != tt.want {
+			t.Errorf("nextLink(%q) = %q, want %q", tt.values, got, tt.want)
+		}
+	}
+}
+
+func TestAPIClient_RelativePathRejectsOtherHosts(t *testing.T) {
+	client := NewAPIClient("http://api.internal:8080", 5*time.Second)
+
+	if path, ok := client.relativePath("/api/v1/tasks", "http://api.internal:8080/api/v1/tasks?page=2"); !ok || path != "/api/v1/tasks?page=2" {
+		t.Errorf("Expected same-host link to become a path, got %q (%v)", path, ok)
+	}
+	if path, ok := client.relativePath("/api/v1/tasks", "?page=3"); !ok || path != "/api/v1/tasks?page=3" {
+		t.Errorf("Expected relative link to resolve against the current page, got %q (%v)", path, ok)
+	}
+	if _, ok := client.relativePath("/api/v1/tasks", "https://elsewhere.example.com/steal"); ok {
+		t.Error("Expected link to another host to be refused")
+	}
+}
@@ -0,0 +1,83 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// SetRetry makes requests retry transient failures up to maxRetries times,
+// waiting backoff before the first retry and doubling the wait each time
+func (c *APIClient) SetRetry(maxRetries int, backoff time.Duration) {
+	c.maxRetries = maxRetries
+	c.retryBackoff = backoff
+}
+
+// doRequest performs a request, retrying transient failures (see
+// isRetryable) with exponential backoff. Retries stop early once ctx is done
+// or its deadline would pass before the next attempt.
+func (c *APIClient) doRequest(ctx context.Context, method, path string, body interface{}) ([]byte, http.Header, error) {
+	wait := c.retryBackoff
+	for attempt := 0; ; attempt++ {
+		respBody, header, err := c.attemptRequest(ctx, method, path, body)
+		if err == nil || attempt >= c.maxRetries || !isRetryable(ctx, method, err) {
+			return respBody, header, err
+		}
+
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(wait).After(deadline) {
+			slog.Warn("Not retrying API request past the context deadline", "method", method, "path", path, "error", err)
+			return nil, nil, err
+		}
+		slog.Warn("Retrying API request after transient failure",
+			"method", method, "path", path, "attempt", attempt+1, "max_retries", c.maxRetries, "backoff", wait, "error", err)
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, nil, err
+		case <-timer.C:
+		}
+		wait *= 2
+	}
+}
+
+// isRetryable reports whether a failed attempt may succeed if repeated. GET,
+// PUT and DELETE are idempotent, so they are retried when the connection
+// failed or a gateway reported the API unavailable. Other methods such as
+// POST could create a duplicate if the API acted on the first attempt, so
+// they are retried only when the connection could not be made and the
+// request was never sent.
+func isRetryable(ctx context.Context, method string, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if !isIdempotent(method) {
+		var opErr *net.OpError
+		return errors.As(err, &opErr) && opErr.Op == "dial"
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		switch apiErr.StatusCode {
+		case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+	var urlErr *url.Error
+	return errors.As(err, &urlErr)
+}
+
+// isIdempotent reports whether repeating a request with method has the same
+// effect as sending it once
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
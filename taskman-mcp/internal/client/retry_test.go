@@ -0,0 +1,214 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newFlakyServer fails the first failures requests with fail, then answers
+// {"ok": true}. The returned counter holds the number of requests received.
+func newFlakyServer(t *testing.T, failures int32, fail func(w http.ResponseWriter)) (*httptest.Server, *atomic.Int32) {
+	t.Helper()
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) <= failures {
+			fail(w)
+			return
+		}
+		w.Write([]byte(`{"ok": true}`))
+	}))
+	return server, &calls
+}
+
+func failWithStatus(status int) func(w http.ResponseWriter) {
+	return func(w http.ResponseWriter) {
+		w.WriteHeader(status)
+	}
+}
+
+// dropConnection closes the connection without a response
+func dropConnection(w http.ResponseWriter) {
+	conn, _, err := w.(http.Hijacker).Hijack()
+	if err == nil {
+		conn.Close()
+	}
+}
+
+func TestAPIClient_RetriesTransientFailures(t *testing.T) {
+	tests := []struct {
+		name string
+		fail func(w http.ResponseWriter)
+	}{
+		{"bad gateway", failWithStatus(http.StatusBadGateway)},
+		{"service unavailable", failWithStatus(http.StatusServiceUnavailable)},
+		{"gateway timeout", failWithStatus(http.StatusGatewayTimeout)},
+		{"connection error", dropConnection},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := newFlakyServer(t, 2, tt.fail)
+			defer server.Close()
+
+			client := NewAPIClient(server.URL, 5*time.Second)
+			client.SetRetry(3, time.Millisecond)
+
+			body, err := client.Get(context.Background(), "/api/v1/tasks/t1")
+			if err != nil {
+				t.Fatalf("Expected success after retries, got %v", err)
+			}
+			if string(body) != `{"ok": true}` {
+				t.Errorf("Unexpected body %s", body)
+			}
+			if calls.Load() != 3 {
+				t.Errorf("Expected 3 attempts, got %d", calls.Load())
+			}
+		})
+	}
+}
+
+func TestAPIClient_RetryLimit(t *testing.T) {
+	server, calls := newFlakyServer(t, 10, failWithStatus(http.StatusServiceUnavailable))
+	defer server.Close()
+
+	client := NewAPIClient(server.URL, 5*time.Second)
+	client.SetRetry(2, time.Millisecond)
+
+	_, err := client.Put(context.Background(), "/api/v1/tasks/t1", map[string]string{"task_name": "x"})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("Expected the last 503 to be returned, got %v", err)
+	}
+	if calls.Load() != 3 {
+		t.Errorf("Expected the first attempt and 2 retries, got %d", calls.Load())
+	}
+}
+
+func TestAPIClient_DoesNotRetryOtherErrors(t *testing.T) {
+	for _, status := range []int{http.StatusInternalServerError, http.StatusBadRequest, http.StatusNotFound} {
+		server, calls := newFlakyServer(t, 1, failWithStatus(status))
+
+		client := NewAPIClient(server.URL, 5*time.Second)
+		client.SetRetry(3, time.Millisecond)
+
+		if _, err := client.Put(context.Background(), "/api/v1/tasks/t1", map[string]string{}); err == nil {
+			t.Errorf("Expected status %d to fail", status)
+		}
+		if calls.Load() != 1 {
+			t.Errorf("Expected status %d not to be retried, got %d attempts", status, calls.Load())
+		}
+		server.Close()
+	}
+}
+
+func TestAPIClient_DoesNotRetrySentPosts(t *testing.T) {
+	tests := []struct {
+		name string
+		fail func(w http.ResponseWriter)
+	}{
+		{"service unavailable", failWithStatus(http.StatusServiceUnavailable)},
+		{"connection dropped after sending", dropConnection},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server, calls := newFlakyServer(t, 1, tt.fail)
+			defer server.Close()
+
+			client := NewAPIClient(server.URL, 5*time.Second)
+			client.SetRetry(3, time.Millisecond)
+
+			if _, err := client.Post(context.Background(), "/api/v1/tasks", map[string]string{}); err == nil {
+				t.Error("Expected the failed POST to be returned")
+			}
+			if calls.Load() != 1 {
+				t.Errorf("Expected a POST the API may have acted on not to be retried, got %d attempts", calls.Load())
+			}
+		})
+	}
+}
+
+func TestIsRetryable_ByMethod(t *testing.T) {
+	refused := &url.Error{Op: "Post", URL: "http://api", Err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}}
+	dropped := &url.Error{Op: "Post", URL: "http://api", Err: io.EOF}
+	unavailable := &APIError{StatusCode: http.StatusServiceUnavailable}
+
+	tests := []struct {
+		method string
+		err    error
+		want   bool
+	}{
+		{http.MethodGet, dropped, true},
+		{http.MethodPut, unavailable, true},
+		{http.MethodDelete, refused, true},
+		{http.MethodPost, refused, true},
+		{http.MethodPost, dropped, false},
+		{http.MethodPost, unavailable, false},
+	}
+	for _, tt := range tests {
+		if got := isRetryable(context.Background(), tt.method, tt.err); got != tt.want {
+			t.Errorf("isRetryable(%s, %v) = %v, want %v", tt.method, tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestAPIClient_RetryDisabledByDefault(t *testing.T) {
+	server, calls := newFlakyServer(t, 1, failWithStatus(http.StatusBadGateway))
+	defer server.Close()
+
+	client := NewAPIClient(server.URL, 5*time.Second)
+	if _, err := client.Get(context.Background(), "/api/v1/tasks/t1"); err == nil {
+		t.Error("Expected the 502 to be returned without retries")
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Expected a single attempt, got %d", calls.Load())
+	}
+}
+
+func TestAPIClient_RetryStopsAtContextDeadline(t *testing.T) {
+	server, calls := newFlakyServer(t, 10, failWithStatus(http.StatusServiceUnavailable))
+	defer server.Close()
+
+	client := NewAPIClient(server.URL, 5*time.Second)
+	client.SetRetry(5, 20*time.Millisecond)
+
+	// The deadline allows the first retries but not the longer later waits
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := client.Get(ctx, "/api/v1/tasks/t1"); err == nil {
+		t.Fatal("Expected failure once the deadline stops retries")
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("Expected retries to stop before the deadline, took %v", elapsed)
+	}
+	if got := calls.Load(); got < 2 || got > 4 {
+		t.Errorf("Expected a few attempts before the deadline, got %d", got)
+	}
+
+	// A cancelled context stops waiting for the next retry
+	calls.Store(0)
+	client.SetRetry(5, time.Hour)
+	ctx, cancel = context.WithCancel(context.Background())
+	time.AfterFunc(20*time.Millisecond, cancel)
+
+	start = time.Now()
+	if _, err := client.Get(ctx, "/api/v1/tasks/t1"); err == nil {
+		t.Fatal("Expected failure after cancellation")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected cancellation to end the backoff, took %v", elapsed)
+	}
+	if calls.Load() != 1 {
+		t.Errorf("Expected a single attempt before cancellation, got %d", calls.Load())
+	}
+}
@@ -0,0 +1,107 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/bchamber/taskman-mcp/internal/tenant"
+)
+
+// tenantParam is the query parameter and payload field that carry the tenant
+const tenantParam = "tenant"
+
+// SetTenant scopes requests whose context names no tenant to defaultTenant;
+// "" leaves them unscoped
+func (c *APIClient) SetTenant(defaultTenant string) {
+	c.defaultTenant = defaultTenant
+}
+
+// tenantFor returns the tenant a request is scoped to, if any
+func (c *APIClient) tenantFor(ctx context.Context) string {
+	if key := tenant.FromContext(ctx); key != "" {
+		return key
+	}
+	return c.defaultTenant
+}
+
+// scopePath appends the tenant filter to a request path, unless it already
+// carries one (as next-page links returned by the API do)
+func scopePath(path, key string) string {
+	if _, query, found := strings.Cut(path, "?"); found {
+		if values, err := url.ParseQuery(query); err == nil && values.Has(tenantParam) {
+			return path
+		}
+	}
+	separator := "?"
+	if strings.Contains(path, "?") {
+		separator = "&"
+	}
+	return path + separator + tenantParam + "=" + url.QueryEscape(key)
+}
+
+// stampTenant records the tenant on a JSON object payload without modifying
+// the caller's map; other payloads are returned unchanged
+func stampTenant(body interface{}, key string) interface{} {
+	payload, ok := body.(map[string]interface{})
+	if !ok {
+		return body
+	}
+	stamped := make(map[string]interface{}, len(payload)+1)
+	for field, value := range payload {
+		stamped[field] = value
+	}
+	stamped[tenantParam] = key
+	return stamped
+}
+
+// isTenantScopedPath reports whether a path returns task or project records,
+// which carry a tenant field
+func isTenantScopedPath(path string) bool {
+	path, _, _ = strings.Cut(path, "?")
+	if strings.Contains(path, "/notes") {
+		return false
+	}
+	return strings.HasPrefix(path, "/api/v1/tasks") || strings.HasPrefix(path, "/api/v1/projects")
+}
+
+// filterTenant drops task and project records that do not belong to key, in
+// case the API ignores the tenant filter. Records without a tenant belong to
+// no tenant. A single record of another tenant is reported as not found.
+func filterTenant(body []byte, key string) ([]byte, error) {
+	type tenantField struct {
+		Tenant *string `json:"tenant"`
+	}
+	belongs := func(raw json.RawMessage) bool {
+		var record tenantField
+		return json.Unmarshal(raw, &record) == nil && record.Tenant != nil && *record.Tenant == key
+	}
+
+	trimmed := bytes.TrimSpace(body)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("[")):
+		var records []json.RawMessage
+		if err := json.Unmarshal(trimmed, &records); err != nil {
+			return body, nil
+		}
+		kept := make([]json.RawMessage, 0, len(records))
+		for _, record := range records {
+			if belongs(record) {
+				kept = append(kept, record)
+			}
+		}
+		if len(kept) == len(records) {
+			return body, nil
+		}
+		return json.Marshal(kept)
+	case bytes.HasPrefix(trimmed, []byte("{")):
+		if belongs(trimmed) {
+			return body, nil
+		}
+		return nil, &APIError{StatusCode: http.StatusNotFound, Message: http.StatusText(http.StatusNotFound)}
+	}
+	return body, nil
+}
@@ -0,0 +1,18 @@
+package client
+
+import (
+	"context"
+	"time"
+)
+
+// WithTimeout bounds ctx to d for a tool call that makes several API
+// requests, so the whole call has one budget. Every request made with the
+// returned context ends at whichever comes first: the context deadline or
+// the client's own per-request timeout. A parent deadline earlier than d is
+// kept, and d <= 0 leaves ctx unbounded beyond its parent.
+func WithTimeout(ctx context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	if d <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, d)
+}
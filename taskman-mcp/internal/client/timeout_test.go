@@ -0,0 +1,108 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAPIClient_ShortContextCancelsInFlightCall(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	// The context deadline is shorter than the client timeout, so it wins
+	client := NewAPIClient(server.URL, 10*time.Second)
+	ctx, cancel := WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Get(ctx, "/api/v1/tasks")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Expected the context deadline to end the call, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Expected the call to end near the 50ms deadline, took %v", elapsed)
+	}
+}
+
+func TestAPIClient_ClientTimeoutWinsOverLongerContext(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewAPIClient(server.URL, 50*time.Millisecond)
+	ctx, cancel := WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	start := time.Now()
+	if _, err := client.Get(ctx, "/api/v1/tasks"); err == nil {
+		t.Fatal("Expected the client timeout to end the call")
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("Expected the call to end near the 50ms client timeout, took %v", elapsed)
+	}
+}
+
+func TestAPIClient_SpentBudgetSendsNothing(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer server.Close()
+
+	client := NewAPIClient(server.URL, 5*time.Second)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := client.Post(ctx, "/api/v1/tasks", map[string]string{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected a cancelled context to fail the call, got %v", err)
+	}
+	if calls.Load() != 0 {
+		t.Errorf("Expected no request to reach the API, got %d", calls.Load())
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	parent, cancelParent := context.WithTimeout(context.Background(), time.Second)
+	defer cancelParent()
+	parentDeadline, _ := parent.Deadline()
+
+	// An earlier parent deadline is kept
+	ctx, cancel := WithTimeout(parent, time.Hour)
+	if deadline, _ := ctx.Deadline(); !deadline.Equal(parentDeadline) {
+		t.Errorf("Expected the parent deadline %v to be kept, got %v", parentDeadline, deadline)
+	}
+	cancel()
+
+	ctx, cancel = WithTimeout(parent, 10*time.Millisecond)
+	if deadline, _ := ctx.Deadline(); !deadline.Before(parentDeadline) {
+		t.Errorf("Expected the shorter budget to win, got %v", deadline)
+	}
+	cancel()
+
+	ctx, cancel = WithTimeout(context.Background(), 0)
+	if _, ok := ctx.Deadline(); ok {
+		t.Error("Expected no deadline for a zero budget")
+	}
+	cancel()
+	if ctx.Err() == nil {
+		t.Error("Expected cancel to end the context")
+	}
+}
@@ -0,0 +1,35 @@
+package clock
+
+import "time"
+
+// Clock supplies the current time. Tools and resources read time through a
+// Clock so tests can pin "now" and reports can be generated as of a past date.
+type Clock interface {
+	Now() time.Time
+}
+
+// Default is the clock used when none is injected
+var Default Clock = Real{}
+
+// Real reads the system clock
+type Real struct{}
+
+// Now returns the current system time
+func (Real) Now() time.Time {
+	return time.Now()
+}
+
+// Fixed always reports the same instant
+type Fixed struct {
+	instant time.Time
+}
+
+// NewFixed creates a clock pinned to instant
+func NewFixed(instant time.Time) Fixed {
+	return Fixed{instant: instant}
+}
+
+// Now returns the pinned instant
+func (f Fixed) Now() time.Time {
+	return f.instant
+}
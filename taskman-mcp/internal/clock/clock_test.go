@@ -0,0 +1,28 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFixed_Now(t *testing.T) {
+	instant := time.Date(2024, 3, 15, 9, 30, 0, 0, time.UTC)
+	fixed := NewFixed(instant)
+
+	if !fixed.Now().Equal(instant) {
+		t.Errorf("Expected %v, got %v", instant, fixed.Now())
+	}
+	if !fixed.Now().Equal(fixed.Now()) {
+		t.Error("Expected fixed clock to return the same instant on every call")
+	}
+}
+
+func TestReal_Now(t *testing.T) {
+	before := time.Now()
+	now := Real{}.Now()
+	after := time.Now()
+
+	if now.Before(before) || now.After(after) {
+		t.Errorf("Expected real clock between %v and %v, got %v", before, after, now)
+	}
+}
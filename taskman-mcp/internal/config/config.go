@@ -1,8 +1,12 @@
 package config
 
 import (
+	"fmt"
 	"log/slog"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -13,50 +17,453 @@ type Config struct {
 	ServerName    string
 	ServerVersion string
 
+	// API authentication
+	APIToken     string // credential sent on every API request; "" sends none
+	APIKeyHeader string // header carrying APIToken; "" sends it as "Authorization: Bearer <token>"
+
+	// API pagination
+	APIPagination string // "none", "link" (Link header rel="next"), "next_field" ({"items": [...], "next": ...})
+	APIMaxPages   int    // pages followed per list request before reporting truncation
+
+	// API retries of connection errors and 502/503/504 responses
+	APIMaxRetries   int           // retries after the first attempt; 0 disables retrying
+	APIRetryBackoff time.Duration // wait before the first retry, doubled for each further retry
+
+	// API circuit breaker
+	APIBreakerThreshold int           // consecutive failed requests that open the breaker; 0 disables
+	APIBreakerCooldown  time.Duration // how long an open breaker fails requests fast before probing
+
+	// API response caching
+	APICacheTTL time.Duration // how long GET responses are reused; writes invalidate related entries; 0 disables
+
+	// Per-tool call budgets; whichever of a budget and APITimeout ends first stops a request
+	HealthCheckTimeout time.Duration // budget for health_check, which should fail fast
+	MultiCallTimeout   time.Duration // total budget for tools that make many API calls; 0 disables
+
+	// Logging configuration
+	LogFormat       string // "text", "json"
+	LogAPIRequests  bool   // log outbound API requests at Debug level
+	LogMaxBodyBytes int    // truncate logged payloads beyond this size
+
 	// Transport configuration
-	TransportMode string // "stdio", "http", "both"
-	HTTPPort      string
-	HTTPHost      string
+	TransportMode  string // "stdio", "http", "both", "unix"
+	HTTPPort       string
+	HTTPHost       string
+	UnixSocketPath string // socket the HTTP/SSE handlers listen on in unix mode
+
+	// Tenant scoping
+	Tenant       string // tenant every task/project query is scoped to; "" is single-tenant
+	TenantHeader string // HTTP header naming the tenant per session, overriding Tenant
+
+	// Tool limits
+	MaxInitialTasks      int
+	InitialTasksOverflow string         // "reject", "truncate"
+	WIPLimit             int            // max "In Progress" tasks per board before warning
+	WIPLimits            map[string]int // hard per-status limits on moves into a status, e.g. In Progress=2
+	WIPLimitScope        string         // "assignee", "project": who the hard limits are counted for
+
+	// Audit fields
+	RequireActorFields bool   // require created_by/updated_by style fields on writes
+	DefaultCreatedBy   string // actor recorded when actor fields are optional and omitted
+	DeletePolicy       string // "soft" (archive and mark deleted), "hard" (issue DELETE)
+
+	// Task assignment
+	ProjectDefaultAssignees map[string]string // per-project assignee for unassigned new tasks, e.g. proj-1=alice
+	AssigneeAliases         map[string]string // entered assignee -> canonical identity, e.g. jdoe=john.doe
+	AssigneeNormalize       bool              // also match assignees ignoring case and repeated whitespace
+
+	// Attention thresholds
+	StaleAfter       time.Duration // open tasks idle this long are stale
+	LongBlockedAfter time.Duration // Blocked tasks idle this long need escalation
+
+	// Due-date clustering
+	DueDateClusterThreshold int // warn when more than this many incomplete tasks are due the same day; 0 disables
+
+	// Priority escalation
+	EscalationAges        map[string]time.Duration // open tasks this old are bumped a priority, by tier, e.g. Low=720h,None=336h
+	EscalationMaxPriority string                   // escalation never raises a task above this priority
+
+	// Due date calendar
+	BusinessDaysOnly bool     // skip weekends and holidays in due-soon calculations
+	Holidays         []string // YYYY-MM-DD dates treated as non-working days
+
+	// Search display limits
+	SearchMaxOverdueShown int
+	SearchMaxTasksShown   int
+	SearchMaxTextBytes    int
+
+	// Search defaults
+	SearchIncludesArchivedByDefault bool // include archived tasks when search_tasks omits archived
+
+	// Note limits
+	MaxNotesReturned int // newest notes returned per task, regardless of caller limits
+	MinNoteLength    int // minimum trimmed length of notes written by tools; 0 disables
+
+	// Tool result size
+	MaxMetaItems int // max items kept in task/note lists embedded in Meta; 0 disables
+
+	// Tool execution concurrency
+	MaxConcurrentToolsPerSession int    // tool calls one session may run at once; 0 disables
+	ToolConcurrencyOverflow      string // "queue" (wait for a slot), "reject"
+
+	// Tool result caching
+	ToolCacheTTL  time.Duration            // TTL for read-only tool results; 0 disables caching
+	ToolCacheTTLs map[string]time.Duration // per-tool TTL overrides, e.g. get_task_overview=30s
+
+	// Webhook notifications
+	WebhookURL       string
+	WebhookQueueSize int
+	ShutdownTimeout  time.Duration
+
+	// Output formatting
+	PriorityStyle       string            // "plain", "decorated"
+	PriorityLabels      map[string]string // per-priority label overrides
+	PriorityPlaceholder string
+	SuppressedInsights  []string // insight keys (e.g. "overview.mostly_not_started") never shown
+}
+
+// Default returns a configuration populated with the built-in defaults
+func Default() *Config {
+	return &Config{
+		APIBaseURL:    "http://localhost:8080",
+		APITimeout:    30 * time.Second,
+		LogLevel:      "INFO",
+		ServerName:    "taskman-mcp",
+		ServerVersion: "1.0.0",
+
+		APIPagination: "none",
+		APIMaxPages:   10,
+
+		APIMaxRetries:   2,
+		APIRetryBackoff: 200 * time.Millisecond,
+
+		APIBreakerThreshold: 5,
+		APIBreakerCooldown:  30 * time.Second,
+
+		HealthCheckTimeout: 5 * time.Second,
+		MultiCallTimeout:   5 * time.Minute,
+
+		LogFormat:       "text",
+		LogMaxBodyBytes: 2048,
+
+		TransportMode: "stdio",
+		HTTPPort:      "8081",
+		HTTPHost:      "localhost",
+
+		MaxInitialTasks:      50,
+		InitialTasksOverflow: "reject",
+		WIPLimit:             5,
+		WIPLimitScope:        "assignee",
+
+		RequireActorFields: true,
+		DeletePolicy:       "soft",
+
+		StaleAfter:       7 * 24 * time.Hour,
+		LongBlockedAfter: 3 * 24 * time.Hour,
+
+		DueDateClusterThreshold: 5,
+
+		EscalationMaxPriority: "High",
+
+		SearchMaxOverdueShown: 5,
+		SearchMaxTasksShown:   10,
+		SearchMaxTextBytes:    16384,
+
+		MaxNotesReturned: 50,
+		MinNoteLength:    0,
+
+		ToolConcurrencyOverflow: "queue",
+
+		WebhookQueueSize: 100,
+		ShutdownTimeout:  10 * time.Second,
+
+		PriorityStyle:       "plain",
+		PriorityPlaceholder: "None",
+	}
 }
 
 func Load() *Config {
 	slog.Info("Loading MCP server configuration")
 
+	var env envSource
+	if path := os.Getenv(ConfigFileEnv); path != "" {
+		values, err := loadFile(path)
+		if err != nil {
+			slog.Warn("Failed to read config file, using environment only", "path", path, "error", err)
+		}
+		env = values
+	}
+
+	defaults := Default()
 	config := &Config{
-		APIBaseURL:    getEnv("TASKMAN_API_BASE_URL", "http://localhost:8080"),
-		APITimeout:    getEnvDuration("TASKMAN_API_TIMEOUT", 30*time.Second),
-		LogLevel:      getEnv("TASKMAN_LOG_LEVEL", "INFO"),
-		ServerName:    getEnv("TASKMAN_MCP_SERVER_NAME", "taskman-mcp"),
-		ServerVersion: getEnv("TASKMAN_MCP_SERVER_VERSION", "1.0.0"),
+		APIBaseURL:    env.getEnv("TASKMAN_API_BASE_URL", defaults.APIBaseURL),
+		APITimeout:    env.getEnvDuration("TASKMAN_API_TIMEOUT", defaults.APITimeout),
+		LogLevel:      env.getEnv("TASKMAN_LOG_LEVEL", defaults.LogLevel),
+		ServerName:    env.getEnv("TASKMAN_MCP_SERVER_NAME", defaults.ServerName),
+		ServerVersion: env.getEnv("TASKMAN_MCP_SERVER_VERSION", defaults.ServerVersion),
+
+		APIToken:     env.getEnv("TASKMAN_API_TOKEN", defaults.APIToken),
+		APIKeyHeader: env.getEnv("TASKMAN_API_KEY_HEADER", defaults.APIKeyHeader),
+
+		APIPagination: env.getEnv("TASKMAN_API_PAGINATION", defaults.APIPagination),
+		APIMaxPages:   env.getEnvInt("TASKMAN_API_MAX_PAGES", defaults.APIMaxPages),
+
+		APIMaxRetries:   env.getEnvInt("TASKMAN_API_MAX_RETRIES", defaults.APIMaxRetries),
+		APIRetryBackoff: env.getEnvDuration("TASKMAN_API_RETRY_BACKOFF", defaults.APIRetryBackoff),
+
+		APIBreakerThreshold: env.getEnvInt("TASKMAN_API_BREAKER_THRESHOLD", defaults.APIBreakerThreshold),
+		APIBreakerCooldown:  env.getEnvDuration("TASKMAN_API_BREAKER_COOLDOWN", defaults.APIBreakerCooldown),
+
+		APICacheTTL: env.getEnvDuration("TASKMAN_API_CACHE_TTL", defaults.APICacheTTL),
+
+		HealthCheckTimeout: env.getEnvDuration("TASKMAN_HEALTH_CHECK_TIMEOUT", defaults.HealthCheckTimeout),
+		MultiCallTimeout:   env.getEnvDuration("TASKMAN_MULTI_CALL_TIMEOUT", defaults.MultiCallTimeout),
+
+		LogFormat:       env.getEnv("TASKMAN_LOG_FORMAT", defaults.LogFormat),
+		LogAPIRequests:  env.getEnvBool("TASKMAN_LOG_API_REQUESTS", defaults.LogAPIRequests),
+		LogMaxBodyBytes: env.getEnvInt("TASKMAN_LOG_MAX_BODY_BYTES", defaults.LogMaxBodyBytes),
+
+		TransportMode: env.getEnv("TASKMAN_MCP_TRANSPORT", defaults.TransportMode),
+		HTTPPort:      env.getEnv("TASKMAN_MCP_HTTP_PORT", defaults.HTTPPort),
+		HTTPHost:      env.getEnv("TASKMAN_MCP_HTTP_HOST", defaults.HTTPHost),
 
-		TransportMode: getEnv("TASKMAN_MCP_TRANSPORT", "stdio"),
-		HTTPPort:      getEnv("TASKMAN_MCP_HTTP_PORT", "8081"),
-		HTTPHost:      getEnv("TASKMAN_MCP_HTTP_HOST", "localhost"),
+		UnixSocketPath: env.getEnv("TASKMAN_MCP_UNIX_SOCKET", defaults.UnixSocketPath),
+
+		Tenant:       env.getEnv("TASKMAN_TENANT", defaults.Tenant),
+		TenantHeader: env.getEnv("TASKMAN_TENANT_HEADER", defaults.TenantHeader),
+
+		MaxInitialTasks:      env.getEnvInt("TASKMAN_MAX_INITIAL_TASKS", defaults.MaxInitialTasks),
+		InitialTasksOverflow: env.getEnv("TASKMAN_INITIAL_TASKS_OVERFLOW", defaults.InitialTasksOverflow),
+		WIPLimit:             env.getEnvInt("TASKMAN_WIP_LIMIT", defaults.WIPLimit),
+		WIPLimits:            env.getEnvIntMap("TASKMAN_WIP_LIMITS", defaults.WIPLimits),
+		WIPLimitScope:        env.getEnv("TASKMAN_WIP_LIMIT_SCOPE", defaults.WIPLimitScope),
+
+		RequireActorFields: env.getEnvBool("TASKMAN_REQUIRE_ACTOR_FIELDS", defaults.RequireActorFields),
+		DefaultCreatedBy:   env.getEnv("TASKMAN_DEFAULT_CREATED_BY", defaults.DefaultCreatedBy),
+		DeletePolicy:       env.getEnv("TASKMAN_DELETE_POLICY", defaults.DeletePolicy),
+
+		ProjectDefaultAssignees: env.getEnvMap("TASKMAN_PROJECT_DEFAULT_ASSIGNEES", defaults.ProjectDefaultAssignees),
+		AssigneeAliases:         env.getEnvMap("TASKMAN_ASSIGNEE_ALIASES", defaults.AssigneeAliases),
+		AssigneeNormalize:       env.getEnvBool("TASKMAN_ASSIGNEE_NORMALIZE", defaults.AssigneeNormalize),
+
+		StaleAfter:       env.getEnvDuration("TASKMAN_STALE_AFTER", defaults.StaleAfter),
+		LongBlockedAfter: env.getEnvDuration("TASKMAN_LONG_BLOCKED_AFTER", defaults.LongBlockedAfter),
+
+		DueDateClusterThreshold: env.getEnvInt("TASKMAN_DUE_DATE_CLUSTER_THRESHOLD", defaults.DueDateClusterThreshold),
+
+		EscalationAges:        env.getEnvDurationMap("TASKMAN_ESCALATION_AGES", defaults.EscalationAges),
+		EscalationMaxPriority: env.getEnv("TASKMAN_ESCALATION_MAX_PRIORITY", defaults.EscalationMaxPriority),
+
+		BusinessDaysOnly: env.getEnvBool("TASKMAN_BUSINESS_DAYS_ONLY", defaults.BusinessDaysOnly),
+		Holidays:         env.getEnvList("TASKMAN_HOLIDAYS", defaults.Holidays),
+
+		SearchMaxOverdueShown: env.getEnvInt("TASKMAN_SEARCH_MAX_OVERDUE_SHOWN", defaults.SearchMaxOverdueShown),
+		SearchMaxTasksShown:   env.getEnvInt("TASKMAN_SEARCH_MAX_TASKS_SHOWN", defaults.SearchMaxTasksShown),
+		SearchMaxTextBytes:    env.getEnvInt("TASKMAN_SEARCH_MAX_TEXT_BYTES", defaults.SearchMaxTextBytes),
+
+		SearchIncludesArchivedByDefault: env.getEnvBool("TASKMAN_SEARCH_INCLUDES_ARCHIVED_BY_DEFAULT", defaults.SearchIncludesArchivedByDefault),
+
+		MaxNotesReturned: env.getEnvInt("TASKMAN_MAX_NOTES_RETURNED", defaults.MaxNotesReturned),
+		MinNoteLength:    env.getEnvInt("TASKMAN_MIN_NOTE_LENGTH", defaults.MinNoteLength),
+
+		MaxMetaItems: env.getEnvInt("TASKMAN_MAX_META_ITEMS", defaults.MaxMetaItems),
+
+		MaxConcurrentToolsPerSession: env.getEnvInt("TASKMAN_MAX_CONCURRENT_TOOLS_PER_SESSION", defaults.MaxConcurrentToolsPerSession),
+		ToolConcurrencyOverflow:      env.getEnv("TASKMAN_TOOL_CONCURRENCY_OVERFLOW", defaults.ToolConcurrencyOverflow),
+
+		ToolCacheTTL:  env.getEnvDuration("TASKMAN_TOOL_CACHE_TTL", defaults.ToolCacheTTL),
+		ToolCacheTTLs: env.getEnvDurationMap("TASKMAN_TOOL_CACHE_TTLS", defaults.ToolCacheTTLs),
+
+		WebhookURL:       env.getEnv("TASKMAN_WEBHOOK_URL", defaults.WebhookURL),
+		WebhookQueueSize: env.getEnvInt("TASKMAN_WEBHOOK_QUEUE_SIZE", defaults.WebhookQueueSize),
+		ShutdownTimeout:  env.getEnvDuration("TASKMAN_SHUTDOWN_TIMEOUT", defaults.ShutdownTimeout),
+
+		PriorityStyle:       env.getEnv("TASKMAN_PRIORITY_STYLE", defaults.PriorityStyle),
+		PriorityLabels:      env.getEnvMap("TASKMAN_PRIORITY_LABELS", defaults.PriorityLabels),
+		PriorityPlaceholder: env.getEnv("TASKMAN_PRIORITY_PLACEHOLDER", defaults.PriorityPlaceholder),
+		SuppressedInsights:  env.getEnvList("TASKMAN_SUPPRESSED_INSIGHTS", defaults.SuppressedInsights),
 	}
 
 	slog.Info("MCP server configuration loaded",
 		"api_base_url", config.APIBaseURL,
+		"api_auth", config.apiAuthMode(),
 		"api_timeout", config.APITimeout,
 		"log_level", config.LogLevel,
 		"server_name", config.ServerName,
 		"server_version", config.ServerVersion,
+		"api_pagination", config.APIPagination,
+		"api_max_pages", config.APIMaxPages,
+		"api_max_retries", config.APIMaxRetries,
+		"api_retry_backoff", config.APIRetryBackoff,
+		"api_breaker_threshold", config.APIBreakerThreshold,
+		"api_breaker_cooldown", config.APIBreakerCooldown,
+		"api_cache_ttl", config.APICacheTTL,
+		"health_check_timeout", config.HealthCheckTimeout,
+		"multi_call_timeout", config.MultiCallTimeout,
+		"log_format", config.LogFormat,
+		"log_api_requests", config.LogAPIRequests,
+		"log_max_body_bytes", config.LogMaxBodyBytes,
 		"transport_mode", config.TransportMode,
 		"http_port", config.HTTPPort,
 		"http_host", config.HTTPHost,
+		"unix_socket_path", config.UnixSocketPath,
+		"tenant", config.Tenant,
+		"tenant_header", config.TenantHeader,
+		"max_initial_tasks", config.MaxInitialTasks,
+		"initial_tasks_overflow", config.InitialTasksOverflow,
+		"wip_limit", config.WIPLimit,
+		"wip_limits", config.WIPLimits,
+		"wip_limit_scope", config.WIPLimitScope,
+		"require_actor_fields", config.RequireActorFields,
+		"default_created_by", config.DefaultCreatedBy,
+		"delete_policy", config.DeletePolicy,
+		"project_default_assignees", len(config.ProjectDefaultAssignees),
+		"assignee_aliases", len(config.AssigneeAliases),
+		"assignee_normalize", config.AssigneeNormalize,
+		"stale_after", config.StaleAfter,
+		"long_blocked_after", config.LongBlockedAfter,
+		"due_date_cluster_threshold", config.DueDateClusterThreshold,
+		"escalation_ages", config.EscalationAges,
+		"escalation_max_priority", config.EscalationMaxPriority,
+		"business_days_only", config.BusinessDaysOnly,
+		"holidays", len(config.Holidays),
+		"search_max_overdue_shown", config.SearchMaxOverdueShown,
+		"search_max_tasks_shown", config.SearchMaxTasksShown,
+		"search_max_text_bytes", config.SearchMaxTextBytes,
+		"search_includes_archived_by_default", config.SearchIncludesArchivedByDefault,
+		"max_notes_returned", config.MaxNotesReturned,
+		"min_note_length", config.MinNoteLength,
+		"max_meta_items", config.MaxMetaItems,
+		"max_concurrent_tools_per_session", config.MaxConcurrentToolsPerSession,
+		"tool_concurrency_overflow", config.ToolConcurrencyOverflow,
+		"tool_cache_ttl", config.ToolCacheTTL,
+		"tool_cache_overrides", len(config.ToolCacheTTLs),
+		"webhook_enabled", config.WebhookURL != "",
+		"webhook_queue_size", config.WebhookQueueSize,
+		"shutdown_timeout", config.ShutdownTimeout,
+		"priority_style", config.PriorityStyle,
+		"suppressed_insights", config.SuppressedInsights,
 	)
 
 	return config
 }
 
-func getEnv(key, defaultValue string) string {
-	if value := os.Getenv(key); value != "" {
+// apiAuthMode describes how API requests are authenticated without
+// revealing the credential, for logging
+func (c *Config) apiAuthMode() string {
+	switch {
+	case c.APIToken == "":
+		return "none"
+	case c.APIKeyHeader == "":
+		return "bearer"
+	default:
+		return "header " + c.APIKeyHeader
+	}
+}
+
+// Validate checks settings that would otherwise fail only once the server starts
+func (c *Config) Validate() error {
+	if c.APIKeyHeader != "" && c.APIToken == "" {
+		return fmt.Errorf("TASKMAN_API_KEY_HEADER is set but TASKMAN_API_TOKEN is empty")
+	}
+	switch c.TransportMode {
+	case "stdio", "http", "both":
+	case "unix":
+		if c.UnixSocketPath == "" {
+			return fmt.Errorf("TASKMAN_MCP_UNIX_SOCKET is required for unix transport")
+		}
+		if info, err := os.Stat(filepath.Dir(c.UnixSocketPath)); err != nil || !info.IsDir() {
+			return fmt.Errorf("unix socket directory %s does not exist", filepath.Dir(c.UnixSocketPath))
+		}
+	default:
+		return fmt.Errorf("invalid transport mode %q (use stdio, http, both or unix)", c.TransportMode)
+	}
+	switch c.APIPagination {
+	case "none", "link", "next_field":
+	default:
+		return fmt.Errorf("invalid API pagination %q (use none, link or next_field)", c.APIPagination)
+	}
+	if c.APIMaxPages < 1 {
+		return fmt.Errorf("TASKMAN_API_MAX_PAGES must be at least 1, got %d", c.APIMaxPages)
+	}
+	if c.APIMaxRetries < 0 {
+		return fmt.Errorf("TASKMAN_API_MAX_RETRIES must not be negative, got %d", c.APIMaxRetries)
+	}
+	if c.APIRetryBackoff < 0 {
+		return fmt.Errorf("TASKMAN_API_RETRY_BACKOFF must not be negative, got %s", c.APIRetryBackoff)
+	}
+	if c.APIBreakerThreshold < 0 {
+		return fmt.Errorf("TASKMAN_API_BREAKER_THRESHOLD must not be negative, got %d", c.APIBreakerThreshold)
+	}
+	if c.APIBreakerThreshold > 0 && c.APIBreakerCooldown <= 0 {
+		return fmt.Errorf("TASKMAN_API_BREAKER_COOLDOWN must be positive when the breaker is enabled, got %s", c.APIBreakerCooldown)
+	}
+	if c.APICacheTTL < 0 {
+		return fmt.Errorf("TASKMAN_API_CACHE_TTL must not be negative, got %s", c.APICacheTTL)
+	}
+	if c.HealthCheckTimeout < 0 || c.MultiCallTimeout < 0 {
+		return fmt.Errorf("TASKMAN_HEALTH_CHECK_TIMEOUT and TASKMAN_MULTI_CALL_TIMEOUT must not be negative")
+	}
+	switch c.LogFormat {
+	case "text", "json":
+	default:
+		return fmt.Errorf("invalid log format %q (use text or json)", c.LogFormat)
+	}
+	switch c.ToolConcurrencyOverflow {
+	case "queue", "reject":
+	default:
+		return fmt.Errorf("invalid tool concurrency overflow %q (use queue or reject)", c.ToolConcurrencyOverflow)
+	}
+	switch c.InitialTasksOverflow {
+	case "reject", "truncate":
+	default:
+		return fmt.Errorf("invalid initial tasks overflow %q (use reject or truncate)", c.InitialTasksOverflow)
+	}
+	switch c.WIPLimitScope {
+	case "assignee", "project":
+	default:
+		return fmt.Errorf("invalid WIP limit scope %q (use assignee or project)", c.WIPLimitScope)
+	}
+	switch c.DeletePolicy {
+	case "soft", "hard":
+	default:
+		return fmt.Errorf("invalid delete policy %q (use soft or hard)", c.DeletePolicy)
+	}
+	switch c.EscalationMaxPriority {
+	case "Low", "Medium", "High":
+	default:
+		return fmt.Errorf("invalid escalation max priority %q (use Low, Medium or High)", c.EscalationMaxPriority)
+	}
+	switch c.PriorityStyle {
+	case "plain", "decorated":
+	default:
+		return fmt.Errorf("invalid priority style %q (use plain or decorated)", c.PriorityStyle)
+	}
+	return nil
+}
+
+// envSource holds the settings read from the config file, which take
+// precedence over the environment. A nil envSource reads the environment only.
+type envSource map[string]string
+
+// lookup returns key's value from the config file, falling back to the
+// environment
+func (env envSource) lookup(key string) string {
+	if value, ok := env[key]; ok {
+		return value
+	}
+	return os.Getenv(key)
+}
+
+func (env envSource) getEnv(key, defaultValue string) string {
+	if value := env.lookup(key); value != "" {
 		return value
 	}
 	return defaultValue
 }
 
-func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
-	if value := os.Getenv(key); value != "" {
+func (env envSource) getEnvDuration(key string, defaultValue time.Duration) time.Duration {
+	if value := env.lookup(key); value != "" {
 		if duration, err := time.ParseDuration(value); err == nil {
 			return duration
 		}
@@ -68,3 +475,116 @@ func getEnvDuration(key string, defaultValue time.Duration) time.Duration {
 	}
 	return defaultValue
 }
+
+func (env envSource) getEnvInt(key string, defaultValue int) int {
+	if value := env.lookup(key); value != "" {
+		if parsed, err := strconv.Atoi(value); err == nil {
+			return parsed
+		}
+		slog.Warn("Invalid integer in environment variable, using default",
+			"key", key,
+			"value", value,
+			"default", defaultValue,
+		)
+	}
+	return defaultValue
+}
+
+func (env envSource) getEnvBool(key string, defaultValue bool) bool {
+	if value := env.lookup(key); value != "" {
+		if parsed, err := strconv.ParseBool(value); err == nil {
+			return parsed
+		}
+		slog.Warn("Invalid boolean in environment variable, using default",
+			"key", key,
+			"value", value,
+			"default", defaultValue,
+		)
+	}
+	return defaultValue
+}
+
+// getEnvList parses a comma-separated list, ignoring empty entries
+func (env envSource) getEnvList(key string, defaultValue []string) []string {
+	value := env.lookup(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	result := []string{}
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// getEnvMap parses a comma-separated list of key=value pairs
+func (env envSource) getEnvMap(key string, defaultValue map[string]string) map[string]string {
+	value := env.lookup(key)
+	if value == "" {
+		return defaultValue
+	}
+
+	result := make(map[string]string)
+	for _, pair := range strings.Split(value, ",") {
+		k, v, ok := strings.Cut(pair, "=")
+		k = strings.TrimSpace(k)
+		if !ok || k == "" {
+			slog.Warn("Ignoring malformed entry in environment variable",
+				"key", key,
+				"entry", pair,
+			)
+			continue
+		}
+		result[k] = strings.TrimSpace(v)
+	}
+	return result
+}
+
+// getEnvIntMap parses a comma-separated list of key=integer pairs
+func (env envSource) getEnvIntMap(key string, defaultValue map[string]int) map[string]int {
+	raw := env.getEnvMap(key, nil)
+	if raw == nil {
+		return defaultValue
+	}
+
+	result := make(map[string]int, len(raw))
+	for k, v := range raw {
+		n, err := strconv.Atoi(v)
+		if err != nil {
+			slog.Warn("Ignoring invalid integer in environment variable",
+				"key", key,
+				"entry", k,
+				"value", v,
+			)
+			continue
+		}
+		result[k] = n
+	}
+	return result
+}
+
+// getEnvDurationMap parses a comma-separated list of key=duration pairs
+func (env envSource) getEnvDurationMap(key string, defaultValue map[string]time.Duration) map[string]time.Duration {
+	raw := env.getEnvMap(key, nil)
+	if raw == nil {
+		return defaultValue
+	}
+
+	result := make(map[string]time.Duration, len(raw))
+	for k, v := range raw {
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			slog.Warn("Ignoring invalid duration in environment variable",
+				"key", key,
+				"entry", k,
+				"value", v,
+			)
+			continue
+		}
+		result[k] = duration
+	}
+	return result
+}
@@ -167,7 +167,7 @@ func TestGetEnv(t *testing.T) {
 			}
 
 			// Test function
-			result := getEnv(tt.key, tt.defaultValue)
+			result := envSource(nil).getEnv(tt.key, tt.defaultValue)
 
 			if result != tt.expected {
 				t.Errorf("Expected %s, got %s", tt.expected, result)
@@ -227,7 +227,7 @@ func TestGetEnvDuration(t *testing.T) {
 			}
 
 			// Test function
-			result := getEnvDuration(tt.key, tt.defaultValue)
+			result := envSource(nil).getEnvDuration(tt.key, tt.defaultValue)
 
 			if result != tt.expected {
 				t.Errorf("Expected %v, got %v", tt.expected, result)
@@ -235,3 +235,133 @@ func TestGetEnvDuration(t *testing.T) {
 		})
 	}
 }
+
+func TestGetEnvMap(t *testing.T) {
+	key := "TEST_ENV_MAP"
+	defer os.Unsetenv(key)
+
+	os.Unsetenv(key)
+	if result := envSource(nil).getEnvMap(key, nil); result != nil {
+		t.Errorf("Expected nil default, got %v", result)
+	}
+
+	os.Setenv(key, "High=[P1], Medium = [P2],bogus,Low=")
+	result := envSource(nil).getEnvMap(key, nil)
+	expected := map[string]string{"High": "[P1]", "Medium": "[P2]", "Low": ""}
+	if len(result) != len(expected) {
+		t.Fatalf("Expected %d entries, got %v", len(expected), result)
+	}
+	for k, v := range expected {
+		if result[k] != v {
+			t.Errorf("Expected %s=%q, got %q", k, v, result[k])
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	cfg := Default()
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Expected default config to be valid: %v", err)
+	}
+
+	cfg.TransportMode = "unix"
+	if err := cfg.Validate(); err == nil {
+		t.Error("Expected unix mode without a socket path to be rejected")
+	}
+
+	cfg.UnixSocketPath = "/nonexistent-dir/mcp.sock"
+	if err := cfg.Validate(); err == nil {
+		t.Error("Expected unix socket in a missing directory to be rejected")
+	}
+
+	cfg.TransportMode = "carrier-pigeon"
+	if err := cfg.Validate(); err == nil {
+		t.Error("Expected unknown transport mode to be rejected")
+	}
+
+	cfg = Default()
+	cfg.LogFormat = "xml"
+	if err := cfg.Validate(); err == nil {
+		t.Error("Expected unknown log format to be rejected")
+	}
+
+	for name, set := range map[string]func(*Config){
+		"initial tasks overflow":  func(c *Config) { c.InitialTasksOverflow = "truncat" },
+		"WIP limit scope":         func(c *Config) { c.WIPLimitScope = "team" },
+		"delete policy":           func(c *Config) { c.DeletePolicy = "purge" },
+		"escalation max priority": func(c *Config) { c.EscalationMaxPriority = "high" },
+		"priority style":          func(c *Config) { c.PriorityStyle = "fancy" },
+	} {
+		cfg = Default()
+		set(cfg)
+		if err := cfg.Validate(); err == nil {
+			t.Errorf("Expected unknown %s to be rejected", name)
+		}
+	}
+
+	cfg = Default()
+	cfg.APIPagination = "cursor"
+	if err := cfg.Validate(); err == nil {
+		t.Error("Expected unknown API pagination style to be rejected")
+	}
+
+	cfg = Default()
+	cfg.APIMaxPages = 0
+	if err := cfg.Validate(); err == nil {
+		t.Error("Expected a zero page cap to be rejected")
+	}
+
+	cfg = Default()
+	cfg.APIMaxRetries = -1
+	if err := cfg.Validate(); err == nil {
+		t.Error("Expected negative API retries to be rejected")
+	}
+
+	cfg = Default()
+	cfg.APIBreakerCooldown = 0
+	if err := cfg.Validate(); err == nil {
+		t.Error("Expected an enabled breaker without a cooldown to be rejected")
+	}
+	cfg.APIBreakerThreshold = 0
+	if err := cfg.Validate(); err != nil {
+		t.Errorf("Expected a disabled breaker to need no cooldown: %v", err)
+	}
+
+	cfg = Default()
+	cfg.APIKeyHeader = "X-API-Key"
+	if err := cfg.Validate(); err == nil {
+		t.Error("Expected an API key header without a token to be rejected")
+	}
+
+	cfg = Default()
+	cfg.ToolConcurrencyOverflow = "drop"
+	if err := cfg.Validate(); err == nil {
+		t.Error("Expected unknown tool concurrency overflow to be rejected")
+	}
+}
+
+func TestGetEnvDurationMap(t *testing.T) {
+	t.Setenv("TEST_DURATION_MAP", "get_board=30s, get_my_work=bogus,health_check=0s")
+
+	got := envSource(nil).getEnvDurationMap("TEST_DURATION_MAP", nil)
+	if len(got) != 2 || got["get_board"] != 30*time.Second || got["health_check"] != 0 {
+		t.Errorf("Unexpected duration map: %v", got)
+	}
+
+	if got := envSource(nil).getEnvDurationMap("TEST_DURATION_MAP_UNSET", nil); got != nil {
+		t.Errorf("Expected default for unset variable, got %v", got)
+	}
+}
+
+func TestGetEnvIntMap(t *testing.T) {
+	t.Setenv("TEST_INT_MAP", "In Progress=2, Review=many,Blocked=0")
+
+	got := envSource(nil).getEnvIntMap("TEST_INT_MAP", nil)
+	if len(got) != 2 || got["In Progress"] != 2 || got["Blocked"] != 0 {
+		t.Errorf("Unexpected int map: %v", got)
+	}
+
+	if got := envSource(nil).getEnvIntMap("TEST_INT_MAP_UNSET", nil); got != nil {
+		t.Errorf("Expected default for unset variable, got %v", got)
+	}
+}
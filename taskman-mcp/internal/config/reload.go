@@ -0,0 +1,125 @@
+package config
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// ConfigFileEnv names an optional KEY=VALUE file whose entries override the
+// environment. It is read at startup and again on every reload; a key removed
+// from the file falls back to the environment on the next reload.
+const ConfigFileEnv = "TASKMAN_CONFIG_FILE"
+
+// reloadableFields lists the settings that can change while the server runs.
+// Anything else (transport, ports, API client, renderers built at startup)
+// needs a restart. Every field listed here must be read through a Store at
+// use, never copied at construction.
+var reloadableFields = map[string]bool{
+	"LogLevel":                        true,
+	"MaxInitialTasks":                 true,
+	"InitialTasksOverflow":            true,
+	"WIPLimit":                        true,
+	"WIPLimits":                       true,
+	"WIPLimitScope":                   true,
+	"RequireActorFields":              true,
+	"DefaultCreatedBy":                true,
+	"DeletePolicy":                    true,
+	"ProjectDefaultAssignees":         true,
+	"StaleAfter":                      true,
+	"LongBlockedAfter":                true,
+	"DueDateClusterThreshold":         true,
+	"EscalationAges":                  true,
+	"EscalationMaxPriority":           true,
+	"SearchMaxOverdueShown":           true,
+	"SearchMaxTasksShown":             true,
+	"SearchMaxTextBytes":              true,
+	"SearchIncludesArchivedByDefault": true,
+	"MaxNotesReturned":                true,
+	"MinNoteLength":                   true,
+	"MaxMetaItems":                    true,
+	"SuppressedInsights":              true,
+}
+
+// loadFile reads the settings in a KEY=VALUE file. Blank lines and lines
+// starting with # are ignored.
+func loadFile(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	values := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("%s:%d: expected KEY=VALUE", path, lineNum)
+		}
+		values[strings.TrimSpace(key)] = strings.TrimSpace(value)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// Store holds the live configuration. A reload publishes a new *Config
+// instead of modifying the current one, so a snapshot returned by Current is
+// never written to and can be read without locking.
+type Store struct {
+	mu      sync.Mutex // serializes reloads
+	current atomic.Pointer[Config]
+}
+
+// NewStore returns a Store publishing cfg
+func NewStore(cfg *Config) *Store {
+	s := &Store{}
+	s.current.Store(cfg)
+	return s
+}
+
+// Current returns the configuration in effect. Handlers read it once at the
+// start of a request and pass that snapshot along, so one request sees one
+// configuration even if a reload lands part way through.
+func (s *Store) Current() *Config {
+	return s.current.Load()
+}
+
+// Reload publishes a copy of the current configuration with the reloadable
+// settings taken from next, and reports which fields changed. Changed
+// settings that require a restart keep their current value and are reported
+// in ignored.
+func (s *Store) Reload(next *Config) (changed []string, ignored []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	fresh := *s.current.Load()
+	current := reflect.ValueOf(&fresh).Elem()
+	updated := reflect.ValueOf(next).Elem()
+	for i := 0; i < current.NumField(); i++ {
+		name := current.Type().Field(i).Name
+		if reflect.DeepEqual(current.Field(i).Interface(), updated.Field(i).Interface()) {
+			continue
+		}
+		if !reloadableFields[name] {
+			ignored = append(ignored, name)
+			continue
+		}
+		current.Field(i).Set(updated.Field(i))
+		changed = append(changed, name)
+	}
+	s.current.Store(&fresh)
+	return changed, ignored
+}
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "taskman.env")
+	contents := "# thresholds\n\nTASKMAN_WIP_LIMIT = 8\nTASKMAN_STALE_AFTER=48h\n"
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	t.Setenv(ConfigFileEnv, path)
+	t.Setenv("TASKMAN_WIP_LIMIT", "")
+	t.Setenv("TASKMAN_STALE_AFTER", "")
+
+	cfg := Load()
+	if cfg.WIPLimit != 8 {
+		t.Errorf("Expected WIPLimit 8 from file, got %d", cfg.WIPLimit)
+	}
+	if cfg.StaleAfter != 48*time.Hour {
+		t.Errorf("Expected StaleAfter 48h from file, got %v", cfg.StaleAfter)
+	}
+
+	// A key removed from the file falls back to the environment
+	t.Setenv("TASKMAN_WIP_LIMIT", "3")
+	if err := os.WriteFile(path, []byte("TASKMAN_STALE_AFTER=48h\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	if cfg := Load(); cfg.WIPLimit != 3 {
+		t.Errorf("Expected WIPLimit 3 from the environment after removal from file, got %d", cfg.WIPLimit)
+	}
+	if os.Getenv("TASKMAN_STALE_AFTER") != "" {
+		t.Error("Expected the config file to leave the environment untouched")
+	}
+
+	if err := os.WriteFile(path, []byte("not a setting\n"), 0o600); err != nil {
+		t.Fatalf("Failed to write config file: %v", err)
+	}
+	if _, err := loadFile(path); err == nil {
+		t.Error("Expected error for malformed line")
+	}
+}
+
+func TestStoreReload(t *testing.T) {
+	cfg := Default()
+	store := NewStore(cfg)
+	next := Default()
+	next.LogLevel = "DEBUG"
+	next.SuppressedInsights = []string{"overview.high_activity"}
+	next.TransportMode = "http"
+
+	changed, ignored := store.Reload(next)
+
+	if len(changed) != 2 || changed[0] != "LogLevel" || changed[1] != "SuppressedInsights" {
+		t.Errorf("Expected LogLevel and SuppressedInsights to change, got %v", changed)
+	}
+	if len(ignored) != 1 || ignored[0] != "TransportMode" {
+		t.Errorf("Expected TransportMode to be ignored, got %v", ignored)
+	}
+	current := store.Current()
+	if current.LogLevel != "DEBUG" || len(current.SuppressedInsights) != 1 {
+		t.Errorf("Expected reloadable settings applied, got %+v", current)
+	}
+	if current.TransportMode != "stdio" {
+		t.Errorf("Expected transport mode unchanged, got %s", current.TransportMode)
+	}
+	if cfg.LogLevel != "INFO" || len(cfg.SuppressedInsights) != 0 {
+		t.Errorf("Expected the previous snapshot to be left untouched, got %+v", cfg)
+	}
+}
@@ -0,0 +1,65 @@
+package identity
+
+import (
+	"strings"
+
+	"github.com/bchamber/taskman-mcp/internal/config"
+)
+
+// Normalizer maps the different ways people enter an assignee ("jdoe",
+// "John Doe") to one canonical identity so workload reports and filters treat
+// them as the same person. Stored values are never rewritten.
+type Normalizer struct {
+	aliases map[string]string
+	fold    bool
+}
+
+// NewNormalizer creates a normalizer from an alias map of entered form to
+// canonical name. When fold is set, names are also compared ignoring case and
+// repeated whitespace, and canonical names are reported in that folded form.
+func NewNormalizer(aliases map[string]string, fold bool) *Normalizer {
+	n := &Normalizer{aliases: make(map[string]string, len(aliases)), fold: fold}
+	for alias, canonical := range aliases {
+		n.aliases[n.key(alias)] = n.key(canonical)
+	}
+	return n
+}
+
+// NewNormalizerFromConfig creates a normalizer from the assignee settings
+func NewNormalizerFromConfig(cfg *config.Config) *Normalizer {
+	if cfg == nil {
+		cfg = config.Default()
+	}
+	return NewNormalizer(cfg.AssigneeAliases, cfg.AssigneeNormalize)
+}
+
+// Enabled reports whether the normalizer can change any name
+func (n *Normalizer) Enabled() bool {
+	return n != nil && (n.fold || len(n.aliases) > 0)
+}
+
+// Canonical returns the identity a raw assignee value rolls up to
+func (n *Normalizer) Canonical(name string) string {
+	if !n.Enabled() {
+		return name
+	}
+	key := n.key(name)
+	if canonical, ok := n.aliases[key]; ok {
+		return canonical
+	}
+	return key
+}
+
+// Matches reports whether a possibly unset assignee is the same person as want
+func (n *Normalizer) Matches(assignee *string, want string) bool {
+	return assignee != nil && n.Canonical(*assignee) == n.Canonical(want)
+}
+
+// key returns the lookup form of a name
+func (n *Normalizer) key(name string) string {
+	name = strings.TrimSpace(name)
+	if !n.fold {
+		return name
+	}
+	return strings.ToLower(strings.Join(strings.Fields(name), " "))
+}
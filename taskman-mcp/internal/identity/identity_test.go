@@ -0,0 +1,62 @@
+package identity
+
+import (
+	"testing"
+
+	"github.com/bchamber/taskman-mcp/internal/config"
+)
+
+func TestNormalizer_Canonical(t *testing.T) {
+	n := NewNormalizer(map[string]string{"jdoe": "john.doe", "John Doe": "john.doe"}, false)
+
+	tests := map[string]string{
+		"jdoe":     "john.doe",
+		"John Doe": "john.doe",
+		"john.doe": "john.doe",
+		"JDOE":     "JDOE",
+		"alice":    "alice",
+	}
+	for raw, want := range tests {
+		if got := n.Canonical(raw); got != want {
+			t.Errorf("Canonical(%q) = %q, want %q", raw, got, want)
+		}
+	}
+}
+
+func TestNormalizer_Fold(t *testing.T) {
+	n := NewNormalizer(map[string]string{"John Doe": "John.Doe"}, true)
+
+	for _, raw := range []string{"john  doe", " JOHN DOE ", "John.Doe", "john.doe"} {
+		if got := n.Canonical(raw); got != "john.doe" {
+			t.Errorf("Canonical(%q) = %q, want john.doe", raw, got)
+		}
+	}
+	if got := n.Canonical("Alice  Smith"); got != "alice smith" {
+		t.Errorf("Expected unaliased names to be folded, got %q", got)
+	}
+}
+
+func TestNormalizer_Matches(t *testing.T) {
+	n := NewNormalizer(map[string]string{"jdoe": "john.doe"}, false)
+	raw := "jdoe"
+
+	if !n.Matches(&raw, "john.doe") {
+		t.Error("Expected alias to match its canonical name")
+	}
+	if n.Matches(nil, "john.doe") {
+		t.Error("Expected unassigned task not to match")
+	}
+	if n.Matches(&raw, "alice") {
+		t.Error("Expected different people not to match")
+	}
+}
+
+func TestNormalizer_Disabled(t *testing.T) {
+	n := NewNormalizerFromConfig(config.Default())
+	if n.Enabled() {
+		t.Error("Expected default configuration to leave assignees untouched")
+	}
+	if got := n.Canonical(" John Doe "); got != " John Doe " {
+		t.Errorf("Expected raw name when disabled, got %q", got)
+	}
+}
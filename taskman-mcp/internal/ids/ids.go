@@ -0,0 +1,46 @@
+package ids
+
+import (
+	"crypto/rand"
+	"fmt"
+	"sync/atomic"
+)
+
+// Generator mints identifiers. Production code uses UUIDs; tests inject a
+// Sequence so they can assert exact IDs.
+type Generator interface {
+	NewID() string
+}
+
+// Default is the generator used when none is injected
+var Default Generator = UUID{}
+
+// UUID generates random version 4 UUIDs
+type UUID struct{}
+
+// NewID returns a new random UUID
+func (UUID) NewID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		panic(fmt.Sprintf("ids: failed to read random bytes: %v", err))
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// Sequence generates deterministic IDs of the form <prefix>-1, <prefix>-2, ...
+type Sequence struct {
+	prefix string
+	next   atomic.Int64
+}
+
+// NewSequence creates a deterministic generator with the given prefix
+func NewSequence(prefix string) *Sequence {
+	return &Sequence{prefix: prefix}
+}
+
+// NewID returns the next ID in the sequence
+func (s *Sequence) NewID() string {
+	return fmt.Sprintf("%s-%d", s.prefix, s.next.Add(1))
+}
@@ -0,0 +1,56 @@
+package ids
+
+import (
+	"regexp"
+	"sync"
+	"testing"
+)
+
+func TestSequence_NewID(t *testing.T) {
+	seq := NewSequence("task")
+
+	for _, want := range []string{"task-1", "task-2", "task-3"} {
+		if got := seq.NewID(); got != want {
+			t.Errorf("Expected %s, got %s", want, got)
+		}
+	}
+}
+
+func TestSequence_Concurrent(t *testing.T) {
+	seq := NewSequence("note")
+
+	var mu sync.Mutex
+	seen := make(map[string]bool)
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			id := seq.NewID()
+			mu.Lock()
+			seen[id] = true
+			mu.Unlock()
+		}()
+	}
+	wg.Wait()
+
+	if len(seen) != 50 {
+		t.Errorf("Expected 50 unique IDs, got %d", len(seen))
+	}
+	if got := seq.NewID(); got != "note-51" {
+		t.Errorf("Expected note-51 after 50 IDs, got %s", got)
+	}
+}
+
+func TestUUID_NewID(t *testing.T) {
+	pattern := regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-4[0-9a-f]{3}-[89ab][0-9a-f]{3}-[0-9a-f]{12}$`)
+
+	first := UUID{}.NewID()
+	second := UUID{}.NewID()
+	if !pattern.MatchString(first) {
+		t.Errorf("Expected a version 4 UUID, got %s", first)
+	}
+	if first == second {
+		t.Errorf("Expected distinct UUIDs, got %s twice", first)
+	}
+}
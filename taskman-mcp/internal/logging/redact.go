@@ -0,0 +1,79 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+)
+
+// DefaultMaxBytes is the default size limit for payloads written to logs
+const DefaultMaxBytes = 2048
+
+// redactedValue replaces sensitive values in logs
+const redactedValue = "[REDACTED]"
+
+// sensitiveHeaderMarkers identify headers whose values must never be logged
+var sensitiveHeaderMarkers = []string{"authorization", "cookie", "token", "secret", "api-key", "apikey", "password"}
+
+// Truncate shortens s to at most maxBytes without splitting a UTF-8 sequence,
+// noting how many bytes were dropped. A non-positive maxBytes disables truncation.
+func Truncate(s string, maxBytes int) string {
+	if maxBytes <= 0 || len(s) <= maxBytes {
+		return s
+	}
+
+	cut := maxBytes
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return fmt.Sprintf("%s...(truncated %d bytes)", s[:cut], len(s)-cut)
+}
+
+// JSONPayload returns data for logging under maxBytes. Payloads that fit and
+// are valid JSON are returned as json.RawMessage so a JSON log handler embeds
+// them as objects instead of escaped strings; text handlers still print them
+// as plain strings. Anything else is truncated and returned as a string.
+func JSONPayload(data []byte, maxBytes int) any {
+	if (maxBytes <= 0 || len(data) <= maxBytes) && json.Valid(data) {
+		return json.RawMessage(data)
+	}
+	return Truncate(string(data), maxBytes)
+}
+
+// IsSensitiveHeader reports whether a header may carry credentials
+func IsSensitiveHeader(name string) bool {
+	lower := strings.ToLower(name)
+	for _, marker := range sensitiveHeaderMarkers {
+		if strings.Contains(lower, marker) {
+			return true
+		}
+	}
+	return false
+}
+
+// RedactHeaders returns a loggable copy of the headers with credentials
+// masked. alsoSensitive names further headers to mask, such as a configured
+// API key header whose name carries none of the usual markers.
+func RedactHeaders(headers http.Header, alsoSensitive ...string) map[string]string {
+	redacted := make(map[string]string, len(headers))
+	for name, values := range headers {
+		if IsSensitiveHeader(name) || containsFold(alsoSensitive, name) {
+			redacted[name] = redactedValue
+			continue
+		}
+		redacted[name] = strings.Join(values, ", ")
+	}
+	return redacted
+}
+
+// containsFold reports whether names contains name, ignoring case
+func containsFold(names []string, name string) bool {
+	for _, candidate := range names {
+		if strings.EqualFold(candidate, name) {
+			return true
+		}
+	}
+	return false
+}
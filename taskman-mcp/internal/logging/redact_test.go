@@ -0,0 +1,72 @@
+package logging
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		maxBytes int
+		expected string
+	}{
+		{"under limit", "hello", 10, "hello"},
+		{"at limit", "hello", 5, "hello"},
+		{"over limit", "hello world", 5, "hello...(truncated 6 bytes)"},
+		{"disabled", "hello world", 0, "hello world"},
+		{"multibyte boundary", "héllo", 2, "h...(truncated 5 bytes)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Truncate(tt.input, tt.maxBytes); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestRedactHeaders(t *testing.T) {
+	headers := http.Header{}
+	headers.Set("Authorization", "Bearer secret-token")
+	headers.Set("X-API-Key", "abc123")
+	headers.Set("Cookie", "session=xyz")
+	headers.Set("Content-Type", "application/json")
+
+	redacted := RedactHeaders(headers)
+
+	for _, name := range []string{"Authorization", "X-Api-Key", "Cookie"} {
+		if redacted[name] != redactedValue {
+			t.Errorf("Expected %s to be redacted, got %q", name, redacted[name])
+		}
+	}
+	if redacted["Content-Type"] != "application/json" {
+		t.Errorf("Expected Content-Type to be preserved, got %q", redacted["Content-Type"])
+	}
+	for _, value := range redacted {
+		if strings.Contains(value, "secret-token") || strings.Contains(value, "abc123") {
+			t.Errorf("Credential leaked into redacted headers: %q", value)
+		}
+	}
+
+	headers.Set("X-Taskman-Auth", "custom-credential")
+	if redacted := RedactHeaders(headers, "x-taskman-auth"); redacted["X-Taskman-Auth"] != redactedValue {
+		t.Errorf("Expected an extra sensitive header to be redacted, got %q", redacted["X-Taskman-Auth"])
+	}
+}
+
+func TestJSONPayload(t *testing.T) {
+	if got, ok := JSONPayload([]byte(`{"name":"get_task_overview"}`), 100).(json.RawMessage); !ok || string(got) != `{"name":"get_task_overview"}` {
+		t.Errorf("Expected valid JSON under the limit as json.RawMessage, got %#v", got)
+	}
+	if got := JSONPayload([]byte(`{"name":"get_task_overview"}`), 10); got != `{"name":"g...(truncated 18 bytes)` {
+		t.Errorf("Expected oversized JSON truncated to a string, got %#v", got)
+	}
+	if got := JSONPayload([]byte("not json"), 100); got != "not json" {
+		t.Errorf("Expected invalid JSON returned as a string, got %#v", got)
+	}
+}
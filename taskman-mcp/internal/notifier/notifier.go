@@ -0,0 +1,202 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bchamber/taskman-mcp/internal/ids"
+)
+
+// Event is an outbound notification delivered to webhook subscribers
+type Event struct {
+	ID        string         `json:"id"`
+	Type      string         `json:"type"`
+	Timestamp time.Time      `json:"timestamp"`
+	Data      map[string]any `json:"data,omitempty"`
+}
+
+// DeliverFunc delivers a single event, returning an error if delivery failed
+type DeliverFunc func(ctx context.Context, event Event) error
+
+// Notifier queues outbound events in a bounded in-memory queue and delivers
+// them from a background worker so tool calls never block on webhooks
+type Notifier struct {
+	queue   chan Event
+	deliver DeliverFunc
+	ids     ids.Generator
+	abort   chan struct{}
+	done    chan struct{}
+
+	mutex  sync.RWMutex
+	closed bool
+
+	delivered atomic.Int64
+	failed    atomic.Int64
+	dropped   atomic.Int64
+}
+
+// NewNotifier creates a notifier with the given queue size and starts its worker
+func NewNotifier(queueSize int, deliver DeliverFunc) *Notifier {
+	if queueSize <= 0 {
+		queueSize = 1
+	}
+
+	n := &Notifier{
+		queue:   make(chan Event, queueSize),
+		deliver: deliver,
+		ids:     ids.Default,
+		abort:   make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+
+	go n.run()
+
+	slog.Info("Notifier started", "queue_size", queueSize)
+	return n
+}
+
+// SetIDGenerator replaces the generator used to assign event IDs
+func (n *Notifier) SetIDGenerator(generator ids.Generator) {
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+	n.ids = generator
+}
+
+// NewWebhookDeliverer returns a DeliverFunc that POSTs events as JSON to the given URL
+func NewWebhookDeliverer(webhookURL string, timeout time.Duration) DeliverFunc {
+	httpClient := &http.Client{Timeout: timeout}
+
+	return func(ctx context.Context, event Event) error {
+		body, err := json.Marshal(event)
+		if err != nil {
+			return fmt.Errorf("failed to marshal event: %w", err)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, "POST", webhookURL, bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("failed to create webhook request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("webhook request failed: %w", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode >= 400 {
+			return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+		}
+		return nil
+	}
+}
+
+// Enqueue adds an event to the queue, returning false if the queue is full or shut down
+func (n *Notifier) Enqueue(event Event) bool {
+	n.mutex.RLock()
+	defer n.mutex.RUnlock()
+
+	if n.closed {
+		slog.Warn("Notifier is shut down, dropping event", "type", event.Type)
+		n.dropped.Add(1)
+		return false
+	}
+
+	if event.ID == "" {
+		event.ID = n.ids.NewID()
+	}
+	if event.Timestamp.IsZero() {
+		event.Timestamp = time.Now()
+	}
+
+	select {
+	case n.queue <- event:
+		return true
+	default:
+		slog.Warn("Notifier queue is full, dropping event", "type", event.Type)
+		n.dropped.Add(1)
+		return false
+	}
+}
+
+// Pending returns the number of events waiting to be delivered
+func (n *Notifier) Pending() int {
+	return len(n.queue)
+}
+
+// Delivered returns the number of events successfully delivered
+func (n *Notifier) Delivered() int64 {
+	return n.delivered.Load()
+}
+
+// Shutdown stops accepting new events and drains the queue until it is empty
+// or ctx expires. It returns the number of events pending when shutdown began
+// and the number delivered during the drain.
+func (n *Notifier) Shutdown(ctx context.Context) (pending int, delivered int) {
+	n.mutex.Lock()
+	if n.closed {
+		n.mutex.Unlock()
+		return 0, 0
+	}
+	n.closed = true
+	pending = len(n.queue)
+	before := n.delivered.Load()
+	close(n.queue)
+	n.mutex.Unlock()
+
+	slog.Info("Draining notifier queue", "pending", pending)
+
+	select {
+	case <-n.done:
+	case <-ctx.Done():
+		close(n.abort)
+		slog.Warn("Notifier drain timed out, abandoning remaining events", "remaining", len(n.queue))
+	}
+
+	delivered = int(n.delivered.Load() - before)
+	slog.Info("Notifier drain completed",
+		"pending", pending,
+		"delivered", delivered,
+		"failed", n.failed.Load(),
+		"dropped", n.dropped.Load(),
+	)
+
+	return pending, delivered
+}
+
+// run delivers queued events until the queue is closed or the drain is aborted
+func (n *Notifier) run() {
+	defer close(n.done)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		select {
+		case <-n.abort:
+			cancel()
+		case <-n.done:
+		}
+	}()
+
+	for event := range n.queue {
+		select {
+		case <-n.abort:
+			return
+		default:
+		}
+
+		if err := n.deliver(ctx, event); err != nil {
+			n.failed.Add(1)
+			slog.Error("Failed to deliver event", "type", event.Type, "error", err)
+			continue
+		}
+		n.delivered.Add(1)
+	}
+}
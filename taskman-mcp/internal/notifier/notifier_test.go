@@ -0,0 +1,161 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bchamber/taskman-mcp/internal/ids"
+)
+
+func TestNotifier_ShutdownDrainsQueue(t *testing.T) {
+	var mutex sync.Mutex
+	var attempted []string
+	release := make(chan struct{})
+
+	notifier := NewNotifier(10, func(ctx context.Context, event Event) error {
+		<-release
+		mutex.Lock()
+		attempted = append(attempted, event.Type)
+		mutex.Unlock()
+		return nil
+	})
+
+	for _, eventType := range []string{"task.created", "task.updated", "note.added"} {
+		if !notifier.Enqueue(Event{Type: eventType}) {
+			t.Fatalf("Failed to enqueue %s", eventType)
+		}
+	}
+
+	// Let the worker proceed only once shutdown has started
+	go func() {
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+
+	pending, delivered := notifier.Shutdown(ctx)
+
+	if pending < 2 {
+		t.Errorf("Expected at least 2 pending events at shutdown, got %d", pending)
+	}
+	if delivered < pending {
+		t.Errorf("Expected all %d pending events delivered, got %d", pending, delivered)
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	if len(attempted) != 3 {
+		t.Errorf("Expected 3 delivery attempts, got %d", len(attempted))
+	}
+
+	if notifier.Enqueue(Event{Type: "late.event"}) {
+		t.Error("Expected enqueue after shutdown to be rejected")
+	}
+}
+
+func TestNotifier_ShutdownTimeout(t *testing.T) {
+	notifier := NewNotifier(10, func(ctx context.Context, event Event) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	notifier.Enqueue(Event{Type: "slow.event"})
+	notifier.Enqueue(Event{Type: "never.delivered"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, delivered := notifier.Shutdown(ctx)
+
+	if time.Since(start) > time.Second {
+		t.Error("Expected shutdown to respect the drain timeout")
+	}
+	if delivered != 0 {
+		t.Errorf("Expected no deliveries, got %d", delivered)
+	}
+}
+
+func TestNotifier_QueueFull(t *testing.T) {
+	block := make(chan struct{})
+	notifier := NewNotifier(1, func(ctx context.Context, event Event) error {
+		<-block
+		return nil
+	})
+	defer func() {
+		close(block)
+		notifier.Shutdown(context.Background())
+	}()
+
+	accepted := 0
+	for i := 0; i < 5; i++ {
+		if notifier.Enqueue(Event{Type: "burst"}) {
+			accepted++
+		}
+	}
+
+	if accepted == 5 {
+		t.Error("Expected some events to be dropped when the queue is full")
+	}
+}
+
+func TestNotifier_AssignsEventIDs(t *testing.T) {
+	var mutex sync.Mutex
+	var delivered []string
+
+	notifier := NewNotifier(10, func(ctx context.Context, event Event) error {
+		mutex.Lock()
+		delivered = append(delivered, event.ID)
+		mutex.Unlock()
+		return nil
+	})
+	notifier.SetIDGenerator(ids.NewSequence("evt"))
+
+	notifier.Enqueue(Event{Type: "task.created"})
+	notifier.Enqueue(Event{ID: "custom", Type: "task.updated"})
+	notifier.Enqueue(Event{Type: "note.added"})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+	defer cancel()
+	notifier.Shutdown(ctx)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+	expected := []string{"evt-1", "custom", "evt-2"}
+	if len(delivered) != len(expected) {
+		t.Fatalf("Expected %d events, got %d", len(expected), len(delivered))
+	}
+	for i, id := range expected {
+		if delivered[i] != id {
+			t.Errorf("Expected event %d to have ID %s, got %s", i, id, delivered[i])
+		}
+	}
+}
+
+func TestNewWebhookDeliverer(t *testing.T) {
+	var received Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("Expected JSON content type, got %s", r.Header.Get("Content-Type"))
+		}
+		json.NewDecoder(r.Body).Decode(&received)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	deliver := NewWebhookDeliverer(server.URL, 5*time.Second)
+	if err := deliver(context.Background(), Event{Type: "task.created", Data: map[string]any{"task_id": "task-1"}}); err != nil {
+		t.Fatalf("Unexpected delivery error: %v", err)
+	}
+
+	if received.Type != "task.created" {
+		t.Errorf("Expected event type task.created, got %s", received.Type)
+	}
+}
@@ -0,0 +1,34 @@
+package recency
+
+import (
+	"sort"
+	"time"
+)
+
+// After reports whether timestamp a is later than b. RFC3339 timestamps are
+// compared as instants, so differing UTC offsets order correctly; when either
+// does not parse the strings are compared instead.
+func After(a, b string) bool {
+	at, errA := time.Parse(time.RFC3339, a)
+	bt, errB := time.Parse(time.RFC3339, b)
+	if errA != nil || errB != nil {
+		return a > b
+	}
+	return at.After(bt)
+}
+
+// Newest returns up to limit items ordered newest first by the timestamp
+// createdAt returns for each. The input is left untouched and a non-positive
+// limit returns every item.
+func Newest[T any](items []T, limit int, createdAt func(T) string) []T {
+	sorted := make([]T, len(items))
+	copy(sorted, items)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return After(createdAt(sorted[i]), createdAt(sorted[j]))
+	})
+
+	if limit > 0 && len(sorted) > limit {
+		sorted = sorted[:limit]
+	}
+	return sorted
+}
@@ -0,0 +1,29 @@
+package recency
+
+import "testing"
+
+func TestAfter(t *testing.T) {
+	// 10:00 in New York is later than 12:00 UTC, though it sorts earlier as a string
+	if !After("2024-01-01T10:00:00-05:00", "2024-01-01T12:00:00Z") {
+		t.Error("Expected offsets to be compared as instants")
+	}
+	if !After("2024-01-02", "2024-01-01") {
+		t.Error("Expected unparseable timestamps to fall back to string order")
+	}
+}
+
+func TestNewest(t *testing.T) {
+	items := []string{"2024-01-01T10:00:00Z", "2024-01-03T10:00:00Z", "2024-01-02T10:00:00Z"}
+	identity := func(s string) string { return s }
+
+	got := Newest(items, 2, identity)
+	if len(got) != 2 || got[0] != "2024-01-03T10:00:00Z" || got[1] != "2024-01-02T10:00:00Z" {
+		t.Errorf("Expected the two newest, newest first, got %v", got)
+	}
+	if items[0] != "2024-01-01T10:00:00Z" {
+		t.Error("Newest should not reorder its input")
+	}
+	if all := Newest(items, 0, identity); len(all) != 3 {
+		t.Errorf("Expected all 3 items with no limit, got %d", len(all))
+	}
+}
@@ -0,0 +1,71 @@
+package render
+
+import (
+	"github.com/bchamber/taskman-mcp/internal/config"
+)
+
+// Priority styles supported by PriorityRenderer
+const (
+	PriorityStylePlain     = "plain"
+	PriorityStyleDecorated = "decorated"
+)
+
+// decoratedPriorityLabels are the built-in markers used by the decorated style
+var decoratedPriorityLabels = map[string]string{
+	"High":   "🔴 High",
+	"Medium": "🟡 Medium",
+	"Low":    "🟢 Low",
+}
+
+// PriorityRenderer maps task priorities to display labels so every tool and
+// resource renders them the same way
+type PriorityRenderer struct {
+	labels      map[string]string
+	placeholder string
+}
+
+// NewPriorityRenderer creates a renderer for the given style. Entries in
+// labels override the style's label for that priority.
+func NewPriorityRenderer(style string, labels map[string]string, placeholder string) *PriorityRenderer {
+	resolved := make(map[string]string)
+	if style == PriorityStyleDecorated {
+		for priority, label := range decoratedPriorityLabels {
+			resolved[priority] = label
+		}
+	}
+	for priority, label := range labels {
+		resolved[priority] = label
+	}
+
+	return &PriorityRenderer{
+		labels:      resolved,
+		placeholder: placeholder,
+	}
+}
+
+// NewPriorityRendererFromConfig creates a renderer from the priority display settings
+func NewPriorityRendererFromConfig(cfg *config.Config) *PriorityRenderer {
+	if cfg == nil {
+		cfg = config.Default()
+	}
+	return NewPriorityRenderer(cfg.PriorityStyle, cfg.PriorityLabels, cfg.PriorityPlaceholder)
+}
+
+// Render returns the display label for a possibly unset priority
+func (r *PriorityRenderer) Render(priority *string) string {
+	if priority == nil {
+		return r.placeholder
+	}
+	return r.Label(*priority)
+}
+
+// Label returns the display label for a priority value
+func (r *PriorityRenderer) Label(priority string) string {
+	if priority == "" {
+		return r.placeholder
+	}
+	if label, ok := r.labels[priority]; ok {
+		return label
+	}
+	return priority
+}
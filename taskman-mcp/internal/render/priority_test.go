@@ -0,0 +1,56 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/bchamber/taskman-mcp/internal/config"
+)
+
+func TestPriorityRenderer_Render(t *testing.T) {
+	high := "High"
+	empty := ""
+	custom := "Urgent"
+
+	tests := []struct {
+		name        string
+		style       string
+		labels      map[string]string
+		placeholder string
+		priority    *string
+		expected    string
+	}{
+		{"plain high", PriorityStylePlain, nil, "None", &high, "High"},
+		{"decorated high", PriorityStyleDecorated, nil, "None", &high, "🔴 High"},
+		{"override label", PriorityStyleDecorated, map[string]string{"High": "[P1]"}, "None", &high, "[P1]"},
+		{"plain with labels", PriorityStylePlain, map[string]string{"High": "[P1]"}, "None", &high, "[P1]"},
+		{"unknown priority passes through", PriorityStyleDecorated, nil, "None", &custom, "Urgent"},
+		{"nil uses placeholder", PriorityStyleDecorated, nil, "—", nil, "—"},
+		{"empty uses placeholder", PriorityStylePlain, nil, "Unset", &empty, "Unset"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			renderer := NewPriorityRenderer(tt.style, tt.labels, tt.placeholder)
+			if got := renderer.Render(tt.priority); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestNewPriorityRendererFromConfig(t *testing.T) {
+	renderer := NewPriorityRendererFromConfig(nil)
+	if got := renderer.Render(nil); got != "None" {
+		t.Errorf("Expected default placeholder 'None', got %q", got)
+	}
+	if got := renderer.Label("High"); got != "High" {
+		t.Errorf("Expected plain label 'High' by default, got %q", got)
+	}
+
+	cfg := config.Default()
+	cfg.PriorityStyle = PriorityStyleDecorated
+	renderer = NewPriorityRendererFromConfig(cfg)
+	if got := renderer.Label("Low"); got != "🟢 Low" {
+		t.Errorf("Expected decorated label, got %q", got)
+	}
+}
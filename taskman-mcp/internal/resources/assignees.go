@@ -0,0 +1,40 @@
+package resources
+
+import (
+	"fmt"
+	"net/url"
+
+	"github.com/bchamber/taskman-mcp/internal/identity"
+)
+
+// userTasksPath returns the API path for a user's assigned tasks. With
+// assignee aliasing enabled every task is fetched and filterAssignedTo picks
+// the user's, since the API only matches the raw stored value.
+func userTasksPath(assignees *identity.Normalizer, userID string) string {
+	if assignees.Enabled() {
+		return "/api/v1/tasks"
+	}
+	return fmt.Sprintf("/api/v1/tasks?assigned_to=%s", url.QueryEscape(userID))
+}
+
+// filterAssignedTo keeps the tasks assigned to any form of userID
+func filterAssignedTo(assignees *identity.Normalizer, tasks []Task, userID string) []Task {
+	if !assignees.Enabled() {
+		return tasks
+	}
+	filtered := []Task{}
+	for _, task := range tasks {
+		if assignees.Matches(task.AssignedTo, userID) {
+			filtered = append(filtered, task)
+		}
+	}
+	return filtered
+}
+
+// assigneeKey returns the workload bucket a task counts toward
+func assigneeKey(assignees *identity.Normalizer, task Task) string {
+	if task.AssignedTo == nil {
+		return "Unassigned"
+	}
+	return assignees.Canonical(*task.AssignedTo)
+}
@@ -9,22 +9,40 @@ import (
 	"strings"
 	"time"
 
+	"github.com/bchamber/taskman-mcp/internal/calendar"
 	"github.com/bchamber/taskman-mcp/internal/client"
+	"github.com/bchamber/taskman-mcp/internal/clock"
+	"github.com/bchamber/taskman-mcp/internal/config"
+	"github.com/bchamber/taskman-mcp/internal/identity"
+	"github.com/bchamber/taskman-mcp/internal/render"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // DashboardResources handles dashboard-related MCP resources
 type DashboardResources struct {
-	apiClient *client.APIClient
+	apiClient  *client.APIClient
+	priorities *render.PriorityRenderer
+	assignees  *identity.Normalizer
+	calendar   *calendar.Calendar
+	clock      clock.Clock
 }
 
 // NewDashboardResources creates a new dashboard resources handler
-func NewDashboardResources(apiClient *client.APIClient) *DashboardResources {
+func NewDashboardResources(apiClient *client.APIClient, cfg *config.Config) *DashboardResources {
 	return &DashboardResources{
-		apiClient: apiClient,
+		apiClient:  apiClient,
+		priorities: render.NewPriorityRendererFromConfig(cfg),
+		assignees:  identity.NewNormalizerFromConfig(cfg),
+		calendar:   calendar.FromConfig(cfg),
+		clock:      clock.Default,
 	}
 }
 
+// SetClock replaces the clock used for overdue and due-soon calculations
+func (dr *DashboardResources) SetClock(c clock.Clock) {
+	dr.clock = c
+}
+
 // HandleSystemDashboardResource handles system dashboard resource requests
 func (dr *DashboardResources) HandleSystemDashboardResource(
 	ctx context.Context,
@@ -60,7 +78,7 @@ func (dr *DashboardResources) HandleSystemDashboardResource(
 	}
 
 	// Build formatted response
-	response := buildSystemDashboardResponse(tasks, projects)
+	response := buildSystemDashboardResponse(dr.priorities, dr.assignees, tasks, projects, dr.clock.Now())
 
 	slog.Info("System dashboard resource retrieved", "task_count", len(tasks), "project_count", len(projects))
 
@@ -95,7 +113,7 @@ func (dr *DashboardResources) HandleUserDashboardResource(
 	}
 
 	// Get tasks assigned to user
-	tasksResp, err := dr.apiClient.Get(ctx, fmt.Sprintf("/api/v1/tasks?assigned_to=%s", url.QueryEscape(userID)))
+	tasksResp, err := dr.apiClient.Get(ctx, userTasksPath(dr.assignees, userID))
 	if err != nil {
 		slog.Error("Failed to get user tasks", "error", err, "user_id", userID)
 		return nil, fmt.Errorf("failed to get user tasks: %w", err)
@@ -106,6 +124,7 @@ func (dr *DashboardResources) HandleUserDashboardResource(
 		slog.Error("Failed to parse user tasks", "error", err)
 		return nil, fmt.Errorf("failed to parse user tasks: %w", err)
 	}
+	tasks = filterAssignedTo(dr.assignees, tasks, userID)
 
 	// Get tasks created by user
 	createdTasksResp, err := dr.apiClient.Get(ctx, fmt.Sprintf("/api/v1/tasks?created_by=%s", url.QueryEscape(userID)))
@@ -121,7 +140,7 @@ func (dr *DashboardResources) HandleUserDashboardResource(
 	}
 
 	// Build formatted response
-	response := buildUserDashboardResponse(userID, tasks, createdTasks)
+	response := buildUserDashboardResponse(dr.priorities, dr.calendar, userID, tasks, createdTasks, dr.clock.Now())
 
 	slog.Info("User dashboard resource retrieved", "user_id", userID, "assigned_tasks", len(tasks), "created_tasks", len(createdTasks))
 
@@ -182,7 +201,7 @@ func (dr *DashboardResources) HandleProjectDashboardResource(
 	}
 
 	// Build formatted response
-	response := buildProjectDashboardResponse(project, tasks)
+	response := buildProjectDashboardResponse(dr.priorities, dr.assignees, project, tasks, dr.clock.Now())
 
 	slog.Info("Project dashboard resource retrieved", "project_id", projectID, "task_count", len(tasks))
 
@@ -198,11 +217,11 @@ func (dr *DashboardResources) HandleProjectDashboardResource(
 }
 
 // buildSystemDashboardResponse formats system dashboard data
-func buildSystemDashboardResponse(tasks []Task, projects []Project) string {
+func buildSystemDashboardResponse(priorities *render.PriorityRenderer, assignees *identity.Normalizer, tasks []Task, projects []Project, now time.Time) string {
 	var response strings.Builder
 
 	response.WriteString("# System Dashboard\n\n")
-	response.WriteString(fmt.Sprintf("**Generated:** %s\n\n", time.Now().Format("2006-01-02 15:04:05")))
+	response.WriteString(fmt.Sprintf("**Generated:** %s\n\n", now.Format("2006-01-02 15:04:05")))
 
 	// Overall statistics
 	response.WriteString("## Overview\n")
@@ -217,22 +236,13 @@ func buildSystemDashboardResponse(tasks []Task, projects []Project) string {
 		overdueTasks := 0
 		completedTasks := 0
 
-		now := time.Now()
 
 		for _, task := range tasks {
 			statusCounts[task.Status]++
 
-			if task.Priority != nil {
-				priorityCounts[*task.Priority]++
-			} else {
-				priorityCounts["None"]++
-			}
+			priorityCounts[priorities.Render(task.Priority)]++
 
-			if task.AssignedTo != nil {
-				assigneeCounts[*task.AssignedTo]++
-			} else {
-				assigneeCounts["Unassigned"]++
-			}
+			assigneeCounts[assigneeKey(assignees, task)]++
 
 			if task.Status == "Complete" {
 				completedTasks++
@@ -337,11 +347,11 @@ func buildSystemDashboardResponse(tasks []Task, projects []Project) string {
 }
 
 // buildUserDashboardResponse formats user dashboard data
-func buildUserDashboardResponse(userID string, assignedTasks []Task, createdTasks []Task) string {
+func buildUserDashboardResponse(priorities *render.PriorityRenderer, cal *calendar.Calendar, userID string, assignedTasks []Task, createdTasks []Task, now time.Time) string {
 	var response strings.Builder
 
 	response.WriteString(fmt.Sprintf("# Dashboard for %s\n\n", userID))
-	response.WriteString(fmt.Sprintf("**Generated:** %s\n\n", time.Now().Format("2006-01-02 15:04:05")))
+	response.WriteString(fmt.Sprintf("**Generated:** %s\n\n", now.Format("2006-01-02 15:04:05")))
 
 	// User statistics
 	response.WriteString("## My Statistics\n")
@@ -355,16 +365,11 @@ func buildUserDashboardResponse(userID string, assignedTasks []Task, createdTask
 		overdueTasks := 0
 		completedTasks := 0
 
-		now := time.Now()
 
 		for _, task := range assignedTasks {
 			statusCounts[task.Status]++
 
-			if task.Priority != nil {
-				priorityCounts[*task.Priority]++
-			} else {
-				priorityCounts["None"]++
-			}
+			priorityCounts[priorities.Render(task.Priority)]++
 
 			if task.Status == "Complete" {
 				completedTasks++
@@ -409,10 +414,7 @@ func buildUserDashboardResponse(userID string, assignedTasks []Task, createdTask
 			response.WriteString(fmt.Sprintf("**Active Tasks:** %d\n\n", len(activeTasks)))
 
 			for _, task := range activeTasks {
-				priority := "None"
-				if task.Priority != nil {
-					priority = *task.Priority
-				}
+				priority := priorities.Render(task.Priority)
 
 				dueDate := "No due date"
 				if task.DueDate != nil {
@@ -432,7 +434,7 @@ func buildUserDashboardResponse(userID string, assignedTasks []Task, createdTask
 			if task.DueDate != nil && task.Status != "Complete" {
 				if dueDate, err := time.Parse(time.RFC3339, *task.DueDate); err == nil {
 					// Tasks due in the next 7 days
-					if dueDate.After(now) && dueDate.Before(now.AddDate(0, 0, 7)) {
+					if dueDate.After(now) && cal.EffectiveDue(dueDate).Before(cal.AddWorkingDays(now, 7)) {
 						upcomingTasks = append(upcomingTasks, task)
 					}
 				}
@@ -442,10 +444,7 @@ func buildUserDashboardResponse(userID string, assignedTasks []Task, createdTask
 		if len(upcomingTasks) > 0 {
 			response.WriteString("\n## Upcoming Deadlines (Next 7 Days)\n")
 			for _, task := range upcomingTasks {
-				priority := "None"
-				if task.Priority != nil {
-					priority = *task.Priority
-				}
+				priority := priorities.Render(task.Priority)
 				response.WriteString(fmt.Sprintf("- **%s** (%s, %s) - Due: %s\n",
 					task.TaskName, task.Status, priority, *task.DueDate))
 			}
@@ -458,13 +457,13 @@ func buildUserDashboardResponse(userID string, assignedTasks []Task, createdTask
 }
 
 // buildProjectDashboardResponse formats project dashboard data
-func buildProjectDashboardResponse(project Project, tasks []Task) string {
+func buildProjectDashboardResponse(priorities *render.PriorityRenderer, assignees *identity.Normalizer, project Project, tasks []Task, now time.Time) string {
 	var response strings.Builder
 
 	response.WriteString(fmt.Sprintf("# Project Dashboard: %s\n\n", project.ProjectName))
 	response.WriteString(fmt.Sprintf("**Project ID:** %s\n", project.ProjectID))
 	response.WriteString(fmt.Sprintf("**Created by:** %s on %s\n", project.CreatedBy, project.CreationDate))
-	response.WriteString(fmt.Sprintf("**Generated:** %s\n\n", time.Now().Format("2006-01-02 15:04:05")))
+	response.WriteString(fmt.Sprintf("**Generated:** %s\n\n", now.Format("2006-01-02 15:04:05")))
 
 	if project.ProjectDescription != nil && *project.ProjectDescription != "" {
 		response.WriteString(fmt.Sprintf("**Description:** %s\n\n", *project.ProjectDescription))
@@ -482,22 +481,13 @@ func buildProjectDashboardResponse(project Project, tasks []Task) string {
 		overdueTasks := 0
 		completedTasks := 0
 
-		now := time.Now()
 
 		for _, task := range tasks {
 			statusCounts[task.Status]++
 
-			if task.Priority != nil {
-				priorityCounts[*task.Priority]++
-			} else {
-				priorityCounts["None"]++
-			}
+			priorityCounts[priorities.Render(task.Priority)]++
 
-			if task.AssignedTo != nil {
-				assigneeCounts[*task.AssignedTo]++
-			} else {
-				assigneeCounts["Unassigned"]++
-			}
+			assigneeCounts[assigneeKey(assignees, task)]++
 
 			if task.Status == "Complete" {
 				completedTasks++
@@ -563,10 +553,7 @@ func buildProjectDashboardResponse(project Project, tasks []Task) string {
 					assignee = *task.AssignedTo
 				}
 
-				priority := "None"
-				if task.Priority != nil {
-					priority = *task.Priority
-				}
+				priority := priorities.Render(task.Priority)
 
 				dueInfo := "No due date"
 				if task.DueDate != nil {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/bchamber/taskman-mcp/internal/client"
+	"github.com/bchamber/taskman-mcp/internal/config"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -165,7 +166,7 @@ func TestDashboardResources_HandleSystemDashboardResource(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	dashboardResources := NewDashboardResources(apiClient)
+	dashboardResources := NewDashboardResources(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -232,7 +233,7 @@ func TestDashboardResources_HandleUserDashboardResource(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	dashboardResources := NewDashboardResources(apiClient)
+	dashboardResources := NewDashboardResources(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -292,7 +293,7 @@ func TestDashboardResources_HandleProjectDashboardResource(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	dashboardResources := NewDashboardResources(apiClient)
+	dashboardResources := NewDashboardResources(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -355,7 +356,7 @@ func TestDashboardResources_HandleUserDashboardResource_InvalidURI(t *testing.T)
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	dashboardResources := NewDashboardResources(apiClient)
+	dashboardResources := NewDashboardResources(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -374,7 +375,7 @@ func TestDashboardResources_HandleUserDashboardResource_EmptyUserID(t *testing.T
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	dashboardResources := NewDashboardResources(apiClient)
+	dashboardResources := NewDashboardResources(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -393,7 +394,7 @@ func TestDashboardResources_HandleProjectDashboardResource_InvalidURI(t *testing
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	dashboardResources := NewDashboardResources(apiClient)
+	dashboardResources := NewDashboardResources(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -412,7 +413,7 @@ func TestDashboardResources_HandleProjectDashboardResource_EmptyProjectID(t *tes
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	dashboardResources := NewDashboardResources(apiClient)
+	dashboardResources := NewDashboardResources(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -425,3 +426,62 @@ func TestDashboardResources_HandleProjectDashboardResource_EmptyProjectID(t *tes
 		t.Fatal("Expected error for empty project ID")
 	}
 }
+
+func TestDashboardResources_AssigneeAliases(t *testing.T) {
+	jdoe, fullName, alice := "jdoe", "John Doe", "alice"
+	tasks := []Task{
+		{TaskID: "task-1", TaskName: "Alias task", Status: "In Progress", AssignedTo: &jdoe},
+		{TaskID: "task-2", TaskName: "Full name task", Status: "Not Started", AssignedTo: &fullName},
+		{TaskID: "task-3", TaskName: "Other task", Status: "In Progress", AssignedTo: &alice},
+	}
+	var userQuery string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects/proj-1":
+			json.NewEncoder(w).Encode(Project{ProjectID: "proj-1", ProjectName: "Aliased"})
+		case "/api/v1/projects/proj-1/tasks":
+			json.NewEncoder(w).Encode(tasks)
+		case "/api/v1/tasks":
+			if r.URL.Query().Get("created_by") == "" {
+				userQuery = r.URL.RawQuery
+			}
+			json.NewEncoder(w).Encode(tasks)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg := config.Default()
+	cfg.AssigneeAliases = map[string]string{"jdoe": "john.doe", "John Doe": "john.doe"}
+	dashboardResources := NewDashboardResources(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+
+	result, err := dashboardResources.HandleProjectDashboardResource(context.Background(), &mcp.ServerSession{}, &mcp.ReadResourceParams{
+		URI: "taskman://dashboard/project/proj-1",
+	})
+	if err != nil {
+		t.Fatalf("HandleProjectDashboardResource failed: %v", err)
+	}
+	text := result.Contents[0].Text
+	if !contains(text, "**john.doe:** 2 tasks") {
+		t.Errorf("Expected aliased assignees to roll up to one person, got: %s", text)
+	}
+	if contains(text, "**jdoe:**") || contains(text, "**John Doe:**") {
+		t.Errorf("Expected no separate workload rows for aliases, got: %s", text)
+	}
+
+	result, err = dashboardResources.HandleUserDashboardResource(context.Background(), &mcp.ServerSession{}, &mcp.ReadResourceParams{
+		URI: "taskman://dashboard/user/john.doe",
+	})
+	if err != nil {
+		t.Fatalf("HandleUserDashboardResource failed: %v", err)
+	}
+	if userQuery != "" {
+		t.Errorf("Expected assignee filter to be applied locally when aliasing, got query %q", userQuery)
+	}
+	text = result.Contents[0].Text
+	if !contains(text, "**Assigned Tasks:** 2") || contains(text, "Other task") {
+		t.Errorf("Expected user dashboard to include every alias of the user only, got: %s", text)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/bchamber/taskman-mcp/internal/client"
+	"github.com/bchamber/taskman-mcp/internal/config"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -128,7 +129,7 @@ func TestResourcesIntegration(t *testing.T) {
 
 		// We can't directly test through the MCP server without setting up transport
 		// So we'll test the resource handlers directly but through the server's registered resources
-		taskResources := NewTaskResources(client.NewAPIClient(apiServer.URL, 30*time.Second))
+		taskResources := NewTaskResources(client.NewAPIClient(apiServer.URL, 30*time.Second), config.Default())
 
 		result, err := taskResources.HandleTaskResource(ctx, session, params)
 		if err != nil {
@@ -157,7 +158,7 @@ func TestResourcesIntegration(t *testing.T) {
 			URI: "taskman://tasks/overview",
 		}
 
-		taskResources := NewTaskResources(client.NewAPIClient(apiServer.URL, 30*time.Second))
+		taskResources := NewTaskResources(client.NewAPIClient(apiServer.URL, 30*time.Second), config.Default())
 
 		result, err := taskResources.HandleTasksOverviewResource(ctx, session, params)
 		if err != nil {
@@ -215,7 +216,7 @@ func TestResourcesIntegration(t *testing.T) {
 			URI: "taskman://dashboard/system",
 		}
 
-		dashboardResources := NewDashboardResources(client.NewAPIClient(apiServer.URL, 30*time.Second))
+		dashboardResources := NewDashboardResources(client.NewAPIClient(apiServer.URL, 30*time.Second), config.Default())
 
 		result, err := dashboardResources.HandleSystemDashboardResource(ctx, session, params)
 		if err != nil {
@@ -286,7 +287,7 @@ func TestResourcesIntegration(t *testing.T) {
 			URI: "taskman://dashboard/user/integration-user",
 		}
 
-		dashboardResources := NewDashboardResources(client.NewAPIClient(apiServerWithFilter.URL, 30*time.Second))
+		dashboardResources := NewDashboardResources(client.NewAPIClient(apiServerWithFilter.URL, 30*time.Second), config.Default())
 
 		result, err := dashboardResources.HandleUserDashboardResource(ctx, session, params)
 		if err != nil {
@@ -334,7 +335,7 @@ func TestResourcesIntegrationErrorHandling(t *testing.T) {
 			URI: "taskman://task/nonexistent",
 		}
 
-		taskResources := NewTaskResources(client.NewAPIClient(apiServer.URL, 30*time.Second))
+		taskResources := NewTaskResources(client.NewAPIClient(apiServer.URL, 30*time.Second), config.Default())
 
 		_, err := taskResources.HandleTaskResource(ctx, session, params)
 		if err == nil {
@@ -370,7 +371,7 @@ func TestResourcesIntegrationErrorHandling(t *testing.T) {
 			URI: "taskman://dashboard/system",
 		}
 
-		dashboardResources := NewDashboardResources(client.NewAPIClient(apiServer.URL, 30*time.Second))
+		dashboardResources := NewDashboardResources(client.NewAPIClient(apiServer.URL, 30*time.Second), config.Default())
 
 		_, err := dashboardResources.HandleSystemDashboardResource(ctx, session, params)
 		if err == nil {
@@ -9,21 +9,39 @@ import (
 	"strings"
 
 	"github.com/bchamber/taskman-mcp/internal/client"
+	"github.com/bchamber/taskman-mcp/internal/config"
+	"github.com/bchamber/taskman-mcp/internal/identity"
+	"github.com/bchamber/taskman-mcp/internal/recency"
+	"github.com/bchamber/taskman-mcp/internal/render"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // TaskResources handles task-related MCP resources
 type TaskResources struct {
-	apiClient *client.APIClient
+	apiClient  *client.APIClient
+	priorities *render.PriorityRenderer
+	assignees  *identity.Normalizer
+	settings   *config.Store
 }
 
 // NewTaskResources creates a new task resources handler
-func NewTaskResources(apiClient *client.APIClient) *TaskResources {
+func NewTaskResources(apiClient *client.APIClient, cfg *config.Config) *TaskResources {
+	if cfg == nil {
+		cfg = config.Default()
+	}
 	return &TaskResources{
-		apiClient: apiClient,
+		apiClient:  apiClient,
+		priorities: render.NewPriorityRendererFromConfig(cfg),
+		assignees:  identity.NewNormalizerFromConfig(cfg),
+		settings:   config.NewStore(cfg),
 	}
 }
 
+// SetConfigStore reads settings from store, so reloads published there apply
+func (tr *TaskResources) SetConfigStore(store *config.Store) {
+	tr.settings = store
+}
+
 // Task represents a task from the API
 type Task struct {
 	TaskID          string   `json:"task_id"`
@@ -109,6 +127,8 @@ func (tr *TaskResources) HandleTaskResource(
 			slog.Warn("Failed to parse task notes", "error", err)
 		}
 	}
+	totalNotes := len(notes)
+	notes = recency.Newest(notes, tr.settings.Current().MaxNotesReturned, func(note TaskNote) string { return note.CreationDate })
 
 	// Get project details if task has a project
 	var project *Project
@@ -127,9 +147,9 @@ func (tr *TaskResources) HandleTaskResource(
 	}
 
 	// Build formatted response
-	response := buildTaskResourceResponse(task, notes, project)
+	response := buildTaskResourceResponse(tr.priorities, task, notes, totalNotes, project)
 
-	slog.Info("Task resource retrieved", "task_id", taskID, "note_count", len(notes), "has_project", project != nil)
+	slog.Info("Task resource retrieved", "task_id", taskID, "note_count", totalNotes, "notes_shown", len(notes), "has_project", project != nil)
 
 	return &mcp.ReadResourceResult{
 		Contents: []*mcp.ResourceContents{
@@ -164,7 +184,7 @@ func (tr *TaskResources) HandleTasksOverviewResource(
 	}
 
 	// Build formatted response
-	response := buildTasksOverviewResponse(tasks)
+	response := buildTasksOverviewResponse(tr.priorities, tr.assignees, tasks)
 
 	slog.Info("Tasks overview resource retrieved", "task_count", len(tasks))
 
@@ -199,7 +219,7 @@ func (tr *TaskResources) HandleUserTasksResource(
 	}
 
 	// Get tasks assigned to user
-	tasksResp, err := tr.apiClient.Get(ctx, fmt.Sprintf("/api/v1/tasks?assigned_to=%s", url.QueryEscape(userID)))
+	tasksResp, err := tr.apiClient.Get(ctx, userTasksPath(tr.assignees, userID))
 	if err != nil {
 		slog.Error("Failed to get user tasks", "error", err, "user_id", userID)
 		return nil, fmt.Errorf("failed to get user tasks: %w", err)
@@ -210,9 +230,10 @@ func (tr *TaskResources) HandleUserTasksResource(
 		slog.Error("Failed to parse user tasks", "error", err)
 		return nil, fmt.Errorf("failed to parse user tasks: %w", err)
 	}
+	tasks = filterAssignedTo(tr.assignees, tasks, userID)
 
 	// Build formatted response
-	response := buildUserTasksResponse(userID, tasks)
+	response := buildUserTasksResponse(tr.priorities, userID, tasks)
 
 	slog.Info("User tasks resource retrieved", "user_id", userID, "task_count", len(tasks))
 
@@ -228,16 +249,14 @@ func (tr *TaskResources) HandleUserTasksResource(
 }
 
 // buildTaskResourceResponse formats individual task data
-func buildTaskResourceResponse(task Task, notes []TaskNote, project *Project) string {
+func buildTaskResourceResponse(priorities *render.PriorityRenderer, task Task, notes []TaskNote, totalNotes int, project *Project) string {
 	var response strings.Builder
 
 	response.WriteString(fmt.Sprintf("# Task: %s\n\n", task.TaskName))
 	response.WriteString(fmt.Sprintf("**ID:** %s\n", task.TaskID))
 	response.WriteString(fmt.Sprintf("**Status:** %s\n", task.Status))
 
-	if task.Priority != nil {
-		response.WriteString(fmt.Sprintf("**Priority:** %s\n", *task.Priority))
-	}
+	response.WriteString(fmt.Sprintf("**Priority:** %s\n", priorities.Render(task.Priority)))
 
 	if task.AssignedTo != nil {
 		response.WriteString(fmt.Sprintf("**Assigned To:** %s\n", *task.AssignedTo))
@@ -264,6 +283,9 @@ func buildTaskResourceResponse(task Task, notes []TaskNote, project *Project) st
 
 	if len(notes) > 0 {
 		response.WriteString("\n## Notes\n\n")
+		if len(notes) < totalNotes {
+			response.WriteString(fmt.Sprintf("_Showing %d of %d notes, newest first._\n\n", len(notes), totalNotes))
+		}
 		for _, note := range notes {
 			response.WriteString(fmt.Sprintf("**%s** (%s):\n%s\n\n", note.CreatedBy, note.CreationDate, note.Note))
 		}
@@ -273,7 +295,7 @@ func buildTaskResourceResponse(task Task, notes []TaskNote, project *Project) st
 }
 
 // buildTasksOverviewResponse formats tasks overview data
-func buildTasksOverviewResponse(tasks []Task) string {
+func buildTasksOverviewResponse(priorities *render.PriorityRenderer, assignees *identity.Normalizer, tasks []Task) string {
 	var response strings.Builder
 
 	response.WriteString("# Tasks Overview\n\n")
@@ -287,17 +309,9 @@ func buildTasksOverviewResponse(tasks []Task) string {
 	for _, task := range tasks {
 		statusCounts[task.Status]++
 
-		if task.Priority != nil {
-			priorityCounts[*task.Priority]++
-		} else {
-			priorityCounts["None"]++
-		}
+		priorityCounts[priorities.Render(task.Priority)]++
 
-		if task.AssignedTo != nil {
-			assigneeCounts[*task.AssignedTo]++
-		} else {
-			assigneeCounts["Unassigned"]++
-		}
+		assigneeCounts[assigneeKey(assignees, task)]++
 	}
 
 	response.WriteString("## Status Breakdown\n")
@@ -341,7 +355,7 @@ func buildTasksOverviewResponse(tasks []Task) string {
 }
 
 // buildUserTasksResponse formats user tasks data
-func buildUserTasksResponse(userID string, tasks []Task) string {
+func buildUserTasksResponse(priorities *render.PriorityRenderer, userID string, tasks []Task) string {
 	var response strings.Builder
 
 	response.WriteString(fmt.Sprintf("# Tasks for %s\n\n", userID))
@@ -367,10 +381,7 @@ func buildUserTasksResponse(userID string, tasks []Task) string {
 			response.WriteString(fmt.Sprintf("## %s (%d)\n\n", status, len(statusTasks)))
 
 			for _, task := range statusTasks {
-				priority := "None"
-				if task.Priority != nil {
-					priority = *task.Priority
-				}
+				priority := priorities.Render(task.Priority)
 
 				dueDate := "No due date"
 				if task.DueDate != nil {
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	"github.com/bchamber/taskman-mcp/internal/client"
+	"github.com/bchamber/taskman-mcp/internal/config"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -142,7 +143,7 @@ func TestTaskResources_HandleTaskResource(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskResources := NewTaskResources(apiClient)
+	taskResources := NewTaskResources(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -206,7 +207,7 @@ func TestTaskResources_HandleTasksOverviewResource(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskResources := NewTaskResources(apiClient)
+	taskResources := NewTaskResources(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -264,7 +265,7 @@ func TestTaskResources_HandleUserTasksResource(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskResources := NewTaskResources(apiClient)
+	taskResources := NewTaskResources(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -315,7 +316,7 @@ func TestTaskResources_HandleTaskResource_InvalidURI(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskResources := NewTaskResources(apiClient)
+	taskResources := NewTaskResources(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -334,7 +335,7 @@ func TestTaskResources_HandleTaskResource_EmptyTaskID(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskResources := NewTaskResources(apiClient)
+	taskResources := NewTaskResources(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -353,7 +354,7 @@ func TestTaskResources_HandleUserTasksResource_InvalidURI(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskResources := NewTaskResources(apiClient)
+	taskResources := NewTaskResources(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -372,7 +373,7 @@ func TestTaskResources_HandleUserTasksResource_EmptyUserID(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskResources := NewTaskResources(apiClient)
+	taskResources := NewTaskResources(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -5,14 +5,23 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"net"
 	"net/http"
+	"os"
+	"reflect"
+	"strings"
 	"sync"
 	"time"
 
 	"github.com/bchamber/taskman-mcp/internal/client"
 	"github.com/bchamber/taskman-mcp/internal/config"
+	"github.com/bchamber/taskman-mcp/internal/logging"
+	"github.com/bchamber/taskman-mcp/internal/monitoring"
+	"github.com/bchamber/taskman-mcp/internal/notifier"
 	"github.com/bchamber/taskman-mcp/internal/prompts"
 	"github.com/bchamber/taskman-mcp/internal/resources"
+	"github.com/bchamber/taskman-mcp/internal/tenant"
+	"github.com/bchamber/taskman-mcp/internal/toolcache"
 	"github.com/bchamber/taskman-mcp/internal/tools"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
@@ -20,8 +29,48 @@ import (
 type Server struct {
 	mcpServer  *mcp.Server
 	apiClient  *client.APIClient
-	config     *config.Config
+	settings   *config.Store
 	httpServer *http.Server
+	notifier   *notifier.Notifier
+	toolCache  *toolcache.Cache
+
+	// Per-session tool execution slots, see concurrencyLimitMiddleware
+	slotsMutex   sync.Mutex
+	sessionSlots map[*mcp.ServerSession]*sessionSlots
+}
+
+// mutatingTools are tools that change data; calling any of them invalidates
+// the tool result cache. New write tools must be added here.
+var mutatingTools = map[string]bool{
+	"create_task_with_context":          true,
+	"update_task_progress":              true,
+	"add_task_note":                     true,
+	"update_task_note":                  true,
+	"delete_task_note":                  true,
+	"create_project_with_initial_tasks": true,
+	"archive_completed_tasks":           true,
+	"route_blocked_to_blocker_owner":    true,
+	"reassign_task":                     true,
+	"split_task":                        true,
+	"delete_task":                       true,
+	"delete_project":                    true,
+	"archive_project":                   true,
+	"update_project":                    true,
+	"restore_task":                      true,
+	"restore_project":                   true,
+	"bulk_tag_tasks":                    true,
+	"bulk_delete_tasks":                 true,
+	"add_task_tags":                     true,
+	"remove_task_tags":                  true,
+	"add_task_link":                     true,
+	"remove_task_link":                  true,
+	"move_note":                         true,
+	"run_priority_escalation":           true,
+	"resume_project_tasks":              true,
+	"bulk_add_tasks_to_project":         true,
+	"find_duplicate_notes":              true,
+	"add_project_milestone":             true,
+	"import_tasks":                      true,
 }
 
 func NewServer(cfg *config.Config) *Server {
@@ -44,15 +93,25 @@ func NewServer(cfg *config.Config) *Server {
 
 	// Create API client
 	apiClient := client.NewAPIClient(cfg.APIBaseURL, cfg.APITimeout)
+	apiClient.SetAuth(cfg.APIToken, cfg.APIKeyHeader)
+	if cfg.LogAPIRequests {
+		apiClient.EnableRequestLogging(cfg.LogMaxBodyBytes)
+	}
+	apiClient.SetMetricsRecorder(monitoring.GetDefault())
+	apiClient.SetTenant(cfg.Tenant)
+	apiClient.SetPagination(cfg.APIPagination, cfg.APIMaxPages)
+	apiClient.SetRetry(cfg.APIMaxRetries, cfg.APIRetryBackoff)
+	apiClient.SetCircuitBreaker(cfg.APIBreakerThreshold, cfg.APIBreakerCooldown)
+	apiClient.SetResponseCache(cfg.APICacheTTL)
 
 	server := &Server{
 		mcpServer: mcpServer,
 		apiClient: apiClient,
-		config:    cfg,
+		settings:  config.NewStore(cfg),
 	}
 
-	// Set up HTTP server if needed
-	if cfg.TransportMode == "http" || cfg.TransportMode == "both" {
+	// Set up HTTP server if needed (unix mode serves the same handlers over a socket)
+	if cfg.TransportMode == "http" || cfg.TransportMode == "both" || cfg.TransportMode == "unix" {
 		server.setupHTTPServer()
 	}
 
@@ -61,13 +120,45 @@ func NewServer(cfg *config.Config) *Server {
 	server.registerResources()
 	server.registerPrompts()
 
+	// Each middleware added wraps the ones before it, so the limiter goes on
+	// first: it then runs inside the cache and cache hits never take a slot.
+
+	// Limit concurrent tool calls per session
+	if cfg.MaxConcurrentToolsPerSession > 0 {
+		server.mcpServer.AddReceivingMiddleware(server.concurrencyLimitMiddleware)
+	}
+
+	// Cache read-only tool results if configured
+	if cfg.ToolCacheTTL > 0 || len(cfg.ToolCacheTTLs) > 0 {
+		server.setupToolCache()
+	}
+
+	// Cap list sizes in tool result Meta (a no-op while MaxMetaItems is 0)
+	server.mcpServer.AddReceivingMiddleware(server.metaLimitMiddleware)
+
 	// Add comprehensive logging middleware
 	server.setupLogging()
 
+	// Set up webhook notifications if configured
+	if cfg.WebhookURL != "" {
+		server.setupNotifier()
+	}
+
 	slog.Info("MCP server created successfully")
 	return server
 }
 
+// Config returns the configuration in effect
+func (s *Server) Config() *config.Config {
+	return s.settings.Current()
+}
+
+// ReloadConfig publishes the reloadable settings in next to the server and
+// its handlers, reporting what changed and which changes need a restart
+func (s *Server) ReloadConfig(next *config.Config) (changed []string, ignored []string) {
+	return s.settings.Reload(next)
+}
+
 func (s *Server) registerTools() {
 	slog.Info("Registering MCP tools")
 
@@ -80,43 +171,46 @@ func (s *Server) registerTools() {
 	)
 
 	// Create task tools handler
-	taskTools := tools.NewTaskTools(s.apiClient)
+	taskTools := tools.NewTaskTools(s.apiClient, s.Config())
+	taskTools.SetConfigStore(s.settings)
 
 	// Create project tools handler
-	projectTools := tools.NewProjectTools(s.apiClient)
+	projectTools := tools.NewProjectTools(s.apiClient, s.Config())
+	projectTools.SetConfigStore(s.settings)
 
 	// Create user tools handler
-	userTools := tools.NewUserTools(s.apiClient)
+	userTools := tools.NewUserTools(s.apiClient, s.Config())
+	userTools.SetConfigStore(s.settings)
 
 	// Register task management tools
 	getTaskOverviewTool := mcp.NewServerTool(
 		"get_task_overview",
-		"Get a dashboard overview of tasks with status breakdown, overdue tasks, and recent activity",
-		taskTools.HandleGetTaskOverview,
+		"Get a dashboard overview of tasks with status breakdown, overdue tasks, and recent activity (recent_hours sets the window, default 24); pass as_of to report relative to a past date",
+		tools.Validated(taskTools.HandleGetTaskOverview),
 	)
 
 	createTaskWithContextTool := mcp.NewServerTool(
 		"create_task_with_context",
 		"Create a new task with context and add an initial planning note. Valid statuses: 'Not Started', 'In Progress', 'Blocked', 'Review', 'Complete'. Valid priorities: 'Low', 'Medium', 'High'",
-		taskTools.HandleCreateTaskWithContext,
+		tools.Validated(taskTools.HandleCreateTaskWithContext),
 	)
 
 	getTaskDetailsTool := mcp.NewServerTool(
 		"get_task_details",
 		"Get complete task details including notes and project information for decision-making",
-		taskTools.HandleGetTaskDetails,
+		tools.Validated(taskTools.HandleGetTaskDetails),
 	)
 
 	updateTaskProgressTool := mcp.NewServerTool(
 		"update_task_progress",
 		"Update task status/progress and add a progress note. Valid statuses: 'Not Started', 'In Progress', 'Blocked', 'Review', 'Complete'. Valid priorities: 'Low', 'Medium', 'High'",
-		taskTools.HandleUpdateTaskProgress,
+		tools.Validated(taskTools.HandleUpdateTaskProgress),
 	)
 
 	searchTasksTool := mcp.NewServerTool(
 		"search_tasks",
-		"Search tasks with advanced filtering. Filter by status ('Not Started', 'In Progress', 'Blocked', 'Review', 'Complete'), priority ('Low', 'Medium', 'High'), assignee, project, creator, dates, and text",
-		taskTools.HandleSearchTasks,
+		"Search tasks with advanced filtering. Filter by status ('Not Started', 'In Progress', 'Blocked', 'Review', 'Complete'), priority ('Low', 'Medium', 'High'), assignee, project, creator, dates, and text (search_mode: substring, regex or exact), and tags (tag_match_mode: any or all). Sort with sort_by (due_date, priority, creation_date, task_name, status) and sort_order (asc, desc)",
+		tools.Validated(taskTools.HandleSearchTasks),
 	)
 
 	// Register project management tools
@@ -128,7 +222,7 @@ func (s *Server) registerTools() {
 
 	createProjectWithInitialTasksTool := mcp.NewServerTool(
 		"create_project_with_initial_tasks",
-		"Create a new project and populate it with initial tasks in one operation",
+		"Create a new project and populate it with initial tasks in one operation; with dry_run, validate and preview what would be created without writing anything",
 		projectTools.HandleCreateProjectWithInitialTasks,
 	)
 
@@ -140,24 +234,366 @@ func (s *Server) registerTools() {
 
 	getAllTasksTool := mcp.NewServerTool(
 		"get_all_tasks",
-		"Get a list of all tasks in the system with status breakdown and insights",
-		taskTools.HandleGetAllTasks,
+		"Get a page of all tasks in the system with status breakdown and insights. Use page and page_size (default 1 and 50); has_more reports whether another page follows",
+		tools.Validated(taskTools.HandleGetAllTasks),
 	)
 
 	addTaskNoteTool := mcp.NewServerTool(
 		"add_task_note",
 		"Add a note to an existing task without requiring status or other changes",
-		taskTools.HandleAddTaskNote,
+		tools.Validated(taskTools.HandleAddTaskNote),
+	)
+
+	updateTaskNoteTool := mcp.NewServerTool(
+		"update_task_note",
+		"Edit the text of an existing note on a task",
+		tools.Validated(taskTools.HandleUpdateTaskNote),
+	)
+
+	deleteTaskNoteTool := mcp.NewServerTool(
+		"delete_task_note",
+		"Delete a note from a task",
+		tools.Validated(taskTools.HandleDeleteTaskNote),
+	)
+
+	getSimilarTasksTool := mcp.NewServerTool(
+		"get_similar_tasks",
+		"Find existing tasks similar to a planned task by name/description, with their status, time to complete, and note count",
+		tools.Validated(taskTools.HandleGetSimilarTasks),
+	)
+
+	archiveCompletedTasksTool := mcp.NewServerTool(
+		"archive_completed_tasks",
+		"Archive completed tasks in bulk, scoped by project and/or completion date (at least one scope is required)",
+		tools.Validated(taskTools.HandleArchiveCompletedTasks),
+	)
+
+	getBoardTool := mcp.NewServerTool(
+		"get_board",
+		"Get a board view of tasks grouped into status columns, sorted by priority and due date, with WIP limit warnings",
+		tools.Validated(taskTools.HandleGetBoard),
+	)
+
+	routeBlockedTool := mcp.NewServerTool(
+		"route_blocked_to_blocker_owner",
+		"Reassign a Blocked task to the owner of the task blocking it and record an explanatory note",
+		tools.Validated(taskTools.HandleRouteBlockedToBlockerOwner),
+	)
+
+	reassignTaskTool := mcp.NewServerTool(
+		"reassign_task",
+		"Reassign a task to another user without a progress update, recording the old and new assignee and an optional reason as a note",
+		tools.Validated(taskTools.HandleReassignTask),
+	)
+
+	getCrossProjectDepsTool := mcp.NewServerTool(
+		"get_cross_project_dependencies",
+		"Find dependencies between a project's tasks and tasks in other projects, grouped by the other project",
+		projectTools.HandleGetCrossProjectDependencies,
+	)
+
+	getNoteContributionsTool := mcp.NewServerTool(
+		"get_note_contributions",
+		"Aggregate note counts per author across tasks, optionally within a project and since a date, ranked by contribution",
+		tools.Validated(taskTools.HandleGetNoteContributions),
+	)
+
+	splitTaskTool := mcp.NewServerTool(
+		"split_task",
+		"Split a task into two or more new tasks that inherit its project, priority and assignee, noting the split on the original",
+		tools.Validated(taskTools.HandleSplitTask),
+	)
+
+	getEscalationDigestTool := mcp.NewServerTool(
+		"get_escalation_digest",
+		"Get a Markdown digest of overdue, long-blocked and stale high-priority tasks for daily reporting",
+		tools.Validated(taskTools.HandleGetEscalationDigest),
+	)
+
+	getAssigneeVelocityTool := mcp.NewServerTool(
+		"get_assignee_velocity",
+		"Get a user's completed tasks per week over a window with average velocity and trend",
+		userTools.HandleGetAssigneeVelocity,
+	)
+
+	getProjectContributorsTool := mcp.NewServerTool(
+		"get_project_contributors",
+		"List everyone who worked on a project (assignees, creators, note authors) with task and note counts",
+		projectTools.HandleGetProjectContributors,
+	)
+
+	suggestReassignmentTool := mcp.NewServerTool(
+		"suggest_task_reassignment",
+		"Suggest moving active tasks from overloaded to underloaded project members, showing the workload before and after. Blocked and Review tasks are never moved; nothing is changed",
+		tools.Validated(projectTools.HandleSuggestReassignment),
+	)
+
+	updateProjectTool := mcp.NewServerTool(
+		"update_project",
+		"Change a project's name or description; omitted fields are left unchanged and clear_description removes the description",
+		tools.Validated(projectTools.HandleUpdateProject),
+	)
+
+	deleteTaskTool := mcp.NewServerTool(
+		"delete_task",
+		"Delete a task (archived and marked deleted under the soft policy, removed permanently under the hard policy)",
+		tools.Validated(taskTools.HandleDeleteTask),
+	)
+
+	deleteProjectTool := mcp.NewServerTool(
+		"delete_project",
+		"Delete a project (archived and marked deleted under the soft policy, removed permanently under the hard policy). Refused while the project has tasks that are not archived",
+		projectTools.HandleDeleteProject,
+	)
+
+	archiveProjectTool := mcp.NewServerTool(
+		"archive_project",
+		"Archive a project without deleting it, leaving its tasks unchanged",
+		tools.Validated(projectTools.HandleArchiveProject),
+	)
+
+	restoreTaskTool := mcp.NewServerTool(
+		"restore_task",
+		"Restore a soft-deleted task, clearing its deleted markers",
+		tools.Validated(taskTools.HandleRestoreTask),
+	)
+
+	restoreProjectTool := mcp.NewServerTool(
+		"restore_project",
+		"Restore a soft-deleted project, clearing its deleted markers",
+		projectTools.HandleRestoreProject,
+	)
+
+	getTaskDependencyTreeTool := mcp.NewServerTool(
+		"get_task_dependency_tree",
+		"Show a task's upstream blockers and downstream dependents up to a given depth, flagging cycles",
+		tools.Validated(taskTools.HandleGetTaskDependencyTree),
+	)
+
+	getTaskTimelineTool := mcp.NewServerTool(
+		"get_task_timeline",
+		"Show a task's activity as a chronological timeline of its creation, start, notes and completion, with relative timestamps",
+		tools.Validated(taskTools.HandleGetTaskTimeline),
+	)
+
+	getTasksChangedSinceTool := mcp.NewServerTool(
+		"get_tasks_changed_since",
+		"Get tasks created or updated at/after a timestamp, oldest change first, with a next_cursor for incremental sync; pass next_cursor as since to get only later changes",
+		tools.Validated(taskTools.HandleGetTasksChangedSince),
+	)
+
+	bulkTagTasksTool := mcp.NewServerTool(
+		"bulk_tag_tasks",
+		"Add and/or remove tags on many tasks at once, returning per-task results",
+		tools.Validated(taskTools.HandleBulkTagTasks),
+	)
+
+	addTaskTagsTool := mcp.NewServerTool(
+		"add_task_tags",
+		"Add tags to a task, ignoring tags it already has (compared case-insensitively), and return the resulting tag list",
+		tools.Validated(taskTools.HandleAddTaskTags),
+	)
+
+	removeTaskTagsTool := mcp.NewServerTool(
+		"remove_task_tags",
+		"Remove tags from a task (compared case-insensitively); tags it does not have are ignored. Returns the resulting tag list",
+		tools.Validated(taskTools.HandleRemoveTaskTags),
+	)
+
+	bulkDeleteTasksTool := mcp.NewServerTool(
+		"bulk_delete_tasks",
+		"Delete many tasks at once under the configured delete policy, continuing past failures and reporting deleted and failed IDs. Deletes immediately by default; set require_confirmation to true to preview what would be deleted without deleting anything",
+		tools.Validated(taskTools.HandleBulkDeleteTasks),
+	)
+
+	getMyOrphanedTasksTool := mcp.NewServerTool(
+		"get_my_orphaned_tasks",
+		"List open tasks you created that nobody is assigned to, oldest first",
+		userTools.HandleGetMyOrphanedTasks,
+	)
+
+	getOverdueByProjectTool := mcp.NewServerTool(
+		"get_overdue_by_project",
+		"Roll up overdue task counts across all projects, ranked worst-first, with a no-project bucket and a grand total",
+		projectTools.HandleGetOverdueByProject,
+	)
+
+	validateTaskDataTool := mcp.NewServerTool(
+		"validate_task_data",
+		"Dry-validate one or more task payloads against the task write rules without writing anything; set check_references to verify projects and assignees",
+		tools.Validated(taskTools.HandleValidateTaskData),
+	)
+
+	getStatusFlowTool := mcp.NewServerTool(
+		"get_status_flow",
+		"Show the workflow statuses and allowed transitions as a list plus Mermaid and Graphviz diagrams, with per-status task counts when scoped by project_id or assigned_to",
+		tools.Validated(taskTools.HandleGetStatusFlow),
+	)
+
+	getAllProjectStatusesTool := mcp.NewServerTool(
+		"get_all_project_statuses",
+		"Get lightweight status summaries (completion %, total/active/overdue counts, health) for every project, least healthy first",
+		projectTools.HandleGetAllProjectStatuses,
+	)
+
+	addTaskLinkTool := mcp.NewServerTool(
+		"add_task_link",
+		"Attach an external http(s) link (design doc, ticket, PR) to a task, or relabel an existing link",
+		tools.Validated(taskTools.HandleAddTaskLink),
+	)
+
+	removeTaskLinkTool := mcp.NewServerTool(
+		"remove_task_link",
+		"Remove an external link from a task by URL",
+		tools.Validated(taskTools.HandleRemoveTaskLink),
+	)
+
+	moveNoteTool := mcp.NewServerTool(
+		"move_note",
+		"Move a note logged on the wrong task to another task, keeping its author and timestamp and leaving a trace note on both tasks",
+		tools.Validated(taskTools.HandleMoveNote),
+	)
+
+	getAttentionNeededTool := mcp.NewServerTool(
+		"get_attention_needed",
+		"Get one priority-ordered list of open tasks that are overdue, blocked, or due today, each with the reasons it needs attention",
+		tools.Validated(taskTools.HandleGetAttentionNeeded),
+	)
+
+	getSprintCompletionsTool := mcp.NewServerTool(
+		"get_sprint_completions",
+		"Get tasks completed within a sprint window and tasks due in it that were carried over unfinished",
+		tools.Validated(taskTools.HandleGetSprintCompletions),
+	)
+
+	getBlockedDurationReportTool := mcp.NewServerTool(
+		"get_blocked_duration_report",
+		"Report total and average time tasks spent Blocked, inferred from blocked/unblocked notes, with the longest-blocked tasks",
+		tools.Validated(taskTools.HandleGetBlockedDurationReport),
+	)
+
+	getOverviewDeltaTool := mcp.NewServerTool(
+		"get_overview_delta",
+		"Summarize what changed since a cutoff: tasks created, completed, newly overdue, and status changes inferred from timestamps",
+		tools.Validated(taskTools.HandleGetOverviewDelta),
+	)
+
+	runPriorityEscalationTool := mcp.NewServerTool(
+		"run_priority_escalation",
+		"Apply the configured priority escalation policy: bump open tasks older than their tier's age one priority level, with an audit note",
+		tools.Validated(taskTools.HandleRunPriorityEscalation),
+	)
+
+	resumeProjectTasksTool := mcp.NewServerTool(
+		"resume_project_tasks",
+		"Resume an interrupted project creation: create only the listed tasks not already in the project (matched by name), reporting created vs skipped",
+		projectTools.HandleResumeProjectTasks,
+	)
+
+	bulkAddTasksTool := mcp.NewServerTool(
+		"bulk_add_tasks_to_project",
+		"Add several tasks to an existing project in one call, reporting which were created and which failed along with the success rate",
+		tools.Validated(projectTools.HandleAddTasksToProject),
+	)
+
+	getProjectRiskScoreTool := mcp.NewServerTool(
+		"get_project_risk_score",
+		"Score a project's forward-looking risk from 0 to 100 from overdue and blocked ratios, unassigned high-priority tasks, staleness and due-date clustering, with contributing factors",
+		projectTools.HandleGetProjectRiskScore,
+	)
+
+	getNewOverdueSinceTool := mcp.NewServerTool(
+		"get_new_overdue_since",
+		"List only tasks that became overdue after a cutoff (date, RFC3339 or duration like 24h), so schedulers alert once per task",
+		tools.Validated(taskTools.HandleGetNewOverdueSince),
+	)
+
+	getOverdueTasksTool := mcp.NewServerTool(
+		"get_overdue_tasks",
+		"List open tasks more than grace_days past their due date, most overdue first with days overdue, optionally filtered by assignee or project",
+		tools.Validated(taskTools.HandleGetOverdueTasks),
+	)
+
+	getTasksDueSoonTool := mcp.NewServerTool(
+		"get_tasks_due_soon",
+		"List open tasks due from today through the next N days (default 7; working days when business days are configured), soonest first and grouped by day, with due today, tomorrow and this week counts; optionally for one assignee",
+		tools.Validated(taskTools.HandleGetTasksDueSoon),
+	)
+
+	getBlockedTasksTool := mcp.NewServerTool(
+		"get_blocked_tasks",
+		"List Blocked tasks oldest-blocked first with how long each has been blocked and its latest note, flagging those blocked over 7 days as critical; optionally filtered by project or assignee",
+		tools.Validated(taskTools.HandleGetBlockedTasks),
+	)
+
+	exportTasksTool := mcp.NewServerTool(
+		"export_tasks",
+		"Export tasks as CSV (header row plus one row per task, for spreadsheets) or JSON, filtered by status, project and assignee; archived tasks are left out",
+		tools.Validated(taskTools.HandleExportTasks),
+	)
+
+	importTasksTool := mcp.NewServerTool(
+		"import_tasks",
+		"Create tasks from a CSV (with a header row) or JSON batch, such as one written by export_tasks. Rows are checked like validate_task_payload and unassigned rows go to the project's default assignee. A malformed batch creates nothing; failed rows are reported without stopping the rest",
+		tools.Validated(taskTools.HandleImportTasks),
+	)
+
+	findDuplicateNotesTool := mcp.NewServerTool(
+		"find_duplicate_notes",
+		"Find notes on a task with identical text (ignoring case and whitespace), optionally deleting all but the earliest in each group",
+		tools.Validated(taskTools.HandleFindDuplicateNotes),
+	)
+
+	getTasksBySourceTool := mcp.NewServerTool(
+		"get_tasks_by_source",
+		"Group incomplete tasks by how they were created (manual, quick_add, import, api) with counts and a sample per source",
+		tools.Validated(taskTools.HandleGetTasksBySource),
+	)
+
+	addProjectMilestoneTool := mcp.NewServerTool(
+		"add_project_milestone",
+		"Add a named milestone with a due date and linked task IDs to a project, replacing any milestone with the same name",
+		tools.Validated(projectTools.HandleAddProjectMilestone),
+	)
+
+	getProjectMilestonesTool := mcp.NewServerTool(
+		"get_project_milestones",
+		"Report each project milestone's completion from its linked tasks and flag milestones at risk from their due date and remaining work",
+		tools.Validated(projectTools.HandleGetProjectMilestones),
+	)
+
+	getProjectTimelineTool := mcp.NewServerTool(
+		"get_project_timeline",
+		"Build a project's timeline from its creation, first task, task completions and milestones, with its completion velocity and a projected end date",
+		tools.Validated(projectTools.HandleGetProjectTimeline),
+	)
+
+	compareAssigneesTool := mcp.NewServerTool(
+		"compare_assignees",
+		"Compare two or more users side by side on active load, overdue tasks, completion velocity and note contributions over a window of weeks, ranked per metric",
+		tools.Validated(userTools.HandleCompareAssignees),
+	)
+
+	getTeamWorkloadTool := mcp.NewServerTool(
+		"get_team_workload",
+		"Rank everyone by active tasks across all projects, with overdue and high-priority counts per person and unassigned tasks in a separate bucket",
+		tools.Validated(userTools.HandleGetTeamWorkload),
+	)
+
+	getPersonalDigestTool := mcp.NewServerTool(
+		"get_personal_digest",
+		"Get a user's morning briefing in Markdown: tasks needing attention, upcoming deadlines, tasks others are waiting on, and new overdue items since yesterday",
+		tools.Validated(userTools.HandleGetPersonalDigest),
 	)
 
 	// Register user-focused tools
 	getMyWorkTool := mcp.NewServerTool(
 		"get_my_work",
-		"Get personalized work queue with prioritized tasks and workload insights",
+		"Get personalized work queue ordered by a tunable score (overdue 3, priority 2, due soon 1 by default) with workload insights",
 		userTools.HandleGetMyWork,
 	)
 
-	s.mcpServer.AddTools(
+	allTools := []*mcp.ServerTool{
 		healthTool,
 		getTaskOverviewTool,
 		createTaskWithContextTool,
@@ -169,10 +605,69 @@ func (s *Server) registerTools() {
 		getAllProjectsTool,
 		getAllTasksTool,
 		addTaskNoteTool,
+		updateTaskNoteTool,
+		deleteTaskNoteTool,
+		getSimilarTasksTool,
+		archiveCompletedTasksTool,
+		getBoardTool,
+		routeBlockedTool,
+		reassignTaskTool,
+		getCrossProjectDepsTool,
+		getNoteContributionsTool,
+		splitTaskTool,
+		getEscalationDigestTool,
+		getAssigneeVelocityTool,
+		getProjectContributorsTool,
+		suggestReassignmentTool,
+		updateProjectTool,
+		deleteTaskTool,
+		deleteProjectTool,
+		archiveProjectTool,
+		restoreTaskTool,
+		restoreProjectTool,
+		getTaskDependencyTreeTool,
+		getTaskTimelineTool,
+		getTasksChangedSinceTool,
+		bulkTagTasksTool,
+		addTaskTagsTool,
+		removeTaskTagsTool,
+		bulkDeleteTasksTool,
+		getMyOrphanedTasksTool,
+		getOverdueByProjectTool,
+		validateTaskDataTool,
+		getStatusFlowTool,
+		getAllProjectStatusesTool,
+		addTaskLinkTool,
+		removeTaskLinkTool,
+		moveNoteTool,
+		getAttentionNeededTool,
+		getSprintCompletionsTool,
+		getBlockedDurationReportTool,
+		getOverviewDeltaTool,
+		runPriorityEscalationTool,
+		resumeProjectTasksTool,
+		bulkAddTasksTool,
+		getProjectRiskScoreTool,
+		getNewOverdueSinceTool,
+		getOverdueTasksTool,
+		getTasksDueSoonTool,
+		getBlockedTasksTool,
+		exportTasksTool,
+		importTasksTool,
+		findDuplicateNotesTool,
+		getTasksBySourceTool,
+		addProjectMilestoneTool,
+		getProjectMilestonesTool,
+		getProjectTimelineTool,
+		compareAssigneesTool,
+		getTeamWorkloadTool,
+		getPersonalDigestTool,
 		getMyWorkTool,
-	)
+	}
 
-	slog.Info("Tools registration completed", "tool_count", 12)
+	s.mcpServer.AddTools(allTools...)
+
+	slog.Info("Tools registration completed", "tool_count", len(allTools))
 }
 
 // Health check tool handler
@@ -183,6 +678,10 @@ func (s *Server) handleHealthCheck(
 ) (*mcp.CallToolResultFor[map[string]any], error) {
 	slog.Info("Executing health_check tool")
 
+	// Fail fast rather than waiting out the full API timeout
+	ctx, cancel := client.WithTimeout(ctx, s.Config().HealthCheckTimeout)
+	defer cancel()
+
 	// Make API call to health endpoint
 	resp, err := s.apiClient.Get(ctx, "/health")
 	if err != nil {
@@ -218,9 +717,10 @@ func (s *Server) registerResources() {
 	slog.Info("Registering MCP resources")
 
 	// Create resource handlers
-	taskResources := resources.NewTaskResources(s.apiClient)
+	taskResources := resources.NewTaskResources(s.apiClient, s.Config())
+	taskResources.SetConfigStore(s.settings)
 	projectResources := resources.NewProjectResources(s.apiClient)
-	dashboardResources := resources.NewDashboardResources(s.apiClient)
+	dashboardResources := resources.NewDashboardResources(s.apiClient, s.Config())
 
 	// Register API status resource
 	statusResource := &mcp.ServerResource{
@@ -486,10 +986,19 @@ func (s *Server) setupHTTPServer() {
 	// Set up streamable HTTP endpoint
 	mux.Handle("/mcp", streamableHandler)
 
-	addr := fmt.Sprintf("%s:%s", s.config.HTTPHost, s.config.HTTPPort)
+	// Scope each session to the tenant its opening request names
+	var handler http.Handler = mux
+	if s.Config().TenantHeader != "" {
+		handler = tenant.Middleware(s.Config().TenantHeader, s.Config().Tenant, mux)
+	}
+
+	addr := fmt.Sprintf("%s:%s", s.Config().HTTPHost, s.Config().HTTPPort)
+	if s.Config().TransportMode == "unix" {
+		addr = s.Config().UnixSocketPath
+	}
 	s.httpServer = &http.Server{
 		Addr:           addr,
-		Handler:        mux,
+		Handler:        handler,
 		ReadTimeout:    10 * time.Second,
 		WriteTimeout:   10 * time.Second,
 		MaxHeaderBytes: 1 << 20,
@@ -503,13 +1012,23 @@ func (s *Server) setupHTTPServer() {
 }
 
 func (s *Server) Run(ctx context.Context) error {
-	slog.Info("Starting MCP server", "transport_mode", s.config.TransportMode)
+	slog.Info("Starting MCP server", "transport_mode", s.Config().TransportMode)
 
 	var wg sync.WaitGroup
 	errCh := make(chan error, 2)
 
+	// Shutdown goroutines run once ctx is cancelled or Run fails, and Run
+	// waits for them so servers are stopped and the socket removed before
+	// it returns
+	stopCtx, stop := context.WithCancel(ctx)
+	var shutdownWG sync.WaitGroup
+	shutdown := func() {
+		stop()
+		shutdownWG.Wait()
+	}
+
 	// Start stdio transport if needed
-	if s.config.TransportMode == "stdio" || s.config.TransportMode == "both" {
+	if s.Config().TransportMode == "stdio" || s.Config().TransportMode == "both" {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
@@ -524,7 +1043,7 @@ func (s *Server) Run(ctx context.Context) error {
 	}
 
 	// Start HTTP server if needed
-	if s.config.TransportMode == "http" || s.config.TransportMode == "both" {
+	if s.Config().TransportMode == "http" || s.Config().TransportMode == "both" {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
@@ -537,8 +1056,10 @@ func (s *Server) Run(ctx context.Context) error {
 		}()
 
 		// Handle graceful HTTP server shutdown
+		shutdownWG.Add(1)
 		go func() {
-			<-ctx.Done()
+			defer shutdownWG.Done()
+			<-stopCtx.Done()
 			if s.httpServer != nil {
 				slog.Info("Shutting down HTTP server")
 				if err := s.httpServer.Shutdown(context.Background()); err != nil {
@@ -548,6 +1069,40 @@ func (s *Server) Run(ctx context.Context) error {
 		}()
 	}
 
+	// Start Unix socket server if needed
+	if s.Config().TransportMode == "unix" {
+		listener, err := listenUnix(s.Config().UnixSocketPath)
+		if err != nil {
+			shutdown()
+			return err
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			slog.Info("Starting HTTP server on unix socket", "path", s.Config().UnixSocketPath)
+
+			if err := s.httpServer.Serve(listener); err != nil && err != http.ErrServerClosed {
+				slog.Error("Unix socket server failed", "error", err)
+				errCh <- fmt.Errorf("unix socket server error: %w", err)
+			}
+		}()
+
+		// Handle graceful shutdown and socket cleanup
+		shutdownWG.Add(1)
+		go func() {
+			defer shutdownWG.Done()
+			<-stopCtx.Done()
+			slog.Info("Shutting down unix socket server")
+			if err := s.httpServer.Shutdown(context.Background()); err != nil {
+				slog.Error("Unix socket server shutdown error", "error", err)
+			}
+			if err := os.Remove(s.Config().UnixSocketPath); err != nil && !os.IsNotExist(err) {
+				slog.Warn("Failed to remove unix socket", "path", s.Config().UnixSocketPath, "error", err)
+			}
+		}()
+	}
+
 	// Wait for either completion or error
 	go func() {
 		wg.Wait()
@@ -558,16 +1113,254 @@ func (s *Server) Run(ctx context.Context) error {
 	select {
 	case err := <-errCh:
 		if err != nil {
+			shutdown()
 			return err
 		}
 	case <-ctx.Done():
 		slog.Info("Server stopped by context cancellation")
 	}
 
+	shutdown()
+	s.drainNotifier()
+
 	slog.Info("MCP server stopped")
 	return nil
 }
 
+// listenUnix listens on a Unix domain socket, replacing a stale socket left by
+// a previous run but refusing to clobber any other kind of file
+func listenUnix(path string) (net.Listener, error) {
+	if info, err := os.Lstat(path); err == nil {
+		if info.Mode()&os.ModeSocket == 0 {
+			return nil, fmt.Errorf("unix socket path %s exists and is not a socket", path)
+		}
+		if err := os.Remove(path); err != nil {
+			return nil, fmt.Errorf("failed to remove stale unix socket: %w", err)
+		}
+	}
+
+	listener, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on unix socket: %w", err)
+	}
+	if err := os.Chmod(path, 0o600); err != nil {
+		listener.Close()
+		return nil, fmt.Errorf("failed to restrict unix socket permissions: %w", err)
+	}
+	return listener, nil
+}
+
+// setupToolCache memoizes read-only tool results and drops them whenever a
+// mutating tool is called
+func (s *Server) setupToolCache() {
+	s.toolCache = toolcache.New(s.Config().ToolCacheTTL, s.Config().ToolCacheTTLs)
+	s.mcpServer.AddReceivingMiddleware(s.toolCacheMiddleware)
+
+	slog.Info("Tool result cache configured", "default_ttl", s.Config().ToolCacheTTL, "overrides", len(s.Config().ToolCacheTTLs))
+}
+
+func (s *Server) toolCacheMiddleware(next mcp.MethodHandler[*mcp.ServerSession]) mcp.MethodHandler[*mcp.ServerSession] {
+	return func(ctx context.Context, session *mcp.ServerSession, method string, params mcp.Params) (mcp.Result, error) {
+		callParams, ok := params.(*mcp.CallToolParamsFor[json.RawMessage])
+		if method != "tools/call" || !ok {
+			return next(ctx, session, method, params)
+		}
+
+		if mutatingTools[callParams.Name] {
+			result, err := next(ctx, session, method, params)
+			s.toolCache.Invalidate()
+			return result, err
+		}
+
+		ttl := s.toolCache.TTL(callParams.Name)
+		if ttl <= 0 {
+			return next(ctx, session, method, params)
+		}
+
+		// Tenants never share cached results
+		key := tenant.FromContext(ctx) + "\x00" + toolcache.Key(callParams.Name, callParams.Arguments)
+		if cached, ok := s.toolCache.Get(key); ok {
+			slog.Debug("Tool result served from cache", "tool", callParams.Name)
+			return copyToolResult(cached.(mcp.Result)), nil
+		}
+
+		// A mutation finishing while this call runs may have made its result stale
+		generation := s.toolCache.Begin()
+		result, err := next(ctx, session, method, params)
+		if err == nil {
+			if toolResult, ok := result.(*mcp.CallToolResult); !ok || !toolResult.IsError {
+				s.toolCache.Put(key, generation, copyToolResult(result), ttl)
+			}
+		}
+		return result, err
+	}
+}
+
+// copyToolResult returns a copy of a tool result, so outer middleware that
+// replaces fields such as Meta never changes the one held by the cache.
+// Middleware replaces the Meta map rather than editing it, so a shallow copy
+// is enough.
+func copyToolResult(result mcp.Result) mcp.Result {
+	toolResult, ok := result.(*mcp.CallToolResult)
+	if !ok {
+		return result
+	}
+	copied := *toolResult
+	return &copied
+}
+
+func (s *Server) metaLimitMiddleware(next mcp.MethodHandler[*mcp.ServerSession]) mcp.MethodHandler[*mcp.ServerSession] {
+	return func(ctx context.Context, session *mcp.ServerSession, method string, params mcp.Params) (mcp.Result, error) {
+		result, err := next(ctx, session, method, params)
+		maxItems := s.Config().MaxMetaItems
+		if method != "tools/call" || err != nil || maxItems <= 0 {
+			return result, err
+		}
+		if toolResult, ok := result.(*mcp.CallToolResult); ok && toolResult.Meta != nil {
+			toolResult.Meta = capMetaItems(toolResult.Meta, maxItems)
+		}
+		return result, err
+	}
+}
+
+// capMetaItems trims task and note lists in a tool result's Meta to at most
+// max items. Capped keys get their true length under meta_totals and the
+// result is flagged with meta_truncated; the response text is left alone.
+func capMetaItems(meta mcp.Meta, max int) mcp.Meta {
+	totals := make(map[string]int)
+	capped := make(mcp.Meta, len(meta))
+	for key, value := range meta {
+		capped[key] = value
+		if !isMetaListKey(key) {
+			continue
+		}
+		list := reflect.ValueOf(value)
+		if list.Kind() != reflect.Slice || list.Len() <= max {
+			continue
+		}
+		capped[key] = list.Slice(0, max).Interface()
+		totals[key] = list.Len()
+	}
+	if len(totals) == 0 {
+		return meta
+	}
+
+	capped["meta_truncated"] = true
+	capped["meta_totals"] = totals
+	slog.Debug("Capped tool result Meta lists", "max_meta_items", max, "totals", totals)
+	return capped
+}
+
+// isMetaListKey reports whether a Meta key holds a task or note list
+func isMetaListKey(key string) bool {
+	return key == "tasks" || key == "notes" || strings.HasSuffix(key, "_tasks") || strings.HasSuffix(key, "_notes")
+}
+
+// sessionSlots bounds one session's concurrent tool calls
+type sessionSlots struct {
+	slots chan struct{}
+	users int // calls holding or waiting for a slot; the entry is dropped at 0
+}
+
+// acquireSessionSlots returns session's slots, creating them on first use
+func (s *Server) acquireSessionSlots(session *mcp.ServerSession) *sessionSlots {
+	s.slotsMutex.Lock()
+	defer s.slotsMutex.Unlock()
+	if s.sessionSlots == nil {
+		s.sessionSlots = make(map[*mcp.ServerSession]*sessionSlots)
+	}
+	entry, ok := s.sessionSlots[session]
+	if !ok {
+		entry = &sessionSlots{slots: make(chan struct{}, s.Config().MaxConcurrentToolsPerSession)}
+		s.sessionSlots[session] = entry
+	}
+	entry.users++
+	return entry
+}
+
+// releaseSessionSlots undoes acquireSessionSlots, forgetting idle sessions
+func (s *Server) releaseSessionSlots(session *mcp.ServerSession, entry *sessionSlots) {
+	s.slotsMutex.Lock()
+	defer s.slotsMutex.Unlock()
+	entry.users--
+	if entry.users == 0 {
+		delete(s.sessionSlots, session)
+	}
+}
+
+// concurrencyLimitMiddleware lets each session run at most
+// MaxConcurrentToolsPerSession tool calls at once. Excess calls wait for a
+// slot, or fail immediately when ToolConcurrencyOverflow is "reject".
+func (s *Server) concurrencyLimitMiddleware(next mcp.MethodHandler[*mcp.ServerSession]) mcp.MethodHandler[*mcp.ServerSession] {
+	return func(ctx context.Context, session *mcp.ServerSession, method string, params mcp.Params) (mcp.Result, error) {
+		if method != "tools/call" {
+			return next(ctx, session, method, params)
+		}
+
+		entry := s.acquireSessionSlots(session)
+		defer s.releaseSessionSlots(session, entry)
+
+		limit := cap(entry.slots)
+		if s.Config().ToolConcurrencyOverflow == "reject" {
+			select {
+			case entry.slots <- struct{}{}:
+			default:
+				slog.Warn("Rejected tool call over session concurrency limit", "limit", limit)
+				return nil, fmt.Errorf("too many concurrent tool calls on this session (limit %d); retry once earlier calls finish", limit)
+			}
+		} else {
+			select {
+			case entry.slots <- struct{}{}:
+			case <-ctx.Done():
+				return nil, fmt.Errorf("tool call cancelled while queued behind %d concurrent calls: %w", limit, ctx.Err())
+			}
+		}
+		defer func() { <-entry.slots }()
+
+		return next(ctx, session, method, params)
+	}
+}
+
+// setupNotifier creates the webhook notifier and emits an event for every completed tool call
+func (s *Server) setupNotifier() {
+	s.notifier = notifier.NewNotifier(
+		s.Config().WebhookQueueSize,
+		notifier.NewWebhookDeliverer(s.Config().WebhookURL, s.Config().APITimeout),
+	)
+
+	s.mcpServer.AddReceivingMiddleware(func(next mcp.MethodHandler[*mcp.ServerSession]) mcp.MethodHandler[*mcp.ServerSession] {
+		return func(ctx context.Context, session *mcp.ServerSession, method string, params mcp.Params) (mcp.Result, error) {
+			result, err := next(ctx, session, method, params)
+
+			if method == "tools/call" && err == nil {
+				if callParams, ok := params.(*mcp.CallToolParamsFor[json.RawMessage]); ok {
+					s.notifier.Enqueue(notifier.Event{
+						Type: "tool.completed",
+						Data: map[string]any{"tool": callParams.Name},
+					})
+				}
+			}
+
+			return result, err
+		}
+	})
+
+	slog.Info("Webhook notifier configured", "queue_size", s.Config().WebhookQueueSize)
+}
+
+// drainNotifier gives queued webhook events a chance to deliver before shutdown
+func (s *Server) drainNotifier() {
+	if s.notifier == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), s.Config().ShutdownTimeout)
+	defer cancel()
+
+	pending, delivered := s.notifier.Shutdown(ctx)
+	slog.Info("Webhook queue drained", "pending", pending, "delivered", delivered)
+}
+
 // setupLogging configures comprehensive logging for the MCP server
 func (s *Server) setupLogging() {
 	slog.Info("Setting up comprehensive MCP request/response logging")
@@ -614,14 +1407,14 @@ func (s *Server) createLoggingMiddleware() mcp.Middleware[*mcp.ServerSession] {
 				slog.Info("MCP Request Parameters",
 					"method", method,
 					"params_type", fmt.Sprintf("%T", params),
-					"params_value", fmt.Sprintf("%+v", params),
+					"params_value", logging.Truncate(fmt.Sprintf("%+v", params), s.Config().LogMaxBodyBytes),
 				)
 				
 				// Try to marshal params to see raw JSON
 				if paramsJSON, err := json.Marshal(params); err == nil {
 					slog.Info("MCP Request Parameters JSON",
 						"method", method,
-						"params_json", string(paramsJSON),
+						"params_json", logging.JSONPayload(paramsJSON, s.Config().LogMaxBodyBytes),
 					)
 				}
 			} else {
@@ -657,14 +1450,14 @@ func (s *Server) createLoggingMiddleware() mcp.Middleware[*mcp.ServerSession] {
 				if result != nil {
 					slog.Info("MCP Response Result",
 						"method", method,
-						"result_value", fmt.Sprintf("%+v", result),
+						"result_value", logging.Truncate(fmt.Sprintf("%+v", result), s.Config().LogMaxBodyBytes),
 					)
 					
 					// Try to marshal result to see raw JSON
 					if resultJSON, err := json.Marshal(result); err == nil {
 						slog.Info("MCP Response Result JSON",
 							"method", method,
-							"result_json", string(resultJSON),
+							"result_json", logging.JSONPayload(resultJSON, s.Config().LogMaxBodyBytes),
 						)
 					}
 				}
@@ -1,10 +1,26 @@
 package server
 
 import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
 	"github.com/bchamber/taskman-mcp/internal/config"
+	"github.com/bchamber/taskman-mcp/internal/tools"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 func TestNewServer(t *testing.T) {
@@ -57,7 +73,7 @@ func TestNewServer(t *testing.T) {
 				t.Error("Expected apiClient to be initialized")
 			}
 
-			if server.config != cfg {
+			if server.Config() != cfg {
 				t.Error("Expected config to be set correctly")
 			}
 
@@ -122,3 +138,462 @@ func TestServer_ServerOptions(t *testing.T) {
 	// - PageSize
 	// - KeepAlive
 }
+
+func TestServer_RunUnixSocket(t *testing.T) {
+	// Keep the path short: Unix socket paths are limited to ~104 bytes
+	dir, err := os.MkdirTemp("", "taskman")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(dir)
+	socketPath := filepath.Join(dir, "mcp.sock")
+
+	cfg := config.Default()
+	cfg.TransportMode = "unix"
+	cfg.UnixSocketPath = socketPath
+	if err := cfg.Validate(); err != nil {
+		t.Fatalf("Expected unix config to be valid: %v", err)
+	}
+
+	server := NewServer(cfg)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() { done <- server.Run(ctx) }()
+
+	deadline := time.Now().Add(2 * time.Second)
+	for {
+		if _, err := os.Stat(socketPath); err == nil {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Socket was not created")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, "unix", socketPath)
+			},
+		},
+	}
+	transport := mcp.NewStreamableClientTransport("http://taskman/mcp", &mcp.StreamableClientTransportOptions{HTTPClient: httpClient})
+	client := mcp.NewClient("test-client", "1.0.0", nil)
+
+	session, err := client.Connect(ctx, transport)
+	if err != nil {
+		t.Fatalf("Failed to connect over unix socket: %v", err)
+	}
+
+	tools, err := session.ListTools(ctx, nil)
+	if err != nil {
+		t.Fatalf("Failed to list tools: %v", err)
+	}
+	found := false
+	for _, tool := range tools.Tools {
+		if tool.Name == "health_check" {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("Expected health_check among %d listed tools", len(tools.Tools))
+	}
+	session.Close()
+
+	cancel()
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Run returned error: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Server did not stop")
+	}
+
+	// Run waits for the shutdown, so the socket is already gone
+	if _, err := os.Stat(socketPath); !os.IsNotExist(err) {
+		t.Errorf("Expected socket file to be removed when Run returns, got %v", err)
+	}
+}
+
+func TestServer_ToolCacheMiddleware(t *testing.T) {
+	cfg := config.Default()
+	cfg.ToolCacheTTL = time.Minute
+	server := NewServer(cfg)
+	if server.toolCache == nil {
+		t.Fatal("Expected tool cache to be configured")
+	}
+
+	calls := make(map[string]int)
+	handler := server.toolCacheMiddleware(func(ctx context.Context, session *mcp.ServerSession, method string, params mcp.Params) (mcp.Result, error) {
+		name := params.(*mcp.CallToolParamsFor[json.RawMessage]).Name
+		calls[name]++
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: name}}}, nil
+	})
+
+	call := func(name, args string) mcp.Result {
+		result, err := handler(context.Background(), nil, "tools/call", &mcp.CallToolParamsFor[json.RawMessage]{
+			Name:      name,
+			Arguments: json.RawMessage(args),
+		})
+		if err != nil {
+			t.Fatalf("Call to %s failed: %v", name, err)
+		}
+		return result
+	}
+
+	first := call("get_task_overview", `{"status": "Blocked"}`)
+	second := call("get_task_overview", `{"status":"Blocked"}`)
+	if calls["get_task_overview"] != 1 {
+		t.Errorf("Expected repeated read to be served from cache, got %d calls", calls["get_task_overview"])
+	}
+	if first.(*mcp.CallToolResult).Content[0] != second.(*mcp.CallToolResult).Content[0] {
+		t.Error("Expected cached call to return the original result")
+	}
+
+	// Callers get their own copy, so replacing Meta on one leaves the cache alone
+	second.(*mcp.CallToolResult).Meta = mcp.Meta{"meta_truncated": true}
+	if third := call("get_task_overview", `{"status":"Blocked"}`); third.(*mcp.CallToolResult).Meta != nil {
+		t.Errorf("Expected cached result unchanged by a caller, got %v", third.(*mcp.CallToolResult).Meta)
+	}
+	first.(*mcp.CallToolResult).Meta = mcp.Meta{"meta_truncated": true}
+	if fourth := call("get_task_overview", `{"status":"Blocked"}`); fourth.(*mcp.CallToolResult).Meta != nil {
+		t.Errorf("Expected cached result unchanged by the first caller, got %v", fourth.(*mcp.CallToolResult).Meta)
+	}
+
+	call("get_task_overview", `{"status":"Complete"}`)
+	if calls["get_task_overview"] != 2 {
+		t.Errorf("Expected different arguments to miss the cache, got %d calls", calls["get_task_overview"])
+	}
+
+	call("update_task_progress", `{"task_id":"t1"}`)
+	call("get_task_overview", `{"status":"Blocked"}`)
+	if calls["get_task_overview"] != 3 {
+		t.Errorf("Expected mutation to invalidate the cache, got %d calls", calls["get_task_overview"])
+	}
+}
+
+func TestServer_ToolCacheSkipsReadsOverlappingMutation(t *testing.T) {
+	cfg := config.Default()
+	cfg.ToolCacheTTL = time.Minute
+	server := NewServer(cfg)
+
+	reading := make(chan struct{})
+	release := make(chan struct{})
+	var calls atomic.Int32
+	handler := server.toolCacheMiddleware(func(ctx context.Context, session *mcp.ServerSession, method string, params mcp.Params) (mcp.Result, error) {
+		name := params.(*mcp.CallToolParamsFor[json.RawMessage]).Name
+		if name == "get_task_overview" && calls.Add(1) == 1 {
+			// The first read fetched its data before the mutation and
+			// finishes after it
+			close(reading)
+			<-release
+		}
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: name}}}, nil
+	})
+	call := func(name string) {
+		if _, err := handler(context.Background(), nil, "tools/call", &mcp.CallToolParamsFor[json.RawMessage]{Name: name}); err != nil {
+			t.Errorf("Call to %s failed: %v", name, err)
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		call("get_task_overview")
+	}()
+	<-reading
+	call("update_task_progress")
+	close(release)
+	<-done
+
+	call("get_task_overview")
+	if calls.Load() != 2 {
+		t.Errorf("Expected the read overlapping a mutation not to be cached, got %d calls", calls.Load())
+	}
+}
+
+func TestServer_MetaLimitMiddleware(t *testing.T) {
+	cfg := config.Default()
+	cfg.MaxMetaItems = 10
+	server := NewServer(cfg)
+
+	tasks := make([]tools.Task, 250)
+	for i := range tasks {
+		tasks[i] = tools.Task{TaskID: fmt.Sprintf("task-%d", i)}
+	}
+	overdue := tasks[:12]
+	handler := server.metaLimitMiddleware(func(ctx context.Context, session *mcp.ServerSession, method string, params mcp.Params) (mcp.Result, error) {
+		return &mcp.CallToolResult{
+			Content: []mcp.Content{&mcp.TextContent{Text: "250 tasks"}},
+			Meta: mcp.Meta{
+				"tasks":         tasks,
+				"overdue_tasks": overdue,
+				"notes":         []tools.TaskNote{{NoteID: "note-1"}},
+				"insights":      make([]string, 20),
+				"total_tasks":   len(tasks),
+			},
+		}, nil
+	})
+
+	result, err := handler(context.Background(), nil, "tools/call", &mcp.CallToolParamsFor[json.RawMessage]{Name: "search_tasks"})
+	if err != nil {
+		t.Fatalf("Call failed: %v", err)
+	}
+	meta := result.(*mcp.CallToolResult).Meta
+
+	if got := meta["tasks"].([]tools.Task); len(got) != 10 || got[0].TaskID != "task-0" {
+		t.Errorf("Expected tasks capped to the first 10, got %d", len(got))
+	}
+	if got := meta["overdue_tasks"].([]tools.Task); len(got) != 10 {
+		t.Errorf("Expected overdue_tasks capped to 10, got %d", len(got))
+	}
+	if got := meta["notes"].([]tools.TaskNote); len(got) != 1 {
+		t.Errorf("Expected short notes list untouched, got %d", len(got))
+	}
+	if got := meta["insights"].([]string); len(got) != 20 {
+		t.Errorf("Expected non task/note lists untouched, got %d", len(got))
+	}
+	if meta["meta_truncated"] != true {
+		t.Errorf("Expected meta_truncated flag, got %v", meta["meta_truncated"])
+	}
+	totals := meta["meta_totals"].(map[string]int)
+	if totals["tasks"] != 250 || totals["overdue_tasks"] != 12 || len(totals) != 2 {
+		t.Errorf("Expected true totals for capped lists, got %v", totals)
+	}
+	if meta["total_tasks"] != 250 {
+		t.Errorf("Expected scalar totals untouched, got %v", meta["total_tasks"])
+	}
+	if text := result.(*mcp.CallToolResult).Content[0].(*mcp.TextContent).Text; text != "250 tasks" {
+		t.Errorf("Expected response text untouched, got %q", text)
+	}
+
+	// Disabled by default
+	cfg.MaxMetaItems = 0
+	result, _ = handler(context.Background(), nil, "tools/call", &mcp.CallToolParamsFor[json.RawMessage]{Name: "search_tasks"})
+	meta = result.(*mcp.CallToolResult).Meta
+	if len(meta["tasks"].([]tools.Task)) != 250 || meta["meta_truncated"] != nil {
+		t.Error("Expected Meta untouched when MaxMetaItems is 0")
+	}
+}
+
+func TestServer_ConcurrencyLimitMiddleware(t *testing.T) {
+	call := &mcp.CallToolParamsFor[json.RawMessage]{Name: "get_task_overview"}
+
+	// blockingServer returns a limited handler whose calls wait for release
+	blockingServer := func(overflow string) (handler mcp.MethodHandler[*mcp.ServerSession], started chan struct{}, release chan struct{}, peak *int32) {
+		cfg := config.Default()
+		cfg.MaxConcurrentToolsPerSession = 2
+		cfg.ToolConcurrencyOverflow = overflow
+		server := NewServer(cfg)
+
+		started = make(chan struct{}, 10)
+		release = make(chan struct{})
+		peak = new(int32)
+		var running int32
+		handler = server.concurrencyLimitMiddleware(func(ctx context.Context, session *mcp.ServerSession, method string, params mcp.Params) (mcp.Result, error) {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				old := atomic.LoadInt32(peak)
+				if n <= old || atomic.CompareAndSwapInt32(peak, old, n) {
+					break
+				}
+			}
+			started <- struct{}{}
+			<-release
+			return &mcp.CallToolResult{}, nil
+		})
+		return handler, started, release, peak
+	}
+
+	t.Run("reject", func(t *testing.T) {
+		handler, started, release, _ := blockingServer("reject")
+		session := &mcp.ServerSession{}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 2; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				handler(context.Background(), session, "tools/call", call)
+			}()
+		}
+		<-started
+		<-started
+
+		for i := 0; i < 3; i++ {
+			_, err := handler(context.Background(), session, "tools/call", call)
+			if err == nil || !strings.Contains(err.Error(), "too many concurrent tool calls on this session (limit 2)") {
+				t.Errorf("Expected excess call %d to be rejected, got %v", i, err)
+			}
+		}
+
+		// Other sessions and non-tool methods are unaffected
+		go handler(context.Background(), &mcp.ServerSession{}, "tools/call", call)
+		select {
+		case <-started:
+		case <-time.After(time.Second):
+			t.Error("Expected another session's call to run")
+		}
+		go handler(context.Background(), session, "tools/list", nil)
+		select {
+		case <-started:
+		case <-time.After(time.Second):
+			t.Error("Expected tools/list to bypass the limit")
+		}
+
+		close(release)
+		wg.Wait()
+	})
+
+	t.Run("queue", func(t *testing.T) {
+		handler, started, release, peak := blockingServer("queue")
+		session := &mcp.ServerSession{}
+
+		var wg sync.WaitGroup
+		errs := make(chan error, 5)
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := handler(context.Background(), session, "tools/call", call)
+				errs <- err
+			}()
+		}
+		<-started
+		<-started
+		select {
+		case <-started:
+			t.Error("Expected a third call to wait for a slot")
+		case <-time.After(50 * time.Millisecond):
+		}
+
+		close(release)
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			if err != nil {
+				t.Errorf("Expected queued calls to succeed, got %v", err)
+			}
+		}
+		if got := atomic.LoadInt32(peak); got != 2 {
+			t.Errorf("Expected at most 2 calls at once, got %d", got)
+		}
+	})
+
+	t.Run("queued call cancelled", func(t *testing.T) {
+		handler, started, release, _ := blockingServer("queue")
+		session := &mcp.ServerSession{}
+		for i := 0; i < 2; i++ {
+			go handler(context.Background(), session, "tools/call", call)
+		}
+		<-started
+		<-started
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		if _, err := handler(ctx, session, "tools/call", call); err == nil || !strings.Contains(err.Error(), "cancelled while queued") {
+			t.Errorf("Expected queued call to give up on cancellation, got %v", err)
+		}
+		close(release)
+	})
+}
+
+func TestServer_CacheHitsBypassConcurrencyLimit(t *testing.T) {
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte("[]"))
+	}))
+	defer api.Close()
+
+	cfg := config.Default()
+	cfg.APIBaseURL = api.URL
+	cfg.ToolCacheTTL = time.Minute
+	cfg.MaxConcurrentToolsPerSession = 1
+	cfg.ToolConcurrencyOverflow = "reject"
+	server := NewServer(cfg)
+
+	ctx := context.Background()
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.mcpServer.Connect(ctx, serverTransport)
+	if err != nil {
+		t.Fatalf("Failed to connect server: %v", err)
+	}
+	session, err := mcp.NewClient("test-client", "1.0.0", nil).Connect(ctx, clientTransport)
+	if err != nil {
+		t.Fatalf("Failed to connect client: %v", err)
+	}
+	defer session.Close()
+
+	overview := func(status string) (*mcp.CallToolResult, error) {
+		return session.CallTool(ctx, &mcp.CallToolParams{
+			Name:      "get_task_overview",
+			Arguments: map[string]any{"status": status},
+		})
+	}
+
+	if _, err := overview("Blocked"); err != nil {
+		t.Fatalf("Failed to warm the cache: %v", err)
+	}
+
+	// Take the session's only slot, as a long-running call would
+	entry := server.acquireSessionSlots(serverSession)
+	entry.slots <- struct{}{}
+	defer server.releaseSessionSlots(serverSession, entry)
+
+	if _, err := overview("In Progress"); err == nil || !strings.Contains(err.Error(), "too many concurrent tool calls") {
+		t.Errorf("Expected an uncached call to be limited, got %v", err)
+	}
+	if result, err := overview("Blocked"); err != nil || result.IsError {
+		t.Errorf("Expected a cache hit to bypass the concurrency limit, got %v", err)
+	}
+}
+
+func TestServer_LoggingMiddlewareJSON(t *testing.T) {
+	var buf bytes.Buffer
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&buf, nil)))
+	defer slog.SetDefault(previous)
+
+	server := NewServer(config.Default())
+	handler := server.createLoggingMiddleware()(func(ctx context.Context, session *mcp.ServerSession, method string, params mcp.Params) (mcp.Result, error) {
+		return &mcp.CallToolResult{Content: []mcp.Content{&mcp.TextContent{Text: "ok"}}}, nil
+	})
+
+	call := &mcp.CallToolParamsFor[json.RawMessage]{Name: "get_task_overview", Arguments: json.RawMessage(`{"status":"Blocked"}`)}
+	if _, err := handler(context.Background(), &mcp.ServerSession{}, "tools/call", call); err != nil {
+		t.Fatalf("Handler failed: %v", err)
+	}
+
+	records := map[string]map[string]any{}
+	scanner := bufio.NewScanner(&buf)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		var record map[string]any
+		if err := json.Unmarshal(scanner.Bytes(), &record); err != nil {
+			t.Fatalf("Expected every log line to be valid JSON, got %q: %v", scanner.Text(), err)
+		}
+		records[record["msg"].(string)] = record
+	}
+
+	params, ok := records["MCP Request Parameters JSON"]
+	if !ok {
+		t.Fatalf("Expected a request parameters line, got %v", records)
+	}
+	for _, key := range []string{"time", "level", "msg", "method", "params_json"} {
+		if _, ok := params[key]; !ok {
+			t.Errorf("Expected key %q in %v", key, params)
+		}
+	}
+	paramsJSON, ok := params["params_json"].(map[string]any)
+	if !ok || paramsJSON["name"] != "get_task_overview" {
+		t.Errorf("Expected params_json embedded as an object, got %#v", params["params_json"])
+	}
+
+	success, ok := records["=== MCP REQUEST SUCCESS ==="]
+	if !ok || success["method"] != "tools/call" || success["duration_ms"] == nil {
+		t.Errorf("Expected a success line with method and duration, got %v", success)
+	}
+}
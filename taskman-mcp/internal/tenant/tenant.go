@@ -0,0 +1,43 @@
+// Package tenant carries the tenant a request is made for, so a shared server
+// can scope every task and project query to one team's data.
+package tenant
+
+import (
+	"context"
+	"net/http"
+	"strings"
+)
+
+type contextKey struct{}
+
+// WithTenant returns a context scoped to tenant; an empty tenant leaves ctx unscoped
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	if tenant == "" {
+		return ctx
+	}
+	return context.WithValue(ctx, contextKey{}, tenant)
+}
+
+// FromContext returns the tenant ctx is scoped to, or "" when unscoped
+func FromContext(ctx context.Context) string {
+	tenant, _ := ctx.Value(contextKey{}).(string)
+	return tenant
+}
+
+// Middleware scopes each HTTP request to the tenant named in header, falling
+// back to defaultTenant. Requests naming no tenant when there is no default
+// are rejected, so a client cannot fall through to unscoped data. An MCP
+// session keeps the tenant of the request that opened it.
+func Middleware(header, defaultTenant string, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimSpace(r.Header.Get(header))
+		if key == "" {
+			key = defaultTenant
+		}
+		if key == "" {
+			http.Error(w, "missing "+header+" header", http.StatusBadRequest)
+			return
+		}
+		next.ServeHTTP(w, r.WithContext(WithTenant(r.Context(), key)))
+	})
+}
@@ -0,0 +1,53 @@
+package tenant
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestContext(t *testing.T) {
+	ctx := context.Background()
+	if FromContext(ctx) != "" {
+		t.Error("Expected background context to be unscoped")
+	}
+	if WithTenant(ctx, "") != ctx {
+		t.Error("Expected empty tenant to leave context unchanged")
+	}
+	if got := FromContext(WithTenant(ctx, "team-a")); got != "team-a" {
+		t.Errorf("Expected team-a, got %q", got)
+	}
+}
+
+func TestMiddleware(t *testing.T) {
+	var seen string
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		seen = FromContext(r.Context())
+	})
+
+	tests := []struct {
+		name          string
+		header        string
+		defaultTenant string
+		wantTenant    string
+		wantStatus    int
+	}{
+		{"header wins", "team-b", "team-a", "team-b", http.StatusOK},
+		{"default applies", "", "team-a", "team-a", http.StatusOK},
+		{"no tenant rejected", "", "", "", http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		seen = ""
+		req := httptest.NewRequest(http.MethodPost, "/mcp", nil)
+		if tt.header != "" {
+			req.Header.Set("X-Tenant", tt.header)
+		}
+		rec := httptest.NewRecorder()
+		Middleware("X-Tenant", tt.defaultTenant, next).ServeHTTP(rec, req)
+
+		if rec.Code != tt.wantStatus || seen != tt.wantTenant {
+			t.Errorf("%s: got status %d tenant %q, want %d %q", tt.name, rec.Code, seen, tt.wantStatus, tt.wantTenant)
+		}
+	}
+}
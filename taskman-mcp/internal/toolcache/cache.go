@@ -0,0 +1,109 @@
+package toolcache
+
+import (
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// Cache memoizes tool results for a short TTL, keyed by tool name and
+// normalized arguments. Any mutation should call Invalidate; generation
+// guards against a call that started before the mutation caching its result.
+type Cache struct {
+	defaultTTL time.Duration
+	toolTTLs   map[string]time.Duration
+	now        func() time.Time
+
+	mutex      sync.Mutex
+	entries    map[string]entry
+	generation uint64
+}
+
+type entry struct {
+	value     any
+	expiresAt time.Time
+}
+
+// New creates a cache. defaultTTL applies to every tool without an entry in
+// toolTTLs; a TTL of zero disables caching for that tool.
+func New(defaultTTL time.Duration, toolTTLs map[string]time.Duration) *Cache {
+	return &Cache{
+		defaultTTL: defaultTTL,
+		toolTTLs:   toolTTLs,
+		now:        time.Now,
+		entries:    make(map[string]entry),
+	}
+}
+
+// TTL returns how long results of the named tool are cached
+func (c *Cache) TTL(tool string) time.Duration {
+	if ttl, ok := c.toolTTLs[tool]; ok {
+		return ttl
+	}
+	return c.defaultTTL
+}
+
+// Key builds a cache key from the tool name and its arguments. Arguments are
+// re-encoded so field order and whitespace don't produce distinct keys.
+func Key(tool string, args json.RawMessage) string {
+	var decoded any
+	if len(args) > 0 && json.Unmarshal(args, &decoded) == nil {
+		if normalized, err := json.Marshal(decoded); err == nil {
+			return tool + ":" + string(normalized)
+		}
+	}
+	return tool + ":" + string(args)
+}
+
+// Get returns the cached value for key if it hasn't expired
+func (c *Cache) Get(key string) (any, bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if !c.now().Before(e.expiresAt) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return e.value, true
+}
+
+// Begin returns the generation a call starts in, to pass to Put
+func (c *Cache) Begin() uint64 {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.generation
+}
+
+// Put stores value under key for ttl unless Invalidate was called since the
+// call began. Non-positive TTLs are ignored.
+func (c *Cache) Put(key string, generation uint64, value any, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if generation != c.generation {
+		return
+	}
+	c.entries[key] = entry{value: value, expiresAt: c.now().Add(ttl)}
+}
+
+// Invalidate drops every cached result and any result still being computed
+func (c *Cache) Invalidate() {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.entries = make(map[string]entry)
+	c.generation++
+}
+
+// Len returns the number of cached entries, including expired ones not yet evicted
+func (c *Cache) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.entries)
+}
@@ -0,0 +1,65 @@
+package toolcache
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestKey_NormalizesArguments(t *testing.T) {
+	a := Key("get_board", json.RawMessage(`{"project_id": "p1", "assigned_to": "alice"}`))
+	b := Key("get_board", json.RawMessage(`{"assigned_to":"alice","project_id":"p1"}`))
+	if a != b {
+		t.Errorf("Expected equivalent arguments to share a key, got %q and %q", a, b)
+	}
+	if Key("get_board", nil) == Key("get_my_work", nil) {
+		t.Error("Expected different tools to have different keys")
+	}
+}
+
+func TestCache_TTLAndInvalidate(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	cache := New(time.Minute, map[string]time.Duration{"health_check": 0})
+	cache.now = func() time.Time { return now }
+
+	if cache.TTL("health_check") != 0 || cache.TTL("get_board") != time.Minute {
+		t.Errorf("Unexpected TTLs: %v, %v", cache.TTL("health_check"), cache.TTL("get_board"))
+	}
+
+	cache.Put("k", cache.Begin(), "v", cache.TTL("get_board"))
+	if v, ok := cache.Get("k"); !ok || v != "v" {
+		t.Fatalf("Expected cached value, got %v %v", v, ok)
+	}
+
+	now = now.Add(time.Minute)
+	if _, ok := cache.Get("k"); ok {
+		t.Error("Expected entry to expire after its TTL")
+	}
+
+	cache.Put("k", cache.Begin(), "v", time.Minute)
+	cache.Invalidate()
+	if _, ok := cache.Get("k"); ok {
+		t.Error("Expected Invalidate to drop entries")
+	}
+
+	cache.Put("disabled", cache.Begin(), "v", cache.TTL("health_check"))
+	if cache.Len() != 0 {
+		t.Error("Expected zero TTL not to be cached")
+	}
+}
+
+func TestCache_PutSkipsResultsStartedBeforeInvalidate(t *testing.T) {
+	cache := New(time.Minute, nil)
+
+	generation := cache.Begin()
+	cache.Invalidate()
+	cache.Put("k", generation, "stale", time.Minute)
+	if _, ok := cache.Get("k"); ok {
+		t.Error("Expected a result begun before Invalidate not to be cached")
+	}
+
+	cache.Put("k", cache.Begin(), "fresh", time.Minute)
+	if v, ok := cache.Get("k"); !ok || v != "fresh" {
+		t.Errorf("Expected a result begun after Invalidate to be cached, got %v %v", v, ok)
+	}
+}
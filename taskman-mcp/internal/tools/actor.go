@@ -0,0 +1,26 @@
+package tools
+
+import (
+	"fmt"
+
+	"github.com/bchamber/taskman-mcp/internal/config"
+)
+
+// defaultActor is recorded on writes when no actor is supplied or configured
+const defaultActor = "system"
+
+// resolveActor returns the user to record for an audited write. When actor
+// fields are required an empty actor is rejected; otherwise it falls back to
+// the configured DefaultCreatedBy, then to "system".
+func resolveActor(cfg *config.Config, actor, field string) (string, error) {
+	if actor != "" {
+		return actor, nil
+	}
+	if cfg.RequireActorFields {
+		return "", fmt.Errorf("%s is required", field)
+	}
+	if cfg.DefaultCreatedBy != "" {
+		return cfg.DefaultCreatedBy, nil
+	}
+	return defaultActor, nil
+}
@@ -0,0 +1,50 @@
+package tools
+
+import (
+	"testing"
+
+	"github.com/bchamber/taskman-mcp/internal/config"
+)
+
+func TestResolveActor(t *testing.T) {
+	strict := config.Default()
+
+	lenient := config.Default()
+	lenient.RequireActorFields = false
+
+	withDefault := config.Default()
+	withDefault.RequireActorFields = false
+	withDefault.DefaultCreatedBy = "gateway"
+
+	tests := []struct {
+		name     string
+		cfg      *config.Config
+		actor    string
+		expected string
+		wantErr  bool
+	}{
+		{"strict with actor", strict, "alice", "alice", false},
+		{"strict without actor", strict, "", "", true},
+		{"lenient with actor", lenient, "alice", "alice", false},
+		{"lenient falls back to system", lenient, "", "system", false},
+		{"lenient uses configured default", withDefault, "", "gateway", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actor, err := resolveActor(tt.cfg, tt.actor, "created_by")
+			if tt.wantErr {
+				if err == nil || err.Error() != "created_by is required" {
+					t.Errorf("Expected created_by is required error, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if actor != tt.expected {
+				t.Errorf("Expected actor %q, got %q", tt.expected, actor)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Archived filter modes accepted by search_tasks
+const (
+	archivedExclude = "false" // only active tasks
+	archivedOnly    = "true"  // only archived tasks
+	archivedAll     = "all"   // both
+)
+
+// normalizeArchivedFilter maps the archived parameter onto a filter mode. An
+// empty value falls back to the configured default.
+func normalizeArchivedFilter(value string, includeByDefault bool) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "":
+		if includeByDefault {
+			return archivedAll, nil
+		}
+		return archivedExclude, nil
+	case "false":
+		return archivedExclude, nil
+	case "true":
+		return archivedOnly, nil
+	case "all":
+		return archivedAll, nil
+	default:
+		return "", fmt.Errorf("invalid archived value %q (use true, false or all)", value)
+	}
+}
+
+// matchesArchivedFilter reports whether a task passes the archived filter mode
+func matchesArchivedFilter(task Task, mode string) bool {
+	switch mode {
+	case archivedOnly:
+		return task.Archived
+	case archivedExclude:
+		return !task.Archived
+	default:
+		return true
+	}
+}
@@ -0,0 +1,30 @@
+package tools
+
+import "testing"
+
+func TestNormalizeArchivedFilter(t *testing.T) {
+	tests := []struct {
+		value            string
+		includeByDefault bool
+		want             string
+		wantErr          bool
+	}{
+		{"", false, archivedExclude, false},
+		{"", true, archivedAll, false},
+		{"true", false, archivedOnly, false},
+		{"FALSE", true, archivedExclude, false},
+		{" all ", false, archivedAll, false},
+		{"maybe", false, "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := normalizeArchivedFilter(tt.value, tt.includeByDefault)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("normalizeArchivedFilter(%q, %v) error = %v, wantErr %v", tt.value, tt.includeByDefault, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("normalizeArchivedFilter(%q, %v) = %q, want %q", tt.value, tt.includeByDefault, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+
+	"github.com/bchamber/taskman-mcp/internal/config"
+	"github.com/bchamber/taskman-mcp/internal/identity"
+)
+
+// projectDefaultAssignee returns who unassigned tasks in a project go to. A
+// default stored on the project wins over the ProjectDefaultAssignees config.
+func projectDefaultAssignee(cfg *config.Config, project Project) string {
+	if project.DefaultAssignee != nil && *project.DefaultAssignee != "" {
+		return *project.DefaultAssignee
+	}
+	return cfg.ProjectDefaultAssignees[project.ProjectID]
+}
+
+// defaultAssigneeForProject looks up a project's default assignee. If the
+// project cannot be fetched the configured default, if any, is still used.
+func (t *TaskTools) defaultAssigneeForProject(ctx context.Context, cfg *config.Config, projectID string) string {
+	project := Project{ProjectID: projectID}
+	resp, err := t.apiClient.Get(ctx, fmt.Sprintf("/api/v1/projects/%s", url.PathEscape(projectID)))
+	if err != nil {
+		slog.Warn("Failed to get project for default assignee", "project_id", projectID, "error", err)
+	} else if err := json.Unmarshal(resp, &project); err != nil {
+		slog.Warn("Failed to parse project for default assignee", "project_id", projectID, "error", err)
+		project = Project{ProjectID: projectID}
+	}
+	return projectDefaultAssignee(cfg, project)
+}
+
+// filterAssignedTo keeps the tasks assigned to any form of assignee. Assignee
+// filters are only sent to the API, which matches raw values, while aliasing
+// is disabled; otherwise they are applied here.
+func filterAssignedTo(assignees *identity.Normalizer, tasks []Task, assignee string) []Task {
+	if assignee == "" || !assignees.Enabled() {
+		return tasks
+	}
+	filtered := []Task{}
+	for _, task := range tasks {
+		if assignees.Matches(task.AssignedTo, assignee) {
+			filtered = append(filtered, task)
+		}
+	}
+	return filtered
+}
+
+// assigneeStatusQuery encodes a task query for one status and assignee. The
+// assignee is left out when aliasing is enabled; see filterAssignedTo.
+func assigneeStatusQuery(assignees *identity.Normalizer, assignee, status string) string {
+	query := url.Values{}
+	if !assignees.Enabled() {
+		query.Set("assigned_to", assignee)
+	}
+	query.Set("status", status)
+	return query.Encode()
+}
@@ -0,0 +1,107 @@
+package tools
+
+import (
+	"time"
+)
+
+// lastActivity returns when a task last changed, falling back to its creation date
+func lastActivity(task Task) (time.Time, bool) {
+	if task.LastUpdateDate != nil {
+		if updated, err := parseDueDate(*task.LastUpdateDate); err == nil && updated != nil {
+			return *updated, true
+		}
+	}
+	if created, err := parseDueDate(task.CreationDate); err == nil && created != nil {
+		return *created, true
+	}
+	return time.Time{}, false
+}
+
+// isTaskStale reports whether an open task has had no activity for at least staleAfter
+func isTaskStale(task Task, now time.Time, staleAfter time.Duration) bool {
+	if task.Status == "Complete" {
+		return false
+	}
+	last, ok := lastActivity(task)
+	return ok && now.Sub(last) >= staleAfter
+}
+
+// blockedDuration estimates how long a Blocked task has been blocked. The API
+// keeps no status history, so the last update is taken as the time it became blocked.
+func blockedDuration(task Task, now time.Time) (time.Duration, bool) {
+	if task.Status != "Blocked" {
+		return 0, false
+	}
+	last, ok := lastActivity(task)
+	if !ok {
+		return 0, false
+	}
+	return now.Sub(last), true
+}
+
+// overdueDuration returns how long past its due date an open task is. It is
+// the one definition of overdue: isTaskOverdue reports whether it is positive.
+func overdueDuration(task Task, now time.Time) (time.Duration, bool) {
+	if task.Status == "Complete" || task.DueDate == nil {
+		return 0, false
+	}
+	due, err := parseDueDate(*task.DueDate)
+	if err != nil || due == nil || !due.Before(now) {
+		return 0, false
+	}
+	return now.Sub(*due), true
+}
+
+// overdueBeyondGrace returns how long past its due date an open task is when
+// that is more than graceDays; tasks within the grace window are not overdue
+func overdueBeyondGrace(task Task, now time.Time, graceDays int) (time.Duration, bool) {
+	overdue, ok := overdueDuration(task, now)
+	if !ok || overdue <= time.Duration(graceDays)*24*time.Hour {
+		return 0, false
+	}
+	return overdue, true
+}
+
+// wholeDays converts a duration to whole days
+func wholeDays(d time.Duration) int {
+	return int(d.Hours() / 24)
+}
+
+// Reasons a task needs attention now, as reported by get_attention_needed
+const (
+	attentionOverdue  = "overdue"
+	attentionBlocked  = "blocked"
+	attentionDueToday = "due today"
+)
+
+// attentionReasons lists why an open task needs attention as of now. A task
+// due on today's date counts as due today, not overdue, whatever its due time.
+func attentionReasons(task Task, now time.Time) []string {
+	if task.Status == "Complete" {
+		return nil
+	}
+
+	var reasons []string
+	if isDueOnDay(task, now) {
+		reasons = append(reasons, attentionDueToday)
+	} else if _, overdue := overdueDuration(task, now); overdue {
+		reasons = append(reasons, attentionOverdue)
+	}
+	if task.Status == "Blocked" {
+		reasons = append(reasons, attentionBlocked)
+	}
+	return reasons
+}
+
+// isDueOnDay reports whether a task's due date falls on the same calendar day as day
+func isDueOnDay(task Task, day time.Time) bool {
+	if task.DueDate == nil {
+		return false
+	}
+	due, err := parseDueDate(*task.DueDate)
+	if err != nil || due == nil {
+		return false
+	}
+	dueLocal := due.In(day.Location())
+	return dueLocal.Year() == day.Year() && dueLocal.YearDay() == day.YearDay()
+}
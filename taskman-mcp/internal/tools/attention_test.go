@@ -0,0 +1,54 @@
+package tools
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAttentionHelpers(t *testing.T) {
+	now := time.Date(2024, 6, 20, 12, 0, 0, 0, time.UTC)
+
+	stale := Task{Status: "In Progress", CreationDate: "2024-06-01T00:00:00Z", LastUpdateDate: stringPtr("2024-06-10T12:00:00Z")}
+	fresh := Task{Status: "In Progress", CreationDate: "2024-06-01T00:00:00Z", LastUpdateDate: stringPtr("2024-06-19T12:00:00Z")}
+	done := Task{Status: "Complete", CreationDate: "2024-01-01T00:00:00Z"}
+
+	if !isTaskStale(stale, now, 7*24*time.Hour) {
+		t.Error("Expected task idle for 10 days to be stale")
+	}
+	if isTaskStale(fresh, now, 7*24*time.Hour) {
+		t.Error("Expected recently updated task not to be stale")
+	}
+	if isTaskStale(done, now, 7*24*time.Hour) {
+		t.Error("Expected completed task never to be stale")
+	}
+
+	blocked := Task{Status: "Blocked", CreationDate: "2024-06-01T00:00:00Z", LastUpdateDate: stringPtr("2024-06-15T12:00:00Z")}
+	if d, ok := blockedDuration(blocked, now); !ok || wholeDays(d) != 5 {
+		t.Errorf("Expected blocked for 5 days, got %v (%v)", d, ok)
+	}
+	if _, ok := blockedDuration(fresh, now); ok {
+		t.Error("Expected non-blocked task to have no blocked duration")
+	}
+
+	overdue := Task{Status: "Not Started", DueDate: stringPtr("2024-06-17")}
+	if d, ok := overdueDuration(overdue, now); !ok || wholeDays(d) != 3 {
+		t.Errorf("Expected 3 days overdue, got %v (%v)", d, ok)
+	}
+	if _, ok := overdueDuration(Task{Status: "Complete", DueDate: stringPtr("2024-06-17")}, now); ok {
+		t.Error("Expected completed task not to be overdue")
+	}
+
+	// Date-only and RFC3339 due dates are overdue by the same rule everywhere
+	for _, due := range []string{"2024-06-17", "2024-06-17T00:00:00Z"} {
+		task := Task{Status: "Not Started", DueDate: stringPtr(due)}
+		if !isTaskOverdue(task, now) {
+			t.Errorf("Expected due date %s to be overdue", due)
+		}
+		if d, ok := overdueBeyondGrace(task, now, 2); !ok || wholeDays(d) != 3 {
+			t.Errorf("Expected due date %s to be 3 days overdue beyond a 2 day grace, got %v (%v)", due, d, ok)
+		}
+		if _, ok := overdueBeyondGrace(task, now, 4); ok {
+			t.Errorf("Expected due date %s to be within a 4 day grace", due)
+		}
+	}
+}
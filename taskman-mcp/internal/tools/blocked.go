@@ -0,0 +1,102 @@
+package tools
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// blockedPeriod is one stretch of time a task is believed to have been Blocked
+type blockedPeriod struct {
+	start       time.Time
+	end         time.Time
+	approximate bool
+}
+
+// noteMarksUnblocked reports whether a note records a task becoming unblocked
+func noteMarksUnblocked(note TaskNote) bool {
+	text := strings.ToLower(note.Note)
+	return strings.Contains(text, "unblock") || strings.Contains(text, "no longer blocked")
+}
+
+// noteMarksBlocked reports whether a note records a task becoming blocked
+func noteMarksBlocked(note TaskNote) bool {
+	return strings.Contains(strings.ToLower(note.Note), "blocked") && !noteMarksUnblocked(note)
+}
+
+// inferBlockedPeriods reconstructs when a task was Blocked. The API keeps no
+// status history, so periods open at a note mentioning "blocked" and close at
+// one mentioning "unblocked". A period still open is closed at now if the task
+// is Blocked, otherwise at its last activity, which is approximate. A Blocked
+// task with no open period falls back to blockedDuration, also approximate.
+func inferBlockedPeriods(task Task, notes []TaskNote, now time.Time) []blockedPeriod {
+	type marker struct {
+		at      time.Time
+		blocked bool
+	}
+	var markers []marker
+	for _, note := range notes {
+		at, err := parseDueDate(note.CreationDate)
+		if err != nil || at == nil {
+			continue
+		}
+		switch {
+		case noteMarksUnblocked(note):
+			markers = append(markers, marker{at: *at, blocked: false})
+		case noteMarksBlocked(note):
+			markers = append(markers, marker{at: *at, blocked: true})
+		}
+	}
+	sort.SliceStable(markers, func(i, j int) bool {
+		return markers[i].at.Before(markers[j].at)
+	})
+
+	var periods []blockedPeriod
+	var open *time.Time
+	for _, m := range markers {
+		switch {
+		case m.blocked && open == nil:
+			start := m.at
+			open = &start
+		case !m.blocked && open != nil:
+			periods = append(periods, blockedPeriod{start: *open, end: m.at})
+			open = nil
+		}
+	}
+
+	if open != nil {
+		if task.Status == "Blocked" {
+			periods = append(periods, blockedPeriod{start: *open, end: now})
+		} else if last, ok := lastActivity(task); ok && last.After(*open) {
+			periods = append(periods, blockedPeriod{start: *open, end: last, approximate: true})
+		}
+	}
+
+	if task.Status == "Blocked" && open == nil {
+		if d, ok := blockedDuration(task, now); ok && d > 0 {
+			start := now.Add(-d)
+			if len(periods) > 0 && start.Before(periods[len(periods)-1].end) {
+				start = periods[len(periods)-1].end
+			}
+			periods = append(periods, blockedPeriod{start: start, end: now, approximate: true})
+		}
+	}
+	return periods
+}
+
+// blockedTimeSince totals the blocked time in periods on or after since. A
+// zero since counts every period.
+func blockedTimeSince(periods []blockedPeriod, since time.Time) (total time.Duration, approximate bool) {
+	for _, period := range periods {
+		start := period.start
+		if !since.IsZero() && start.Before(since) {
+			start = since
+		}
+		if !period.end.After(start) {
+			continue
+		}
+		total += period.end.Sub(start)
+		approximate = approximate || period.approximate
+	}
+	return total, approximate
+}
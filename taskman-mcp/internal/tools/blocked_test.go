@@ -0,0 +1,65 @@
+package tools
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInferBlockedPeriods(t *testing.T) {
+	now := time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)
+	note := func(text, at string) TaskNote {
+		return TaskNote{Note: text, CreationDate: at}
+	}
+
+	// Closed period entirely from notes
+	resolved := Task{Status: "In Progress", LastUpdateDate: stringPtr("2024-06-05T12:00:00Z")}
+	periods := inferBlockedPeriods(resolved, []TaskNote{
+		note("Unblocked once the key arrived", "2024-06-03T12:00:00Z"),
+		note("Blocked waiting on API key", "2024-06-01T12:00:00Z"),
+	}, now)
+	if len(periods) != 1 || periods[0].approximate || periods[0].end.Sub(periods[0].start) != 48*time.Hour {
+		t.Errorf("Expected one exact 48h period, got %+v", periods)
+	}
+
+	// Still blocked: open period runs to now
+	stuck := Task{Status: "Blocked", LastUpdateDate: stringPtr("2024-06-09T12:00:00Z")}
+	periods = inferBlockedPeriods(stuck, []TaskNote{note("Blocked by vendor", "2024-06-06T12:00:00Z")}, now)
+	if len(periods) != 1 || periods[0].approximate || periods[0].end != now {
+		t.Errorf("Expected exact period open until now, got %+v", periods)
+	}
+
+	// Blocked without notes falls back to the last update
+	periods = inferBlockedPeriods(stuck, nil, now)
+	if len(periods) != 1 || !periods[0].approximate || periods[0].end.Sub(periods[0].start) != 24*time.Hour {
+		t.Errorf("Expected approximate 24h period from last update, got %+v", periods)
+	}
+
+	// Unblocked without a note closes at the last update, approximately
+	moved := Task{Status: "In Progress", LastUpdateDate: stringPtr("2024-06-08T12:00:00Z")}
+	periods = inferBlockedPeriods(moved, []TaskNote{note("blocked on review", "2024-06-07T12:00:00Z")}, now)
+	if len(periods) != 1 || !periods[0].approximate || periods[0].end.Sub(periods[0].start) != 24*time.Hour {
+		t.Errorf("Expected approximate period closed at last update, got %+v", periods)
+	}
+
+	if periods := inferBlockedPeriods(resolved, []TaskNote{note("Good progress", "2024-06-02T12:00:00Z")}, now); len(periods) != 0 {
+		t.Errorf("Expected no periods for a task never blocked, got %+v", periods)
+	}
+}
+
+func TestBlockedTimeSince(t *testing.T) {
+	start := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	periods := []blockedPeriod{
+		{start: start, end: start.Add(48 * time.Hour)},
+		{start: start.Add(96 * time.Hour), end: start.Add(120 * time.Hour), approximate: true},
+	}
+
+	if total, approx := blockedTimeSince(periods, time.Time{}); total != 72*time.Hour || !approx {
+		t.Errorf("Expected 72h approximate, got %v (%v)", total, approx)
+	}
+	if total, _ := blockedTimeSince(periods, start.Add(24*time.Hour)); total != 48*time.Hour {
+		t.Errorf("Expected period clipped at since, got %v", total)
+	}
+	if total, approx := blockedTimeSince(periods[:1], start.Add(72*time.Hour)); total != 0 || approx {
+		t.Errorf("Expected nothing after since, got %v (%v)", total, approx)
+	}
+}
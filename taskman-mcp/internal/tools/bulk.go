@@ -0,0 +1,42 @@
+package tools
+
+import "sync"
+
+// bulkConcurrency bounds how many API calls bulk tools make in parallel
+const bulkConcurrency = 5
+
+// runBounded calls fn for each index in [0, n) with at most limit calls in flight
+func runBounded(n int, limit int, fn func(i int)) {
+	if limit <= 0 {
+		limit = 1
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, limit)
+
+	for i := 0; i < n; i++ {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			fn(i)
+		}(i)
+	}
+
+	wg.Wait()
+}
+
+// runConcurrently calls every fn at once and waits for all of them. Each fn
+// records its own result, so one failed fetch never cancels the others.
+func runConcurrently(fns ...func()) {
+	var wg sync.WaitGroup
+	for _, fn := range fns {
+		wg.Add(1)
+		go func(fn func()) {
+			defer wg.Done()
+			fn()
+		}(fn)
+	}
+	wg.Wait()
+}
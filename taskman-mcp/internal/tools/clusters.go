@@ -0,0 +1,57 @@
+package tools
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// DueDateCluster is a day with more incomplete tasks due than the configured threshold
+type DueDateCluster struct {
+	Date    string   `json:"date"` // YYYY-MM-DD
+	Count   int      `json:"count"`
+	TaskIDs []string `json:"task_ids"`
+}
+
+// dueDateClusters groups incomplete, unarchived tasks by due day and returns
+// the days with more than threshold tasks, earliest first. Due dates are
+// normalized with parseDueDate so date-only and timestamp values on the same
+// UTC day land together. A non-positive threshold disables detection.
+func dueDateClusters(tasks []Task, threshold int) []DueDateCluster {
+	if threshold <= 0 {
+		return nil
+	}
+
+	byDay := make(map[string][]string)
+	for _, task := range tasks {
+		if task.Status == "Complete" || task.Archived || task.DueDate == nil {
+			continue
+		}
+		due, err := parseDueDate(*task.DueDate)
+		if err != nil || due == nil {
+			continue
+		}
+		day := due.UTC().Format("2006-01-02")
+		byDay[day] = append(byDay[day], task.TaskID)
+	}
+
+	var clusters []DueDateCluster
+	for day, taskIDs := range byDay {
+		if len(taskIDs) > threshold {
+			clusters = append(clusters, DueDateCluster{Date: day, Count: len(taskIDs), TaskIDs: taskIDs})
+		}
+	}
+	sort.Slice(clusters, func(i, j int) bool {
+		return clusters[i].Date < clusters[j].Date
+	})
+	return clusters
+}
+
+// dueDateClusterInsight describes the crunch days found by dueDateClusters
+func dueDateClusterInsight(clusters []DueDateCluster) string {
+	days := make([]string, len(clusters))
+	for i, cluster := range clusters {
+		days[i] = fmt.Sprintf("%s (%d tasks)", cluster.Date, cluster.Count)
+	}
+	return "📆 Due-date crunch: many incomplete tasks are due on " + strings.Join(days, ", ") + " - consider spreading them out"
+}
@@ -0,0 +1,26 @@
+package tools
+
+import "testing"
+
+func TestDueDateClusters(t *testing.T) {
+	tasks := []Task{
+		{TaskID: "t1", Status: "Not Started", DueDate: stringPtr("2024-06-14")},
+		{TaskID: "t2", Status: "In Progress", DueDate: stringPtr("2024-06-14T09:00:00Z")},
+		{TaskID: "t3", Status: "Blocked", DueDate: stringPtr("2024-06-14T17:30:00Z")},
+		{TaskID: "t4", Status: "Complete", DueDate: stringPtr("2024-06-14")},
+		{TaskID: "t5", Status: "Not Started", DueDate: stringPtr("2024-06-20")},
+		{TaskID: "t6", Status: "Not Started", DueDate: stringPtr("not a date")},
+	}
+
+	clusters := dueDateClusters(tasks, 2)
+	if len(clusters) != 1 || clusters[0].Date != "2024-06-14" || clusters[0].Count != 3 {
+		t.Fatalf("Expected one cluster of 3 tasks on 2024-06-14, got %+v", clusters)
+	}
+
+	if clusters := dueDateClusters(tasks, 3); len(clusters) != 0 {
+		t.Errorf("Expected no cluster when the count only reaches the threshold, got %+v", clusters)
+	}
+	if clusters := dueDateClusters(tasks, 0); clusters != nil {
+		t.Errorf("Expected detection disabled at threshold 0, got %+v", clusters)
+	}
+}
@@ -0,0 +1,82 @@
+package tools
+
+import (
+	"sort"
+	"time"
+)
+
+// Metrics compared by compare_assignees
+const (
+	metricActiveLoad        = "active_load"
+	metricOverdue           = "overdue_count"
+	metricVelocity          = "velocity"
+	metricNoteContributions = "note_contributions"
+)
+
+// comparisonMetrics lists the compared metrics in display order
+var comparisonMetrics = []string{metricActiveLoad, metricOverdue, metricVelocity, metricNoteContributions}
+
+// AssigneeMetrics is one assignee's side of a comparison
+type AssigneeMetrics struct {
+	UserID            string  `json:"user_id"`
+	ActiveLoad        int     `json:"active_load"`   // open, unarchived tasks
+	OverdueCount      int     `json:"overdue_count"` // open tasks past their due date
+	CompletedInWindow int     `json:"completed_in_window"`
+	Velocity          float64 `json:"velocity"`           // average completions per week in the window
+	NoteContributions int     `json:"note_contributions"` // notes written in the window
+}
+
+// metricValue returns the named metric for an assignee
+func (m AssigneeMetrics) metricValue(metric string) float64 {
+	switch metric {
+	case metricActiveLoad:
+		return float64(m.ActiveLoad)
+	case metricOverdue:
+		return float64(m.OverdueCount)
+	case metricVelocity:
+		return m.Velocity
+	case metricNoteContributions:
+		return float64(m.NoteContributions)
+	}
+	return 0
+}
+
+// assigneeTaskMetrics fills in the task-based metrics for one assignee's
+// tasks; note contributions are counted separately
+func assigneeTaskMetrics(userID string, tasks []Task, now time.Time, weeks int) AssigneeMetrics {
+	metrics := AssigneeMetrics{UserID: userID}
+	for _, task := range tasks {
+		if task.Status == "Complete" || task.Archived {
+			continue
+		}
+		metrics.ActiveLoad++
+		if isTaskOverdue(task, now) {
+			metrics.OverdueCount++
+		}
+	}
+
+	series, _ := weeklyCompletions(tasks, now, weeks)
+	for _, count := range series {
+		metrics.CompletedInWindow += count
+	}
+	metrics.Velocity = averageVelocity(series)
+	return metrics
+}
+
+// rankAssignees orders user IDs per metric, highest value first. Ties keep
+// the order the users were given in.
+func rankAssignees(metrics []AssigneeMetrics) map[string][]string {
+	rankings := make(map[string][]string, len(comparisonMetrics))
+	for _, metric := range comparisonMetrics {
+		ordered := append([]AssigneeMetrics{}, metrics...)
+		sort.SliceStable(ordered, func(i, j int) bool {
+			return ordered[i].metricValue(metric) > ordered[j].metricValue(metric)
+		})
+		ranking := make([]string, len(ordered))
+		for i, m := range ordered {
+			ranking[i] = m.UserID
+		}
+		rankings[metric] = ranking
+	}
+	return rankings
+}
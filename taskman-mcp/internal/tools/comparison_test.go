@@ -0,0 +1,41 @@
+package tools
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestAssigneeTaskMetrics(t *testing.T) {
+	now := time.Date(2024, 6, 12, 12, 0, 0, 0, time.UTC)
+	tasks := []Task{
+		{TaskID: "t1", Status: "In Progress", DueDate: stringPtr("2024-06-01T17:00:00Z")},
+		{TaskID: "t2", Status: "Not Started", DueDate: stringPtr("2024-06-30T17:00:00Z")},
+		{TaskID: "t3", Status: "Complete", CompletionDate: stringPtr("2024-06-10T10:00:00Z")},
+		{TaskID: "t4", Status: "Complete", CompletionDate: stringPtr("2024-06-03T10:00:00Z")},
+		{TaskID: "t5", Status: "Not Started", Archived: true},
+	}
+
+	metrics := assigneeTaskMetrics("alice", tasks, now, 2)
+	want := AssigneeMetrics{UserID: "alice", ActiveLoad: 2, OverdueCount: 1, CompletedInWindow: 2, Velocity: 1}
+	if metrics != want {
+		t.Errorf("assigneeTaskMetrics = %+v, want %+v", metrics, want)
+	}
+}
+
+func TestRankAssignees(t *testing.T) {
+	rankings := rankAssignees([]AssigneeMetrics{
+		{UserID: "alice", ActiveLoad: 2, OverdueCount: 1, Velocity: 3, NoteContributions: 1},
+		{UserID: "bob", ActiveLoad: 5, OverdueCount: 1, Velocity: 1, NoteContributions: 4},
+	})
+
+	want := map[string][]string{
+		metricActiveLoad:        {"bob", "alice"},
+		metricOverdue:           {"alice", "bob"},
+		metricVelocity:          {"alice", "bob"},
+		metricNoteContributions: {"bob", "alice"},
+	}
+	if !reflect.DeepEqual(rankings, want) {
+		t.Errorf("rankAssignees = %v, want %v", rankings, want)
+	}
+}
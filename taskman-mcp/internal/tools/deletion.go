@@ -0,0 +1,105 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/bchamber/taskman-mcp/internal/config"
+)
+
+// deletedTag marks tasks that were soft-deleted so they can be found and restored
+const deletedTag = "deleted"
+
+// isHardDelete reports whether the configured policy permanently removes data
+func isHardDelete(cfg *config.Config) bool {
+	return cfg.DeletePolicy == "hard"
+}
+
+// deleteTask deletes a task under the configured policy: a DELETE under the
+// hard policy, otherwise archiving it with the deleted tag and a note saying
+// who deleted it at deletedAt
+func (t *TaskTools) deleteTask(ctx context.Context, cfg *config.Config, task Task, deletedBy string, deletedAt time.Time) error {
+	taskPath := fmt.Sprintf("/api/v1/tasks/%s", url.PathEscape(task.TaskID))
+	if isHardDelete(cfg) {
+		if _, err := t.apiClient.Delete(ctx, taskPath); err != nil {
+			slog.Error("Failed to delete task", "error", err, "task_id", task.TaskID)
+			return fmt.Errorf("failed to delete task: %w", err)
+		}
+		slog.Info("Task hard-deleted", "task_id", task.TaskID, "deleted_by", deletedBy)
+		return nil
+	}
+
+	updateRequest := map[string]interface{}{
+		"archived":        true,
+		"tags":            withTag(task.Tags, deletedTag),
+		"last_updated_by": deletedBy,
+	}
+	if _, err := t.apiClient.Put(ctx, taskPath, updateRequest); err != nil {
+		slog.Error("Failed to archive task", "error", err, "task_id", task.TaskID)
+		return fmt.Errorf("failed to archive task: %w", err)
+	}
+
+	noteRequest := map[string]interface{}{
+		"note":       softDeleteNote(deletedBy, deletedAt),
+		"created_by": deletedBy,
+	}
+	if _, err := t.apiClient.Post(ctx, taskPath+"/notes", noteRequest); err != nil {
+		slog.Error("Failed to add deletion note", "error", err, "task_id", task.TaskID)
+		// Continue - the task is archived even if the note failed
+	}
+
+	slog.Info("Task soft-deleted", "task_id", task.TaskID, "deleted_by", deletedBy)
+	return nil
+}
+
+// withTag returns tags with tag appended unless it is already present
+func withTag(tags []string, tag string) []string {
+	for _, existing := range tags {
+		if existing == tag {
+			return tags
+		}
+	}
+	return append(append([]string{}, tags...), tag)
+}
+
+// withoutTag returns tags with every occurrence of tag removed
+func withoutTag(tags []string, tag string) []string {
+	kept := []string{}
+	for _, existing := range tags {
+		if existing != tag {
+			kept = append(kept, existing)
+		}
+	}
+	return kept
+}
+
+// isSoftDeletedTask reports whether a task was archived by a soft delete
+func isSoftDeletedTask(task Task) bool {
+	if !task.Archived {
+		return false
+	}
+	for _, tag := range task.Tags {
+		if tag == deletedTag {
+			return true
+		}
+	}
+	return false
+}
+
+// isSoftDeletedProject reports whether a project was archived by a soft delete
+func isSoftDeletedProject(project Project) bool {
+	return project.Archived && project.DeletedBy != nil
+}
+
+// softDeleteNote records who soft-deleted an item and when
+func softDeleteNote(deletedBy string, deletedAt time.Time) string {
+	return fmt.Sprintf("🗑️ Deleted by %s at %s (soft delete - unarchive to restore)", deletedBy, deletedAt.UTC().Format(time.RFC3339))
+}
+
+// restoreNote records who restored a soft-deleted item and when
+func restoreNote(restoredBy string, restoredAt time.Time) string {
+	return fmt.Sprintf("♻️ Restored by %s at %s", restoredBy, restoredAt.UTC().Format(time.RFC3339))
+}
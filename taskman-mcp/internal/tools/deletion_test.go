@@ -0,0 +1,41 @@
+package tools
+
+import (
+	"testing"
+
+	"github.com/bchamber/taskman-mcp/internal/config"
+)
+
+func TestWithTag(t *testing.T) {
+	tags := []string{"backend"}
+
+	got := withTag(tags, deletedTag)
+	if len(got) != 2 || got[1] != deletedTag {
+		t.Errorf("Expected deleted tag appended, got %v", got)
+	}
+	if len(tags) != 1 {
+		t.Error("withTag should not modify its input")
+	}
+
+	if again := withTag(got, deletedTag); len(again) != 2 {
+		t.Errorf("Expected tag not to be duplicated, got %v", again)
+	}
+}
+
+func TestIsHardDelete(t *testing.T) {
+	cfg := config.Default()
+	if isHardDelete(cfg) {
+		t.Error("Expected default policy to be soft")
+	}
+	cfg.DeletePolicy = "hard"
+	if !isHardDelete(cfg) {
+		t.Error("Expected hard policy to be detected")
+	}
+}
+
+func TestWithoutTag(t *testing.T) {
+	got := withoutTag([]string{"backend", deletedTag, "api"}, deletedTag)
+	if len(got) != 2 || got[0] != "backend" || got[1] != "api" {
+		t.Errorf("Expected deleted tag removed, got %v", got)
+	}
+}
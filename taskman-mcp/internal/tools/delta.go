@@ -0,0 +1,62 @@
+package tools
+
+import (
+	"fmt"
+	"time"
+)
+
+// parseSince parses a cutoff given as a date, an RFC3339 timestamp or a
+// duration before now such as "24h"
+func parseSince(since string, now time.Time) (time.Time, error) {
+	if parsed, err := parseDueDate(since); err == nil && parsed != nil {
+		return *parsed, nil
+	}
+	if d, err := time.ParseDuration(since); err == nil && d > 0 {
+		return now.Add(-d), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid since %q: expected YYYY-MM-DD, RFC3339 or a duration like 24h", since)
+}
+
+// timestampSince reports whether a possibly unset timestamp is at or after since
+func timestampSince(value *string, since time.Time) bool {
+	if value == nil {
+		return false
+	}
+	parsed, err := parseDueDate(*value)
+	return err == nil && parsed != nil && !parsed.Before(since)
+}
+
+// createdSince reports whether a task was created at or after since
+func createdSince(task Task, since time.Time) bool {
+	return timestampSince(&task.CreationDate, since)
+}
+
+// completedSince reports whether a task was completed at or after since
+func completedSince(task Task, since time.Time) bool {
+	return task.Status == "Complete" && timestampSince(task.CompletionDate, since)
+}
+
+// becameOverdueSince reports whether an open task is overdue now but was not
+// yet overdue at since
+func becameOverdueSince(task Task, since, now time.Time) bool {
+	if _, overdue := overdueDuration(task, now); !overdue {
+		return false
+	}
+	return timestampSince(task.DueDate, since)
+}
+
+// statusChangeSince infers whether a task's status changed since the cutoff.
+// The API keeps no status history, so the evidence is the timestamp that
+// moved: completion_date, then start_date, then last_update_date. The last
+// only shows the task changed, not necessarily its status.
+func statusChangeSince(task Task, since time.Time) (evidence string, changed bool) {
+	switch {
+	case completedSince(task, since):
+		return "completion_date", true
+	case task.Status != "Not Started" && timestampSince(task.StartDate, since):
+		return "start_date", true
+	case timestampSince(task.LastUpdateDate, since):
+		return "last_update_date", true
+	}
+	return "", false
+}
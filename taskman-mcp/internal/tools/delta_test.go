@@ -0,0 +1,49 @@
+package tools
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseSince(t *testing.T) {
+	now := time.Date(2024, 6, 10, 9, 0, 0, 0, time.UTC)
+
+	tests := map[string]time.Time{
+		"2024-06-09":           time.Date(2024, 6, 9, 0, 0, 0, 0, time.UTC),
+		"2024-06-09T17:30:00Z": time.Date(2024, 6, 9, 17, 30, 0, 0, time.UTC),
+		"24h":                  time.Date(2024, 6, 9, 9, 0, 0, 0, time.UTC),
+	}
+	for input, want := range tests {
+		got, err := parseSince(input, now)
+		if err != nil || !got.Equal(want) {
+			t.Errorf("parseSince(%q) = %v (%v), want %v", input, got, err, want)
+		}
+	}
+
+	for _, bad := range []string{"yesterday", "-24h", "0s"} {
+		if _, err := parseSince(bad, now); err == nil {
+			t.Errorf("Expected error for %q", bad)
+		}
+	}
+}
+
+func TestStatusChangeSince(t *testing.T) {
+	since := time.Date(2024, 6, 9, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		task     Task
+		evidence string
+	}{
+		{"completed", Task{Status: "Complete", CompletionDate: stringPtr("2024-06-09T10:00:00Z"), LastUpdateDate: stringPtr("2024-06-09T10:00:00Z")}, "completion_date"},
+		{"started", Task{Status: "In Progress", StartDate: stringPtr("2024-06-09T11:00:00Z")}, "start_date"},
+		{"updated", Task{Status: "Blocked", StartDate: stringPtr("2024-06-01T11:00:00Z"), LastUpdateDate: stringPtr("2024-06-09T12:00:00Z")}, "last_update_date"},
+		{"untouched", Task{Status: "In Progress", StartDate: stringPtr("2024-06-01T11:00:00Z"), LastUpdateDate: stringPtr("2024-06-08T12:00:00Z")}, ""},
+	}
+	for _, tt := range tests {
+		evidence, changed := statusChangeSince(tt.task, since)
+		if evidence != tt.evidence || changed != (tt.evidence != "") {
+			t.Errorf("%s: expected %q, got %q (%v)", tt.name, tt.evidence, evidence, changed)
+		}
+	}
+}
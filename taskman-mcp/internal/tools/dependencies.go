@@ -0,0 +1,160 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+)
+
+// fetchTask retrieves a single task by ID
+func (t *TaskTools) fetchTask(ctx context.Context, taskID string) (*Task, error) {
+	taskResp, err := t.apiClient.Get(ctx, fmt.Sprintf("/api/v1/tasks/%s", url.PathEscape(taskID)))
+	if err != nil {
+		return nil, err
+	}
+
+	var task Task
+	if err := json.Unmarshal(taskResp, &task); err != nil {
+		return nil, fmt.Errorf("failed to parse task %s: %w", taskID, err)
+	}
+	return &task, nil
+}
+
+// lookupBlockers resolves the tasks recorded in task.BlockedBy. Blockers that
+// cannot be fetched are returned by ID in missing rather than failing the lookup.
+func (t *TaskTools) lookupBlockers(ctx context.Context, task Task) (blockers []Task, missing []string) {
+	for _, blockerID := range task.BlockedBy {
+		if blockerID == "" || blockerID == task.TaskID {
+			continue
+		}
+		blocker, err := t.fetchTask(ctx, blockerID)
+		if err != nil {
+			slog.Warn("Failed to look up blocking task", "error", err, "task_id", task.TaskID, "blocker_id", blockerID)
+			missing = append(missing, blockerID)
+			continue
+		}
+		blockers = append(blockers, *blocker)
+	}
+	return blockers, missing
+}
+
+// dependencyEdge records that Task is blocked by Blocker
+type dependencyEdge struct {
+	Task    Task
+	Blocker Task
+}
+
+// buildDependencyEdges resolves blocked_by references within tasks into edges.
+// References to tasks outside the set are skipped.
+func buildDependencyEdges(tasks []Task) []dependencyEdge {
+	tasksByID := make(map[string]Task, len(tasks))
+	for _, task := range tasks {
+		tasksByID[task.TaskID] = task
+	}
+
+	edges := []dependencyEdge{}
+	for _, task := range tasks {
+		for _, blockerID := range task.BlockedBy {
+			blocker, ok := tasksByID[blockerID]
+			if !ok || blockerID == task.TaskID {
+				continue
+			}
+			edges = append(edges, dependencyEdge{Task: task, Blocker: blocker})
+		}
+	}
+	return edges
+}
+
+// taskProjectID returns the task's project ID, or "" when it has none
+func taskProjectID(task Task) string {
+	if task.ProjectID == nil {
+		return ""
+	}
+	return *task.ProjectID
+}
+
+// dependencyNode is one task in a dependency tree. Satisfied reports whether
+// the edge to its parent is resolved, i.e. the blocking side is Complete.
+type dependencyNode struct {
+	TaskID    string           `json:"task_id"`
+	TaskName  string           `json:"task_name"`
+	Status    string           `json:"status"`
+	Satisfied bool             `json:"satisfied"`
+	Cycle     bool             `json:"cycle,omitempty"`
+	Children  []dependencyNode `json:"children,omitempty"`
+}
+
+// dependencyGraph indexes dependency edges in both directions
+type dependencyGraph struct {
+	tasks      map[string]Task
+	blockers   map[string][]Task // task ID -> tasks blocking it
+	dependents map[string][]Task // task ID -> tasks it blocks
+}
+
+// newDependencyGraph builds a graph from the edges within tasks
+func newDependencyGraph(tasks []Task) *dependencyGraph {
+	g := &dependencyGraph{
+		tasks:      make(map[string]Task, len(tasks)),
+		blockers:   make(map[string][]Task),
+		dependents: make(map[string][]Task),
+	}
+	for _, task := range tasks {
+		g.tasks[task.TaskID] = task
+	}
+	for _, edge := range buildDependencyEdges(tasks) {
+		g.blockers[edge.Task.TaskID] = append(g.blockers[edge.Task.TaskID], edge.Blocker)
+		g.dependents[edge.Blocker.TaskID] = append(g.dependents[edge.Blocker.TaskID], edge.Task)
+	}
+	return g
+}
+
+// upstream returns the blockers of taskID as trees up to depth levels deep.
+// Tasks already on the current path are marked as cycles and not expanded.
+func (g *dependencyGraph) upstream(taskID string, depth int) (nodes []dependencyNode, cycles []string) {
+	return g.walk(taskID, depth, g.blockers, true, map[string]bool{taskID: true})
+}
+
+// downstream returns the dependents of taskID as trees up to depth levels deep
+func (g *dependencyGraph) downstream(taskID string, depth int) (nodes []dependencyNode, cycles []string) {
+	return g.walk(taskID, depth, g.dependents, false, map[string]bool{taskID: true})
+}
+
+func (g *dependencyGraph) walk(taskID string, depth int, next map[string][]Task, upstream bool, onPath map[string]bool) ([]dependencyNode, []string) {
+	if depth <= 0 {
+		return nil, nil
+	}
+
+	parent := g.tasks[taskID]
+	nodes := []dependencyNode{}
+	var cycles []string
+	for _, task := range next[taskID] {
+		node := dependencyNode{
+			TaskID:   task.TaskID,
+			TaskName: task.TaskName,
+			Status:   task.Status,
+		}
+		if upstream {
+			node.Satisfied = task.Status == "Complete"
+		} else {
+			node.Satisfied = parent.Status == "Complete"
+		}
+
+		if onPath[task.TaskID] {
+			node.Cycle = true
+			cycles = append(cycles, fmt.Sprintf("%s -> %s", taskID, task.TaskID))
+			nodes = append(nodes, node)
+			continue
+		}
+
+		onPath[task.TaskID] = true
+		children, childCycles := g.walk(task.TaskID, depth-1, next, upstream, onPath)
+		delete(onPath, task.TaskID)
+
+		node.Children = children
+		cycles = append(cycles, childCycles...)
+		nodes = append(nodes, node)
+	}
+	return nodes, cycles
+}
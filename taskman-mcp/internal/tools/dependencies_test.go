@@ -0,0 +1,43 @@
+package tools
+
+import (
+	"testing"
+)
+
+func TestBuildDependencyEdges(t *testing.T) {
+	tasks := []Task{
+		{TaskID: "a", BlockedBy: []string{"b", "missing", "a"}},
+		{TaskID: "b", BlockedBy: []string{"c"}},
+		{TaskID: "c"},
+	}
+
+	edges := buildDependencyEdges(tasks)
+	if len(edges) != 2 {
+		t.Fatalf("Expected 2 edges, got %d", len(edges))
+	}
+	if edges[0].Task.TaskID != "a" || edges[0].Blocker.TaskID != "b" {
+		t.Errorf("Expected a blocked by b, got %s blocked by %s", edges[0].Task.TaskID, edges[0].Blocker.TaskID)
+	}
+	if edges[1].Task.TaskID != "b" || edges[1].Blocker.TaskID != "c" {
+		t.Errorf("Expected b blocked by c, got %s blocked by %s", edges[1].Task.TaskID, edges[1].Blocker.TaskID)
+	}
+}
+
+func TestDependencyGraph_DetectsCycles(t *testing.T) {
+	graph := newDependencyGraph([]Task{
+		{TaskID: "x", BlockedBy: []string{"y"}},
+		{TaskID: "y", BlockedBy: []string{"z"}},
+		{TaskID: "z", BlockedBy: []string{"x"}},
+	})
+
+	nodes, cycles := graph.upstream("x", 10)
+	if len(cycles) != 1 || cycles[0] != "z -> x" {
+		t.Fatalf("Expected cycle z -> x, got %v", cycles)
+	}
+
+	// x <- y <- z <- x(cycle)
+	z := nodes[0].Children[0]
+	if z.TaskID != "z" || len(z.Children) != 1 || !z.Children[0].Cycle {
+		t.Errorf("Expected z's child to be marked as a cycle, got %+v", z)
+	}
+}
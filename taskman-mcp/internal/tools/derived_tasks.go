@@ -0,0 +1,32 @@
+package tools
+
+import (
+	"fmt"
+)
+
+// derivedTaskRequest builds a creation request for a task derived from source
+// (a split piece, clone or subtask). The new task starts fresh but inherits the
+// source's project, priority, assignee and due date.
+func derivedTaskRequest(source Task, name, createdBy string) map[string]interface{} {
+	taskRequest := map[string]interface{}{
+		"task_name":        name,
+		"task_description": fmt.Sprintf("Derived from \"%s\" (%s)", source.TaskName, source.TaskID),
+		"status":           "Not Started",
+		"created_by":       createdBy,
+	}
+
+	if source.ProjectID != nil && *source.ProjectID != "" {
+		taskRequest["project_id"] = *source.ProjectID
+	}
+	if source.Priority != nil && *source.Priority != "" {
+		taskRequest["priority"] = *source.Priority
+	}
+	if source.AssignedTo != nil && *source.AssignedTo != "" {
+		taskRequest["assigned_to"] = *source.AssignedTo
+	}
+	if source.DueDate != nil && *source.DueDate != "" {
+		taskRequest["due_date"] = *source.DueDate
+	}
+
+	return taskRequest
+}
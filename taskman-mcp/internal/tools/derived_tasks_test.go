@@ -0,0 +1,42 @@
+package tools
+
+import (
+	"testing"
+)
+
+func TestDerivedTaskRequest(t *testing.T) {
+	source := Task{
+		TaskID:     "task-1",
+		TaskName:   "Big task",
+		Status:     "In Progress",
+		Priority:   stringPtr("High"),
+		AssignedTo: stringPtr("alice"),
+		ProjectID:  stringPtr("proj-1"),
+	}
+
+	request := derivedTaskRequest(source, "Piece one", "bob")
+
+	expected := map[string]interface{}{
+		"task_name":   "Piece one",
+		"status":      "Not Started",
+		"created_by":  "bob",
+		"project_id":  "proj-1",
+		"priority":    "High",
+		"assigned_to": "alice",
+	}
+	for key, value := range expected {
+		if request[key] != value {
+			t.Errorf("Expected %s=%v, got %v", key, value, request[key])
+		}
+	}
+	if _, ok := request["due_date"]; ok {
+		t.Error("Expected no due_date when source has none")
+	}
+
+	bare := derivedTaskRequest(Task{TaskID: "task-2", TaskName: "Bare"}, "Child", "bob")
+	for _, key := range []string{"project_id", "priority", "assigned_to"} {
+		if _, ok := bare[key]; ok {
+			t.Errorf("Expected %s to be omitted for a bare source", key)
+		}
+	}
+}
@@ -0,0 +1,31 @@
+package tools
+
+import (
+	"strings"
+)
+
+// displayTruncatedNote is appended when rendered text exceeds its byte cap
+const displayTruncatedNote = "\n... results truncated in display (full results are in the metadata)\n"
+
+// truncateDisplayText caps text at maxBytes, cutting at the last complete line
+// that leaves room for the truncation note. A non-positive maxBytes disables the cap.
+func truncateDisplayText(text string, maxBytes int) (string, bool) {
+	if maxBytes <= 0 || len(text) <= maxBytes {
+		return text, false
+	}
+
+	budget := maxBytes - len(displayTruncatedNote)
+	if budget <= 0 {
+		note := strings.TrimPrefix(displayTruncatedNote, "\n")
+		if len(note) > maxBytes {
+			note = note[:maxBytes]
+		}
+		return note, true
+	}
+
+	cut := strings.LastIndex(text[:budget], "\n")
+	if cut < 0 {
+		cut = 0
+	}
+	return text[:cut] + displayTruncatedNote, true
+}
@@ -0,0 +1,40 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTruncateDisplayText(t *testing.T) {
+	text := strings.Repeat("- a task line\n", 50)
+
+	unchanged, truncated := truncateDisplayText(text, 0)
+	if truncated || unchanged != text {
+		t.Error("Expected a zero cap to disable truncation")
+	}
+
+	unchanged, truncated = truncateDisplayText(text, len(text))
+	if truncated || unchanged != text {
+		t.Error("Expected text at the cap to be unchanged")
+	}
+
+	capped, truncated := truncateDisplayText(text, 200)
+	if !truncated {
+		t.Fatal("Expected text to be truncated")
+	}
+	if len(capped) > 200 {
+		t.Errorf("Expected at most 200 bytes, got %d", len(capped))
+	}
+	if !strings.HasSuffix(capped, displayTruncatedNote) {
+		t.Errorf("Expected truncation note, got %q", capped)
+	}
+	body := strings.TrimSuffix(capped, displayTruncatedNote)
+	if !strings.HasSuffix(body, "- a task line") {
+		t.Errorf("Expected truncation on a line boundary, got %q", body)
+	}
+
+	tiny, truncated := truncateDisplayText(text, 10)
+	if !truncated || len(tiny) > 10 {
+		t.Errorf("Expected tiny cap to be honoured, got %q", tiny)
+	}
+}
@@ -0,0 +1,58 @@
+package tools
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/bchamber/taskman-mcp/internal/calendar"
+)
+
+// defaultDueSoonDays is the get_tasks_due_soon horizon when none is given
+const defaultDueSoonDays = 7
+
+// dueDayOffset returns how many calendar days after now's date a task is
+// effectively due, in now's location: 0 for today, 1 for tomorrow, negative
+// when the date has passed. A due date on a non-working day counts from the
+// working day before it (see calendar.EffectiveDue).
+func dueDayOffset(task Task, now time.Time, cal *calendar.Calendar) (int, bool) {
+	if task.DueDate == nil {
+		return 0, false
+	}
+	due, err := parseDueDate(*task.DueDate)
+	if err != nil || due == nil {
+		return 0, false
+	}
+	dueLocal := due.In(now.Location())
+	dueDay := cal.EffectiveDue(time.Date(dueLocal.Year(), dueLocal.Month(), dueLocal.Day(), 0, 0, 0, 0, now.Location()))
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	// Rounded so a daylight saving change does not shift the count
+	return int(math.Round(dueDay.Sub(today).Hours() / 24)), true
+}
+
+// tasksDueSoon returns the open, unarchived tasks effectively due from today
+// through days working days from now with the calendar days until each is
+// due, soonest first; ties go to the higher priority. Every day is a working
+// day unless cal counts business days only. A task due earlier today still
+// counts as due today rather than overdue.
+func tasksDueSoon(tasks []Task, now time.Time, days int, cal *calendar.Calendar) []digestEntry {
+	dueSoon := []digestEntry{}
+	for _, task := range tasks {
+		if task.Status == "Complete" || task.Archived {
+			continue
+		}
+		offset, ok := dueDayOffset(task, now, cal)
+		if !ok || offset < 0 || cal.WorkingDaysBetween(now, now.AddDate(0, 0, offset)) > days {
+			continue
+		}
+		dueSoon = append(dueSoon, digestEntry{Task: task, Days: offset})
+	}
+
+	sort.SliceStable(dueSoon, func(i, j int) bool {
+		if c := compareDueDates(dueSoon[i].Task, dueSoon[j].Task); c != 0 {
+			return c < 0
+		}
+		return priorityRank(dueSoon[i].Task.Priority) < priorityRank(dueSoon[j].Task.Priority)
+	})
+	return dueSoon
+}
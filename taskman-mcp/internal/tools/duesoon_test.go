@@ -0,0 +1,97 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bchamber/taskman-mcp/internal/calendar"
+)
+
+func TestTasksDueSoon_DayBoundaries(t *testing.T) {
+	now := time.Date(2024, 6, 12, 22, 0, 0, 0, time.UTC)
+	tasks := []Task{
+		{TaskID: "next-week", Status: "Not Started", DueDate: stringPtr("2024-06-19")},
+		{TaskID: "past-horizon", Status: "Not Started", DueDate: stringPtr("2024-06-20T00:00:00Z")},
+		{TaskID: "midnight", Status: "In Progress", DueDate: stringPtr("2024-06-13T00:00:00Z")},
+		{TaskID: "earlier-today", Status: "In Progress", DueDate: stringPtr("2024-06-12T08:00:00Z")},
+		{TaskID: "end-of-today", Status: "Blocked", DueDate: stringPtr("2024-06-12T23:59:59Z")},
+		{TaskID: "yesterday", Status: "In Progress", DueDate: stringPtr("2024-06-11T23:59:59Z")},
+		{TaskID: "tomorrow-date", Status: "Review", Priority: stringPtr("High"), DueDate: stringPtr("2024-06-13")},
+		{TaskID: "six-days", Status: "Not Started", DueDate: stringPtr("2024-06-18T12:00:00Z")},
+		{TaskID: "done", Status: "Complete", DueDate: stringPtr("2024-06-13")},
+		{TaskID: "archived", Status: "Not Started", DueDate: stringPtr("2024-06-13"), Archived: true},
+		{TaskID: "undated", Status: "Not Started"},
+	}
+
+	dueSoon := tasksDueSoon(tasks, now, 7, calendar.New(false, nil))
+
+	var got []string
+	for _, entry := range dueSoon {
+		got = append(got, fmt.Sprintf("%s:%d", entry.Task.TaskID, entry.Days))
+	}
+	expected := "earlier-today:0,end-of-today:0,tomorrow-date:1,midnight:1,six-days:6,next-week:7"
+	if strings.Join(got, ",") != expected {
+		t.Errorf("Expected %s, got %s", expected, strings.Join(got, ","))
+	}
+
+	// A shorter horizon stops at the end of its last day
+	if dueSoon := tasksDueSoon(tasks, now, 1, calendar.New(false, nil)); len(dueSoon) != 4 {
+		t.Errorf("Expected 4 tasks due today or tomorrow, got %d", len(dueSoon))
+	}
+}
+
+func TestDueDayOffset_UsesClockLocation(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("time zone data unavailable: %v", err)
+	}
+	// 21:00 on June 12 in New York is already June 13 in UTC
+	now := time.Date(2024, 6, 12, 21, 0, 0, 0, newYork)
+
+	cases := map[string]int{
+		"2024-06-13T02:00:00Z": 0, // 22:00 on June 12 in New York
+		"2024-06-13T04:00:00Z": 1, // midnight on June 13 in New York
+		"2024-06-12T03:00:00Z": -1,
+	}
+	for due, expected := range cases {
+		offset, ok := dueDayOffset(Task{DueDate: stringPtr(due)}, now, calendar.New(false, nil))
+		if !ok || offset != expected {
+			t.Errorf("Due %s: expected offset %d, got %d (ok %v)", due, expected, offset, ok)
+		}
+	}
+}
+
+func TestTasksDueSoon_BusinessDays(t *testing.T) {
+	// Wednesday; Thursday June 13 is a holiday
+	now := time.Date(2024, 6, 12, 9, 0, 0, 0, time.UTC)
+	cal := calendar.New(true, []string{"2024-06-13"})
+	tasks := []Task{
+		{TaskID: "holiday", Status: "Not Started", DueDate: stringPtr("2024-06-13")},
+		{TaskID: "friday", Status: "Not Started", DueDate: stringPtr("2024-06-14")},
+		{TaskID: "saturday", Status: "Not Started", DueDate: stringPtr("2024-06-15")},
+		{TaskID: "monday", Status: "Not Started", DueDate: stringPtr("2024-06-17")},
+		{TaskID: "tuesday", Status: "Not Started", DueDate: stringPtr("2024-06-18")},
+	}
+
+	// Two working days reach Monday, skipping the holiday and the weekend.
+	// Work due on the holiday or the weekend is due the working day before.
+	var got []string
+	for _, entry := range tasksDueSoon(tasks, now, 2, cal) {
+		got = append(got, fmt.Sprintf("%s:%d", entry.Task.TaskID, entry.Days))
+	}
+	expected := "holiday:0,friday:2,saturday:2,monday:5"
+	if strings.Join(got, ",") != expected {
+		t.Errorf("Expected %s, got %s", expected, strings.Join(got, ","))
+	}
+
+	// Without business days the same horizon is two calendar days
+	got = nil
+	for _, entry := range tasksDueSoon(tasks, now, 2, calendar.New(false, []string{"2024-06-13"})) {
+		got = append(got, entry.Task.TaskID)
+	}
+	if strings.Join(got, ",") != "holiday,friday" {
+		t.Errorf("Expected holiday,friday without business days, got %v", got)
+	}
+}
@@ -0,0 +1,89 @@
+package tools
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bchamber/taskman-mcp/internal/config"
+)
+
+// noPriorityTier is the escalation tier for tasks without a priority
+const noPriorityTier = "None"
+
+// escalationPolicy bumps open tasks one priority level once they have been
+// open longer than the age configured for their current priority
+type escalationPolicy struct {
+	ages        map[string]time.Duration // priority tier -> age that triggers a bump
+	maxPriority string                   // escalation never goes above this priority
+}
+
+// escalationPolicyFromConfig builds the policy from the escalation settings
+func escalationPolicyFromConfig(cfg *config.Config) escalationPolicy {
+	return escalationPolicy{ages: cfg.EscalationAges, maxPriority: cfg.EscalationMaxPriority}
+}
+
+// escalation is the policy's decision to bump one task
+type escalation struct {
+	from      string // noPriorityTier when the task had none
+	to        string
+	age       time.Duration
+	threshold time.Duration
+}
+
+// priorityLevel returns the position of a priority in validPriorities, lowest
+// first, or -1 for unset or unknown priorities
+func priorityLevel(priority string) int {
+	for i, p := range validPriorities {
+		if p == priority {
+			return i
+		}
+	}
+	return -1
+}
+
+// evaluateEscalation decides whether policy bumps task as of now. It has no
+// side effects. Tasks are aged from creation and only move up one level,
+// never past the policy's cap.
+func evaluateEscalation(task Task, policy escalationPolicy, now time.Time) (escalation, bool) {
+	if task.Status == "Complete" || task.Archived {
+		return escalation{}, false
+	}
+
+	from := noPriorityTier
+	if task.Priority != nil && *task.Priority != "" {
+		from = *task.Priority
+	}
+	threshold, ok := policy.ages[from]
+	if !ok || threshold <= 0 {
+		return escalation{}, false
+	}
+
+	level := priorityLevel(from)
+	if from != noPriorityTier && level < 0 {
+		return escalation{}, false
+	}
+	maxLevel := priorityLevel(policy.maxPriority)
+	if maxLevel < 0 {
+		maxLevel = len(validPriorities) - 1
+	}
+	if level+1 > maxLevel {
+		return escalation{}, false
+	}
+
+	created, err := parseDueDate(task.CreationDate)
+	if err != nil || created == nil {
+		return escalation{}, false
+	}
+	age := now.Sub(*created)
+	if age < threshold {
+		return escalation{}, false
+	}
+
+	return escalation{from: from, to: validPriorities[level+1], age: age, threshold: threshold}, true
+}
+
+// escalationAuditNote is recorded on a task when the policy bumps its priority
+func escalationAuditNote(e escalation) string {
+	return fmt.Sprintf("⬆️ Priority escalated from %s to %s by policy: open %d days (threshold for %s is %d days)",
+		e.from, e.to, wholeDays(e.age), e.from, wholeDays(e.threshold))
+}
@@ -0,0 +1,49 @@
+package tools
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEvaluateEscalation(t *testing.T) {
+	now := time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)
+	policy := escalationPolicy{
+		ages: map[string]time.Duration{
+			"None":   7 * 24 * time.Hour,
+			"Low":    14 * 24 * time.Hour,
+			"Medium": 28 * 24 * time.Hour,
+			"High":   time.Hour,
+		},
+		maxPriority: "High",
+	}
+
+	tests := []struct {
+		name string
+		task Task
+		from string
+		to   string
+	}{
+		{"old low", Task{Status: "Not Started", Priority: stringPtr("Low"), CreationDate: "2024-06-01T12:00:00Z"}, "Low", "Medium"},
+		{"recent low", Task{Status: "Not Started", Priority: stringPtr("Low"), CreationDate: "2024-06-25T12:00:00Z"}, "", ""},
+		{"old unprioritized", Task{Status: "In Progress", CreationDate: "2024-06-20T12:00:00Z"}, "None", "Low"},
+		{"old medium", Task{Status: "Blocked", Priority: stringPtr("Medium"), CreationDate: "2024-05-01T12:00:00Z"}, "Medium", "High"},
+		{"high is the ceiling", Task{Status: "In Progress", Priority: stringPtr("High"), CreationDate: "2024-01-01T12:00:00Z"}, "", ""},
+		{"complete", Task{Status: "Complete", Priority: stringPtr("Low"), CreationDate: "2024-01-01T12:00:00Z"}, "", ""},
+		{"unparseable creation", Task{Status: "Not Started", Priority: stringPtr("Low"), CreationDate: "someday"}, "", ""},
+	}
+	for _, tt := range tests {
+		decision, ok := evaluateEscalation(tt.task, policy, now)
+		if ok != (tt.to != "") || decision.from != tt.from || decision.to != tt.to {
+			t.Errorf("%s: expected %q → %q, got %+v (%v)", tt.name, tt.from, tt.to, decision, ok)
+		}
+	}
+
+	// A lower cap stops escalation short of High
+	policy.maxPriority = "Medium"
+	if _, ok := evaluateEscalation(tests[3].task, policy, now); ok {
+		t.Error("Expected Medium cap to stop escalation to High")
+	}
+	if _, ok := evaluateEscalation(tests[0].task, policy, now); !ok {
+		t.Error("Expected Low to still escalate up to the Medium cap")
+	}
+}
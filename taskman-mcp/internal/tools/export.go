@@ -0,0 +1,56 @@
+package tools
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+)
+
+// Export formats accepted by export_tasks
+const (
+	exportCSV  = "csv"
+	exportJSON = "json"
+)
+
+// exportColumns lists the CSV columns written by export_tasks, in order
+var exportColumns = []string{
+	"task_id", "task_name", "task_description", "status", "priority", "assigned_to",
+	"project_id", "due_date", "start_date", "completion_date", "tags",
+	"created_by", "creation_date", "last_update_date",
+}
+
+// exportRow returns a task's CSV fields in exportColumns order. Unset fields
+// are empty and tags are joined with semicolons.
+func exportRow(task Task) []string {
+	value := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	return []string{
+		task.TaskID, task.TaskName, value(task.TaskDescription), task.Status, value(task.Priority), value(task.AssignedTo),
+		value(task.ProjectID), value(task.DueDate), value(task.StartDate), value(task.CompletionDate), strings.Join(task.Tags, ";"),
+		task.CreatedBy, task.CreationDate, value(task.LastUpdateDate),
+	}
+}
+
+// tasksCSV renders tasks as CSV with a header row. Fields containing commas,
+// quotes or newlines are quoted as RFC 4180 requires.
+func tasksCSV(tasks []Task) (string, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if err := writer.Write(exportColumns); err != nil {
+		return "", err
+	}
+	for _, task := range tasks {
+		if err := writer.Write(exportRow(task)); err != nil {
+			return "", err
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
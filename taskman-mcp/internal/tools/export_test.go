@@ -0,0 +1,45 @@
+package tools
+
+import (
+	"encoding/csv"
+	"strings"
+	"testing"
+)
+
+func TestTasksCSV_EscapesFields(t *testing.T) {
+	description := "Fix login, then \"verify\" it\nacross browsers"
+	tasks := []Task{
+		{TaskID: "t1", TaskName: "Login, again", TaskDescription: &description, Status: "In Progress", Tags: []string{"auth", "web"}},
+		{TaskID: "t2", TaskName: "Plain", Status: "Not Started"},
+	}
+
+	out, err := tasksCSV(tasks)
+	if err != nil {
+		t.Fatalf("tasksCSV failed: %v", err)
+	}
+
+	if !strings.Contains(out, `"Fix login, then ""verify"" it`+"\n"+`across browsers"`) {
+		t.Errorf("Expected the description quoted with doubled quotes, got %q", out)
+	}
+	if !strings.Contains(out, `"Login, again"`) {
+		t.Errorf("Expected the task name quoted, got %q", out)
+	}
+
+	// The output round-trips through a CSV reader
+	records, err := csv.NewReader(strings.NewReader(out)).ReadAll()
+	if err != nil {
+		t.Fatalf("Failed to read CSV back: %v", err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("Expected a header and 2 rows, got %d records", len(records))
+	}
+	if strings.Join(records[0], ",") != strings.Join(exportColumns, ",") {
+		t.Errorf("Unexpected header: %v", records[0])
+	}
+	if records[1][2] != description || records[1][1] != "Login, again" || records[1][10] != "auth;web" {
+		t.Errorf("Unexpected first row: %q", records[1])
+	}
+	if records[2][2] != "" || records[2][4] != "" {
+		t.Errorf("Expected unset fields to be empty, got %q", records[2])
+	}
+}
@@ -0,0 +1,176 @@
+package tools
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/bchamber/taskman-mcp/internal/config"
+)
+
+// importRecord is one task in an import_tasks batch. CSV batches use the
+// same column names; other columns, such as those only export_tasks writes,
+// are ignored so an export can be imported again.
+type importRecord struct {
+	TaskName        string   `json:"task_name"`
+	TaskDescription string   `json:"task_description,omitempty"`
+	Status          string   `json:"status,omitempty"`
+	Priority        string   `json:"priority,omitempty"`
+	AssignedTo      string   `json:"assigned_to,omitempty"`
+	ProjectID       string   `json:"project_id,omitempty"`
+	DueDate         string   `json:"due_date,omitempty"`
+	Tags            []string `json:"tags,omitempty"`
+
+	invalid string // why the row could not be read, for CSV rows of the wrong width
+}
+
+// importRowError is a batch row that was not created
+type importRowError struct {
+	Row      int    `json:"row"` // 1-based, not counting a CSV header
+	TaskName string `json:"task_name,omitempty"`
+	Error    string `json:"error"`
+}
+
+// parseImportBatch parses data in format into records. A batch that cannot
+// be parsed as a whole is an error; problems with individual rows are left
+// to validateImportRecord.
+func parseImportBatch(format, data string) ([]importRecord, error) {
+	if strings.TrimSpace(data) == "" {
+		return nil, fmt.Errorf("data is required")
+	}
+	switch format {
+	case exportJSON:
+		var records []importRecord
+		if err := json.Unmarshal([]byte(data), &records); err != nil {
+			return nil, fmt.Errorf("malformed JSON batch, expected an array of task objects: %w", err)
+		}
+		return records, nil
+	case exportCSV:
+		return parseImportCSV(data)
+	}
+	return nil, fmt.Errorf("unsupported format '%s'", format)
+}
+
+// parseImportCSV reads a CSV batch with a header row naming its columns; the
+// task_name column is required. Tags are separated by semicolons. Broken
+// quoting rejects the batch, while a row with the wrong number of fields is
+// only a failed row.
+func parseImportCSV(data string) ([]importRecord, error) {
+	reader := csv.NewReader(strings.NewReader(data))
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("malformed CSV batch: %w", err)
+	}
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := columns["task_name"]; !ok {
+		return nil, fmt.Errorf("malformed CSV batch: header has no task_name column")
+	}
+
+	var records []importRecord
+	for {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("malformed CSV batch: %w", err)
+		}
+		if len(row) != len(header) {
+			records = append(records, importRecord{
+				invalid: fmt.Sprintf("row has %d fields, the header has %d", len(row), len(header)),
+			})
+			continue
+		}
+		field := func(name string) string {
+			if i, ok := columns[name]; ok {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		record := importRecord{
+			TaskName:        field("task_name"),
+			TaskDescription: field("task_description"),
+			Status:          field("status"),
+			Priority:        field("priority"),
+			AssignedTo:      field("assigned_to"),
+			ProjectID:       field("project_id"),
+			DueDate:         field("due_date"),
+		}
+		if tags := field("tags"); tags != "" {
+			record.Tags = strings.Split(tags, ";")
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+// importPayload converts a row to a task payload, filing it under projectID
+// when the row names no project of its own
+func importPayload(record importRecord, projectID, createdBy string) map[string]any {
+	payload := map[string]any{
+		"task_name":  strings.TrimSpace(record.TaskName),
+		"created_by": createdBy,
+	}
+	if record.ProjectID != "" {
+		projectID = record.ProjectID
+	}
+	for field, value := range map[string]string{
+		"task_description": record.TaskDescription,
+		"status":           record.Status,
+		"priority":         record.Priority,
+		"assigned_to":      record.AssignedTo,
+		"project_id":       projectID,
+		"due_date":         record.DueDate,
+	} {
+		if value != "" {
+			payload[field] = value
+		}
+	}
+	if len(record.Tags) > 0 {
+		tags := make([]any, len(record.Tags))
+		for i, tag := range record.Tags {
+			tags[i] = tag
+		}
+		payload["tags"] = tags
+	}
+	return payload
+}
+
+// validateImportRecord checks a row's payload with the same rules as
+// validate_task_payload and builds its task creation request
+func validateImportRecord(cfg *config.Config, record importRecord, payload map[string]any, now time.Time) (map[string]any, error) {
+	if record.invalid != "" {
+		return nil, fmt.Errorf("%s", record.invalid)
+	}
+	if errs, _ := validateTaskPayload(cfg, payload, taskReferences{}, now); len(errs) > 0 {
+		messages := make([]string, len(errs))
+		for i, issue := range errs {
+			messages[i] = fmt.Sprintf("%s: %s", issue["field"], issue["message"])
+		}
+		return nil, fmt.Errorf("%s", strings.Join(messages, "; "))
+	}
+
+	taskRequest := map[string]any{
+		"status": "Not Started",
+		"source": sourceImport,
+	}
+	for field, value := range payload {
+		taskRequest[field] = value
+	}
+	if dueDate, _ := payload["due_date"].(string); dueDate != "" {
+		parsed, _ := parseDueDate(dueDate)
+		taskRequest["due_date"] = parsed.Format(time.RFC3339)
+	}
+	delete(taskRequest, "tags")
+	if tags, _ := mergeTags(nil, record.Tags, nil); len(tags) > 0 {
+		taskRequest["tags"] = tags
+	}
+	return taskRequest, nil
+}
@@ -0,0 +1,100 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bchamber/taskman-mcp/internal/config"
+)
+
+func TestParseImportBatch(t *testing.T) {
+	csvData := "task_name,priority,tags,task_id\n" +
+		"\"Write docs, again\",High,docs;web,ignored\n" +
+		"Too wide,Low,,x,extra\n"
+	records, err := parseImportBatch("csv", csvData)
+	if err != nil {
+		t.Fatalf("parseImportBatch failed: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 records, got %+v", records)
+	}
+	if records[0].TaskName != "Write docs, again" || records[0].Priority != "High" || strings.Join(records[0].Tags, ",") != "docs,web" {
+		t.Errorf("Unexpected first record: %+v", records[0])
+	}
+	if _, err := validateImportRecord(config.Default(), records[1], importPayload(records[1], "", "alice"), time.Now()); err == nil || !strings.Contains(err.Error(), "5 fields") {
+		t.Errorf("Expected the wide row to fail on its own, got %v", err)
+	}
+
+	jsonData := `[{"task_name": "From JSON", "status": "In Progress", "tags": ["a"]}, {"task_description": "no name"}]`
+	records, err = parseImportBatch("json", jsonData)
+	if err != nil {
+		t.Fatalf("parseImportBatch failed: %v", err)
+	}
+	if len(records) != 2 || records[0].TaskName != "From JSON" || records[0].Status != "In Progress" {
+		t.Errorf("Unexpected JSON records: %+v", records)
+	}
+
+	malformed := []struct {
+		name, format, data string
+	}{
+		{"unterminated quote", "csv", "task_name,priority\n\"Broken,High\n"},
+		{"no task_name column", "csv", "name,priority\nTask,High\n"},
+		{"not an array", "json", `{"task_name": "Single"}`},
+		{"truncated JSON", "json", `[{"task_name": "A"}`},
+		{"empty", "csv", "  "},
+	}
+	for _, tc := range malformed {
+		if _, err := parseImportBatch(tc.format, tc.data); err == nil {
+			t.Errorf("%s: expected the batch to be rejected", tc.name)
+		}
+	}
+}
+
+func TestValidateImportRecord(t *testing.T) {
+	cfg := config.Default()
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	validate := func(record importRecord, projectID string) (map[string]any, error) {
+		return validateImportRecord(cfg, record, importPayload(record, projectID, "alice"), now)
+	}
+
+	request, err := validate(importRecord{TaskName: " Task ", DueDate: "2024-06-30", Tags: []string{"a", "A", " "}}, "proj-1")
+	if err != nil {
+		t.Fatalf("validateImportRecord failed: %v", err)
+	}
+	if request["task_name"] != "Task" || request["project_id"] != "proj-1" || request["source"] != sourceImport || request["status"] != "Not Started" {
+		t.Errorf("Unexpected request: %+v", request)
+	}
+	if request["due_date"] != "2024-06-30T00:00:00Z" {
+		t.Errorf("Expected a normalized due date, got %v", request["due_date"])
+	}
+	if tags := request["tags"].([]string); len(tags) != 1 || tags[0] != "a" {
+		t.Errorf("Expected duplicate and blank tags dropped, got %v", tags)
+	}
+
+	request, _ = validate(importRecord{TaskName: "Own project", ProjectID: "proj-2"}, "proj-1")
+	if request["project_id"] != "proj-2" {
+		t.Errorf("Expected the row's project to win, got %v", request["project_id"])
+	}
+
+	// A past due date is only a validate_task_payload warning
+	if _, err := validate(importRecord{TaskName: "Late", DueDate: "2024-01-01"}, ""); err != nil {
+		t.Errorf("Expected a past due date to be accepted, got %v", err)
+	}
+
+	for _, record := range []importRecord{
+		{TaskName: "  "},
+		{TaskName: "Bad status", Status: "Done"},
+		{TaskName: "Bad priority", Priority: "Urgent"},
+		{TaskName: "Bad date", DueDate: "next week"},
+	} {
+		if _, err := validate(record, ""); err == nil {
+			t.Errorf("Expected %+v to be rejected", record)
+		}
+	}
+
+	_, err = validate(importRecord{TaskName: "Both bad", Status: "Done", Priority: "Urgent"}, "")
+	if err == nil || !strings.Contains(err.Error(), "status: ") || !strings.Contains(err.Error(), "priority: ") {
+		t.Errorf("Expected every field error reported, got %v", err)
+	}
+}
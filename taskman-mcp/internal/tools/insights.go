@@ -0,0 +1,88 @@
+package tools
+
+import "github.com/bchamber/taskman-mcp/internal/config"
+
+// Stable insight keys, used by the SuppressedInsights config to turn
+// individual insights off without touching their wording
+const (
+	// get_task_overview
+	insightOverviewProjectsUnavailable = "overview.projects_unavailable"
+	insightOverviewOverdue             = "overview.overdue"
+	insightOverviewMostlyNotStarted    = "overview.mostly_not_started"
+	insightOverviewManyInProgress      = "overview.many_in_progress"
+	insightOverviewHighActivity        = "overview.high_activity"
+	insightOverviewDueDateCluster      = "overview.due_date_cluster"
+
+	// get_project_status
+	insightProjectOverdue        = "project.overdue"
+	insightProjectNearlyComplete = "project.nearly_complete"
+	insightProjectFinalStretch   = "project.final_stretch"
+	insightProjectHalfway        = "project.halfway"
+	insightProjectEarlyStages    = "project.early_stages"
+	insightProjectHighActivity   = "project.high_activity"
+	insightProjectStartMore      = "project.start_more_tasks"
+	insightProjectDueDateCluster = "project.due_date_cluster"
+
+	// create_project_with_initial_tasks
+	insightNewProjectAllCreated  = "new_project.all_created"
+	insightNewProjectFailed      = "new_project.failed_tasks"
+	insightNewProjectManyTasks   = "new_project.many_tasks"
+	insightNewProjectTasksCapped = "new_project.tasks_capped"
+	insightNewProjectUnassigned  = "new_project.unassigned"
+	insightNewProjectAllAssigned = "new_project.all_assigned"
+	insightNewProjectMostlyHigh  = "new_project.mostly_high_priority"
+
+	// get_my_work
+	insightWorkCaughtUp     = "my_work.caught_up"
+	insightWorkLight        = "my_work.light"
+	insightWorkHeavy        = "my_work.heavy"
+	insightWorkModerate     = "my_work.moderate"
+	insightWorkOverdue      = "my_work.overdue"
+	insightWorkDueSoon      = "my_work.due_soon"
+	insightWorkMostlyHigh   = "my_work.mostly_high_priority"
+	insightWorkBlocked      = "my_work.blocked"
+	insightWorkManyProjects = "my_work.many_projects"
+
+	// get_task_details
+	insightDetailsOverdue      = "details.overdue"
+	insightDetailsIdle         = "details.idle"
+	insightDetailsNoNotes      = "details.no_progress_notes"
+	insightDetailsNoPriority   = "details.no_priority"
+	insightDetailsUnassigned   = "details.unassigned"
+	insightDetailsNoDueDate    = "details.no_due_date"
+	insightDetailsBlockedNotes = "details.blocked_check_notes"
+
+	// update_task_progress
+	insightProgressCompleted      = "progress.completed"
+	insightProgressCompletedEarly = "progress.completed_early"
+	insightProgressCompletedLate  = "progress.completed_late"
+	insightProgressBlocked        = "progress.blocked"
+	insightProgressStarted        = "progress.started"
+	insightProgressEscalated      = "progress.priority_escalated"
+
+	// search_tasks
+	insightSearchNoResults    = "search.no_results"
+	insightSearchSingleResult = "search.single_result"
+	insightSearchLargeResult  = "search.large_result_set"
+	insightSearchOverdue      = "search.overdue"
+	insightSearchSingleStatus = "search.single_status"
+	insightSearchMostlyHigh   = "search.mostly_high_priority"
+)
+
+// isInsightSuppressed reports whether key is listed in SuppressedInsights
+func isInsightSuppressed(cfg *config.Config, key string) bool {
+	for _, suppressed := range cfg.SuppressedInsights {
+		if suppressed == key {
+			return true
+		}
+	}
+	return false
+}
+
+// appendInsight adds message to insights unless its key is suppressed
+func appendInsight(cfg *config.Config, insights []string, key, message string) []string {
+	if isInsightSuppressed(cfg, key) {
+		return insights
+	}
+	return append(insights, message)
+}
@@ -0,0 +1,20 @@
+package tools
+
+import (
+	"testing"
+
+	"github.com/bchamber/taskman-mcp/internal/config"
+)
+
+func TestAppendInsight(t *testing.T) {
+	cfg := config.Default()
+	cfg.SuppressedInsights = []string{insightDetailsNoDueDate}
+
+	var insights []string
+	insights = appendInsight(cfg, insights, insightDetailsNoPriority, "priority")
+	insights = appendInsight(cfg, insights, insightDetailsNoDueDate, "due date")
+
+	if len(insights) != 1 || insights[0] != "priority" {
+		t.Errorf("Expected only the unsuppressed insight, got %v", insights)
+	}
+}
@@ -3,21 +3,33 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/bchamber/taskman-mcp/internal/client"
+	"github.com/bchamber/taskman-mcp/internal/clock"
+	"github.com/bchamber/taskman-mcp/internal/config"
+	"github.com/bchamber/taskman-mcp/internal/ids"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
-// Integration test server that mimics real API behavior
-func createIntegrationAPIServer() *httptest.Server {
+// Integration test server that mimics real API behavior. Extra tasks are
+// added to the default test data.
+func createIntegrationAPIServer(extraTasks ...Task) *httptest.Server {
 	// In-memory storage for testing
+	var mu sync.Mutex
 	tasks := make(map[string]Task)
 	projects := make(map[string]Project)
 	notes := make(map[string][]TaskNote)
+	taskIDs := ids.NewSequence("task-new")
+	noteIDs := ids.NewSequence("note-new")
 
 	// Initialize with some test data
 	tasks["task-1"] = Task{
@@ -41,6 +53,13 @@ func createIntegrationAPIServer() *httptest.Server {
 		CreationDate:       "2024-01-01T09:00:00Z",
 	}
 
+	projects["proj-2"] = Project{
+		ProjectID:    "proj-2",
+		ProjectName:  "Second Integration Project",
+		CreatedBy:    "admin",
+		CreationDate: "2024-01-02T09:00:00Z",
+	}
+
 	notes["task-1"] = []TaskNote{
 		{
 			NoteID:       "note-1",
@@ -51,7 +70,14 @@ func createIntegrationAPIServer() *httptest.Server {
 		},
 	}
 
+	for _, task := range extraTasks {
+		tasks[task.TaskID] = task
+	}
+
 	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
 		switch {
 		case r.Method == "GET" && r.URL.Path == "/api/v1/tasks":
 			// Return all tasks or filter by query params
@@ -75,6 +101,19 @@ func createIntegrationAPIServer() *httptest.Server {
 					result = append(result, task)
 				}
 			}
+
+			// Page in task ID order when asked to
+			if limit, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil {
+				sort.Slice(result, func(i, j int) bool { return result[i].TaskID < result[j].TaskID })
+				offset, _ := strconv.Atoi(r.URL.Query().Get("offset"))
+				if offset > len(result) {
+					offset = len(result)
+				}
+				result = result[offset:]
+				if limit < len(result) {
+					result = result[:limit]
+				}
+			}
 			json.NewEncoder(w).Encode(result)
 
 		case r.Method == "GET" && r.URL.Path == "/api/v1/projects":
@@ -88,7 +127,7 @@ func createIntegrationAPIServer() *httptest.Server {
 			var req map[string]interface{}
 			json.NewDecoder(r.Body).Decode(&req)
 
-			taskID := "task-new-" + time.Now().Format("20060102150405")
+			taskID := taskIDs.NewID()
 			task := Task{
 				TaskID:       taskID,
 				TaskName:     req["task_name"].(string),
@@ -128,6 +167,23 @@ func createIntegrationAPIServer() *httptest.Server {
 				http.NotFound(w, r)
 			}
 
+		case r.Method == "PUT" && strings.HasPrefix(r.URL.Path, "/api/v1/tasks/") && strings.Contains(r.URL.Path, "/notes/"):
+			parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/tasks/"), "/notes/")
+			taskID, noteID := parts[0], parts[1]
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			for i, note := range notes[taskID] {
+				if note.NoteID == noteID {
+					note.Note = req["note"].(string)
+					note.LastUpdatedBy = stringPtr(req["updated_by"].(string))
+					note.LastUpdateDate = stringPtr(time.Now().Format(time.RFC3339))
+					notes[taskID][i] = note
+					json.NewEncoder(w).Encode(note)
+					return
+				}
+			}
+			http.NotFound(w, r)
+
 		case r.Method == "PUT" && len(r.URL.Path) > 14 && r.URL.Path[:14] == "/api/v1/tasks/" && (len(r.URL.Path) < 6 || r.URL.Path[len(r.URL.Path)-6:] != "/notes"):
 			taskID := r.URL.Path[14:] // Extract task ID from path
 			if task, exists := tasks[taskID]; exists {
@@ -153,6 +209,22 @@ func createIntegrationAPIServer() *httptest.Server {
 				if startDate, ok := req["start_date"]; ok {
 					task.StartDate = stringPtr(startDate.(string))
 				}
+				if archived, ok := req["archived"].(bool); ok {
+					task.Archived = archived
+				}
+				if tags, ok := req["tags"].([]interface{}); ok {
+					task.Tags = []string{}
+					for _, tag := range tags {
+						task.Tags = append(task.Tags, tag.(string))
+					}
+				}
+				if links, ok := req["links"].([]interface{}); ok {
+					task.Links = []TaskLink{}
+					for _, raw := range links {
+						link := raw.(map[string]interface{})
+						task.Links = append(task.Links, TaskLink{Label: link["label"].(string), URL: link["url"].(string)})
+					}
+				}
 
 				task.LastUpdateDate = stringPtr(time.Now().Format(time.RFC3339))
 				tasks[taskID] = task
@@ -174,7 +246,7 @@ func createIntegrationAPIServer() *httptest.Server {
 			var req map[string]interface{}
 			json.NewDecoder(r.Body).Decode(&req)
 
-			noteID := "note-" + time.Now().Format("20060102150405")
+			noteID := noteIDs.NewID()
 			note := TaskNote{
 				NoteID:       noteID,
 				TaskID:       taskID,
@@ -182,6 +254,9 @@ func createIntegrationAPIServer() *httptest.Server {
 				CreatedBy:    req["created_by"].(string),
 				CreationDate: time.Now().Format(time.RFC3339),
 			}
+			if creationDate, ok := req["creation_date"].(string); ok {
+				note.CreationDate = creationDate
+			}
 
 			if taskNotes, exists := notes[taskID]; exists {
 				notes[taskID] = append(taskNotes, note)
@@ -190,6 +265,49 @@ func createIntegrationAPIServer() *httptest.Server {
 			}
 			json.NewEncoder(w).Encode(note)
 
+		case r.Method == "DELETE" && strings.HasPrefix(r.URL.Path, "/api/v1/tasks/") && strings.Contains(r.URL.Path, "/notes/"):
+			parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/v1/tasks/"), "/notes/")
+			taskID, noteID := parts[0], parts[1]
+			remaining := []TaskNote{}
+			found := false
+			for _, note := range notes[taskID] {
+				if note.NoteID == noteID {
+					found = true
+					continue
+				}
+				remaining = append(remaining, note)
+			}
+			if !found {
+				http.NotFound(w, r)
+				return
+			}
+			notes[taskID] = remaining
+			w.WriteHeader(http.StatusNoContent)
+
+		case r.Method == "DELETE" && strings.HasPrefix(r.URL.Path, "/api/v1/tasks/"):
+			taskID := strings.TrimPrefix(r.URL.Path, "/api/v1/tasks/")
+			if _, exists := tasks[taskID]; !exists {
+				http.NotFound(w, r)
+				return
+			}
+			delete(tasks, taskID)
+			delete(notes, taskID)
+			w.WriteHeader(http.StatusNoContent)
+
+		case r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/api/v1/projects/") && strings.HasSuffix(r.URL.Path, "/tasks"):
+			projectID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v1/projects/"), "/tasks")
+			if _, exists := projects[projectID]; !exists {
+				http.NotFound(w, r)
+				return
+			}
+			result := []Task{}
+			for _, task := range tasks {
+				if task.ProjectID != nil && *task.ProjectID == projectID {
+					result = append(result, task)
+				}
+			}
+			json.NewEncoder(w).Encode(result)
+
 		case r.Method == "GET" && len(r.URL.Path) > 17 && r.URL.Path[:17] == "/api/v1/projects/":
 			projectID := r.URL.Path[17:] // Extract project ID from path
 			if project, exists := projects[projectID]; exists {
@@ -209,7 +327,7 @@ func TestTaskTools_IntegrationWorkflow(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskTools := NewTaskTools(apiClient)
+	taskTools := NewTaskTools(apiClient, config.Default())
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
 
@@ -404,7 +522,7 @@ func TestTaskTools_IntegrationErrorHandling(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskTools := NewTaskTools(apiClient)
+	taskTools := NewTaskTools(apiClient, config.Default())
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
 
@@ -439,3 +557,589 @@ func TestTaskTools_IntegrationErrorHandling(t *testing.T) {
 		}
 	})
 }
+
+func TestTaskTools_IntegrationBulkTagTasks(t *testing.T) {
+	server := createIntegrationAPIServer(
+		Task{TaskID: "task-2", TaskName: "Second", Status: "In Progress", Tags: []string{"Q3-Goals", "backend"}},
+		Task{TaskID: "task-3", TaskName: "Third", Status: "Not Started", Tags: []string{"legacy"}},
+	)
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	ctx := context.Background()
+
+	result, err := taskTools.HandleBulkTagTasks(ctx, &mcp.ServerSession{}, &mcp.CallToolParamsFor[BulkTagTasksParams]{
+		Arguments: BulkTagTasksParams{
+			TaskIDs:    []string{"task-1", "task-2", "task-3", "task-2"},
+			AddTags:    []string{"q3-goals"},
+			RemoveTags: []string{"LEGACY"},
+			UpdatedBy:  "test.user",
+		},
+	})
+	if err != nil {
+		t.Fatalf("HandleBulkTagTasks failed: %v", err)
+	}
+
+	if result.Meta["updated_count"] != 2 || result.Meta["unchanged_count"] != 1 || result.Meta["failed_count"] != 0 {
+		t.Errorf("Expected 2 updated and 1 unchanged, got %+v", result.Meta)
+	}
+
+	expected := map[string]string{
+		"task-1": "q3-goals",
+		"task-2": "Q3-Goals,backend",
+		"task-3": "q3-goals",
+	}
+	for taskID, want := range expected {
+		task, err := taskTools.fetchTask(ctx, taskID)
+		if err != nil {
+			t.Fatalf("Failed to fetch %s: %v", taskID, err)
+		}
+		if got := strings.Join(task.Tags, ","); got != want {
+			t.Errorf("Expected %s tags %q, got %q", taskID, want, got)
+		}
+	}
+}
+
+func TestTaskTools_IntegrationAddRemoveTaskTags(t *testing.T) {
+	server := createIntegrationAPIServer(
+		Task{TaskID: "task-2", TaskName: "Tagged", Status: "In Progress", Tags: []string{"backend"}},
+	)
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	ctx := context.Background()
+	session := &mcp.ServerSession{}
+	tags := func(result *mcp.CallToolResultFor[map[string]any]) string {
+		return strings.Join(result.Meta["tags"].([]string), ",")
+	}
+
+	addArgs := TaskTagsParams{TaskID: "task-2", Tags: []string{"urgent", "Backend", "urgent", "q3"}, UpdatedBy: "test.user"}
+	result, err := taskTools.HandleAddTaskTags(ctx, session, &mcp.CallToolParamsFor[TaskTagsParams]{Arguments: addArgs})
+	if err != nil {
+		t.Fatalf("HandleAddTaskTags failed: %v", err)
+	}
+	if tags(result) != "backend,urgent,q3" || result.Meta["changed"] != true {
+		t.Errorf("Expected deduped tags in order, got %s (changed=%v)", tags(result), result.Meta["changed"])
+	}
+
+	// Adding the same tags again changes nothing
+	result, err = taskTools.HandleAddTaskTags(ctx, session, &mcp.CallToolParamsFor[TaskTagsParams]{Arguments: addArgs})
+	if err != nil {
+		t.Fatalf("Repeated HandleAddTaskTags failed: %v", err)
+	}
+	if tags(result) != "backend,urgent,q3" || result.Meta["changed"] != false {
+		t.Errorf("Expected repeated add to be a no-op, got %s (changed=%v)", tags(result), result.Meta["changed"])
+	}
+
+	removeArgs := TaskTagsParams{TaskID: "task-2", Tags: []string{"URGENT", "not-there"}, UpdatedBy: "test.user"}
+	result, err = taskTools.HandleRemoveTaskTags(ctx, session, &mcp.CallToolParamsFor[TaskTagsParams]{Arguments: removeArgs})
+	if err != nil {
+		t.Fatalf("HandleRemoveTaskTags failed: %v", err)
+	}
+	if tags(result) != "backend,q3" || result.Meta["changed"] != true {
+		t.Errorf("Expected urgent removed, got %s (changed=%v)", tags(result), result.Meta["changed"])
+	}
+
+	// Removing tags that are no longer present is a no-op, not an error
+	result, err = taskTools.HandleRemoveTaskTags(ctx, session, &mcp.CallToolParamsFor[TaskTagsParams]{Arguments: removeArgs})
+	if err != nil {
+		t.Fatalf("Repeated HandleRemoveTaskTags failed: %v", err)
+	}
+	if tags(result) != "backend,q3" || result.Meta["changed"] != false {
+		t.Errorf("Expected repeated remove to be a no-op, got %s (changed=%v)", tags(result), result.Meta["changed"])
+	}
+
+	task, err := taskTools.fetchTask(ctx, "task-2")
+	if err != nil {
+		t.Fatalf("Failed to fetch task-2: %v", err)
+	}
+	if got := strings.Join(task.Tags, ","); got != "backend,q3" {
+		t.Errorf("Expected stored tags backend,q3, got %q", got)
+	}
+
+	if _, err := taskTools.HandleRemoveTaskTags(ctx, session, &mcp.CallToolParamsFor[TaskTagsParams]{
+		Arguments: TaskTagsParams{TaskID: "missing", Tags: []string{"x"}, UpdatedBy: "test.user"},
+	}); err == nil {
+		t.Error("Expected error for a task that does not exist")
+	}
+}
+
+func TestTaskTools_IntegrationGetAllTasksPages(t *testing.T) {
+	server := createIntegrationAPIServer(
+		Task{TaskID: "task-2", TaskName: "Second", Status: "In Progress"},
+		Task{TaskID: "task-3", TaskName: "Third", Status: "Review"},
+		Task{TaskID: "task-4", TaskName: "Fourth", Status: "Blocked"},
+		Task{TaskID: "task-5", TaskName: "Fifth", Status: "Complete"},
+	)
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	list := func(args GetAllTasksParams) (*mcp.CallToolResultFor[map[string]any], string) {
+		t.Helper()
+		result, err := taskTools.HandleGetAllTasks(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetAllTasksParams]{Arguments: args})
+		if err != nil {
+			t.Fatalf("HandleGetAllTasks failed: %v", err)
+		}
+		var ids []string
+		for _, task := range result.Meta["tasks"].([]Task) {
+			ids = append(ids, task.TaskID)
+		}
+		return result, strings.Join(ids, ",")
+	}
+
+	result, ids := list(GetAllTasksParams{PageSize: 3})
+	if ids != "task-1,task-2,task-3" || result.Meta["page"] != 1 || result.Meta["page_size"] != 3 || result.Meta["has_more"] != true {
+		t.Errorf("Expected first page of three with more to come, got %s (meta %+v)", ids, result.Meta)
+	}
+	if result.Meta["total_count_exact"] != false || result.Meta["total_count"] != 4 {
+		t.Errorf("Expected a lower-bound total of 4 before the last page, got %v (exact=%v)", result.Meta["total_count"], result.Meta["total_count_exact"])
+	}
+	if text := result.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "Page 1 (tasks 1-3 of at least 4)") || !strings.Contains(text, "page 2") {
+		t.Errorf("Expected page 1 header and pointer to page 2, got:\n%s", text)
+	}
+
+	result, ids = list(GetAllTasksParams{Page: 2, PageSize: 3})
+	if ids != "task-4,task-5" || result.Meta["has_more"] != false || result.Meta["total_count"] != 5 || result.Meta["total_count_exact"] != true {
+		t.Errorf("Expected last page with the exact total, got %s (meta %+v)", ids, result.Meta)
+	}
+	if text := result.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "Page 2 (tasks 4-5 of 5)") {
+		t.Errorf("Expected page 2 header, got:\n%s", text)
+	}
+
+	// No paging params returns the first page at the default size
+	result, ids = list(GetAllTasksParams{})
+	if ids != "task-1,task-2,task-3,task-4,task-5" || result.Meta["page_size"] != defaultTaskPageSize || result.Meta["has_more"] != false {
+		t.Errorf("Expected every task on the default page, got %s (meta %+v)", ids, result.Meta)
+	}
+}
+
+func TestTaskTools_IntegrationGetBlockedTasks(t *testing.T) {
+	now := time.Date(2024, 6, 20, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) *string {
+		return stringPtr(now.AddDate(0, 0, -days).Format(time.RFC3339))
+	}
+	server := createIntegrationAPIServer(
+		Task{TaskID: "recent", TaskName: "Waiting on review", Status: "Blocked", AssignedTo: stringPtr("alice"), ProjectID: stringPtr("proj-1"), LastUpdateDate: daysAgo(2)},
+		Task{TaskID: "ancient", TaskName: "Waiting on vendor", Status: "Blocked", AssignedTo: stringPtr("bob"), ProjectID: stringPtr("proj-1"), LastUpdateDate: daysAgo(12)},
+		Task{TaskID: "week", TaskName: "Waiting on legal", Status: "Blocked", AssignedTo: stringPtr("alice"), ProjectID: stringPtr("proj-2"), LastUpdateDate: daysAgo(7)},
+		Task{TaskID: "archived", TaskName: "Abandoned", Status: "Blocked", LastUpdateDate: daysAgo(30), Archived: true},
+		Task{TaskID: "moving", TaskName: "Unblocked", Status: "In Progress", LastUpdateDate: daysAgo(20)},
+	)
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	taskTools.SetClock(clock.NewFixed(now))
+	ctx := context.Background()
+
+	for _, note := range []struct{ taskID, text, at string }{
+		{"ancient", "Blocked on vendor contract", *daysAgo(12)},
+		{"ancient", "Vendor says next month", *daysAgo(3)},
+	} {
+		if _, err := taskTools.apiClient.Post(ctx, "/api/v1/tasks/"+note.taskID+"/notes", map[string]any{
+			"note": note.text, "created_by": "bob", "creation_date": note.at,
+		}); err != nil {
+			t.Fatalf("Failed to seed note: %v", err)
+		}
+	}
+
+	list := func(args GetBlockedTasksParams) (*mcp.CallToolResultFor[map[string]any], []map[string]any) {
+		t.Helper()
+		result, err := taskTools.HandleGetBlockedTasks(ctx, &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetBlockedTasksParams]{Arguments: args})
+		if err != nil {
+			t.Fatalf("HandleGetBlockedTasks failed: %v", err)
+		}
+		return result, result.Meta["tasks"].([]map[string]any)
+	}
+
+	result, entries := list(GetBlockedTasksParams{})
+	var order []string
+	for _, entry := range entries {
+		order = append(order, fmt.Sprintf("%s:%v:%v", entry["task_id"], entry["days_blocked"], entry["critical"]))
+	}
+	if got := strings.Join(order, ","); got != "ancient:12:true,week:7:false,recent:2:false" {
+		t.Errorf("Expected oldest blocked first with only >7 days critical, got %s", got)
+	}
+	if result.Meta["critical_count"] != 1 {
+		t.Errorf("Expected 1 critical task, got %v", result.Meta["critical_count"])
+	}
+	if note, ok := entries[0]["latest_note"].(TaskNote); !ok || note.Note != "Vendor says next month" {
+		t.Errorf("Expected the newest note on the oldest blocked task, got %+v", entries[0]["latest_note"])
+	}
+	if _, ok := entries[1]["latest_note"]; ok {
+		t.Errorf("Expected no latest note for a task without notes, got %+v", entries[1]["latest_note"])
+	}
+	if text := result.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "🚨 Waiting on vendor") || !strings.Contains(text, "Latest note (bob,") {
+		t.Errorf("Expected critical marker and latest note in text, got:\n%s", text)
+	}
+
+	_, entries = list(GetBlockedTasksParams{ProjectID: "proj-1", AssignedTo: "alice"})
+	if len(entries) != 1 || entries[0]["task_id"] != "recent" {
+		t.Errorf("Expected only alice's proj-1 blocked task, got %+v", entries)
+	}
+}
+
+func TestTaskTools_IntegrationBulkDeleteTasks(t *testing.T) {
+	for _, policy := range []string{"hard", "soft"} {
+		t.Run(policy, func(t *testing.T) {
+			server := createIntegrationAPIServer(
+				Task{TaskID: "task-2", TaskName: "Second", Status: "In Progress"},
+				Task{TaskID: "task-3", TaskName: "Third", Status: "Not Started"},
+			)
+			defer server.Close()
+
+			cfg := config.Default()
+			cfg.DeletePolicy = policy
+			taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+			ctx := context.Background()
+
+			result, err := taskTools.HandleBulkDeleteTasks(ctx, &mcp.ServerSession{}, &mcp.CallToolParamsFor[BulkDeleteTasksParams]{
+				Arguments: BulkDeleteTasksParams{
+					TaskIDs:   []string{"task-1", "missing-1", "task-2", "missing-2", "task-3"},
+					DeletedBy: "test.user",
+				},
+			})
+			if err != nil {
+				t.Fatalf("HandleBulkDeleteTasks failed: %v", err)
+			}
+
+			if result.Meta["deleted_count"] != 3 || result.Meta["failed_count"] != 2 {
+				t.Errorf("Expected 3 deleted and 2 failed, got %+v", result.Meta)
+			}
+			var failedIDs []string
+			for _, entry := range result.Meta["failed"].([]map[string]any) {
+				failedIDs = append(failedIDs, entry["task_id"].(string))
+			}
+			if strings.Join(failedIDs, ",") != "missing-1,missing-2" {
+				t.Errorf("Expected the missing IDs to fail, got %v", failedIDs)
+			}
+			if text := result.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "Deleted 3 of 5 tasks") {
+				t.Errorf("Expected summary line, got:\n%s", text)
+			}
+
+			for _, taskID := range []string{"task-1", "task-2", "task-3"} {
+				task, err := taskTools.fetchTask(ctx, taskID)
+				switch {
+				case policy == "hard" && err == nil:
+					t.Errorf("Expected %s to be removed under the hard policy", taskID)
+				case policy == "soft" && (err != nil || !isSoftDeletedTask(*task)):
+					t.Errorf("Expected %s to be archived and tagged deleted under the soft policy, got %+v (%v)", taskID, task, err)
+				}
+			}
+		})
+	}
+}
+
+func TestTaskTools_IntegrationBulkDeleteTasksPreview(t *testing.T) {
+	server := createIntegrationAPIServer()
+	defer server.Close()
+
+	cfg := config.Default()
+	cfg.DeletePolicy = "hard"
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+	ctx := context.Background()
+
+	result, err := taskTools.HandleBulkDeleteTasks(ctx, &mcp.ServerSession{}, &mcp.CallToolParamsFor[BulkDeleteTasksParams]{
+		Arguments: BulkDeleteTasksParams{TaskIDs: []string{"task-1", "missing"}, RequireConfirmation: true, DeletedBy: "test.user"},
+	})
+	if err != nil {
+		t.Fatalf("HandleBulkDeleteTasks failed: %v", err)
+	}
+	if result.Meta["confirmation_required"] != true || result.Meta["deleted_count"] != 0 || len(result.Meta["would_delete"].([]map[string]any)) != 1 {
+		t.Errorf("Expected a preview of one deletable task, got %+v", result.Meta)
+	}
+	if _, err := taskTools.fetchTask(ctx, "task-1"); err != nil {
+		t.Errorf("Expected preview to leave task-1 in place: %v", err)
+	}
+	if text := result.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "without require_confirmation") {
+		t.Errorf("Expected the preview to say how to delete, got:\n%s", text)
+	}
+}
+
+func TestProjectTools_IntegrationGetAllProjectStatuses(t *testing.T) {
+	server := createIntegrationAPIServer(
+		Task{TaskID: "task-2", TaskName: "Shipped", Status: "Complete", ProjectID: stringPtr("proj-2")},
+		Task{TaskID: "task-3", TaskName: "Building", Status: "In Progress", ProjectID: stringPtr("proj-2"), DueDate: stringPtr("2999-01-01T00:00:00Z")},
+	)
+	defer server.Close()
+
+	projectTools := NewProjectTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+
+	result, err := projectTools.HandleGetAllProjectStatuses(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetAllProjectStatusesParams]{})
+	if err != nil {
+		t.Fatalf("HandleGetAllProjectStatuses failed: %v", err)
+	}
+
+	statuses := result.Meta["projects"].([]map[string]any)
+	if len(statuses) != 2 {
+		t.Fatalf("Expected 2 project statuses, got %d", len(statuses))
+	}
+
+	// proj-1 holds task-1, which is past its 2024 due date, so it sorts first
+	first, second := statuses[0], statuses[1]
+	if first["project_id"] != "proj-1" || first["health"] != "critical" || first["overdue_tasks"] != 1 {
+		t.Errorf("Expected proj-1 first as critical with 1 overdue task, got %+v", first)
+	}
+	if second["project_id"] != "proj-2" || second["health"] != "healthy" {
+		t.Errorf("Expected proj-2 second as healthy, got %+v", second)
+	}
+	if second["total_tasks"] != 2 || second["active_tasks"] != 1 || second["completion_percentage"] != 50.0 {
+		t.Errorf("Unexpected proj-2 summary: %+v", second)
+	}
+	if result.Meta["failed_count"] != 0 {
+		t.Errorf("Expected no failed projects, got %v", result.Meta["failed_count"])
+	}
+}
+
+func TestTaskTools_IntegrationTaskLinks(t *testing.T) {
+	server := createIntegrationAPIServer()
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	ctx := context.Background()
+	session := &mcp.ServerSession{}
+
+	addLink := func(linkURL, label string) (*mcp.CallToolResultFor[map[string]any], error) {
+		return taskTools.HandleAddTaskLink(ctx, session, &mcp.CallToolParamsFor[AddTaskLinkParams]{
+			Arguments: AddTaskLinkParams{TaskID: "task-1", URL: linkURL, Label: label, UpdatedBy: "test.user"},
+		})
+	}
+
+	if _, err := addLink("https://docs.example.com/design", "Design doc"); err != nil {
+		t.Fatalf("HandleAddTaskLink failed: %v", err)
+	}
+	result, err := addLink("https://github.com/org/repo/pull/7", "")
+	if err != nil {
+		t.Fatalf("HandleAddTaskLink without label failed: %v", err)
+	}
+	if link := result.Meta["link"].(TaskLink); link.Label != "github.com" {
+		t.Errorf("Expected label to default to the host, got %q", link.Label)
+	}
+
+	if _, err := addLink("not a url", "Broken"); err == nil || !strings.Contains(err.Error(), "invalid url") {
+		t.Errorf("Expected invalid URL to be rejected, got %v", err)
+	}
+	if _, err := addLink("ftp://files.example.com/spec.pdf", "FTP"); err == nil {
+		t.Error("Expected non-http URL to be rejected")
+	}
+
+	task, err := taskTools.fetchTask(ctx, "task-1")
+	if err != nil {
+		t.Fatalf("Failed to fetch task: %v", err)
+	}
+	if len(task.Links) != 2 || task.Links[0].Label != "Design doc" {
+		t.Fatalf("Expected 2 links stored on the task, got %+v", task.Links)
+	}
+
+	details, err := taskTools.HandleGetTaskDetails(ctx, session, &mcp.CallToolParamsFor[GetTaskDetailsParams]{
+		Arguments: GetTaskDetailsParams{TaskID: "task-1"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetTaskDetails failed: %v", err)
+	}
+	text := details.Content[0].(*mcp.TextContent).Text
+	if !strings.Contains(text, "🔗 Links (2):") || !strings.Contains(text, "- Design doc: https://docs.example.com/design") {
+		t.Errorf("Expected links in task details, got: %s", text)
+	}
+
+	if _, err := taskTools.HandleRemoveTaskLink(ctx, session, &mcp.CallToolParamsFor[RemoveTaskLinkParams]{
+		Arguments: RemoveTaskLinkParams{TaskID: "task-1", URL: "https://docs.example.com/design", UpdatedBy: "test.user"},
+	}); err != nil {
+		t.Fatalf("HandleRemoveTaskLink failed: %v", err)
+	}
+	task, _ = taskTools.fetchTask(ctx, "task-1")
+	if len(task.Links) != 1 || task.Links[0].URL != "https://github.com/org/repo/pull/7" {
+		t.Errorf("Expected only the PR link to remain, got %+v", task.Links)
+	}
+
+	if _, err := taskTools.HandleRemoveTaskLink(ctx, session, &mcp.CallToolParamsFor[RemoveTaskLinkParams]{
+		Arguments: RemoveTaskLinkParams{TaskID: "task-1", URL: "https://docs.example.com/design", UpdatedBy: "test.user"},
+	}); err == nil {
+		t.Error("Expected removing a missing link to fail")
+	}
+}
+
+func TestTaskTools_IntegrationMoveNote(t *testing.T) {
+	server := createIntegrationAPIServer(
+		Task{TaskID: "task-2", TaskName: "Right Task", Status: "In Progress"},
+	)
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	ctx := context.Background()
+	session := &mcp.ServerSession{}
+
+	result, err := taskTools.HandleMoveNote(ctx, session, &mcp.CallToolParamsFor[MoveNoteParams]{
+		Arguments: MoveNoteParams{FromTaskID: "task-1", NoteID: "note-1", ToTaskID: "task-2", MovedBy: "test.user"},
+	})
+	if err != nil {
+		t.Fatalf("HandleMoveNote failed: %v", err)
+	}
+
+	moved := result.Meta["note"].(TaskNote)
+	if moved.TaskID != "task-2" || moved.Note != "Initial task note" {
+		t.Errorf("Expected note recreated on task-2, got %+v", moved)
+	}
+	if moved.CreatedBy != "admin" || moved.CreationDate != "2024-01-01T10:30:00Z" {
+		t.Errorf("Expected original author and timestamp to be preserved, got %+v", moved)
+	}
+
+	notesFor := func(taskID string) []TaskNote {
+		resp, err := taskTools.apiClient.Get(ctx, "/api/v1/tasks/"+taskID+"/notes")
+		if err != nil {
+			t.Fatalf("Failed to get notes for %s: %v", taskID, err)
+		}
+		var notes []TaskNote
+		json.Unmarshal(resp, &notes)
+		return notes
+	}
+
+	sourceNotes := notesFor("task-1")
+	for _, note := range sourceNotes {
+		if note.Note == "Initial task note" {
+			t.Errorf("Expected moved note to be gone from task-1, got %+v", sourceNotes)
+		}
+	}
+	if len(sourceNotes) != 1 || !strings.Contains(sourceNotes[0].Note, "moved to task task-2") {
+		t.Errorf("Expected a trace note on task-1, got %+v", sourceNotes)
+	}
+
+	targetNotes := notesFor("task-2")
+	if len(targetNotes) != 2 || targetNotes[0].Note != "Initial task note" || !strings.Contains(targetNotes[1].Note, "moved from task task-1") {
+		t.Errorf("Expected moved note and trace note on task-2, got %+v", targetNotes)
+	}
+
+	if _, err := taskTools.HandleMoveNote(ctx, session, &mcp.CallToolParamsFor[MoveNoteParams]{
+		Arguments: MoveNoteParams{FromTaskID: "task-1", NoteID: "note-1", ToTaskID: "task-2", MovedBy: "test.user"},
+	}); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("Expected moving an already-moved note to fail, got %v", err)
+	}
+	if _, err := taskTools.HandleMoveNote(ctx, session, &mcp.CallToolParamsFor[MoveNoteParams]{
+		Arguments: MoveNoteParams{FromTaskID: "task-1", NoteID: "note-1", ToTaskID: "missing", MovedBy: "test.user"},
+	}); err == nil {
+		t.Error("Expected missing target task to fail")
+	}
+}
+
+func TestTaskTools_IntegrationUpdateDeleteTaskNote(t *testing.T) {
+	server := createIntegrationAPIServer()
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	ctx := context.Background()
+	session := &mcp.ServerSession{}
+	notesFor := func(taskID string) []TaskNote {
+		resp, err := taskTools.apiClient.Get(ctx, "/api/v1/tasks/"+taskID+"/notes")
+		if err != nil {
+			t.Fatalf("Failed to get notes for %s: %v", taskID, err)
+		}
+		var notes []TaskNote
+		json.Unmarshal(resp, &notes)
+		return notes
+	}
+
+	result, err := taskTools.HandleUpdateTaskNote(ctx, session, &mcp.CallToolParamsFor[UpdateTaskNoteParams]{
+		Arguments: UpdateTaskNoteParams{TaskID: "task-1", NoteID: "note-1", Note: "Revised task note", UpdatedBy: "test.user"},
+	})
+	if err != nil {
+		t.Fatalf("HandleUpdateTaskNote failed: %v", err)
+	}
+	revised := result.Meta["note"].(TaskNote)
+	if revised.NoteID != "note-1" || revised.Note != "Revised task note" || revised.LastUpdatedBy == nil || *revised.LastUpdatedBy != "test.user" {
+		t.Errorf("Expected the revised note in meta, got %+v", revised)
+	}
+	if notes := notesFor("task-1"); len(notes) != 1 || notes[0].Note != "Revised task note" {
+		t.Errorf("Expected the stored note to be revised, got %+v", notes)
+	}
+
+	if _, err := taskTools.HandleUpdateTaskNote(ctx, session, &mcp.CallToolParamsFor[UpdateTaskNoteParams]{
+		Arguments: UpdateTaskNoteParams{TaskID: "missing", NoteID: "note-1", Note: "Nope", UpdatedBy: "test.user"},
+	}); err == nil || !strings.Contains(err.Error(), "failed to verify task exists") {
+		t.Errorf("Expected editing a note on a missing task to fail before the update, got %v", err)
+	}
+	if _, err := taskTools.HandleUpdateTaskNote(ctx, session, &mcp.CallToolParamsFor[UpdateTaskNoteParams]{
+		Arguments: UpdateTaskNoteParams{TaskID: "task-1", NoteID: "missing", Note: "Nope", UpdatedBy: "test.user"},
+	}); err == nil || !strings.Contains(err.Error(), "failed to update note") {
+		t.Errorf("Expected editing a missing note to fail, got %v", err)
+	}
+
+	result, err = taskTools.HandleDeleteTaskNote(ctx, session, &mcp.CallToolParamsFor[DeleteTaskNoteParams]{
+		Arguments: DeleteTaskNoteParams{TaskID: "task-1", NoteID: "note-1"},
+	})
+	if err != nil {
+		t.Fatalf("HandleDeleteTaskNote failed: %v", err)
+	}
+	if result.Meta["note_id"] != "note-1" || result.Meta["success"] != true {
+		t.Errorf("Expected deletion of note-1 in meta, got %+v", result.Meta)
+	}
+	if notes := notesFor("task-1"); len(notes) != 0 {
+		t.Errorf("Expected task-1 to have no notes left, got %+v", notes)
+	}
+
+	if _, err := taskTools.HandleDeleteTaskNote(ctx, session, &mcp.CallToolParamsFor[DeleteTaskNoteParams]{
+		Arguments: DeleteTaskNoteParams{TaskID: "task-1", NoteID: "note-1"},
+	}); err == nil {
+		t.Error("Expected deleting an already-deleted note to fail")
+	}
+	if _, err := taskTools.HandleDeleteTaskNote(ctx, session, &mcp.CallToolParamsFor[DeleteTaskNoteParams]{
+		Arguments: DeleteTaskNoteParams{TaskID: "missing", NoteID: "note-1"},
+	}); err == nil || !strings.Contains(err.Error(), "failed to verify task exists") {
+		t.Errorf("Expected deleting from a missing task to fail early, got %v", err)
+	}
+}
+
+func TestTaskTools_IntegrationFindDuplicateNotes(t *testing.T) {
+	server := createIntegrationAPIServer()
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	ctx := context.Background()
+	session := &mcp.ServerSession{}
+
+	// An automation posts the same note twice
+	for i := 0; i < 2; i++ {
+		if _, err := taskTools.apiClient.Post(ctx, "/api/v1/tasks/task-1/notes", map[string]interface{}{
+			"note":       "CI build failed on main",
+			"created_by": "ci-bot",
+		}); err != nil {
+			t.Fatalf("Failed to add note: %v", err)
+		}
+	}
+
+	result, err := taskTools.HandleFindDuplicateNotes(ctx, session, &mcp.CallToolParamsFor[FindDuplicateNotesParams]{
+		Arguments: FindDuplicateNotesParams{TaskID: "task-1"},
+	})
+	if err != nil {
+		t.Fatalf("HandleFindDuplicateNotes failed: %v", err)
+	}
+	groups := result.Meta["groups"].([][]TaskNote)
+	if len(groups) != 1 || len(groups[0]) != 2 || groups[0][0].Note != "CI build failed on main" {
+		t.Errorf("Expected one group of two identical notes, got %+v", groups)
+	}
+	if removed := result.Meta["removed_note_ids"].([]string); len(removed) != 0 {
+		t.Errorf("Expected nothing removed without remove_duplicates, got %v", removed)
+	}
+
+	result, err = taskTools.HandleFindDuplicateNotes(ctx, session, &mcp.CallToolParamsFor[FindDuplicateNotesParams]{
+		Arguments: FindDuplicateNotesParams{TaskID: "task-1", RemoveDuplicates: true},
+	})
+	if err != nil {
+		t.Fatalf("HandleFindDuplicateNotes failed: %v", err)
+	}
+	if removed := result.Meta["removed_note_ids"].([]string); len(removed) != 1 {
+		t.Errorf("Expected one duplicate removed, got %v", removed)
+	}
+
+	resp, err := taskTools.apiClient.Get(ctx, "/api/v1/tasks/task-1/notes")
+	if err != nil {
+		t.Fatalf("Failed to get notes: %v", err)
+	}
+	var notes []TaskNote
+	json.Unmarshal(resp, &notes)
+	if len(notes) != 2 {
+		t.Errorf("Expected the original note plus one copy of the duplicate, got %+v", notes)
+	}
+
+	if _, err := taskTools.HandleFindDuplicateNotes(ctx, session, &mcp.CallToolParamsFor[FindDuplicateNotesParams]{}); err == nil {
+		t.Error("Expected error when task_id is missing")
+	}
+}
@@ -0,0 +1,60 @@
+package tools
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// TaskLink is an external reference (design doc, ticket, PR) attached to a task
+type TaskLink struct {
+	Label string `json:"label"`
+	URL   string `json:"url"`
+}
+
+// validateLinkURL accepts absolute http(s) URLs and returns them trimmed
+func validateLinkURL(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", fmt.Errorf("url is required")
+	}
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid url '%s': %w", raw, err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return "", fmt.Errorf("invalid url '%s': only http and https links are supported", raw)
+	}
+	if parsed.Host == "" {
+		return "", fmt.Errorf("invalid url '%s': missing host", raw)
+	}
+	return raw, nil
+}
+
+// withLink adds link, or relabels an existing link with the same URL
+func withLink(links []TaskLink, link TaskLink) (updated []TaskLink, changed bool) {
+	updated = append([]TaskLink{}, links...)
+	for i, existing := range updated {
+		if existing.URL == link.URL {
+			if existing.Label == link.Label {
+				return updated, false
+			}
+			updated[i].Label = link.Label
+			return updated, true
+		}
+	}
+	return append(updated, link), true
+}
+
+// withoutLink removes the link with the given URL
+func withoutLink(links []TaskLink, linkURL string) (updated []TaskLink, removed bool) {
+	updated = []TaskLink{}
+	for _, existing := range links {
+		if existing.URL == linkURL {
+			removed = true
+			continue
+		}
+		updated = append(updated, existing)
+	}
+	return updated, removed
+}
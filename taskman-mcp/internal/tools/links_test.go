@@ -0,0 +1,48 @@
+package tools
+
+import "testing"
+
+func TestValidateLinkURL(t *testing.T) {
+	valid := []string{"https://example.com/doc", "http://wiki.internal/page?id=1", "  https://example.com  "}
+	for _, raw := range valid {
+		if _, err := validateLinkURL(raw); err != nil {
+			t.Errorf("Expected %q to be valid, got %v", raw, err)
+		}
+	}
+
+	invalid := []string{"", "example.com/doc", "ftp://example.com/file", "https://", "javascript:alert(1)", "http://exa mple.com"}
+	for _, raw := range invalid {
+		if _, err := validateLinkURL(raw); err == nil {
+			t.Errorf("Expected %q to be rejected", raw)
+		}
+	}
+}
+
+func TestWithLinkAndWithoutLink(t *testing.T) {
+	links := []TaskLink{{Label: "Spec", URL: "https://example.com/spec"}}
+
+	if _, changed := withLink(links, TaskLink{Label: "Spec", URL: "https://example.com/spec"}); changed {
+		t.Error("Expected identical link to be unchanged")
+	}
+
+	relabeled, changed := withLink(links, TaskLink{Label: "Design spec", URL: "https://example.com/spec"})
+	if !changed || len(relabeled) != 1 || relabeled[0].Label != "Design spec" {
+		t.Errorf("Expected link to be relabeled, got %+v", relabeled)
+	}
+	if links[0].Label != "Spec" {
+		t.Error("Expected original links to be left untouched")
+	}
+
+	added, _ := withLink(links, TaskLink{Label: "PR", URL: "https://example.com/pr/1"})
+	if len(added) != 2 {
+		t.Fatalf("Expected 2 links, got %d", len(added))
+	}
+
+	remaining, removed := withoutLink(added, "https://example.com/spec")
+	if !removed || len(remaining) != 1 || remaining[0].Label != "PR" {
+		t.Errorf("Expected spec link removed, got %+v", remaining)
+	}
+	if _, removed := withoutLink(remaining, "https://example.com/missing"); removed {
+		t.Error("Expected missing link not to be reported as removed")
+	}
+}
@@ -0,0 +1,113 @@
+package tools
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+)
+
+// Milestone groups project tasks that must be done by a due date
+type Milestone struct {
+	Name    string   `json:"name"`
+	DueDate string   `json:"due_date"`
+	TaskIDs []string `json:"task_ids"`
+}
+
+// MilestoneProgress reports how far a milestone's linked tasks have come
+type MilestoneProgress struct {
+	Milestone
+	TotalTasks        int      `json:"total_tasks"`
+	CompletedTasks    int      `json:"completed_tasks"`
+	CompletionPercent float64  `json:"completion_percent"`
+	RemainingTaskIDs  []string `json:"remaining_task_ids"`
+	MissingTaskIDs    []string `json:"missing_task_ids,omitempty"` // linked tasks no longer in the project
+	DaysRemaining     int      `json:"days_remaining"`             // negative once overdue
+	Overdue           bool     `json:"overdue"`
+	AtRisk            bool     `json:"at_risk"`
+	RiskReasons       []string `json:"risk_reasons,omitempty"`
+}
+
+// milestoneDeadline returns the end of a milestone's due date. Date-only
+// values count the whole day; timestamps are used as given.
+func milestoneDeadline(dueDate string) (time.Time, error) {
+	due, err := parseDueDate(dueDate)
+	if err != nil {
+		return time.Time{}, err
+	}
+	if due == nil {
+		return time.Time{}, fmt.Errorf("due_date is required")
+	}
+	if !strings.Contains(dueDate, "T") {
+		return due.Add(24 * time.Hour), nil
+	}
+	return *due, nil
+}
+
+// withMilestone adds milestone, or replaces an existing one with the same
+// name (case-insensitive)
+func withMilestone(milestones []Milestone, milestone Milestone) (updated []Milestone, replaced bool) {
+	updated = append([]Milestone{}, milestones...)
+	for i, existing := range updated {
+		if strings.EqualFold(existing.Name, milestone.Name) {
+			updated[i] = milestone
+			return updated, true
+		}
+	}
+	return append(updated, milestone), false
+}
+
+// milestoneProgress measures a milestone against the project's tasks. It is
+// at risk when overdue with work left, when a linked task is blocked, or when
+// more tasks remain than days before the deadline.
+func milestoneProgress(milestone Milestone, tasks []Task, now time.Time) MilestoneProgress {
+	byID := make(map[string]Task, len(tasks))
+	for _, task := range tasks {
+		byID[task.TaskID] = task
+	}
+
+	progress := MilestoneProgress{Milestone: milestone, RemainingTaskIDs: []string{}}
+	blocked := 0
+	for _, taskID := range milestone.TaskIDs {
+		task, ok := byID[taskID]
+		if !ok {
+			progress.MissingTaskIDs = append(progress.MissingTaskIDs, taskID)
+			continue
+		}
+		progress.TotalTasks++
+		switch task.Status {
+		case "Complete":
+			progress.CompletedTasks++
+		case "Blocked":
+			blocked++
+			progress.RemainingTaskIDs = append(progress.RemainingTaskIDs, taskID)
+		default:
+			progress.RemainingTaskIDs = append(progress.RemainingTaskIDs, taskID)
+		}
+	}
+	if progress.TotalTasks > 0 {
+		progress.CompletionPercent = math.Round(float64(progress.CompletedTasks)/float64(progress.TotalTasks)*1000) / 10
+	}
+
+	deadline, err := milestoneDeadline(milestone.DueDate)
+	if err != nil {
+		return progress
+	}
+	remaining := len(progress.RemainingTaskIDs)
+	progress.Overdue = now.After(deadline)
+	progress.DaysRemaining = int(math.Floor(deadline.Sub(now).Hours() / 24))
+
+	if remaining == 0 {
+		return progress
+	}
+	if progress.Overdue {
+		progress.RiskReasons = append(progress.RiskReasons, fmt.Sprintf("past due with %d tasks incomplete", remaining))
+	} else if remaining > progress.DaysRemaining {
+		progress.RiskReasons = append(progress.RiskReasons, fmt.Sprintf("%d tasks left with %d days to go", remaining, progress.DaysRemaining))
+	}
+	if blocked > 0 {
+		progress.RiskReasons = append(progress.RiskReasons, fmt.Sprintf("%d linked tasks blocked", blocked))
+	}
+	progress.AtRisk = len(progress.RiskReasons) > 0
+	return progress
+}
@@ -0,0 +1,69 @@
+package tools
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMilestoneProgress(t *testing.T) {
+	now := time.Date(2024, 6, 12, 12, 0, 0, 0, time.UTC)
+	tasks := []Task{
+		{TaskID: "t1", Status: "Complete"},
+		{TaskID: "t2", Status: "Complete"},
+		{TaskID: "t3", Status: "In Progress"},
+		{TaskID: "t4", Status: "Not Started"},
+		{TaskID: "t5", Status: "Blocked"},
+	}
+
+	tests := []struct {
+		name        string
+		milestone   Milestone
+		wantPercent float64
+		wantOverdue bool
+		wantAtRisk  bool
+	}{
+		{"overdue with incomplete tasks", Milestone{Name: "Beta", DueDate: "2024-06-10", TaskIDs: []string{"t1", "t2", "t3", "t4"}}, 50, true, true},
+		{"overdue but done", Milestone{Name: "Alpha", DueDate: "2024-06-01", TaskIDs: []string{"t1", "t2"}}, 100, true, false},
+		{"due today counts the whole day", Milestone{Name: "Today", DueDate: "2024-06-12", TaskIDs: []string{"t1"}}, 100, false, false},
+		{"on track", Milestone{Name: "GA", DueDate: "2024-07-01", TaskIDs: []string{"t1", "t3", "t4"}}, 33.3, false, false},
+		{"more work than days", Milestone{Name: "Crunch", DueDate: "2024-06-13T12:00:00Z", TaskIDs: []string{"t3", "t4"}}, 0, false, true},
+		{"blocked task", Milestone{Name: "Blocked", DueDate: "2024-07-01", TaskIDs: []string{"t1", "t5"}}, 50, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := milestoneProgress(tt.milestone, tasks, now)
+			if got.CompletionPercent != tt.wantPercent {
+				t.Errorf("CompletionPercent = %v, want %v", got.CompletionPercent, tt.wantPercent)
+			}
+			if got.Overdue != tt.wantOverdue {
+				t.Errorf("Overdue = %v, want %v", got.Overdue, tt.wantOverdue)
+			}
+			if got.AtRisk != tt.wantAtRisk {
+				t.Errorf("AtRisk = %v, want %v (reasons %v)", got.AtRisk, tt.wantAtRisk, got.RiskReasons)
+			}
+		})
+	}
+
+	missing := milestoneProgress(Milestone{Name: "Gone", DueDate: "2024-07-01", TaskIDs: []string{"t1", "deleted"}}, tasks, now)
+	if missing.TotalTasks != 1 || len(missing.MissingTaskIDs) != 1 || missing.CompletionPercent != 100 {
+		t.Errorf("Expected missing tasks reported and excluded, got %+v", missing)
+	}
+}
+
+func TestWithMilestone(t *testing.T) {
+	milestones := []Milestone{{Name: "Beta", DueDate: "2024-06-10"}}
+
+	updated, replaced := withMilestone(milestones, Milestone{Name: "beta", DueDate: "2024-06-20"})
+	if !replaced || len(updated) != 1 || updated[0].DueDate != "2024-06-20" {
+		t.Errorf("Expected same-named milestone replaced, got %+v", updated)
+	}
+	if milestones[0].DueDate != "2024-06-10" {
+		t.Error("withMilestone should not modify its input")
+	}
+
+	updated, replaced = withMilestone(milestones, Milestone{Name: "GA", DueDate: "2024-07-01"})
+	if replaced || len(updated) != 2 {
+		t.Errorf("Expected new milestone appended, got %+v", updated)
+	}
+}
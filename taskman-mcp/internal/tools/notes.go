@@ -0,0 +1,109 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"sort"
+	"strings"
+
+	"github.com/bchamber/taskman-mcp/internal/client"
+	"github.com/bchamber/taskman-mcp/internal/recency"
+)
+
+// fetchNotesForTasks loads notes for every task with bounded concurrency.
+// Failures are logged and leave that task's entry nil.
+func fetchNotesForTasks(ctx context.Context, apiClient *client.APIClient, tasks []Task) [][]TaskNote {
+	notesByTask := make([][]TaskNote, len(tasks))
+	runBounded(len(tasks), bulkConcurrency, func(i int) {
+		notesResp, err := apiClient.Get(ctx, fmt.Sprintf("/api/v1/tasks/%s/notes", url.PathEscape(tasks[i].TaskID)))
+		if err != nil {
+			slog.Warn("Failed to get notes for task", "error", err, "task_id", tasks[i].TaskID)
+			return
+		}
+		var notes []TaskNote
+		if err := json.Unmarshal(notesResp, &notes); err != nil {
+			slog.Warn("Failed to parse notes for task", "error", err, "task_id", tasks[i].TaskID)
+			return
+		}
+		notesByTask[i] = notes
+	})
+	return notesByTask
+}
+
+// effectiveNotesLimit applies the configured cap to a caller-requested limit.
+// A non-positive request means "as many as allowed"; a non-positive cap disables it.
+func effectiveNotesLimit(requested, maxNotes int) int {
+	if maxNotes <= 0 {
+		return requested
+	}
+	if requested <= 0 || requested > maxNotes {
+		return maxNotes
+	}
+	return requested
+}
+
+// newestNotes returns up to limit notes ordered newest first. A non-positive
+// limit returns every note.
+func newestNotes(notes []TaskNote, limit int) []TaskNote {
+	return recency.Newest(notes, limit, func(note TaskNote) string { return note.CreationDate })
+}
+
+// noteCreatedAfter reports whether a was created after b
+func noteCreatedAfter(a, b TaskNote) bool {
+	return recency.After(a.CreationDate, b.CreationDate)
+}
+
+// deleteTaskNote removes a single note from a task
+func (t *TaskTools) deleteTaskNote(ctx context.Context, taskID, noteID string) error {
+	_, err := t.apiClient.Delete(ctx, fmt.Sprintf("/api/v1/tasks/%s/notes/%s", url.PathEscape(taskID), url.PathEscape(noteID)))
+	return err
+}
+
+// normalizeNoteText folds case and whitespace so repeated automation notes compare equal
+func normalizeNoteText(text string) string {
+	return strings.Join(strings.Fields(strings.ToLower(text)), " ")
+}
+
+// duplicateNoteGroups groups notes whose normalized text is identical. Each
+// group is ordered oldest first and groups follow the order their text first
+// appears; notes without a duplicate are left out.
+func duplicateNoteGroups(notes []TaskNote) [][]TaskNote {
+	var order []string
+	byText := make(map[string][]TaskNote)
+	for _, note := range notes {
+		key := normalizeNoteText(note.Note)
+		if key == "" {
+			continue
+		}
+		if _, seen := byText[key]; !seen {
+			order = append(order, key)
+		}
+		byText[key] = append(byText[key], note)
+	}
+
+	var groups [][]TaskNote
+	for _, key := range order {
+		group := byText[key]
+		if len(group) < 2 {
+			continue
+		}
+		sort.SliceStable(group, func(i, j int) bool {
+			return noteCreatedAfter(group[j], group[i])
+		})
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// movedNoteTraceFrom is recorded on the source task when one of its notes is moved away
+func movedNoteTraceFrom(note TaskNote, target Task, movedBy string) string {
+	return fmt.Sprintf("📦 Note %s by %s moved to task %s (%s) by %s", note.NoteID, note.CreatedBy, target.TaskID, target.TaskName, movedBy)
+}
+
+// movedNoteTraceTo is recorded on the target task when a note is moved onto it
+func movedNoteTraceTo(note TaskNote, source Task, movedBy string) string {
+	return fmt.Sprintf("📦 Note moved from task %s (%s) by %s; originally written by %s at %s", source.TaskID, source.TaskName, movedBy, note.CreatedBy, note.CreationDate)
+}
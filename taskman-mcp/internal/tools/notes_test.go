@@ -0,0 +1,71 @@
+package tools
+
+import "testing"
+
+func TestEffectiveNotesLimit(t *testing.T) {
+	tests := []struct {
+		name      string
+		requested int
+		maxNotes  int
+		want      int
+	}{
+		{"unset request uses cap", 0, 50, 50},
+		{"small request honored", 10, 50, 10},
+		{"large request capped", 1000, 50, 50},
+		{"cap disabled", 1000, 0, 1000},
+		{"cap and request unset", 0, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := effectiveNotesLimit(tt.requested, tt.maxNotes); got != tt.want {
+				t.Errorf("effectiveNotesLimit(%d, %d) = %d, want %d", tt.requested, tt.maxNotes, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewestNotes(t *testing.T) {
+	notes := []TaskNote{
+		{NoteID: "n1", CreationDate: "2024-01-01T10:00:00Z"},
+		{NoteID: "n3", CreationDate: "2024-01-03T10:00:00Z"},
+		{NoteID: "n2", CreationDate: "2024-01-02T10:00:00Z"},
+	}
+
+	got := newestNotes(notes, 2)
+	if len(got) != 2 {
+		t.Fatalf("Expected 2 notes, got %d", len(got))
+	}
+	if got[0].NoteID != "n3" || got[1].NoteID != "n2" {
+		t.Errorf("Expected newest notes n3, n2; got %s, %s", got[0].NoteID, got[1].NoteID)
+	}
+	if notes[0].NoteID != "n1" {
+		t.Error("newestNotes should not reorder its input")
+	}
+
+	if all := newestNotes(notes, 0); len(all) != 3 {
+		t.Errorf("Expected all 3 notes with no limit, got %d", len(all))
+	}
+}
+
+func TestDuplicateNoteGroups(t *testing.T) {
+	notes := []TaskNote{
+		{NoteID: "n2", Note: "Build  FAILED on main", CreationDate: "2024-01-02T10:00:00Z"},
+		{NoteID: "n1", Note: "build failed on main", CreationDate: "2024-01-01T10:00:00Z"},
+		{NoteID: "n3", Note: "Deployed to staging", CreationDate: "2024-01-03T10:00:00Z"},
+		{NoteID: "n4", Note: "build failed on main\n", CreationDate: "2024-01-04T10:00:00Z"},
+	}
+
+	groups := duplicateNoteGroups(notes)
+	if len(groups) != 1 {
+		t.Fatalf("Expected 1 duplicate group, got %d", len(groups))
+	}
+	got := []string{groups[0][0].NoteID, groups[0][1].NoteID, groups[0][2].NoteID}
+	if got[0] != "n1" || got[1] != "n2" || got[2] != "n4" {
+		t.Errorf("Expected group ordered oldest first (n1, n2, n4), got %v", got)
+	}
+
+	if groups := duplicateNoteGroups(notes[2:3]); len(groups) != 0 {
+		t.Errorf("Expected no groups for unique notes, got %d", len(groups))
+	}
+}
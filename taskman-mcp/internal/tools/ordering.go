@@ -0,0 +1,165 @@
+package tools
+
+import (
+	"sort"
+	"strings"
+)
+
+// canonicalStatuses lists task statuses in workflow order
+var canonicalStatuses = []string{"Not Started", "In Progress", "Blocked", "Review", "Complete"}
+
+// statusRank returns the workflow position of a status; unknown statuses sort last
+func statusRank(status string) int {
+	for i, s := range canonicalStatuses {
+		if s == status {
+			return i
+		}
+	}
+	return len(canonicalStatuses)
+}
+
+// priorityRank returns a sort rank for a priority, highest priority first;
+// unset or unknown priorities sort last
+func priorityRank(priority *string) int {
+	if priority == nil {
+		return 3
+	}
+	switch *priority {
+	case "High":
+		return 0
+	case "Medium":
+		return 1
+	case "Low":
+		return 2
+	default:
+		return 3
+	}
+}
+
+// dueUnix returns the task's due date as a unix timestamp, if it has a parseable one
+func dueUnix(task Task) (int64, bool) {
+	if task.DueDate == nil {
+		return 0, false
+	}
+	parsed, err := parseDueDate(*task.DueDate)
+	if err != nil || parsed == nil {
+		return 0, false
+	}
+	return parsed.Unix(), true
+}
+
+// compareDueDates orders tasks by due date, earliest first; tasks without a
+// parseable due date sort after those with one
+func compareDueDates(a, b Task) int {
+	aTime, aOK := dueUnix(a)
+	bTime, bOK := dueUnix(b)
+
+	switch {
+	case aOK && bOK:
+		if aTime < bTime {
+			return -1
+		}
+		if aTime > bTime {
+			return 1
+		}
+		return 0
+	case aOK:
+		return -1
+	case bOK:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// sortTasksByPriorityAndDue sorts tasks in place by priority, then due date
+func sortTasksByPriorityAndDue(tasks []Task) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		pi, pj := priorityRank(tasks[i].Priority), priorityRank(tasks[j].Priority)
+		if pi != pj {
+			return pi < pj
+		}
+		return compareDueDates(tasks[i], tasks[j]) < 0
+	})
+}
+
+// sortTasks sorts tasks in place by a search_tasks sort key: due_date,
+// priority (High > Medium > Low), creation_date, task_name or status
+// (workflow order). order "desc" reverses the comparison, but tasks with no
+// due date, priority or creation date sort last either way.
+func sortTasks(tasks []Task, sortBy, order string) {
+	desc := order == "desc"
+	directed := func(cmp int) bool {
+		if desc {
+			return cmp > 0
+		}
+		return cmp < 0
+	}
+	// missingLast orders tasks lacking the key after those having it
+	missingLast := func(aOK, bOK bool, cmp func() int) bool {
+		if aOK != bOK {
+			return aOK
+		}
+		return aOK && directed(cmp())
+	}
+
+	sort.SliceStable(tasks, func(i, j int) bool {
+		a, b := tasks[i], tasks[j]
+		switch sortBy {
+		case "due_date":
+			aDue, aOK := dueUnix(a)
+			bDue, bOK := dueUnix(b)
+			return missingLast(aOK, bOK, func() int { return compareInt64(aDue, bDue) })
+		case "priority":
+			// Ranks run highest first, so ascending priority is descending rank
+			aRank, bRank := priorityRank(a.Priority), priorityRank(b.Priority)
+			return missingLast(aRank < 3, bRank < 3, func() int { return compareInt64(int64(bRank), int64(aRank)) })
+		case "creation_date":
+			aCreated, aOK := createdUnix(a)
+			bCreated, bOK := createdUnix(b)
+			return missingLast(aOK, bOK, func() int { return compareInt64(aCreated, bCreated) })
+		case "task_name":
+			return directed(strings.Compare(strings.ToLower(a.TaskName), strings.ToLower(b.TaskName)))
+		case "status":
+			if cmp := compareInt64(int64(statusRank(a.Status)), int64(statusRank(b.Status))); cmp != 0 {
+				return directed(cmp)
+			}
+			return directed(strings.Compare(a.Status, b.Status))
+		}
+		return false
+	})
+}
+
+// createdUnix returns the task's creation date as a unix timestamp, if parseable
+func createdUnix(task Task) (int64, bool) {
+	parsed, err := parseDueDate(task.CreationDate)
+	if err != nil || parsed == nil {
+		return 0, false
+	}
+	return parsed.Unix(), true
+}
+
+// compareInt64 returns -1, 0 or 1 as a is less than, equal to or greater than b
+func compareInt64(a, b int64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// orderedStatuses returns the canonical statuses followed by any other
+// statuses present in the grouping, sorted alphabetically
+func orderedStatuses(present map[string][]Task) []string {
+	ordered := append([]string{}, canonicalStatuses...)
+	extra := []string{}
+	for status := range present {
+		if statusRank(status) == len(canonicalStatuses) {
+			extra = append(extra, status)
+		}
+	}
+	sort.Strings(extra)
+	return append(ordered, extra...)
+}
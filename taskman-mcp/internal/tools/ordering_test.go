@@ -0,0 +1,83 @@
+package tools
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSortTasksByPriorityAndDue(t *testing.T) {
+	tasks := []Task{
+		{TaskID: "low", Priority: stringPtr("Low"), DueDate: stringPtr("2024-01-01T00:00:00Z")},
+		{TaskID: "none"},
+		{TaskID: "high-late", Priority: stringPtr("High"), DueDate: stringPtr("2024-03-01T00:00:00Z")},
+		{TaskID: "high-nodue", Priority: stringPtr("High")},
+		{TaskID: "high-early", Priority: stringPtr("High"), DueDate: stringPtr("2024-02-01")},
+		{TaskID: "medium", Priority: stringPtr("Medium")},
+	}
+
+	sortTasksByPriorityAndDue(tasks)
+
+	got := []string{}
+	for _, task := range tasks {
+		got = append(got, task.TaskID)
+	}
+	expected := []string{"high-early", "high-late", "high-nodue", "medium", "low", "none"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected order %v, got %v", expected, got)
+	}
+}
+
+func TestOrderedStatuses(t *testing.T) {
+	present := map[string][]Task{
+		"Review":  {},
+		"Waiting": {},
+		"Archive": {},
+	}
+
+	got := orderedStatuses(present)
+	expected := []string{"Not Started", "In Progress", "Blocked", "Review", "Complete", "Archive", "Waiting"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v, got %v", expected, got)
+	}
+}
+
+func TestSortTasks(t *testing.T) {
+	tasks := []Task{
+		{TaskID: "b", TaskName: "beta", Status: "Review", Priority: stringPtr("Low"), DueDate: stringPtr("2024-03-01"), CreationDate: "2024-01-02T00:00:00Z"},
+		{TaskID: "n", TaskName: "Nodue", Status: "Complete", CreationDate: "not a date"},
+		{TaskID: "a", TaskName: "Alpha", Status: "Not Started", Priority: stringPtr("High"), DueDate: stringPtr("2024-02-01"), CreationDate: "2024-01-03T00:00:00Z"},
+		{TaskID: "c", TaskName: "charlie", Status: "In Progress", Priority: stringPtr("Medium"), DueDate: stringPtr("2024-01-01T00:00:00Z"), CreationDate: "2024-01-01T00:00:00Z"},
+	}
+
+	tests := []struct {
+		sortBy string
+		order  string
+		want   []string
+	}{
+		{"due_date", "asc", []string{"c", "a", "b", "n"}},
+		{"due_date", "desc", []string{"b", "a", "c", "n"}},
+		{"priority", "asc", []string{"b", "c", "a", "n"}},
+		{"priority", "desc", []string{"a", "c", "b", "n"}},
+		{"creation_date", "", []string{"c", "b", "a", "n"}},
+		{"creation_date", "desc", []string{"a", "b", "c", "n"}},
+		{"task_name", "asc", []string{"a", "b", "c", "n"}},
+		{"task_name", "desc", []string{"n", "c", "b", "a"}},
+		{"status", "asc", []string{"a", "c", "b", "n"}},
+		{"status", "desc", []string{"n", "b", "c", "a"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.sortBy+"_"+tt.order, func(t *testing.T) {
+			sorted := append([]Task{}, tasks...)
+			sortTasks(sorted, tt.sortBy, tt.order)
+
+			got := []string{}
+			for _, task := range sorted {
+				got = append(got, task.TaskID)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Expected order %v, got %v", tt.want, got)
+			}
+		})
+	}
+}
@@ -5,25 +5,58 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"math"
 	"net/url"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/bchamber/taskman-mcp/internal/client"
+	"github.com/bchamber/taskman-mcp/internal/clock"
+	"github.com/bchamber/taskman-mcp/internal/config"
+	"github.com/bchamber/taskman-mcp/internal/identity"
+	"github.com/bchamber/taskman-mcp/internal/render"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // ProjectTools handles project management MCP tools
 type ProjectTools struct {
-	apiClient *client.APIClient
+	apiClient  *client.APIClient
+	settings   *config.Store
+	priorities *render.PriorityRenderer
+	assignees  *identity.Normalizer
+	clock      clock.Clock
 }
 
 // NewProjectTools creates a new project tools handler
-func NewProjectTools(apiClient *client.APIClient) *ProjectTools {
+func NewProjectTools(apiClient *client.APIClient, cfg *config.Config) *ProjectTools {
+	if cfg == nil {
+		cfg = config.Default()
+	}
 	return &ProjectTools{
-		apiClient: apiClient,
+		apiClient:  apiClient,
+		settings:   config.NewStore(cfg),
+		priorities: render.NewPriorityRendererFromConfig(cfg),
+		assignees:  identity.NewNormalizerFromConfig(cfg),
+		clock:      clock.Default,
 	}
 }
 
+// SetClock replaces the clock used for date calculations and timestamps
+func (p *ProjectTools) SetClock(c clock.Clock) {
+	p.clock = c
+}
+
+// SetConfigStore reads settings from store, so reloads published there apply
+func (p *ProjectTools) SetConfigStore(store *config.Store) {
+	p.settings = store
+}
+
+// config returns the configuration in effect
+func (p *ProjectTools) config() *config.Config {
+	return p.settings.Current()
+}
+
 // GetProjectStatusParams defines input for get_project_status tool
 type GetProjectStatusParams struct {
 	ProjectID string `json:"project_id"`
@@ -34,7 +67,10 @@ type CreateProjectWithInitialTasksParams struct {
 	ProjectName        string            `json:"project_name"`
 	ProjectDescription string            `json:"project_description,omitempty"`
 	CreatedBy          string            `json:"created_by"`
+	DefaultAssignee    string            `json:"default_assignee,omitempty"`
 	InitialTasks       []InitialTaskSpec `json:"initial_tasks"`
+	SkipIfExists       bool              `json:"skip_if_exists,omitempty"`
+	DryRun             bool              `json:"dry_run,omitempty"` // validate and preview without creating anything
 }
 
 // InitialTaskSpec defines a task to be created with the project
@@ -55,6 +91,8 @@ func (p *ProjectTools) HandleGetProjectStatus(
 ) (*mcp.CallToolResultFor[map[string]any], error) {
 	slog.Info("Executing get_project_status tool", "params", params.Arguments)
 
+	cfg := p.config()
+
 	// Validate required fields
 	if params.Arguments.ProjectID == "" {
 		return nil, fmt.Errorf("project_id is required")
@@ -95,6 +133,7 @@ func (p *ProjectTools) HandleGetProjectStatus(
 
 	totalTasks := len(tasks)
 
+	now := p.clock.Now()
 	for _, task := range tasks {
 		// Count by status
 		statusCounts[task.Status]++
@@ -115,7 +154,7 @@ func (p *ProjectTools) HandleGetProjectStatus(
 		}
 
 		// Check if overdue
-		if isTaskOverdue(task) {
+		if isTaskOverdue(task, now) {
 			overdueTasks = append(overdueTasks, task)
 		}
 	}
@@ -131,26 +170,31 @@ func (p *ProjectTools) HandleGetProjectStatus(
 	var insights []string
 
 	if len(overdueTasks) > 0 {
-		insights = append(insights, fmt.Sprintf("⚠️ %d tasks are overdue and need attention", len(overdueTasks)))
+		insights = appendInsight(cfg, insights, insightProjectOverdue, fmt.Sprintf("⚠️ %d tasks are overdue and need attention", len(overdueTasks)))
 	}
 
 	if completionPercentage >= 90 {
-		insights = append(insights, "🎉 Project is nearly complete!")
+		insights = appendInsight(cfg, insights, insightProjectNearlyComplete, "🎉 Project is nearly complete!")
 	} else if completionPercentage >= 75 {
-		insights = append(insights, "📈 Project is in final stretch")
+		insights = appendInsight(cfg, insights, insightProjectFinalStretch, "📈 Project is in final stretch")
 	} else if completionPercentage >= 50 {
-		insights = append(insights, "🔄 Project is halfway complete")
+		insights = appendInsight(cfg, insights, insightProjectHalfway, "🔄 Project is halfway complete")
 	} else if completionPercentage < 25 && totalTasks > 0 {
-		insights = append(insights, "🚀 Project is in early stages")
+		insights = appendInsight(cfg, insights, insightProjectEarlyStages, "🚀 Project is in early stages")
 	}
 
 	if len(activeTasks) > totalTasks/2 && totalTasks > 0 {
-		insights = append(insights, "🔥 High activity - many tasks in progress")
+		insights = appendInsight(cfg, insights, insightProjectHighActivity, "🔥 High activity - many tasks in progress")
 	}
 
 	notStartedCount := statusCounts["Not Started"]
 	if notStartedCount > len(activeTasks) && totalTasks > 3 {
-		insights = append(insights, "📋 Consider starting more tasks to increase momentum")
+		insights = appendInsight(cfg, insights, insightProjectStartMore, "📋 Consider starting more tasks to increase momentum")
+	}
+
+	clusters := dueDateClusters(tasks, cfg.DueDateClusterThreshold)
+	if len(clusters) > 0 {
+		insights = appendInsight(cfg, insights, insightProjectDueDateCluster, dueDateClusterInsight(clusters))
 	}
 
 	// Generate next actions
@@ -181,6 +225,7 @@ func (p *ProjectTools) HandleGetProjectStatus(
 	// Build comprehensive response
 	result := map[string]any{
 		"project":               project,
+		"default_assignee":      projectDefaultAssignee(cfg, project),
 		"tasks":                 tasks,
 		"total_tasks":           totalTasks,
 		"completion_percentage": completionPercentage,
@@ -190,6 +235,7 @@ func (p *ProjectTools) HandleGetProjectStatus(
 		"overdue_tasks":         overdueTasks,
 		"active_tasks":          activeTasks,
 		"completed_tasks":       completedTasks,
+		"due_date_clusters":     clusters,
 		"insights":              insights,
 		"next_actions":          nextActions,
 	}
@@ -205,6 +251,10 @@ func (p *ProjectTools) HandleGetProjectStatus(
 	responseText += fmt.Sprintf("\nCreated by: %s\nCreated: %s\n",
 		project.CreatedBy, project.CreationDate)
 
+	if defaultAssignee := projectDefaultAssignee(cfg, project); defaultAssignee != "" {
+		responseText += fmt.Sprintf("Default assignee: %s\n", defaultAssignee)
+	}
+
 	responseText += fmt.Sprintf("\n📊 Project Metrics:\n")
 	responseText += fmt.Sprintf("Total Tasks: %d\n", totalTasks)
 	responseText += fmt.Sprintf("Completion: %.1f%%\n", completionPercentage)
@@ -278,17 +328,46 @@ func (p *ProjectTools) HandleCreateProjectWithInitialTasks(
 ) (*mcp.CallToolResultFor[map[string]any], error) {
 	slog.Info("Executing create_project_with_initial_tasks tool", "params", params.Arguments)
 
+	cfg := p.config()
+
+	// Many API calls share one budget
+	ctx, cancel := client.WithTimeout(ctx, cfg.MultiCallTimeout)
+	defer cancel()
+
 	// Validate required fields
 	if params.Arguments.ProjectName == "" {
 		return nil, fmt.Errorf("project_name is required")
 	}
-	if params.Arguments.CreatedBy == "" {
-		return nil, fmt.Errorf("created_by is required")
+	createdBy, err := resolveActor(cfg, params.Arguments.CreatedBy, "created_by")
+	if err != nil {
+		return nil, err
 	}
+	params.Arguments.CreatedBy = createdBy
 	if len(params.Arguments.InitialTasks) == 0 {
 		return nil, fmt.Errorf("initial_tasks are required (at least one task)")
 	}
 
+	// Enforce the configured cap on initial tasks
+	initialTasks, capWarning, err := capInitialTasks(cfg, params.Arguments.InitialTasks, "initial_tasks")
+	if err != nil {
+		return nil, err
+	}
+
+	// Return the existing project instead of creating a duplicate if requested
+	var existing *Project
+	if params.Arguments.SkipIfExists {
+		existing, err = p.findProjectByName(ctx, params.Arguments.ProjectName)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if params.Arguments.DryRun {
+		return p.buildDryRunProjectResult(cfg, params.Arguments, initialTasks, capWarning, existing), nil
+	}
+	if existing != nil {
+		return buildExistingProjectResult(*existing, len(params.Arguments.InitialTasks)), nil
+	}
+
 	// Build project creation request
 	projectRequest := map[string]interface{}{
 		"project_name": params.Arguments.ProjectName,
@@ -298,6 +377,9 @@ func (p *ProjectTools) HandleCreateProjectWithInitialTasks(
 	if params.Arguments.ProjectDescription != "" {
 		projectRequest["project_description"] = params.Arguments.ProjectDescription
 	}
+	if params.Arguments.DefaultAssignee != "" {
+		projectRequest["default_assignee"] = params.Arguments.DefaultAssignee
+	}
 
 	// Create the project
 	projectResp, err := p.apiClient.Post(ctx, "/api/v1/projects", projectRequest)
@@ -312,68 +394,29 @@ func (p *ProjectTools) HandleCreateProjectWithInitialTasks(
 		return nil, fmt.Errorf("failed to parse created project: %w", err)
 	}
 
-	// Create initial tasks
-	var createdTasks []Task
-	var failedTasks []InitialTaskSpec
-
-	for _, taskSpec := range params.Arguments.InitialTasks {
-		taskRequest := map[string]interface{}{
-			"task_name":  taskSpec.TaskName,
-			"project_id": createdProject.ProjectID,
-			"created_by": params.Arguments.CreatedBy,
-		}
-
-		if taskSpec.TaskDescription != "" {
-			taskRequest["task_description"] = taskSpec.TaskDescription
-		}
-		if taskSpec.Status != "" {
-			taskRequest["status"] = taskSpec.Status
-		} else {
-			taskRequest["status"] = "Not Started"
-		}
-		if taskSpec.Priority != "" {
-			taskRequest["priority"] = taskSpec.Priority
-		}
-		if taskSpec.AssignedTo != "" {
-			taskRequest["assigned_to"] = taskSpec.AssignedTo
-		}
-		if taskSpec.DueDate != "" {
-			// Validate and format due date
-			if dueDate, err := parseDueDate(taskSpec.DueDate); err == nil && dueDate != nil {
-				taskRequest["due_date"] = dueDate.Format(time.RFC3339)
-			} else {
-				slog.Warn("Failed to parse due date for task", "task_name", taskSpec.TaskName, "due_date", taskSpec.DueDate, "error", err)
-			}
-		}
-
-		taskResp, err := p.apiClient.Post(ctx, "/api/v1/tasks", taskRequest)
-		if err != nil {
-			slog.Error("Failed to create task", "error", err, "task_name", taskSpec.TaskName)
-			failedTasks = append(failedTasks, taskSpec)
-			continue
-		}
-
-		var createdTask Task
-		if err := json.Unmarshal(taskResp, &createdTask); err != nil {
-			slog.Error("Failed to parse created task", "error", err, "task_name", taskSpec.TaskName)
-			failedTasks = append(failedTasks, taskSpec)
-			continue
-		}
-
-		createdTasks = append(createdTasks, createdTask)
+	// Unassigned initial tasks go to the project's default assignee
+	if createdProject.DefaultAssignee == nil && params.Arguments.DefaultAssignee != "" {
+		createdProject.DefaultAssignee = &params.Arguments.DefaultAssignee
 	}
 
+	// Create initial tasks
+	createdTasks, failedTasks, _ := p.createTasksInProject(ctx, cfg, createdProject, initialTasks, params.Arguments.CreatedBy)
+
 	// Analyze task creation results
 	var insights []string
 
 	if len(failedTasks) == 0 {
-		insights = append(insights, "✅ All initial tasks created successfully")
+		insights = appendInsight(cfg, insights, insightNewProjectAllCreated, "✅ All initial tasks created successfully")
 	} else {
-		insights = append(insights, fmt.Sprintf("⚠️ %d tasks failed to create", len(failedTasks)))
+		insights = appendInsight(cfg, insights, insightNewProjectFailed, fmt.Sprintf("⚠️ %d tasks failed to create", len(failedTasks)))
 	}
 
 	if len(createdTasks) > 5 {
-		insights = append(insights, "📋 Large project with many initial tasks")
+		insights = appendInsight(cfg, insights, insightNewProjectManyTasks, "📋 Large project with many initial tasks")
+	}
+
+	if capWarning != "" {
+		insights = appendInsight(cfg, insights, insightNewProjectTasksCapped, capWarning)
 	}
 
 	// Count task priorities and assignments
@@ -390,14 +433,14 @@ func (p *ProjectTools) HandleCreateProjectWithInitialTasks(
 	}
 
 	if assignedCount == 0 {
-		insights = append(insights, "👤 No tasks assigned yet - consider assigning team members")
+		insights = appendInsight(cfg, insights, insightNewProjectUnassigned, "👤 No tasks assigned yet - consider assigning team members")
 	} else if assignedCount == len(createdTasks) {
-		insights = append(insights, "👥 All tasks have been assigned")
+		insights = appendInsight(cfg, insights, insightNewProjectAllAssigned, "👥 All tasks have been assigned")
 	}
 
 	highPriorityCount := priorityCounts["High"]
 	if highPriorityCount > len(createdTasks)/2 {
-		insights = append(insights, "🔥 Many high-priority tasks - ensure adequate resources")
+		insights = appendInsight(cfg, insights, insightNewProjectMostlyHigh, "🔥 Many high-priority tasks - ensure adequate resources")
 	}
 
 	// Generate next steps
@@ -410,7 +453,7 @@ func (p *ProjectTools) HandleCreateProjectWithInitialTasks(
 	}
 
 	if len(failedTasks) > 0 {
-		nextSteps = append(nextSteps, "🔄 Retry creating failed tasks manually")
+		nextSteps = append(nextSteps, "🔄 Retry failed tasks with resume_project_tasks")
 	}
 
 	nextSteps = append(nextSteps, "📅 Review and adjust task due dates as needed")
@@ -422,12 +465,14 @@ func (p *ProjectTools) HandleCreateProjectWithInitialTasks(
 		"project":       createdProject,
 		"created_tasks": createdTasks,
 		"failed_tasks":  failedTasks,
-		"total_planned": len(params.Arguments.InitialTasks),
+		"total_planned": len(initialTasks),
 		"total_created": len(createdTasks),
 		"total_failed":  len(failedTasks),
-		"success_rate":  float64(len(createdTasks)) / float64(len(params.Arguments.InitialTasks)) * 100,
+		"success_rate":  float64(len(createdTasks)) / float64(len(initialTasks)) * 100,
 		"insights":      insights,
 		"next_steps":    nextSteps,
+		"truncated":     capWarning != "",
+		"max_tasks":     cfg.MaxInitialTasks,
 	}
 
 	// Build response text
@@ -441,12 +486,12 @@ func (p *ProjectTools) HandleCreateProjectWithInitialTasks(
 	responseText += fmt.Sprintf("Created by: %s\n", createdProject.CreatedBy)
 
 	responseText += fmt.Sprintf("\n📊 Task Creation Summary:\n")
-	responseText += fmt.Sprintf("Planned: %d tasks\n", len(params.Arguments.InitialTasks))
+	responseText += fmt.Sprintf("Planned: %d tasks\n", len(initialTasks))
 	responseText += fmt.Sprintf("Created: %d tasks\n", len(createdTasks))
 
 	if len(failedTasks) > 0 {
 		responseText += fmt.Sprintf("Failed: %d tasks\n", len(failedTasks))
-		successRate := float64(len(createdTasks)) / float64(len(params.Arguments.InitialTasks)) * 100
+		successRate := float64(len(createdTasks)) / float64(len(initialTasks)) * 100
 		responseText += fmt.Sprintf("Success Rate: %.1f%%\n", successRate)
 	}
 
@@ -454,10 +499,7 @@ func (p *ProjectTools) HandleCreateProjectWithInitialTasks(
 		responseText += fmt.Sprintf("\n✅ Created Tasks:\n")
 		for _, task := range createdTasks {
 			status := task.Status
-			priority := "None"
-			if task.Priority != nil {
-				priority = *task.Priority
-			}
+			priority := p.priorities.Render(task.Priority)
 			assignee := "Unassigned"
 			if task.AssignedTo != nil && *task.AssignedTo != "" {
 				assignee = *task.AssignedTo
@@ -499,6 +541,271 @@ func (p *ProjectTools) HandleCreateProjectWithInitialTasks(
 	}, nil
 }
 
+// capInitialTasks enforces the configured maximum number of tasks created in
+// one call, either refusing the request or truncating it with a warning
+func capInitialTasks(cfg *config.Config, specs []InitialTaskSpec, field string) ([]InitialTaskSpec, string, error) {
+	maxTasks := cfg.MaxInitialTasks
+	if maxTasks <= 0 || len(specs) <= maxTasks {
+		return specs, "", nil
+	}
+	if cfg.InitialTasksOverflow != "truncate" {
+		return nil, "", fmt.Errorf("too many %s: %d requested, maximum is %d", field, len(specs), maxTasks)
+	}
+	warning := fmt.Sprintf("⚠️ %d tasks requested but the maximum is %d - only the first %d were created",
+		len(specs), maxTasks, maxTasks)
+	slog.Warn("Truncating tasks to configured maximum", "field", field, "requested", len(specs), "max_initial_tasks", maxTasks)
+	return specs[:maxTasks], warning, nil
+}
+
+// validateInitialTaskSpec checks a task spec the way creating it would,
+// returning the spec with its due date normalized to RFC3339
+func validateInitialTaskSpec(taskSpec InitialTaskSpec) (InitialTaskSpec, error) {
+	if strings.TrimSpace(taskSpec.TaskName) == "" {
+		return taskSpec, fmt.Errorf("task_name is required")
+	}
+	if taskSpec.Status != "" {
+		if err := validateStatus(taskSpec.Status); err != nil {
+			return taskSpec, err
+		}
+	}
+	if taskSpec.Priority != "" {
+		if err := validatePriority(taskSpec.Priority); err != nil {
+			return taskSpec, err
+		}
+	}
+	dueDate, err := parseDueDate(taskSpec.DueDate)
+	if err != nil {
+		return taskSpec, fmt.Errorf("invalid due_date: %w", err)
+	}
+	if dueDate != nil {
+		taskSpec.DueDate = dueDate.Format(time.RFC3339)
+	}
+	return taskSpec, nil
+}
+
+// buildDryRunProjectResult previews create_project_with_initial_tasks: the
+// project and task specs that would be sent, with every problem a real run
+// would hit. existing is the project skip_if_exists would return instead, if any.
+func (p *ProjectTools) buildDryRunProjectResult(cfg *config.Config, args CreateProjectWithInitialTasksParams, initialTasks []InitialTaskSpec, capWarning string, existing *Project) *mcp.CallToolResultFor[map[string]any] {
+	project := Project{ProjectName: args.ProjectName, CreatedBy: args.CreatedBy}
+	if args.ProjectDescription != "" {
+		project.ProjectDescription = &args.ProjectDescription
+	}
+	if args.DefaultAssignee != "" {
+		project.DefaultAssignee = &args.DefaultAssignee
+	}
+	defaultAssignee := projectDefaultAssignee(cfg, project)
+
+	taskSpecs := make([]InitialTaskSpec, 0, len(initialTasks))
+	invalidTasks := []taskCreationResult{}
+	for _, taskSpec := range initialTasks {
+		if taskSpec.AssignedTo == "" {
+			taskSpec.AssignedTo = defaultAssignee
+		}
+		validated, err := validateInitialTaskSpec(taskSpec)
+		if err != nil {
+			invalidTasks = append(invalidTasks, taskCreationResult{TaskName: taskSpec.TaskName, Error: err.Error()})
+		}
+		taskSpecs = append(taskSpecs, validated)
+	}
+
+	result := map[string]any{
+		"dry_run":       true,
+		"project":       project,
+		"task_specs":    taskSpecs,
+		"invalid_tasks": invalidTasks,
+		"total_planned": len(taskSpecs),
+		"total_valid":   len(taskSpecs) - len(invalidTasks),
+		"total_invalid": len(invalidTasks),
+		"truncated":     capWarning != "",
+		"max_tasks":     cfg.MaxInitialTasks,
+	}
+	if existing != nil {
+		result["existing_project"] = *existing
+	}
+
+	responseText := "DRY RUN — nothing was created\n=============================\n\n"
+	responseText += fmt.Sprintf("Project: %s\n", project.ProjectName)
+	if args.ProjectDescription != "" {
+		responseText += fmt.Sprintf("Description: %s\n", args.ProjectDescription)
+	}
+	responseText += fmt.Sprintf("Created by: %s\n", project.CreatedBy)
+	if existing != nil {
+		responseText += fmt.Sprintf("\nℹ️ A project with this name already exists (%s); with skip_if_exists a real run would create nothing\n", existing.ProjectID)
+	}
+	if capWarning != "" {
+		responseText += capWarning + "\n"
+	}
+
+	responseText += fmt.Sprintf("\n📋 Tasks that would be created (%d):\n", len(taskSpecs))
+	for _, taskSpec := range taskSpecs {
+		status := taskSpec.Status
+		if status == "" {
+			status = "Not Started"
+		}
+		assignee := taskSpec.AssignedTo
+		if assignee == "" {
+			assignee = "Unassigned"
+		}
+		priority := p.priorities.Render(nil)
+		if taskSpec.Priority != "" {
+			priority = p.priorities.Render(&taskSpec.Priority)
+		}
+		responseText += fmt.Sprintf("- %s (%s, %s) - %s\n", taskSpec.TaskName, status, priority, assignee)
+	}
+
+	if len(invalidTasks) > 0 {
+		responseText += fmt.Sprintf("\n❌ %d tasks would fail:\n", len(invalidTasks))
+		for _, invalid := range invalidTasks {
+			responseText += fmt.Sprintf("- %s: %s\n", invalid.TaskName, invalid.Error)
+		}
+	} else {
+		responseText += "\n✅ All tasks are valid\n"
+	}
+
+	slog.Info("Project creation dry run", "project_name", project.ProjectName, "tasks_planned", len(taskSpecs), "tasks_invalid", len(invalidTasks))
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}
+}
+
+// createTasksInProject creates each spec as a task in project, in order,
+// giving unassigned tasks the project's default assignee. Failed specs are
+// returned as sent, default assignee included; results has one entry per
+// spec.
+func (p *ProjectTools) createTasksInProject(ctx context.Context, cfg *config.Config, project Project, specs []InitialTaskSpec, createdBy string) ([]Task, []InitialTaskSpec, []taskCreationResult) {
+	defaultAssignee := projectDefaultAssignee(cfg, project)
+
+	createdTasks := []Task{}
+	failedTasks := []InitialTaskSpec{}
+	results := make([]taskCreationResult, 0, len(specs))
+
+	for _, taskSpec := range specs {
+		if taskSpec.AssignedTo == "" && defaultAssignee != "" {
+			taskSpec.AssignedTo = defaultAssignee
+			slog.Info("Applied project default assignee", "project_id", project.ProjectID, "task_name", taskSpec.TaskName, "assigned_to", defaultAssignee)
+		}
+
+		createdTask, err := p.createInitialTask(ctx, taskSpec, project.ProjectID, createdBy)
+		if err != nil {
+			failedTasks = append(failedTasks, taskSpec)
+			results = append(results, taskCreationResult{TaskName: taskSpec.TaskName, Error: err.Error()})
+			continue
+		}
+		createdTasks = append(createdTasks, createdTask)
+		results = append(results, taskCreationResult{TaskName: taskSpec.TaskName, Success: true, TaskID: createdTask.TaskID})
+	}
+	return createdTasks, failedTasks, results
+}
+
+// createInitialTask creates one task from an initial task spec in a project.
+// An invalid status, priority or due date fails the task rather than being
+// sent to the API or silently dropped.
+func (p *ProjectTools) createInitialTask(ctx context.Context, taskSpec InitialTaskSpec, projectID, createdBy string) (Task, error) {
+	taskSpec, err := validateInitialTaskSpec(taskSpec)
+	if err != nil {
+		slog.Warn("Invalid initial task", "task_name", taskSpec.TaskName, "error", err)
+		return Task{}, err
+	}
+
+	taskRequest := map[string]interface{}{
+		"task_name":  taskSpec.TaskName,
+		"project_id": projectID,
+		"created_by": createdBy,
+	}
+
+	if taskSpec.TaskDescription != "" {
+		taskRequest["task_description"] = taskSpec.TaskDescription
+	}
+	if taskSpec.Status != "" {
+		taskRequest["status"] = taskSpec.Status
+	} else {
+		taskRequest["status"] = "Not Started"
+	}
+	if taskSpec.Priority != "" {
+		taskRequest["priority"] = taskSpec.Priority
+	}
+	if taskSpec.AssignedTo != "" {
+		taskRequest["assigned_to"] = taskSpec.AssignedTo
+	}
+	if taskSpec.DueDate != "" {
+		taskRequest["due_date"] = taskSpec.DueDate
+	}
+
+	taskResp, err := p.apiClient.Post(ctx, "/api/v1/tasks", taskRequest)
+	if err != nil {
+		slog.Error("Failed to create task", "error", err, "task_name", taskSpec.TaskName)
+		return Task{}, err
+	}
+
+	var createdTask Task
+	if err := json.Unmarshal(taskResp, &createdTask); err != nil {
+		slog.Error("Failed to parse created task", "error", err, "task_name", taskSpec.TaskName)
+		return Task{}, err
+	}
+	return createdTask, nil
+}
+
+// findProjectByName looks up a project by name (case-insensitive), returning nil if none matches
+func (p *ProjectTools) findProjectByName(ctx context.Context, name string) (*Project, error) {
+	projectsResp, err := p.apiClient.Get(ctx, "/api/v1/projects")
+	if err != nil {
+		slog.Error("Failed to get projects", "error", err)
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+
+	var projects []Project
+	if err := json.Unmarshal(projectsResp, &projects); err != nil {
+		slog.Error("Failed to parse projects", "error", err)
+		return nil, fmt.Errorf("failed to parse projects: %w", err)
+	}
+
+	for _, project := range projects {
+		if strings.EqualFold(strings.TrimSpace(project.ProjectName), strings.TrimSpace(name)) {
+			return &project, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// buildExistingProjectResult builds the no-op response returned when skip_if_exists finds a project
+func buildExistingProjectResult(project Project, plannedCount int) *mcp.CallToolResultFor[map[string]any] {
+	result := map[string]any{
+		"project":       project,
+		"skipped":       true,
+		"created_tasks": []Task{},
+		"failed_tasks":  []InitialTaskSpec{},
+		"total_planned": plannedCount,
+		"total_created": 0,
+		"total_failed":  0,
+		"insights":      []string{"ℹ️ A project with this name already exists - nothing was created"},
+	}
+
+	responseText := fmt.Sprintf("Project Already Exists (no-op)\n==============================\n\nProject: %s\nID: %s\n",
+		project.ProjectName, project.ProjectID)
+	responseText += fmt.Sprintf("Created by: %s\n", project.CreatedBy)
+	responseText += "\nℹ️ skip_if_exists was set and a project with this name already exists.\n"
+	responseText += fmt.Sprintf("No project or tasks were created (%d initial tasks skipped).\n", plannedCount)
+
+	slog.Info("Project creation skipped, project already exists", "project_id", project.ProjectID, "project_name", project.ProjectName)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}
+}
+
 // GetAllProjectsParams defines input for get_all_projects tool
 type GetAllProjectsParams struct {
 	// No parameters needed for listing all projects
@@ -512,8 +819,8 @@ func (p *ProjectTools) HandleGetAllProjects(
 ) (*mcp.CallToolResultFor[map[string]any], error) {
 	slog.Info("Executing get_all_projects tool")
 
-	// Get all projects from API
-	projectsResp, err := p.apiClient.Get(ctx, "/api/v1/projects")
+	// Get all projects from API, following pagination up to the page cap
+	projectsResp, truncated, err := p.apiClient.GetList(ctx, "/api/v1/projects")
 	if err != nil {
 		slog.Error("Failed to get projects", "error", err)
 		return nil, fmt.Errorf("failed to get projects: %w", err)
@@ -544,11 +851,15 @@ func (p *ProjectTools) HandleGetAllProjects(
 			responseText += "\n"
 		}
 	}
+	if truncated {
+		responseText += "⚠️  The API has more pages of projects than the configured page cap; only the pages fetched are listed.\n"
+	}
 
 	result := map[string]any{
 		"projects":      projects,
 		"total_count":   len(projects),
 		"project_list":  projects,
+		"more_pages":    truncated,
 	}
 
 	slog.Info("Projects list retrieved", "total_projects", len(projects))
@@ -562,3 +873,1934 @@ func (p *ProjectTools) HandleGetAllProjects(
 		Meta: result,
 	}, nil
 }
+
+// GetCrossProjectDependenciesParams defines input for get_cross_project_dependencies tool
+type GetCrossProjectDependenciesParams struct {
+	ProjectID string `json:"project_id"`
+}
+
+// HandleGetCrossProjectDependencies implements the get_cross_project_dependencies tool
+func (p *ProjectTools) HandleGetCrossProjectDependencies(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetCrossProjectDependenciesParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_cross_project_dependencies tool", "params", params.Arguments)
+
+	// Validate required fields
+	if params.Arguments.ProjectID == "" {
+		return nil, fmt.Errorf("project_id is required")
+	}
+	projectID := params.Arguments.ProjectID
+
+	// Get all projects to resolve names
+	projectsResp, err := p.apiClient.Get(ctx, "/api/v1/projects")
+	if err != nil {
+		slog.Error("Failed to get projects", "error", err)
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+
+	var projects []Project
+	if err := json.Unmarshal(projectsResp, &projects); err != nil {
+		slog.Error("Failed to parse projects", "error", err)
+		return nil, fmt.Errorf("failed to parse projects: %w", err)
+	}
+
+	projectNames := make(map[string]string, len(projects))
+	for _, project := range projects {
+		projectNames[project.ProjectID] = project.ProjectName
+	}
+	if _, ok := projectNames[projectID]; !ok {
+		return nil, fmt.Errorf("project %s not found", projectID)
+	}
+
+	// Get all tasks so dependencies across projects can be resolved
+	tasksResp, err := p.apiClient.Get(ctx, "/api/v1/tasks")
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+
+	// Collect edges that cross the project boundary, grouped by the other project
+	linksByProject := make(map[string][]map[string]any)
+	externalOrder := []string{}
+	dependsOnCount, dependedOnByCount := 0, 0
+
+	for _, edge := range buildDependencyEdges(tasks) {
+		taskProject := taskProjectID(edge.Task)
+		blockerProject := taskProjectID(edge.Blocker)
+		if taskProject == blockerProject {
+			continue
+		}
+
+		var local, external Task
+		var direction, externalProject string
+		switch projectID {
+		case taskProject:
+			local, external = edge.Task, edge.Blocker
+			direction, externalProject = "depends_on", blockerProject
+			dependsOnCount++
+		case blockerProject:
+			local, external = edge.Blocker, edge.Task
+			direction, externalProject = "depended_on_by", taskProject
+			dependedOnByCount++
+		default:
+			continue
+		}
+
+		if _, seen := linksByProject[externalProject]; !seen {
+			externalOrder = append(externalOrder, externalProject)
+		}
+		linksByProject[externalProject] = append(linksByProject[externalProject], map[string]any{
+			"direction":            direction,
+			"task_id":              local.TaskID,
+			"task_name":            local.TaskName,
+			"task_status":          local.Status,
+			"external_task_id":     external.TaskID,
+			"external_task_name":   external.TaskName,
+			"external_task_status": external.Status,
+		})
+	}
+
+	externalProjects := []map[string]any{}
+	for _, externalID := range externalOrder {
+		name := projectNames[externalID]
+		if externalID == "" {
+			name = "No Project"
+		} else if name == "" {
+			name = externalID
+		}
+		externalProjects = append(externalProjects, map[string]any{
+			"project_id":   externalID,
+			"project_name": name,
+			"links":        linksByProject[externalID],
+		})
+	}
+
+	result := map[string]any{
+		"project_id":           projectID,
+		"project_name":         projectNames[projectID],
+		"external_projects":    externalProjects,
+		"depends_on_count":     dependsOnCount,
+		"depended_on_by_count": dependedOnByCount,
+		"total_links":          dependsOnCount + dependedOnByCount,
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Cross-Project Dependencies\n==========================\n\nProject: %s\nID: %s\n", projectNames[projectID], projectID)
+
+	if len(externalProjects) == 0 {
+		responseText += "\n✅ No dependencies on other projects\n"
+	} else {
+		responseText += fmt.Sprintf("Depends on other projects: %d\nDepended on by other projects: %d\n", dependsOnCount, dependedOnByCount)
+		for _, external := range externalProjects {
+			links := external["links"].([]map[string]any)
+			responseText += fmt.Sprintf("\n🔗 %s (%d links):\n", external["project_name"], len(links))
+			for _, link := range links {
+				if link["direction"] == "depends_on" {
+					responseText += fmt.Sprintf("- %s is blocked by %s (%s)\n", link["task_name"], link["external_task_name"], link["external_task_status"])
+				} else {
+					responseText += fmt.Sprintf("- %s blocks %s (%s)\n", link["task_name"], link["external_task_name"], link["external_task_status"])
+				}
+			}
+		}
+		responseText += "\n💡 Insights:\n"
+		responseText += fmt.Sprintf("- Coordinate with %d other project(s) on shared work\n", len(externalProjects))
+	}
+
+	slog.Info("Cross-project dependencies retrieved", "project_id", projectID, "external_projects", len(externalProjects), "total_links", dependsOnCount+dependedOnByCount)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetProjectContributorsParams defines input for get_project_contributors tool
+type GetProjectContributorsParams struct {
+	ProjectID string `json:"project_id"`
+}
+
+// projectContributor accumulates one person's involvement in a project
+type projectContributor struct {
+	name     string
+	assigned int
+	created  int
+	notes    int
+	tasks    map[string]bool
+}
+
+// HandleGetProjectContributors implements the get_project_contributors tool
+func (p *ProjectTools) HandleGetProjectContributors(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetProjectContributorsParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_project_contributors tool", "params", params.Arguments)
+
+	// Validate required fields
+	if params.Arguments.ProjectID == "" {
+		return nil, fmt.Errorf("project_id is required")
+	}
+
+	// Get project tasks
+	tasksResp, err := p.apiClient.Get(ctx, fmt.Sprintf("/api/v1/projects/%s/tasks", url.PathEscape(params.Arguments.ProjectID)))
+	if err != nil {
+		slog.Error("Failed to get project tasks", "error", err, "project_id", params.Arguments.ProjectID)
+		return nil, fmt.Errorf("failed to get project tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse project tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse project tasks: %w", err)
+	}
+
+	notesByTask := fetchNotesForTasks(ctx, p.apiClient, tasks)
+
+	contributors := make(map[string]*projectContributor)
+	contributor := func(name string) *projectContributor {
+		name = p.assignees.Canonical(name)
+		if name == "" {
+			name = "Unknown"
+		}
+		c, ok := contributors[name]
+		if !ok {
+			c = &projectContributor{name: name, tasks: make(map[string]bool)}
+			contributors[name] = c
+		}
+		return c
+	}
+
+	unassignedTasks := 0
+	totalNotes := 0
+	for i, task := range tasks {
+		if task.AssignedTo != nil && *task.AssignedTo != "" {
+			c := contributor(*task.AssignedTo)
+			c.assigned++
+			c.tasks[task.TaskID] = true
+		} else {
+			unassignedTasks++
+		}
+
+		c := contributor(task.CreatedBy)
+		c.created++
+		c.tasks[task.TaskID] = true
+
+		for _, note := range notesByTask[i] {
+			c := contributor(note.CreatedBy)
+			c.notes++
+			c.tasks[task.TaskID] = true
+			totalNotes++
+		}
+	}
+
+	ranked := make([]*projectContributor, 0, len(contributors))
+	for _, c := range contributors {
+		ranked = append(ranked, c)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if len(ranked[i].tasks) != len(ranked[j].tasks) {
+			return len(ranked[i].tasks) > len(ranked[j].tasks)
+		}
+		if ranked[i].notes != ranked[j].notes {
+			return ranked[i].notes > ranked[j].notes
+		}
+		return ranked[i].name < ranked[j].name
+	})
+
+	contributorList := []map[string]any{}
+	for _, c := range ranked {
+		roles := []string{}
+		if c.assigned > 0 {
+			roles = append(roles, "assignee")
+		}
+		if c.created > 0 {
+			roles = append(roles, "creator")
+		}
+		if c.notes > 0 {
+			roles = append(roles, "commenter")
+		}
+
+		contributorList = append(contributorList, map[string]any{
+			"user_id":        c.name,
+			"roles":          roles,
+			"task_count":     len(c.tasks),
+			"assigned_tasks": c.assigned,
+			"created_tasks":  c.created,
+			"note_count":     c.notes,
+		})
+	}
+
+	result := map[string]any{
+		"project_id":        params.Arguments.ProjectID,
+		"contributors":      contributorList,
+		"contributor_count": len(contributorList),
+		"total_tasks":       len(tasks),
+		"total_notes":       totalNotes,
+		"unassigned_tasks":  unassignedTasks,
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Project Contributors\n====================\n\nProject ID: %s\nTasks: %d\nNotes: %d\n",
+		params.Arguments.ProjectID, len(tasks), totalNotes)
+	if unassignedTasks > 0 {
+		responseText += fmt.Sprintf("Unassigned tasks: %d\n", unassignedTasks)
+	}
+
+	if len(contributorList) == 0 {
+		responseText += "\n👥 No contributors found for this project\n"
+	} else {
+		responseText += fmt.Sprintf("\n👥 Contributors (%d):\n", len(contributorList))
+		for _, c := range contributorList {
+			responseText += fmt.Sprintf("- %s [%s]: %d tasks (%d assigned, %d created), %d notes\n",
+				c["user_id"], strings.Join(c["roles"].([]string), ", "), c["task_count"],
+				c["assigned_tasks"], c["created_tasks"], c["note_count"])
+		}
+	}
+
+	slog.Info("Project contributors aggregated", "project_id", params.Arguments.ProjectID, "contributors", len(contributorList))
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// SuggestReassignmentParams defines input for suggest_task_reassignment tool
+type SuggestReassignmentParams struct {
+	ProjectID string `json:"project_id" validate:"required"`
+}
+
+// HandleSuggestReassignment implements the suggest_task_reassignment tool.
+// It only suggests moves; nothing is reassigned.
+func (p *ProjectTools) HandleSuggestReassignment(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[SuggestReassignmentParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing suggest_task_reassignment tool", "params", params.Arguments)
+
+	// Validate required fields
+	if params.Arguments.ProjectID == "" {
+		return nil, fmt.Errorf("project_id is required")
+	}
+
+	// Get project tasks
+	tasksResp, err := p.apiClient.Get(ctx, fmt.Sprintf("/api/v1/projects/%s/tasks", url.PathEscape(params.Arguments.ProjectID)))
+	if err != nil {
+		slog.Error("Failed to get project tasks", "error", err, "project_id", params.Arguments.ProjectID)
+		return nil, fmt.Errorf("failed to get project tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse project tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse project tasks: %w", err)
+	}
+
+	plan := planRebalancing(tasks, p.assignees.Canonical)
+	suggestions := plan.Suggestions
+	if suggestions == nil {
+		suggestions = []reassignmentSuggestion{}
+	}
+
+	result := map[string]any{
+		"project_id":       params.Arguments.ProjectID,
+		"average_load":     plan.Average,
+		"before":           plan.Before,
+		"after":            plan.After,
+		"overloaded":       plan.Overloaded,
+		"underloaded":      plan.Underloaded,
+		"suggestions":      suggestions,
+		"suggestion_count": len(suggestions),
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Workload Rebalancing\n====================\n\nProject ID: %s\n", params.Arguments.ProjectID)
+
+	if len(plan.Before) == 0 {
+		responseText += "\n👥 No assigned tasks in this project - nothing to balance\n"
+	} else {
+		members := make([]string, 0, len(plan.Before))
+		for member := range plan.Before {
+			members = append(members, member)
+		}
+		sort.Slice(members, func(i, j int) bool {
+			if plan.Before[members[i]] != plan.Before[members[j]] {
+				return plan.Before[members[i]] > plan.Before[members[j]]
+			}
+			return members[i] < members[j]
+		})
+
+		responseText += fmt.Sprintf("Average active tasks per member: %.1f\n", plan.Average)
+		responseText += "\n📊 Active Tasks (before → after):\n"
+		for _, member := range members {
+			line := fmt.Sprintf("- %s: %d → %d", member, plan.Before[member], plan.After[member])
+			if float64(plan.Before[member]) > plan.Average {
+				line += " (overloaded)"
+			} else if float64(plan.Before[member]) < plan.Average {
+				line += " (underloaded)"
+			}
+			responseText += line + "\n"
+		}
+
+		if len(suggestions) == 0 {
+			if len(plan.Overloaded) > 0 {
+				responseText += "\n✅ No moves would narrow the gap - remaining imbalance is in Blocked or Review tasks or within one task\n"
+			} else {
+				responseText += "\n✅ Workload is already balanced\n"
+			}
+		} else {
+			responseText += fmt.Sprintf("\n🔀 Suggested Reassignments (%d):\n", len(suggestions))
+			for _, suggestion := range suggestions {
+				responseText += fmt.Sprintf("- Move %s (%s, %s) from %s to %s\n",
+					suggestion.TaskName, suggestion.TaskID, suggestion.Status, suggestion.From, suggestion.To)
+			}
+			responseText += "\n💡 Use reassign_task to apply a suggestion\n"
+		}
+	}
+
+	slog.Info("Reassignment suggestions generated", "project_id", params.Arguments.ProjectID, "members", len(plan.Before), "suggestions", len(suggestions))
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// UpdateProjectParams defines input for update_project tool. Empty fields
+// are left unchanged; set clear_description to remove the description.
+type UpdateProjectParams struct {
+	ProjectID          string `json:"project_id" validate:"required"`
+	ProjectName        string `json:"project_name,omitempty"`
+	ProjectDescription string `json:"project_description,omitempty"`
+	ClearDescription   bool   `json:"clear_description,omitempty"`
+	UpdatedBy          string `json:"updated_by"`
+}
+
+// HandleUpdateProject implements the update_project tool
+func (p *ProjectTools) HandleUpdateProject(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[UpdateProjectParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing update_project tool", "params", params.Arguments)
+
+	cfg := p.config()
+
+	// Validate required fields
+	if params.Arguments.ProjectID == "" {
+		return nil, fmt.Errorf("project_id is required")
+	}
+	name := strings.TrimSpace(params.Arguments.ProjectName)
+	description := strings.TrimSpace(params.Arguments.ProjectDescription)
+	if params.Arguments.ClearDescription && description != "" {
+		return nil, fmt.Errorf("project_description and clear_description cannot be used together")
+	}
+	if name == "" && description == "" && !params.Arguments.ClearDescription {
+		return nil, fmt.Errorf("nothing to update: provide project_name, project_description or clear_description")
+	}
+	updatedBy, err := resolveActor(cfg, params.Arguments.UpdatedBy, "updated_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.UpdatedBy = updatedBy
+
+	projectPath := fmt.Sprintf("/api/v1/projects/%s", url.PathEscape(params.Arguments.ProjectID))
+
+	// Get project details
+	projectResp, err := p.apiClient.Get(ctx, projectPath)
+	if err != nil {
+		slog.Error("Failed to get project", "error", err, "project_id", params.Arguments.ProjectID)
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	var project Project
+	if err := json.Unmarshal(projectResp, &project); err != nil {
+		slog.Error("Failed to parse project", "error", err)
+		return nil, fmt.Errorf("failed to parse project: %w", err)
+	}
+
+	// Only fields that differ from the current project are sent
+	currentDescription := ""
+	if project.ProjectDescription != nil {
+		currentDescription = *project.ProjectDescription
+	}
+	updateRequest := map[string]interface{}{}
+	var changes []string
+	if name != "" && name != project.ProjectName {
+		updateRequest["project_name"] = name
+		changes = append(changes, fmt.Sprintf("Name: %s → %s", project.ProjectName, name))
+	}
+	switch {
+	case params.Arguments.ClearDescription && project.ProjectDescription != nil:
+		updateRequest["project_description"] = nil
+		changes = append(changes, "Description: cleared")
+	case description != "" && description != currentDescription:
+		updateRequest["project_description"] = description
+		changes = append(changes, fmt.Sprintf("Description: %s", description))
+	}
+
+	changedFields := make([]string, 0, len(updateRequest))
+	for _, field := range []string{"project_name", "project_description"} {
+		if _, ok := updateRequest[field]; ok {
+			changedFields = append(changedFields, field)
+		}
+	}
+
+	updated := project
+	if len(updateRequest) > 0 {
+		updateRequest["last_updated_by"] = updatedBy
+		updateResp, err := p.apiClient.Put(ctx, projectPath, updateRequest)
+		if err != nil {
+			slog.Error("Failed to update project", "error", err, "project_id", project.ProjectID)
+			return nil, fmt.Errorf("failed to update project: %w", err)
+		}
+		if err := json.Unmarshal(updateResp, &updated); err != nil {
+			slog.Error("Failed to parse updated project", "error", err)
+			return nil, fmt.Errorf("failed to parse updated project: %w", err)
+		}
+	}
+
+	result := map[string]any{
+		"project":        updated,
+		"changed_fields": changedFields,
+		"updated_by":     updatedBy,
+	}
+
+	responseText := fmt.Sprintf("Project Updated\n===============\n\nProject: %s\nID: %s\n", updated.ProjectName, updated.ProjectID)
+	if len(changes) == 0 {
+		responseText += "\n✅ Project already matches - nothing changed\n"
+	} else {
+		responseText += fmt.Sprintf("Updated by: %s\n\nChanges:\n", updatedBy)
+		for _, change := range changes {
+			responseText += fmt.Sprintf("- %s\n", change)
+		}
+	}
+
+	slog.Info("Project updated", "project_id", project.ProjectID, "updated_by", updatedBy, "changed_fields", changedFields)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// DeleteProjectParams defines input for delete_project tool
+type DeleteProjectParams struct {
+	ProjectID string `json:"project_id"`
+	DeletedBy string `json:"deleted_by"`
+}
+
+// HandleDeleteProject implements the delete_project tool. Projects that
+// still have unarchived tasks are refused so no live work is orphaned.
+func (p *ProjectTools) HandleDeleteProject(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[DeleteProjectParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing delete_project tool", "params", params.Arguments)
+
+	cfg := p.config()
+
+	// Validate required fields
+	if params.Arguments.ProjectID == "" {
+		return nil, fmt.Errorf("project_id is required")
+	}
+	deletedBy, err := resolveActor(cfg, params.Arguments.DeletedBy, "deleted_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.DeletedBy = deletedBy
+
+	projectPath := fmt.Sprintf("/api/v1/projects/%s", url.PathEscape(params.Arguments.ProjectID))
+
+	// Get project details
+	projectResp, err := p.apiClient.Get(ctx, projectPath)
+	if err != nil {
+		slog.Error("Failed to get project", "error", err, "project_id", params.Arguments.ProjectID)
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	var project Project
+	if err := json.Unmarshal(projectResp, &project); err != nil {
+		slog.Error("Failed to parse project", "error", err)
+		return nil, fmt.Errorf("failed to parse project: %w", err)
+	}
+
+	tasks, err := p.fetchProjectTasks(ctx, project.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	activeTasks := countUnarchivedTasks(tasks)
+	if activeTasks > 0 {
+		return nil, fmt.Errorf("project %s still has %d non-archived tasks blocking deletion; archive or delete them first", project.ProjectID, activeTasks)
+	}
+
+	result := map[string]any{
+		"project_id":     project.ProjectID,
+		"project_name":   project.ProjectName,
+		"deleted_by":     deletedBy,
+		"policy":         cfg.DeletePolicy,
+		"archived_tasks": len(tasks),
+	}
+
+	responseText := fmt.Sprintf("Project Deleted\n===============\n\nProject: %s\nID: %s\nDeleted by: %s\n", project.ProjectName, project.ProjectID, deletedBy)
+
+	if isHardDelete(cfg) {
+		if _, err := p.apiClient.Delete(ctx, projectPath); err != nil {
+			slog.Error("Failed to delete project", "error", err, "project_id", project.ProjectID)
+			return nil, fmt.Errorf("failed to delete project: %w", err)
+		}
+		result["recoverable"] = false
+		responseText += "\n⚠️ Project permanently deleted (hard delete policy)\n"
+
+		slog.Info("Project hard-deleted", "project_id", project.ProjectID, "deleted_by", deletedBy)
+	} else {
+		deletedAt := p.clock.Now().UTC().Format(time.RFC3339)
+		updateRequest := map[string]interface{}{
+			"archived":        true,
+			"deleted_by":      deletedBy,
+			"deleted_at":      deletedAt,
+			"last_updated_by": deletedBy,
+		}
+		if _, err := p.apiClient.Put(ctx, projectPath, updateRequest); err != nil {
+			slog.Error("Failed to archive project", "error", err, "project_id", project.ProjectID)
+			return nil, fmt.Errorf("failed to archive project: %w", err)
+		}
+
+		result["recoverable"] = true
+		result["deleted_at"] = deletedAt
+		responseText += "\n🗃️ Project archived and marked deleted (soft delete policy)\n"
+		responseText += "♻️ Unarchive the project to restore it\n"
+
+		slog.Info("Project soft-deleted", "project_id", project.ProjectID, "deleted_by", deletedBy)
+	}
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// fetchProjectTasks loads every task in a project
+func (p *ProjectTools) fetchProjectTasks(ctx context.Context, projectID string) ([]Task, error) {
+	tasksResp, err := p.apiClient.Get(ctx, fmt.Sprintf("/api/v1/projects/%s/tasks", url.PathEscape(projectID)))
+	if err != nil {
+		slog.Error("Failed to get project tasks", "error", err, "project_id", projectID)
+		return nil, fmt.Errorf("failed to get project tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse project tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse project tasks: %w", err)
+	}
+	return tasks, nil
+}
+
+// countUnarchivedTasks counts the tasks that are not archived
+func countUnarchivedTasks(tasks []Task) int {
+	count := 0
+	for _, task := range tasks {
+		if !task.Archived {
+			count++
+		}
+	}
+	return count
+}
+
+// ArchiveProjectParams defines input for archive_project tool
+type ArchiveProjectParams struct {
+	ProjectID  string `json:"project_id" validate:"required"`
+	ArchivedBy string `json:"archived_by"`
+}
+
+// HandleArchiveProject implements the archive_project tool. Unlike
+// delete_project it only sets the project's archived flag; its tasks are
+// left as they are.
+func (p *ProjectTools) HandleArchiveProject(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[ArchiveProjectParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing archive_project tool", "params", params.Arguments)
+
+	cfg := p.config()
+
+	// Validate required fields
+	if params.Arguments.ProjectID == "" {
+		return nil, fmt.Errorf("project_id is required")
+	}
+	archivedBy, err := resolveActor(cfg, params.Arguments.ArchivedBy, "archived_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.ArchivedBy = archivedBy
+
+	projectPath := fmt.Sprintf("/api/v1/projects/%s", url.PathEscape(params.Arguments.ProjectID))
+
+	// Get project details
+	projectResp, err := p.apiClient.Get(ctx, projectPath)
+	if err != nil {
+		slog.Error("Failed to get project", "error", err, "project_id", params.Arguments.ProjectID)
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	var project Project
+	if err := json.Unmarshal(projectResp, &project); err != nil {
+		slog.Error("Failed to parse project", "error", err)
+		return nil, fmt.Errorf("failed to parse project: %w", err)
+	}
+
+	tasks, err := p.fetchProjectTasks(ctx, project.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+	activeTasks := countUnarchivedTasks(tasks)
+
+	result := map[string]any{
+		"project_id":       project.ProjectID,
+		"project_name":     project.ProjectName,
+		"archived_by":      archivedBy,
+		"task_count":       len(tasks),
+		"unarchived_tasks": activeTasks,
+		"already_archived": project.Archived,
+	}
+
+	responseText := fmt.Sprintf("Project Archived\n================\n\nProject: %s\nID: %s\n", project.ProjectName, project.ProjectID)
+
+	if project.Archived {
+		responseText += "\n✅ Project is already archived - nothing changed\n"
+	} else {
+		updateRequest := map[string]interface{}{
+			"archived":        true,
+			"last_updated_by": archivedBy,
+		}
+		if _, err := p.apiClient.Put(ctx, projectPath, updateRequest); err != nil {
+			slog.Error("Failed to archive project", "error", err, "project_id", project.ProjectID)
+			return nil, fmt.Errorf("failed to archive project: %w", err)
+		}
+		responseText += fmt.Sprintf("Archived by: %s\n\n🗃️ Project archived\n", archivedBy)
+	}
+	responseText += fmt.Sprintf("Tasks: %d (%d not archived)\n", len(tasks), activeTasks)
+	if activeTasks > 0 {
+		responseText += "💡 Tasks are not archived with the project; archive them before using delete_project\n"
+	}
+
+	slog.Info("Project archived", "project_id", project.ProjectID, "archived_by", archivedBy, "unarchived_tasks", activeTasks)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// RestoreProjectParams defines input for restore_project tool
+type RestoreProjectParams struct {
+	ProjectID  string `json:"project_id"`
+	RestoredBy string `json:"restored_by"`
+}
+
+// HandleRestoreProject implements the restore_project tool
+func (p *ProjectTools) HandleRestoreProject(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[RestoreProjectParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing restore_project tool", "params", params.Arguments)
+
+	cfg := p.config()
+
+	// Validate required fields
+	if params.Arguments.ProjectID == "" {
+		return nil, fmt.Errorf("project_id is required")
+	}
+	restoredBy, err := resolveActor(cfg, params.Arguments.RestoredBy, "restored_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.RestoredBy = restoredBy
+
+	projectPath := fmt.Sprintf("/api/v1/projects/%s", url.PathEscape(params.Arguments.ProjectID))
+
+	// Get project details
+	projectResp, err := p.apiClient.Get(ctx, projectPath)
+	if err != nil {
+		slog.Error("Failed to get project", "error", err, "project_id", params.Arguments.ProjectID)
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	var project Project
+	if err := json.Unmarshal(projectResp, &project); err != nil {
+		slog.Error("Failed to parse project", "error", err)
+		return nil, fmt.Errorf("failed to parse project: %w", err)
+	}
+
+	if !isSoftDeletedProject(project) {
+		return nil, fmt.Errorf("project %s is not deleted (only soft-deleted projects can be restored)", project.ProjectID)
+	}
+
+	updateRequest := map[string]interface{}{
+		"archived":        false,
+		"deleted_by":      nil,
+		"deleted_at":      nil,
+		"last_updated_by": restoredBy,
+	}
+	updateResp, err := p.apiClient.Put(ctx, projectPath, updateRequest)
+	if err != nil {
+		slog.Error("Failed to restore project", "error", err, "project_id", project.ProjectID)
+		return nil, fmt.Errorf("failed to restore project: %w", err)
+	}
+
+	var restored Project
+	if err := json.Unmarshal(updateResp, &restored); err != nil {
+		slog.Error("Failed to parse restored project", "error", err)
+		return nil, fmt.Errorf("failed to parse restored project: %w", err)
+	}
+
+	result := map[string]any{
+		"project":     restored,
+		"restored_by": restoredBy,
+		"restored_at": p.clock.Now().UTC().Format(time.RFC3339),
+	}
+
+	responseText := fmt.Sprintf("Project Restored\n================\n\nProject: %s\nID: %s\nRestored by: %s\n",
+		restored.ProjectName, restored.ProjectID, restoredBy)
+	responseText += "\n♻️ Project is active again\n"
+
+	slog.Info("Project restored", "project_id", project.ProjectID, "restored_by", restoredBy)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetOverdueByProjectParams defines input for get_overdue_by_project tool
+type GetOverdueByProjectParams struct{}
+
+// projectOverdue accumulates overdue work for one project bucket
+type projectOverdue struct {
+	projectID   string
+	projectName string
+	overdue     []Task
+	maxOverdue  time.Duration
+}
+
+// HandleGetOverdueByProject implements the get_overdue_by_project tool
+func (p *ProjectTools) HandleGetOverdueByProject(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetOverdueByProjectParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_overdue_by_project tool")
+
+	// Get all tasks
+	tasksResp, err := p.apiClient.Get(ctx, "/api/v1/tasks")
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+
+	// Get projects for names
+	projectsAvailable := true
+	projectNames := make(map[string]string)
+	projectsResp, err := p.apiClient.Get(ctx, "/api/v1/projects")
+	if err != nil {
+		slog.Error("Failed to get projects", "error", err)
+		// Continue without project names - not critical
+		projectsAvailable = false
+	} else {
+		var projects []Project
+		if err := json.Unmarshal(projectsResp, &projects); err != nil {
+			slog.Error("Failed to parse projects", "error", err)
+			projectsAvailable = false
+		}
+		for _, project := range projects {
+			projectNames[project.ProjectID] = project.ProjectName
+		}
+	}
+
+	// Group overdue tasks by project
+	now := p.clock.Now()
+	buckets := make(map[string]*projectOverdue)
+	totalOverdue := 0
+
+	for _, task := range tasks {
+		overdueFor, ok := overdueDuration(task, now)
+		if !ok {
+			continue
+		}
+		totalOverdue++
+
+		projectID := taskProjectID(task)
+		bucket, exists := buckets[projectID]
+		if !exists {
+			name := projectNames[projectID]
+			if projectID == "" {
+				name = "No Project"
+			} else if name == "" {
+				name = projectID
+			}
+			bucket = &projectOverdue{projectID: projectID, projectName: name}
+			buckets[projectID] = bucket
+		}
+		bucket.overdue = append(bucket.overdue, task)
+		if overdueFor > bucket.maxOverdue {
+			bucket.maxOverdue = overdueFor
+		}
+	}
+
+	// Rank worst-first; the no-project bucket always goes last
+	ranked := make([]*projectOverdue, 0, len(buckets))
+	var unassigned *projectOverdue
+	for _, bucket := range buckets {
+		if bucket.projectID == "" {
+			unassigned = bucket
+			continue
+		}
+		ranked = append(ranked, bucket)
+	}
+	sort.Slice(ranked, func(i, j int) bool {
+		if len(ranked[i].overdue) != len(ranked[j].overdue) {
+			return len(ranked[i].overdue) > len(ranked[j].overdue)
+		}
+		if ranked[i].maxOverdue != ranked[j].maxOverdue {
+			return ranked[i].maxOverdue > ranked[j].maxOverdue
+		}
+		return ranked[i].projectName < ranked[j].projectName
+	})
+
+	summarize := func(bucket *projectOverdue) map[string]any {
+		overdueTasks := make([]map[string]any, 0, len(bucket.overdue))
+		for _, task := range bucket.overdue {
+			overdueFor, _ := overdueDuration(task, now)
+			overdueTasks = append(overdueTasks, map[string]any{
+				"task_id":      task.TaskID,
+				"task_name":    task.TaskName,
+				"status":       task.Status,
+				"due_date":     *task.DueDate,
+				"days_overdue": wholeDays(overdueFor),
+			})
+		}
+		return map[string]any{
+			"project_id":       bucket.projectID,
+			"project_name":     bucket.projectName,
+			"overdue_count":    len(bucket.overdue),
+			"max_days_overdue": wholeDays(bucket.maxOverdue),
+			"overdue_tasks":    overdueTasks,
+		}
+	}
+
+	projectSummaries := make([]map[string]any, 0, len(ranked))
+	for _, bucket := range ranked {
+		projectSummaries = append(projectSummaries, summarize(bucket))
+	}
+
+	var unassignedSummary map[string]any
+	if unassigned != nil {
+		unassignedSummary = summarize(unassigned)
+	}
+
+	result := map[string]any{
+		"projects":              projectSummaries,
+		"no_project":            unassignedSummary,
+		"total_overdue":         totalOverdue,
+		"projects_with_overdue": len(ranked),
+		"projects_available":    projectsAvailable,
+	}
+
+	// Build response text
+	responseText := "Overdue Tasks by Project\n========================\n\n"
+	responseText += fmt.Sprintf("Total overdue: %d\nProjects with overdue tasks: %d\n", totalOverdue, len(ranked))
+
+	if totalOverdue == 0 {
+		responseText += "\n✅ No overdue tasks in any project\n"
+	} else {
+		responseText += "\n⚠️ Ranked by overdue count:\n"
+		for i, bucket := range ranked {
+			responseText += fmt.Sprintf("%d. %s: %d overdue (oldest %d days)\n", i+1, bucket.projectName, len(bucket.overdue), wholeDays(bucket.maxOverdue))
+		}
+		if unassigned != nil {
+			responseText += fmt.Sprintf("\n📭 No Project: %d overdue (oldest %d days)\n", len(unassigned.overdue), wholeDays(unassigned.maxOverdue))
+		}
+	}
+
+	if !projectsAvailable {
+		responseText += "\n💡 Insights:\n- ⚠️ Project names unavailable - showing project IDs\n"
+	}
+
+	slog.Info("Overdue by project generated", "total_overdue", totalOverdue, "projects_with_overdue", len(ranked))
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetAllProjectStatusesParams defines input for get_all_project_statuses tool
+type GetAllProjectStatusesParams struct{}
+
+// projectHealthRank orders health levels worst first for get_all_project_statuses
+var projectHealthRank = map[string]int{"critical": 0, "at_risk": 1, "healthy": 2, "unknown": 3}
+
+// projectStatusSummary is the lightweight per-project status returned by get_all_project_statuses
+type projectStatusSummary struct {
+	project              Project
+	total                int
+	completed            int
+	active               int
+	blocked              int
+	overdue              int
+	completionPercentage float64
+	health               string
+	err                  error
+}
+
+// summarizeProjectTasks computes the lightweight status for one project's tasks
+func summarizeProjectTasks(project Project, tasks []Task, now time.Time) projectStatusSummary {
+	summary := projectStatusSummary{project: project, total: len(tasks)}
+	for _, task := range tasks {
+		switch task.Status {
+		case "Complete":
+			summary.completed++
+		case "In Progress", "Review":
+			summary.active++
+		case "Blocked":
+			summary.blocked++
+		}
+		if isTaskOverdue(task, now) {
+			summary.overdue++
+		}
+	}
+	if summary.total > 0 {
+		summary.completionPercentage = float64(summary.completed) / float64(summary.total) * 100
+	}
+
+	open := summary.total - summary.completed
+	switch {
+	case summary.overdue > 0 && summary.overdue*4 >= open:
+		summary.health = "critical"
+	case summary.overdue > 0 || summary.blocked > 0:
+		summary.health = "at_risk"
+	default:
+		summary.health = "healthy"
+	}
+	return summary
+}
+
+// HandleGetAllProjectStatuses implements the get_all_project_statuses tool
+func (p *ProjectTools) HandleGetAllProjectStatuses(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetAllProjectStatusesParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_all_project_statuses tool")
+
+	// Get all projects
+	projectsResp, err := p.apiClient.Get(ctx, "/api/v1/projects")
+	if err != nil {
+		slog.Error("Failed to get projects", "error", err)
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+
+	var projects []Project
+	if err := json.Unmarshal(projectsResp, &projects); err != nil {
+		slog.Error("Failed to parse projects", "error", err)
+		return nil, fmt.Errorf("failed to parse projects: %w", err)
+	}
+
+	// Fetch each project's tasks concurrently; a failed project is reported rather than failing the batch
+	now := p.clock.Now()
+	summaries := make([]projectStatusSummary, len(projects))
+	runBounded(len(projects), bulkConcurrency, func(i int) {
+		project := projects[i]
+		tasksResp, err := p.apiClient.Get(ctx, fmt.Sprintf("/api/v1/projects/%s/tasks", url.PathEscape(project.ProjectID)))
+		if err != nil {
+			slog.Error("Failed to get project tasks", "error", err, "project_id", project.ProjectID)
+			summaries[i] = projectStatusSummary{project: project, health: "unknown", err: err}
+			return
+		}
+
+		var tasks []Task
+		if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+			slog.Error("Failed to parse project tasks", "error", err, "project_id", project.ProjectID)
+			summaries[i] = projectStatusSummary{project: project, health: "unknown", err: err}
+			return
+		}
+
+		summaries[i] = summarizeProjectTasks(project, tasks, now)
+	})
+
+	// Least healthy projects first
+	sort.SliceStable(summaries, func(i, j int) bool {
+		a, b := summaries[i], summaries[j]
+		if projectHealthRank[a.health] != projectHealthRank[b.health] {
+			return projectHealthRank[a.health] < projectHealthRank[b.health]
+		}
+		if a.overdue != b.overdue {
+			return a.overdue > b.overdue
+		}
+		if a.completionPercentage != b.completionPercentage {
+			return a.completionPercentage < b.completionPercentage
+		}
+		return a.project.ProjectName < b.project.ProjectName
+	})
+
+	statuses := make([]map[string]any, 0, len(summaries))
+	healthCounts := make(map[string]int)
+	failedCount := 0
+	for _, summary := range summaries {
+		healthCounts[summary.health]++
+		status := map[string]any{
+			"project_id":            summary.project.ProjectID,
+			"project_name":          summary.project.ProjectName,
+			"health":                summary.health,
+			"total_tasks":           summary.total,
+			"completed_tasks":       summary.completed,
+			"active_tasks":          summary.active,
+			"blocked_tasks":         summary.blocked,
+			"overdue_tasks":         summary.overdue,
+			"completion_percentage": summary.completionPercentage,
+		}
+		if summary.err != nil {
+			failedCount++
+			status["error"] = summary.err.Error()
+		}
+		statuses = append(statuses, status)
+	}
+
+	result := map[string]any{
+		"projects":       statuses,
+		"total_projects": len(projects),
+		"health_counts":  healthCounts,
+		"failed_count":   failedCount,
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Project Statuses (%d)\n", len(projects))
+	responseText += "=====================\n\n"
+
+	if len(projects) == 0 {
+		responseText += "No projects found.\n"
+	}
+	for _, summary := range summaries {
+		if summary.err != nil {
+			responseText += fmt.Sprintf("❓ %s: status unavailable (%v)\n", summary.project.ProjectName, summary.err)
+			continue
+		}
+		icon := "✅"
+		switch summary.health {
+		case "critical":
+			icon = "🚨"
+		case "at_risk":
+			icon = "⚠️"
+		}
+		responseText += fmt.Sprintf("%s %s: %.1f%% complete, %d tasks, %d active, %d overdue\n",
+			icon, summary.project.ProjectName, summary.completionPercentage, summary.total, summary.active, summary.overdue)
+	}
+
+	slog.Info("Project statuses generated", "total_projects", len(projects), "failed", failedCount)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// ResumeProjectTasksParams defines input for resume_project_tasks tool
+type ResumeProjectTasksParams struct {
+	ProjectID string            `json:"project_id"`
+	Tasks     []InitialTaskSpec `json:"tasks"`
+	CreatedBy string            `json:"created_by"`
+}
+
+// HandleResumeProjectTasks implements the resume_project_tasks tool. It
+// finishes an interrupted create_project_with_initial_tasks by creating only
+// the tasks whose names are not already in the project, so it is safe to re-run.
+func (p *ProjectTools) HandleResumeProjectTasks(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[ResumeProjectTasksParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing resume_project_tasks tool", "params", params.Arguments)
+
+	cfg := p.config()
+
+	// Many API calls share one budget
+	ctx, cancel := client.WithTimeout(ctx, cfg.MultiCallTimeout)
+	defer cancel()
+
+	// Validate required fields
+	if params.Arguments.ProjectID == "" {
+		return nil, fmt.Errorf("project_id is required")
+	}
+	createdBy, err := resolveActor(cfg, params.Arguments.CreatedBy, "created_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.CreatedBy = createdBy
+	if len(params.Arguments.Tasks) == 0 {
+		return nil, fmt.Errorf("tasks are required (at least one task)")
+	}
+	for i, taskSpec := range params.Arguments.Tasks {
+		if strings.TrimSpace(taskSpec.TaskName) == "" {
+			return nil, fmt.Errorf("tasks[%d].task_name is required", i)
+		}
+	}
+
+	// Get project details
+	projectResp, err := p.apiClient.Get(ctx, fmt.Sprintf("/api/v1/projects/%s", url.PathEscape(params.Arguments.ProjectID)))
+	if err != nil {
+		slog.Error("Failed to get project", "error", err, "project_id", params.Arguments.ProjectID)
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	var project Project
+	if err := json.Unmarshal(projectResp, &project); err != nil {
+		slog.Error("Failed to parse project", "error", err)
+		return nil, fmt.Errorf("failed to parse project: %w", err)
+	}
+
+	// Get the tasks that already made it into the project
+	tasksResp, err := p.apiClient.Get(ctx, fmt.Sprintf("/api/v1/projects/%s/tasks", url.PathEscape(params.Arguments.ProjectID)))
+	if err != nil {
+		slog.Error("Failed to get project tasks", "error", err, "project_id", params.Arguments.ProjectID)
+		return nil, fmt.Errorf("failed to get project tasks: %w", err)
+	}
+
+	var existingTasks []Task
+	if err := json.Unmarshal(tasksResp, &existingTasks); err != nil {
+		slog.Error("Failed to parse project tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse project tasks: %w", err)
+	}
+
+	// Task names match case-insensitively, like project names in skip_if_exists
+	present := make(map[string]bool, len(existingTasks))
+	for _, task := range existingTasks {
+		present[strings.ToLower(strings.TrimSpace(task.TaskName))] = true
+	}
+
+	missingTasks := []InitialTaskSpec{}
+	skippedTasks := []InitialTaskSpec{}
+	for _, taskSpec := range params.Arguments.Tasks {
+		name := strings.ToLower(strings.TrimSpace(taskSpec.TaskName))
+		if present[name] {
+			skippedTasks = append(skippedTasks, taskSpec)
+			continue
+		}
+		// Also guards against the same name appearing twice in the request
+		present[name] = true
+		missingTasks = append(missingTasks, taskSpec)
+	}
+
+	createdTasks, failedTasks, _ := p.createTasksInProject(ctx, cfg, project, missingTasks, params.Arguments.CreatedBy)
+
+	result := map[string]any{
+		"project":       project,
+		"created_tasks": createdTasks,
+		"skipped_tasks": skippedTasks,
+		"failed_tasks":  failedTasks,
+		"total_planned": len(params.Arguments.Tasks),
+		"total_created": len(createdTasks),
+		"total_skipped": len(skippedTasks),
+		"total_failed":  len(failedTasks),
+		"complete":      len(failedTasks) == 0,
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Project Tasks Resumed\n=====================\n\nProject: %s\nID: %s\n", project.ProjectName, project.ProjectID)
+	responseText += fmt.Sprintf("\n📊 Summary:\nPlanned: %d tasks\nCreated: %d\nAlready present: %d\n",
+		len(params.Arguments.Tasks), len(createdTasks), len(skippedTasks))
+	if len(failedTasks) > 0 {
+		responseText += fmt.Sprintf("Failed: %d\n", len(failedTasks))
+	}
+
+	if len(createdTasks) > 0 {
+		responseText += "\n✅ Created Tasks:\n"
+		for _, task := range createdTasks {
+			responseText += fmt.Sprintf("- %s (ID: %s)\n", task.TaskName, task.TaskID)
+		}
+	}
+
+	if len(skippedTasks) > 0 {
+		responseText += "\n⏭️ Skipped (already in project):\n"
+		for _, taskSpec := range skippedTasks {
+			responseText += fmt.Sprintf("- %s\n", taskSpec.TaskName)
+		}
+	}
+
+	if len(failedTasks) > 0 {
+		responseText += "\n❌ Failed Tasks:\n"
+		for _, taskSpec := range failedTasks {
+			responseText += fmt.Sprintf("- %s\n", taskSpec.TaskName)
+		}
+		responseText += "\n🔄 Re-run resume_project_tasks with the same list to retry the failed tasks\n"
+	} else {
+		responseText += "\n🎉 All planned tasks are present in the project\n"
+	}
+
+	slog.Info("Project tasks resumed", "project_id", project.ProjectID, "created", len(createdTasks), "skipped", len(skippedTasks), "failed", len(failedTasks))
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// AddTasksToProjectParams defines input for bulk_add_tasks_to_project tool
+type AddTasksToProjectParams struct {
+	ProjectID string            `json:"project_id" validate:"required"`
+	Tasks     []InitialTaskSpec `json:"tasks"`
+	CreatedBy string            `json:"created_by"`
+}
+
+// taskCreationResult reports whether one requested task was created
+type taskCreationResult struct {
+	TaskName string `json:"task_name"`
+	Success  bool   `json:"success"`
+	TaskID   string `json:"task_id,omitempty"`
+	Error    string `json:"error,omitempty"`
+}
+
+// HandleAddTasksToProject implements the bulk_add_tasks_to_project tool. It
+// adds tasks to an existing project the way create_project_with_initial_tasks
+// adds them to a new one; a task that fails does not stop the rest.
+func (p *ProjectTools) HandleAddTasksToProject(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[AddTasksToProjectParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing bulk_add_tasks_to_project tool", "params", params.Arguments)
+
+	cfg := p.config()
+
+	// Many API calls share one budget
+	ctx, cancel := client.WithTimeout(ctx, cfg.MultiCallTimeout)
+	defer cancel()
+
+	// Validate required fields
+	if params.Arguments.ProjectID == "" {
+		return nil, fmt.Errorf("project_id is required")
+	}
+	createdBy, err := resolveActor(cfg, params.Arguments.CreatedBy, "created_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.CreatedBy = createdBy
+	if len(params.Arguments.Tasks) == 0 {
+		return nil, fmt.Errorf("tasks are required (at least one task)")
+	}
+	for i, taskSpec := range params.Arguments.Tasks {
+		if strings.TrimSpace(taskSpec.TaskName) == "" {
+			return nil, fmt.Errorf("tasks[%d].task_name is required", i)
+		}
+	}
+
+	// Enforce the configured cap on tasks created in one call
+	taskSpecs, capWarning, err := capInitialTasks(cfg, params.Arguments.Tasks, "tasks")
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify the project exists before creating anything
+	projectResp, err := p.apiClient.Get(ctx, fmt.Sprintf("/api/v1/projects/%s", url.PathEscape(params.Arguments.ProjectID)))
+	if err != nil {
+		slog.Error("Failed to get project", "error", err, "project_id", params.Arguments.ProjectID)
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	var project Project
+	if err := json.Unmarshal(projectResp, &project); err != nil {
+		slog.Error("Failed to parse project", "error", err)
+		return nil, fmt.Errorf("failed to parse project: %w", err)
+	}
+
+	createdTasks, failedTasks, results := p.createTasksInProject(ctx, cfg, project, taskSpecs, params.Arguments.CreatedBy)
+
+	successRate := float64(len(createdTasks)) / float64(len(taskSpecs)) * 100
+
+	result := map[string]any{
+		"project":       project,
+		"results":       results,
+		"created_tasks": createdTasks,
+		"failed_tasks":  failedTasks,
+		"total_planned": len(taskSpecs),
+		"total_created": len(createdTasks),
+		"total_failed":  len(failedTasks),
+		"success_rate":  successRate,
+		"truncated":     capWarning != "",
+		"max_tasks":     cfg.MaxInitialTasks,
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Tasks Added to Project\n======================\n\nProject: %s\nID: %s\n", project.ProjectName, project.ProjectID)
+	responseText += fmt.Sprintf("\n📊 Task Creation Summary:\nPlanned: %d tasks\nCreated: %d tasks\n", len(taskSpecs), len(createdTasks))
+	if len(failedTasks) > 0 {
+		responseText += fmt.Sprintf("Failed: %d tasks\nSuccess Rate: %.1f%%\n", len(failedTasks), successRate)
+	}
+	if capWarning != "" {
+		responseText += capWarning + "\n"
+	}
+
+	if len(createdTasks) > 0 {
+		responseText += "\n✅ Created Tasks:\n"
+		for _, task := range createdTasks {
+			assignee := "Unassigned"
+			if task.AssignedTo != nil && *task.AssignedTo != "" {
+				assignee = *task.AssignedTo
+			}
+			responseText += fmt.Sprintf("- %s (%s, %s) - %s\n", task.TaskName, task.Status, p.priorities.Render(task.Priority), assignee)
+		}
+	}
+
+	if len(failedTasks) > 0 {
+		responseText += "\n❌ Failed Tasks:\n"
+		for _, taskResult := range results {
+			if !taskResult.Success {
+				responseText += fmt.Sprintf("- %s: %s\n", taskResult.TaskName, taskResult.Error)
+			}
+		}
+		responseText += "\n🔄 Retry failed tasks with resume_project_tasks\n"
+	}
+
+	slog.Info("Tasks added to project", "project_id", project.ProjectID, "tasks_created", len(createdTasks), "tasks_failed", len(failedTasks))
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetProjectRiskScoreParams defines input for get_project_risk_score tool
+type GetProjectRiskScoreParams struct {
+	ProjectID string `json:"project_id"`
+}
+
+// HandleGetProjectRiskScore implements the get_project_risk_score tool
+func (p *ProjectTools) HandleGetProjectRiskScore(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetProjectRiskScoreParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_project_risk_score tool", "params", params.Arguments)
+
+	cfg := p.config()
+
+	// Validate required fields
+	if params.Arguments.ProjectID == "" {
+		return nil, fmt.Errorf("project_id is required")
+	}
+
+	// Get project details
+	projectResp, err := p.apiClient.Get(ctx, fmt.Sprintf("/api/v1/projects/%s", url.PathEscape(params.Arguments.ProjectID)))
+	if err != nil {
+		slog.Error("Failed to get project", "error", err, "project_id", params.Arguments.ProjectID)
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	var project Project
+	if err := json.Unmarshal(projectResp, &project); err != nil {
+		slog.Error("Failed to parse project", "error", err)
+		return nil, fmt.Errorf("failed to parse project: %w", err)
+	}
+
+	// Get project tasks
+	tasksResp, err := p.apiClient.Get(ctx, fmt.Sprintf("/api/v1/projects/%s/tasks", url.PathEscape(params.Arguments.ProjectID)))
+	if err != nil {
+		slog.Error("Failed to get project tasks", "error", err, "project_id", params.Arguments.ProjectID)
+		return nil, fmt.Errorf("failed to get project tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse project tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse project tasks: %w", err)
+	}
+
+	now := p.clock.Now()
+	risk := scoreProjectRisk(tasks, now, cfg.StaleAfter)
+
+	factors := make([]map[string]any, 0, len(risk.factors))
+	for _, factor := range risk.factors {
+		factors = append(factors, map[string]any{
+			"factor":     factor.name,
+			"points":     math.Round(factor.points()*10) / 10,
+			"max_points": factor.maxPoints,
+			"detail":     factor.detail,
+		})
+	}
+
+	result := map[string]any{
+		"project_id":   project.ProjectID,
+		"project_name": project.ProjectName,
+		"risk_score":   risk.score,
+		"risk_level":   risk.level,
+		"factors":      factors,
+		"open_tasks":   risk.openTasks,
+		"total_tasks":  len(tasks),
+		"as_of":        now.UTC().Format(time.RFC3339),
+	}
+
+	// Build response text
+	icon := "✅"
+	switch risk.level {
+	case "high":
+		icon = "🚨"
+	case "medium":
+		icon = "⚠️"
+	}
+	responseText := fmt.Sprintf("Project Risk Score\n==================\n\nProject: %s\nID: %s\n\n%s Risk score: %d/100 (%s)\n",
+		project.ProjectName, project.ProjectID, icon, risk.score, risk.level)
+
+	if risk.openTasks == 0 {
+		responseText += "\nNo open tasks - nothing left at risk.\n"
+	} else {
+		responseText += "\n📊 Contributing Factors:\n"
+		for _, factor := range risk.factors {
+			responseText += fmt.Sprintf("- %s: %.1f/%d pts (%s)\n", factor.name, factor.points(), factor.maxPoints, factor.detail)
+		}
+	}
+
+	slog.Info("Project risk scored", "project_id", project.ProjectID, "risk_score", risk.score, "risk_level", risk.level)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// AddProjectMilestoneParams defines input for add_project_milestone tool
+type AddProjectMilestoneParams struct {
+	ProjectID string   `json:"project_id" validate:"required"`
+	Name      string   `json:"name" validate:"required,max=200"`
+	DueDate   string   `json:"due_date" validate:"required,date"`
+	TaskIDs   []string `json:"task_ids" validate:"required"`
+	UpdatedBy string   `json:"updated_by"`
+}
+
+// HandleAddProjectMilestone implements the add_project_milestone tool.
+// Milestones are stored on the project; adding one with an existing name
+// replaces it.
+func (p *ProjectTools) HandleAddProjectMilestone(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[AddProjectMilestoneParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing add_project_milestone tool", "params", params.Arguments)
+
+	cfg := p.config()
+
+	// Validate required fields
+	if params.Arguments.ProjectID == "" {
+		return nil, fmt.Errorf("project_id is required")
+	}
+	name := strings.TrimSpace(params.Arguments.Name)
+	if name == "" {
+		return nil, fmt.Errorf("name is required")
+	}
+	if len(params.Arguments.TaskIDs) == 0 {
+		return nil, fmt.Errorf("task_ids is required")
+	}
+	if _, err := milestoneDeadline(params.Arguments.DueDate); err != nil {
+		return nil, fmt.Errorf("invalid due_date: %w", err)
+	}
+	updatedBy, err := resolveActor(cfg, params.Arguments.UpdatedBy, "updated_by")
+	if err != nil {
+		return nil, err
+	}
+
+	projectPath := fmt.Sprintf("/api/v1/projects/%s", url.PathEscape(params.Arguments.ProjectID))
+
+	// Get project details
+	projectResp, err := p.apiClient.Get(ctx, projectPath)
+	if err != nil {
+		slog.Error("Failed to get project", "error", err, "project_id", params.Arguments.ProjectID)
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	var project Project
+	if err := json.Unmarshal(projectResp, &project); err != nil {
+		slog.Error("Failed to parse project", "error", err)
+		return nil, fmt.Errorf("failed to parse project: %w", err)
+	}
+
+	// Linked tasks must belong to the project
+	tasksResp, err := p.apiClient.Get(ctx, projectPath+"/tasks")
+	if err != nil {
+		slog.Error("Failed to get project tasks", "error", err, "project_id", project.ProjectID)
+		return nil, fmt.Errorf("failed to get project tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse project tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse project tasks: %w", err)
+	}
+
+	inProject := make(map[string]bool, len(tasks))
+	for _, task := range tasks {
+		inProject[task.TaskID] = true
+	}
+	taskIDs := []string{}
+	seen := make(map[string]bool)
+	var unknown []string
+	for _, taskID := range params.Arguments.TaskIDs {
+		taskID = strings.TrimSpace(taskID)
+		if taskID == "" || seen[taskID] {
+			continue
+		}
+		seen[taskID] = true
+		if !inProject[taskID] {
+			unknown = append(unknown, taskID)
+			continue
+		}
+		taskIDs = append(taskIDs, taskID)
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("tasks not in project %s: %s", project.ProjectID, strings.Join(unknown, ", "))
+	}
+
+	milestone := Milestone{Name: name, DueDate: params.Arguments.DueDate, TaskIDs: taskIDs}
+	milestones, replaced := withMilestone(project.Milestones, milestone)
+
+	updateRequest := map[string]interface{}{
+		"milestones":      milestones,
+		"last_updated_by": updatedBy,
+	}
+	if _, err := p.apiClient.Put(ctx, projectPath, updateRequest); err != nil {
+		slog.Error("Failed to save project milestones", "error", err, "project_id", project.ProjectID)
+		return nil, fmt.Errorf("failed to save milestone: %w", err)
+	}
+
+	progress := milestoneProgress(milestone, tasks, p.clock.Now())
+
+	result := map[string]any{
+		"project_id": project.ProjectID,
+		"milestone":  progress,
+		"replaced":   replaced,
+		"updated_by": updatedBy,
+	}
+
+	action := "Added"
+	if replaced {
+		action = "Updated"
+	}
+	responseText := fmt.Sprintf("Milestone %s\n", action)
+	responseText += strings.Repeat("=", len(responseText)-1) + "\n\n"
+	responseText += fmt.Sprintf("Project: %s (%s)\nMilestone: %s\nDue: %s\nLinked tasks: %d\nCompletion: %.1f%%\n",
+		project.ProjectName, project.ProjectID, milestone.Name, milestone.DueDate, len(taskIDs), progress.CompletionPercent)
+	if progress.AtRisk {
+		responseText += fmt.Sprintf("\n⚠️ Already at risk: %s\n", strings.Join(progress.RiskReasons, "; "))
+	}
+
+	slog.Info("Project milestone saved", "project_id", project.ProjectID, "milestone", milestone.Name, "tasks", len(taskIDs), "replaced", replaced)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetProjectMilestonesParams defines input for get_project_milestones tool
+type GetProjectMilestonesParams struct {
+	ProjectID string `json:"project_id" validate:"required"`
+}
+
+// HandleGetProjectMilestones implements the get_project_milestones tool
+func (p *ProjectTools) HandleGetProjectMilestones(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetProjectMilestonesParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_project_milestones tool", "params", params.Arguments)
+
+	// Validate required fields
+	if params.Arguments.ProjectID == "" {
+		return nil, fmt.Errorf("project_id is required")
+	}
+
+	projectPath := fmt.Sprintf("/api/v1/projects/%s", url.PathEscape(params.Arguments.ProjectID))
+
+	// Get project details
+	projectResp, err := p.apiClient.Get(ctx, projectPath)
+	if err != nil {
+		slog.Error("Failed to get project", "error", err, "project_id", params.Arguments.ProjectID)
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	var project Project
+	if err := json.Unmarshal(projectResp, &project); err != nil {
+		slog.Error("Failed to parse project", "error", err)
+		return nil, fmt.Errorf("failed to parse project: %w", err)
+	}
+
+	// Get project tasks
+	tasksResp, err := p.apiClient.Get(ctx, projectPath+"/tasks")
+	if err != nil {
+		slog.Error("Failed to get project tasks", "error", err, "project_id", project.ProjectID)
+		return nil, fmt.Errorf("failed to get project tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse project tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse project tasks: %w", err)
+	}
+
+	now := p.clock.Now()
+	milestones := make([]MilestoneProgress, 0, len(project.Milestones))
+	atRisk := 0
+	for _, milestone := range project.Milestones {
+		progress := milestoneProgress(milestone, tasks, now)
+		if progress.AtRisk {
+			atRisk++
+		}
+		milestones = append(milestones, progress)
+	}
+	sort.SliceStable(milestones, func(i, j int) bool {
+		return milestones[i].DaysRemaining < milestones[j].DaysRemaining
+	})
+
+	result := map[string]any{
+		"project_id":    project.ProjectID,
+		"project_name":  project.ProjectName,
+		"milestones":    milestones,
+		"at_risk_count": atRisk,
+		"as_of":         now.UTC().Format(time.RFC3339),
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Project Milestones: %s\n", project.ProjectName)
+	responseText += strings.Repeat("=", len(responseText)-1) + "\n\n"
+	responseText += fmt.Sprintf("Project ID: %s\n", project.ProjectID)
+
+	if len(milestones) == 0 {
+		responseText += "\nNo milestones yet. Use add_project_milestone to create one.\n"
+	}
+	for _, milestone := range milestones {
+		icon := "🎯"
+		switch {
+		case milestone.TotalTasks > 0 && milestone.CompletedTasks == milestone.TotalTasks:
+			icon = "✅"
+		case milestone.AtRisk:
+			icon = "⚠️"
+		}
+		responseText += fmt.Sprintf("\n%s %s (due %s): %.1f%% complete, %d/%d tasks\n",
+			icon, milestone.Name, milestone.DueDate, milestone.CompletionPercent, milestone.CompletedTasks, milestone.TotalTasks)
+		if milestone.AtRisk {
+			responseText += fmt.Sprintf("   At risk: %s\n", strings.Join(milestone.RiskReasons, "; "))
+		}
+		if len(milestone.MissingTaskIDs) > 0 {
+			responseText += fmt.Sprintf("   Missing tasks: %s\n", strings.Join(milestone.MissingTaskIDs, ", "))
+		}
+	}
+
+	slog.Info("Project milestones reported", "project_id", project.ProjectID, "milestones", len(milestones), "at_risk", atRisk)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetProjectTimelineParams defines input for get_project_timeline tool
+type GetProjectTimelineParams struct {
+	ProjectID string `json:"project_id" validate:"required"`
+}
+
+// HandleGetProjectTimeline implements the get_project_timeline tool
+func (p *ProjectTools) HandleGetProjectTimeline(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetProjectTimelineParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_project_timeline tool", "params", params.Arguments)
+
+	// Validate required fields
+	if params.Arguments.ProjectID == "" {
+		return nil, fmt.Errorf("project_id is required")
+	}
+
+	// Get project details
+	projectResp, err := p.apiClient.Get(ctx, fmt.Sprintf("/api/v1/projects/%s", url.PathEscape(params.Arguments.ProjectID)))
+	if err != nil {
+		slog.Error("Failed to get project", "error", err, "project_id", params.Arguments.ProjectID)
+		return nil, fmt.Errorf("failed to get project: %w", err)
+	}
+
+	var project Project
+	if err := json.Unmarshal(projectResp, &project); err != nil {
+		slog.Error("Failed to parse project", "error", err)
+		return nil, fmt.Errorf("failed to parse project: %w", err)
+	}
+
+	tasks, err := p.fetchProjectTasks(ctx, project.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := p.clock.Now()
+	events := buildProjectTimeline(project, tasks, now)
+
+	completed, remaining := 0, 0
+	for _, task := range tasks {
+		switch {
+		case task.Status == "Complete":
+			completed++
+		case !task.Archived:
+			remaining++
+		}
+	}
+
+	// Velocity is measured from the project's creation, or from its first
+	// task when the creation date is missing
+	var start time.Time
+	hasStart := false
+	for _, event := range events {
+		if event.dated && (event.Type == timelineCreated || event.Type == timelineFirstTask) {
+			start, hasStart = event.at, true
+			break
+		}
+	}
+
+	result := map[string]any{
+		"project_id":         project.ProjectID,
+		"project_name":       project.ProjectName,
+		"events":             events,
+		"completed_tasks":    completed,
+		"remaining_tasks":    remaining,
+		"velocity_available": false,
+		"as_of":              now.UTC().Format(time.RFC3339),
+	}
+
+	var velocityText string
+	perDay, ok := 0.0, false
+	if hasStart {
+		perDay, ok = dailyVelocity(completed, start, now)
+	}
+	switch {
+	case completed == 0:
+		velocityText = "Velocity cannot be computed yet: no tasks have been completed.\n"
+	case !ok:
+		velocityText = "Velocity cannot be computed: the project has no usable start date.\n"
+	default:
+		result["velocity_available"] = true
+		result["velocity_per_day"] = perDay
+		result["velocity_since"] = start.UTC().Format(time.RFC3339)
+		velocityText = fmt.Sprintf("Velocity: %.2f tasks/day since %s\n", perDay, start.Format("2006-01-02"))
+		if end, ok := projectedCompletion(remaining, perDay, now); ok {
+			result["projected_end_date"] = end.UTC().Format(time.RFC3339)
+			if remaining == 0 {
+				velocityText += "All tasks are complete.\n"
+			} else {
+				velocityText += fmt.Sprintf("Projected completion: %s (%d tasks remaining)\n", end.Format("2006-01-02"), remaining)
+			}
+		}
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Project Timeline: %s\n", project.ProjectName)
+	responseText += strings.Repeat("=", len(responseText)-1) + "\n\n"
+	responseText += fmt.Sprintf("Project ID: %s\n", project.ProjectID)
+	responseText += fmt.Sprintf("Tasks: %d completed, %d remaining\n", completed, remaining)
+	responseText += velocityText
+
+	if len(events) == 0 {
+		responseText += "\nNo dated activity recorded for this project.\n"
+	} else {
+		responseText += "\n"
+	}
+	for _, event := range events {
+		when := event.Time
+		if event.Relative != "" {
+			when += " (" + event.Relative + ")"
+		}
+		responseText += fmt.Sprintf("• %s: %s", when, event.Description)
+		if event.Actor != "" {
+			responseText += fmt.Sprintf(" by %s", event.Actor)
+		}
+		responseText += "\n"
+	}
+
+	slog.Info("Project timeline built", "project_id", project.ProjectID, "events", len(events), "velocity_available", result["velocity_available"])
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
@@ -5,15 +5,21 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/bchamber/taskman-mcp/internal/client"
+	"github.com/bchamber/taskman-mcp/internal/clock"
+	"github.com/bchamber/taskman-mcp/internal/config"
+	"github.com/bchamber/taskman-mcp/internal/ids"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // Mock API server for project tools testing
 func createProjectMockAPIServer() *httptest.Server {
+	taskIDs := ids.NewSequence("task-new")
+
 	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch {
 		case r.Method == "GET" && r.URL.Path == "/api/v1/projects/proj-1":
@@ -82,7 +88,7 @@ func createProjectMockAPIServer() *httptest.Server {
 			var req map[string]interface{}
 			json.NewDecoder(r.Body).Decode(&req)
 
-			taskID := "task-new-" + time.Now().Format("20060102150405")
+			taskID := taskIDs.NewID()
 			task := Task{
 				TaskID:       taskID,
 				TaskName:     req["task_name"].(string),
@@ -120,7 +126,7 @@ func TestProjectTools_HandleGetProjectStatus(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	projectTools := NewProjectTools(apiClient)
+	projectTools := NewProjectTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -180,7 +186,7 @@ func TestProjectTools_HandleCreateProjectWithInitialTasks(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	projectTools := NewProjectTools(apiClient)
+	projectTools := NewProjectTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -255,7 +261,7 @@ func TestProjectTools_HandleGetProjectStatus_MissingProjectID(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	projectTools := NewProjectTools(apiClient)
+	projectTools := NewProjectTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -271,12 +277,117 @@ func TestProjectTools_HandleGetProjectStatus_MissingProjectID(t *testing.T) {
 	}
 }
 
+func TestProjectTools_HandleCreateProjectWithInitialTasks_InvalidDueDate(t *testing.T) {
+	server := createProjectMockAPIServer()
+	defer server.Close()
+
+	projectTools := NewProjectTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+
+	result, err := projectTools.HandleCreateProjectWithInitialTasks(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[CreateProjectWithInitialTasksParams]{
+		Arguments: CreateProjectWithInitialTasksParams{
+			ProjectName: "New Test Project",
+			CreatedBy:   "test.user",
+			InitialTasks: []InitialTaskSpec{
+				{TaskName: "Dated", DueDate: "2024-06-30"},
+				{TaskName: "Badly dated", DueDate: "end of june"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("HandleCreateProjectWithInitialTasks failed: %v", err)
+	}
+
+	// The bad date fails its task instead of creating it without a due date
+	failed := result.Meta["failed_tasks"].([]InitialTaskSpec)
+	if len(failed) != 1 || failed[0].TaskName != "Badly dated" {
+		t.Errorf("Expected only the badly dated task to fail, got %+v", failed)
+	}
+}
+
+func TestProjectTools_HandleCreateProjectWithInitialTasks_DryRun(t *testing.T) {
+	var reads, writes int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "GET" {
+			writes++
+			http.Error(w, "unexpected write", http.StatusInternalServerError)
+			return
+		}
+		reads++
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Project{{ProjectID: "proj-1", ProjectName: "Existing"}})
+	}))
+	defer server.Close()
+
+	projectTools := NewProjectTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+
+	result, err := projectTools.HandleCreateProjectWithInitialTasks(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[CreateProjectWithInitialTasksParams]{
+		Arguments: CreateProjectWithInitialTasksParams{
+			ProjectName:     "Launch",
+			CreatedBy:       "test.user",
+			DefaultAssignee: "alice",
+			DryRun:          true,
+			InitialTasks: []InitialTaskSpec{
+				{TaskName: "Plan", Priority: "High", DueDate: "2024-06-30"},
+				{TaskName: "Bad status", Status: "Done"},
+				{TaskName: "Bad priority", Priority: "Urgent"},
+				{TaskName: "Bad date", DueDate: "end of june", AssignedTo: "bob"},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("HandleCreateProjectWithInitialTasks failed: %v", err)
+	}
+
+	if writes != 0 || reads != 0 {
+		t.Errorf("Expected no API calls in a dry run, got %d writes and %d reads", writes, reads)
+	}
+	if result.Meta["dry_run"] != true {
+		t.Error("Expected dry_run in Meta")
+	}
+	text := result.Content[0].(*mcp.TextContent).Text
+	if !strings.HasPrefix(text, "DRY RUN — nothing was created") {
+		t.Errorf("Expected the dry run banner, got %q", text)
+	}
+
+	specs := result.Meta["task_specs"].([]InitialTaskSpec)
+	if len(specs) != 4 || specs[0].AssignedTo != "alice" || specs[0].DueDate != "2024-06-30T00:00:00Z" || specs[3].AssignedTo != "bob" {
+		t.Errorf("Unexpected task specs: %+v", specs)
+	}
+	invalid := result.Meta["invalid_tasks"].([]taskCreationResult)
+	if len(invalid) != 3 || invalid[0].TaskName != "Bad status" || invalid[1].TaskName != "Bad priority" || invalid[2].TaskName != "Bad date" {
+		t.Errorf("Expected the three bad tasks reported, got %+v", invalid)
+	}
+	if project := result.Meta["project"].(Project); project.ProjectName != "Launch" || project.ProjectID != "" {
+		t.Errorf("Unexpected would-be project: %+v", project)
+	}
+
+	// skip_if_exists may still look the project up, but never writes
+	result, err = projectTools.HandleCreateProjectWithInitialTasks(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[CreateProjectWithInitialTasksParams]{
+		Arguments: CreateProjectWithInitialTasksParams{
+			ProjectName:  "Existing",
+			CreatedBy:    "test.user",
+			SkipIfExists: true,
+			DryRun:       true,
+			InitialTasks: []InitialTaskSpec{{TaskName: "Plan"}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("HandleCreateProjectWithInitialTasks failed: %v", err)
+	}
+	if writes != 0 {
+		t.Errorf("Expected no API writes in a dry run, got %d", writes)
+	}
+	if existing, ok := result.Meta["existing_project"].(Project); !ok || existing.ProjectID != "proj-1" {
+		t.Errorf("Expected the existing project in Meta, got %v", result.Meta["existing_project"])
+	}
+}
+
 func TestProjectTools_HandleCreateProjectWithInitialTasks_MissingRequiredFields(t *testing.T) {
 	server := createProjectMockAPIServer()
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	projectTools := NewProjectTools(apiClient)
+	projectTools := NewProjectTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -315,3 +426,1113 @@ func TestProjectTools_HandleCreateProjectWithInitialTasks_MissingRequiredFields(
 		t.Fatal("Expected error for missing initial_tasks")
 	}
 }
+
+func TestProjectTools_HandleCreateProjectWithInitialTasks_SkipIfExists(t *testing.T) {
+	var projects []Project
+	taskPosts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v1/projects":
+			json.NewEncoder(w).Encode(projects)
+
+		case r.Method == "POST" && r.URL.Path == "/api/v1/projects":
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+
+			project := Project{
+				ProjectID:    "proj-created",
+				ProjectName:  req["project_name"].(string),
+				CreatedBy:    req["created_by"].(string),
+				CreationDate: time.Now().Format(time.RFC3339),
+			}
+			projects = append(projects, project)
+			json.NewEncoder(w).Encode(project)
+
+		case r.Method == "POST" && r.URL.Path == "/api/v1/tasks":
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+
+			taskPosts++
+			json.NewEncoder(w).Encode(Task{
+				TaskID:       "task-created",
+				TaskName:     req["task_name"].(string),
+				Status:       "Not Started",
+				CreatedBy:    req["created_by"].(string),
+				CreationDate: time.Now().Format(time.RFC3339),
+			})
+
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
+	projectTools := NewProjectTools(apiClient, config.Default())
+
+	ctx := context.Background()
+	session := &mcp.ServerSession{}
+	params := &mcp.CallToolParamsFor[CreateProjectWithInitialTasksParams]{
+		Arguments: CreateProjectWithInitialTasksParams{
+			ProjectName:  "Setup Project",
+			CreatedBy:    "test.user",
+			InitialTasks: []InitialTaskSpec{{TaskName: "Task 1"}, {TaskName: "Task 2"}},
+			SkipIfExists: true,
+		},
+	}
+
+	// First call creates the project and its tasks
+	first, err := projectTools.HandleCreateProjectWithInitialTasks(ctx, session, params)
+	if err != nil {
+		t.Fatalf("First call failed: %v", err)
+	}
+	if skipped, _ := first.Meta["skipped"].(bool); skipped {
+		t.Fatal("First call should not be skipped")
+	}
+	if taskPosts != 2 {
+		t.Fatalf("Expected 2 tasks created on first call, got %d", taskPosts)
+	}
+
+	// Second call with a differently-cased name returns the existing project
+	params.Arguments.ProjectName = "setup PROJECT"
+	second, err := projectTools.HandleCreateProjectWithInitialTasks(ctx, session, params)
+	if err != nil {
+		t.Fatalf("Second call failed: %v", err)
+	}
+
+	if skipped, _ := second.Meta["skipped"].(bool); !skipped {
+		t.Error("Expected second call to be a no-op")
+	}
+	if project := second.Meta["project"].(Project); project.ProjectID != "proj-created" {
+		t.Errorf("Expected existing project proj-created, got %s", project.ProjectID)
+	}
+	if len(projects) != 1 {
+		t.Errorf("Expected 1 project, got %d", len(projects))
+	}
+	if taskPosts != 2 {
+		t.Errorf("Expected no new tasks on second call, got %d total task creations", taskPosts)
+	}
+}
+
+func TestProjectTools_HandleCreateProjectWithInitialTasks_MaxInitialTasks(t *testing.T) {
+	server := createProjectMockAPIServer()
+	defer server.Close()
+
+	cfg := config.Default()
+	cfg.MaxInitialTasks = 2
+
+	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
+	projectTools := NewProjectTools(apiClient, cfg)
+
+	ctx := context.Background()
+	session := &mcp.ServerSession{}
+	params := &mcp.CallToolParamsFor[CreateProjectWithInitialTasksParams]{
+		Arguments: CreateProjectWithInitialTasksParams{
+			ProjectName:  "Big Project",
+			CreatedBy:    "test.user",
+			InitialTasks: []InitialTaskSpec{{TaskName: "Task 1"}, {TaskName: "Task 2"}, {TaskName: "Task 3"}},
+		},
+	}
+
+	// Default overflow mode rejects the request
+	_, err := projectTools.HandleCreateProjectWithInitialTasks(ctx, session, params)
+	if err == nil {
+		t.Fatal("Expected error for too many initial tasks")
+	}
+	if !strings.Contains(err.Error(), "maximum is 2") {
+		t.Errorf("Expected error to report the cap, got: %v", err)
+	}
+
+	// Truncate mode creates only up to the cap and reports it
+	cfg.InitialTasksOverflow = "truncate"
+	result, err := projectTools.HandleCreateProjectWithInitialTasks(ctx, session, params)
+	if err != nil {
+		t.Fatalf("Expected truncation to succeed, got: %v", err)
+	}
+	if total := result.Meta["total_created"].(int); total != 2 {
+		t.Errorf("Expected 2 tasks created, got %d", total)
+	}
+	if truncated, _ := result.Meta["truncated"].(bool); !truncated {
+		t.Error("Expected truncated flag to be set")
+	}
+}
+
+func TestProjectTools_HandleGetCrossProjectDependencies(t *testing.T) {
+	projects := []Project{
+		{ProjectID: "proj-app", ProjectName: "Mobile App"},
+		{ProjectID: "proj-api", ProjectName: "Platform API"},
+	}
+	tasks := []Task{
+		{TaskID: "app-1", TaskName: "Build login screen", Status: "Blocked", ProjectID: stringPtr("proj-app"), BlockedBy: []string{"api-1"}},
+		{TaskID: "app-2", TaskName: "Polish UI", Status: "In Progress", ProjectID: stringPtr("proj-app"), BlockedBy: []string{"app-1"}},
+		{TaskID: "api-1", TaskName: "Ship auth endpoint", Status: "In Progress", ProjectID: stringPtr("proj-api")},
+		{TaskID: "api-2", TaskName: "Document SDK", Status: "Not Started", ProjectID: stringPtr("proj-api"), BlockedBy: []string{"app-2"}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects":
+			json.NewEncoder(w).Encode(projects)
+		case "/api/v1/tasks":
+			json.NewEncoder(w).Encode(tasks)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
+	projectTools := NewProjectTools(apiClient, config.Default())
+
+	result, err := projectTools.HandleGetCrossProjectDependencies(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetCrossProjectDependenciesParams]{
+		Arguments: GetCrossProjectDependenciesParams{ProjectID: "proj-app"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetCrossProjectDependencies failed: %v", err)
+	}
+
+	externalProjects := result.Meta["external_projects"].([]map[string]any)
+	if len(externalProjects) != 1 {
+		t.Fatalf("Expected 1 external project, got %d", len(externalProjects))
+	}
+	if externalProjects[0]["project_name"] != "Platform API" {
+		t.Errorf("Expected external project name 'Platform API', got %v", externalProjects[0]["project_name"])
+	}
+
+	links := externalProjects[0]["links"].([]map[string]any)
+	if len(links) != 2 {
+		t.Fatalf("Expected 2 cross-project links, got %d", len(links))
+	}
+	if links[0]["direction"] != "depends_on" || links[0]["task_id"] != "app-1" || links[0]["external_task_id"] != "api-1" {
+		t.Errorf("Expected app-1 to depend on api-1, got %+v", links[0])
+	}
+	if links[1]["direction"] != "depended_on_by" || links[1]["task_id"] != "app-2" || links[1]["external_task_id"] != "api-2" {
+		t.Errorf("Expected app-2 to be depended on by api-2, got %+v", links[1])
+	}
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	if !strings.Contains(text, "Platform API") || !strings.Contains(text, "Build login screen is blocked by Ship auth endpoint") {
+		t.Errorf("Expected cross-link in response text, got: %s", text)
+	}
+
+	if _, err := projectTools.HandleGetCrossProjectDependencies(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetCrossProjectDependenciesParams]{}); err == nil {
+		t.Error("Expected error for missing project_id")
+	}
+}
+
+func TestProjectTools_HandleGetProjectContributors(t *testing.T) {
+	tasks := []Task{
+		{TaskID: "t1", TaskName: "Design API", Status: "In Progress", ProjectID: stringPtr("proj-1"), AssignedTo: stringPtr("alice"), CreatedBy: "bob"},
+		{TaskID: "t2", TaskName: "Write docs", Status: "Not Started", ProjectID: stringPtr("proj-1"), CreatedBy: "bob"},
+	}
+	notes := map[string][]TaskNote{
+		"t1": {
+			{NoteID: "n1", TaskID: "t1", CreatedBy: "alice"},
+			{NoteID: "n2", TaskID: "t1", CreatedBy: "carol"},
+		},
+		"t2": {
+			{NoteID: "n3", TaskID: "t2", CreatedBy: "carol"},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/v1/projects/proj-1/tasks" {
+			json.NewEncoder(w).Encode(tasks)
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "/notes") {
+			taskID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v1/tasks/"), "/notes")
+			json.NewEncoder(w).Encode(notes[taskID])
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	projectTools := NewProjectTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+
+	result, err := projectTools.HandleGetProjectContributors(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetProjectContributorsParams]{
+		Arguments: GetProjectContributorsParams{ProjectID: "proj-1"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetProjectContributors failed: %v", err)
+	}
+
+	if result.Meta["unassigned_tasks"] != 1 {
+		t.Errorf("Expected 1 unassigned task, got %v", result.Meta["unassigned_tasks"])
+	}
+	if result.Meta["total_notes"] != 3 {
+		t.Errorf("Expected 3 notes, got %v", result.Meta["total_notes"])
+	}
+
+	byUser := make(map[string]map[string]any)
+	for _, c := range result.Meta["contributors"].([]map[string]any) {
+		byUser[c["user_id"].(string)] = c
+	}
+	if len(byUser) != 3 {
+		t.Fatalf("Expected 3 contributors, got %d: %+v", len(byUser), byUser)
+	}
+
+	carol, ok := byUser["carol"]
+	if !ok {
+		t.Fatal("Expected commenter-only user carol to appear as a contributor")
+	}
+	if roles := carol["roles"].([]string); len(roles) != 1 || roles[0] != "commenter" {
+		t.Errorf("Expected carol to be a commenter only, got %v", roles)
+	}
+	if carol["note_count"] != 2 || carol["task_count"] != 2 || carol["assigned_tasks"] != 0 {
+		t.Errorf("Unexpected counts for carol: %+v", carol)
+	}
+
+	if roles := byUser["alice"]["roles"].([]string); len(roles) != 2 || roles[0] != "assignee" || roles[1] != "commenter" {
+		t.Errorf("Expected alice to be assignee and commenter, got %v", roles)
+	}
+	if byUser["bob"]["created_tasks"] != 2 {
+		t.Errorf("Expected bob to have created 2 tasks, got %v", byUser["bob"]["created_tasks"])
+	}
+
+	if _, err := projectTools.HandleGetProjectContributors(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetProjectContributorsParams]{}); err == nil {
+		t.Error("Expected error for missing project_id")
+	}
+}
+
+func TestProjectTools_HandleSuggestReassignment(t *testing.T) {
+	tasks := []Task{
+		{TaskID: "t1", TaskName: "Design API", Status: "Not Started", AssignedTo: stringPtr("alice")},
+		{TaskID: "t2", TaskName: "Write docs", Status: "Not Started", AssignedTo: stringPtr("alice")},
+		{TaskID: "t3", TaskName: "Fix login", Status: "Blocked", AssignedTo: stringPtr("alice")},
+		{TaskID: "t4", TaskName: "Old work", Status: "Complete", AssignedTo: stringPtr("bob")},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/v1/projects/proj-1/tasks" {
+			json.NewEncoder(w).Encode(tasks)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	projectTools := NewProjectTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+
+	result, err := projectTools.HandleSuggestReassignment(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[SuggestReassignmentParams]{
+		Arguments: SuggestReassignmentParams{ProjectID: "proj-1"},
+	})
+	if err != nil {
+		t.Fatalf("HandleSuggestReassignment failed: %v", err)
+	}
+
+	suggestions := result.Meta["suggestions"].([]reassignmentSuggestion)
+	if len(suggestions) != 1 || suggestions[0].TaskID != "t1" || suggestions[0].From != "alice" || suggestions[0].To != "bob" {
+		t.Errorf("Expected t1 moved from alice to bob, got %+v", suggestions)
+	}
+	before, after := result.Meta["before"].(map[string]int), result.Meta["after"].(map[string]int)
+	if before["alice"] != 3 || before["bob"] != 0 || after["alice"] != 2 || after["bob"] != 1 {
+		t.Errorf("Unexpected distribution before %v after %v", before, after)
+	}
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"- alice: 3 → 2 (overloaded)", "- bob: 0 → 1 (underloaded)", "Move Design API (t1, Not Started) from alice to bob"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected %q in text, got:\n%s", want, text)
+		}
+	}
+
+	if _, err := projectTools.HandleSuggestReassignment(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[SuggestReassignmentParams]{}); err == nil {
+		t.Error("Expected error for missing project_id")
+	}
+}
+
+func TestProjectTools_HandleGetProjectTimeline(t *testing.T) {
+	projects := map[string]Project{
+		"proj-1": {ProjectID: "proj-1", ProjectName: "Launch", CreatedBy: "alice", CreationDate: "2024-06-01T00:00:00Z",
+			Milestones: []Milestone{{Name: "Beta", DueDate: "2024-06-20"}}},
+		"proj-2": {ProjectID: "proj-2", ProjectName: "Fresh", CreationDate: "2024-06-08T00:00:00Z"},
+	}
+	tasks := map[string][]Task{
+		"proj-1": {
+			{TaskID: "t2", TaskName: "Build", Status: "Complete", CreationDate: "2024-06-03T00:00:00Z", CompletionDate: stringPtr("2024-06-09T00:00:00Z")},
+			{TaskID: "t1", TaskName: "Plan", Status: "Complete", CreatedBy: "bob", CreationDate: "2024-06-02T00:00:00Z", CompletionDate: stringPtr("2024-06-05T00:00:00Z")},
+			{TaskID: "t3", TaskName: "Test", Status: "In Progress", CreationDate: "2024-06-04T00:00:00Z"},
+			{TaskID: "t4", TaskName: "Ship", Status: "Not Started", CreationDate: "2024-06-04T00:00:00Z"},
+			{TaskID: "t5", TaskName: "Dropped", Status: "Not Started", Archived: true, CreationDate: "2024-06-04T00:00:00Z"},
+		},
+		"proj-2": {
+			{TaskID: "t6", TaskName: "Start", Status: "In Progress", CreationDate: "2024-06-09T00:00:00Z"},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/projects/")
+		if projectID, ok := strings.CutSuffix(id, "/tasks"); ok {
+			json.NewEncoder(w).Encode(tasks[projectID])
+			return
+		}
+		if project, ok := projects[id]; ok {
+			json.NewEncoder(w).Encode(project)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	projectTools := NewProjectTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	projectTools.SetClock(clock.NewFixed(time.Date(2024, 6, 11, 0, 0, 0, 0, time.UTC)))
+
+	result, err := projectTools.HandleGetProjectTimeline(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetProjectTimelineParams]{
+		Arguments: GetProjectTimelineParams{ProjectID: "proj-1"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetProjectTimeline failed: %v", err)
+	}
+
+	events := result.Meta["events"].([]timelineEvent)
+	var order []string
+	for _, event := range events {
+		order = append(order, event.Type+":"+event.TaskID)
+	}
+	if expected := "created: first_task:t1 completed:t1 completed:t2 milestone:"; strings.Join(order, " ") != expected {
+		t.Errorf("Expected events %q, got %q", expected, strings.Join(order, " "))
+	}
+
+	// Two completions over the 10 days since creation leave 2 active tasks
+	if result.Meta["velocity_available"] != true || result.Meta["velocity_per_day"] != 0.2 {
+		t.Errorf("Expected velocity of 0.2 tasks/day, got %v", result.Meta["velocity_per_day"])
+	}
+	if result.Meta["completed_tasks"] != 2 || result.Meta["remaining_tasks"] != 2 {
+		t.Errorf("Expected 2 completed and 2 remaining, got %v and %v", result.Meta["completed_tasks"], result.Meta["remaining_tasks"])
+	}
+	if result.Meta["projected_end_date"] != "2024-06-21T00:00:00Z" {
+		t.Errorf("Expected projected end 2024-06-21, got %v", result.Meta["projected_end_date"])
+	}
+	text := result.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"Velocity: 0.20 tasks/day since 2024-06-01", "Projected completion: 2024-06-21 (2 tasks remaining)", "First task created: Plan by bob"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected %q in text, got:\n%s", want, text)
+		}
+	}
+
+	// Without completed tasks there is no velocity to project from
+	result, err = projectTools.HandleGetProjectTimeline(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetProjectTimelineParams]{
+		Arguments: GetProjectTimelineParams{ProjectID: "proj-2"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetProjectTimeline failed: %v", err)
+	}
+	if result.Meta["velocity_available"] != false {
+		t.Errorf("Expected velocity to be unavailable, got %v", result.Meta["velocity_available"])
+	}
+	if _, ok := result.Meta["projected_end_date"]; ok {
+		t.Errorf("Expected no projected end date, got %v", result.Meta["projected_end_date"])
+	}
+	if text := result.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "Velocity cannot be computed yet") {
+		t.Errorf("Expected velocity to be reported as not computable, got:\n%s", text)
+	}
+
+	if _, err := projectTools.HandleGetProjectTimeline(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetProjectTimelineParams]{}); err == nil {
+		t.Error("Expected error for missing project_id")
+	}
+}
+
+func TestProjectTools_HandleDeleteProject(t *testing.T) {
+	for _, policy := range []string{"soft", "hard"} {
+		t.Run(policy, func(t *testing.T) {
+			var methods []string
+			var update map[string]any
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				methods = append(methods, r.Method)
+				w.Header().Set("Content-Type", "application/json")
+				if r.URL.Path == "/api/v1/projects/proj-1/tasks" {
+					json.NewEncoder(w).Encode([]Task{{TaskID: "t1", Status: "Complete", Archived: true}})
+					return
+				}
+				if r.URL.Path != "/api/v1/projects/proj-1" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				switch r.Method {
+				case "GET":
+					json.NewEncoder(w).Encode(Project{ProjectID: "proj-1", ProjectName: "Legacy"})
+				case "PUT":
+					json.NewDecoder(r.Body).Decode(&update)
+					json.NewEncoder(w).Encode(Project{ProjectID: "proj-1", ProjectName: "Legacy"})
+				case "DELETE":
+					w.WriteHeader(http.StatusNoContent)
+				}
+			}))
+			defer server.Close()
+
+			cfg := config.Default()
+			cfg.DeletePolicy = policy
+			projectTools := NewProjectTools(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+
+			if _, err := projectTools.HandleDeleteProject(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[DeleteProjectParams]{
+				Arguments: DeleteProjectParams{ProjectID: "proj-1", DeletedBy: "alice"},
+			}); err != nil {
+				t.Fatalf("HandleDeleteProject failed: %v", err)
+			}
+
+			if policy == "soft" {
+				if strings.Join(methods, ",") != "GET,GET,PUT" {
+					t.Errorf("Expected soft delete to archive via PUT, got %v", methods)
+				}
+				if update["archived"] != true || update["deleted_by"] != "alice" || update["deleted_at"] == nil {
+					t.Errorf("Expected archived project marked deleted, got %+v", update)
+				}
+			} else if strings.Join(methods, ",") != "GET,GET,DELETE" {
+				t.Errorf("Expected hard delete to call DELETE, got %v", methods)
+			}
+		})
+	}
+}
+
+func TestProjectTools_HandleDeleteProject_RefusesWithActiveTasks(t *testing.T) {
+	var methods []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		methods = append(methods, r.Method)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects/proj-1":
+			json.NewEncoder(w).Encode(Project{ProjectID: "proj-1", ProjectName: "Live"})
+		case "/api/v1/projects/proj-1/tasks":
+			json.NewEncoder(w).Encode([]Task{
+				{TaskID: "t1", Status: "In Progress"},
+				{TaskID: "t2", Status: "Complete"},
+				{TaskID: "t3", Status: "Complete", Archived: true},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	projectTools := NewProjectTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	_, err := projectTools.HandleDeleteProject(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[DeleteProjectParams]{
+		Arguments: DeleteProjectParams{ProjectID: "proj-1", DeletedBy: "alice"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "2 non-archived tasks") {
+		t.Errorf("Expected deletion refused over 2 non-archived tasks, got %v", err)
+	}
+	if strings.Join(methods, ",") != "GET,GET" {
+		t.Errorf("Expected no write after the refusal, got %v", methods)
+	}
+}
+
+func TestProjectTools_HandleArchiveProject(t *testing.T) {
+	project := Project{ProjectID: "proj-1", ProjectName: "Legacy"}
+	var updates []map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.URL.Path == "/api/v1/projects/proj-1/tasks":
+			json.NewEncoder(w).Encode([]Task{
+				{TaskID: "t1", Status: "Not Started"},
+				{TaskID: "t2", Status: "Complete", Archived: true},
+			})
+		case r.URL.Path == "/api/v1/projects/proj-1" && r.Method == "PUT":
+			var update map[string]any
+			json.NewDecoder(r.Body).Decode(&update)
+			updates = append(updates, update)
+			project.Archived = true
+			json.NewEncoder(w).Encode(project)
+		case r.URL.Path == "/api/v1/projects/proj-1":
+			json.NewEncoder(w).Encode(project)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	projectTools := NewProjectTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	params := &mcp.CallToolParamsFor[ArchiveProjectParams]{
+		Arguments: ArchiveProjectParams{ProjectID: "proj-1", ArchivedBy: "alice"},
+	}
+
+	result, err := projectTools.HandleArchiveProject(context.Background(), &mcp.ServerSession{}, params)
+	if err != nil {
+		t.Fatalf("HandleArchiveProject failed: %v", err)
+	}
+	if len(updates) != 1 || updates[0]["archived"] != true || updates[0]["last_updated_by"] != "alice" {
+		t.Errorf("Expected one PUT setting archived, got %+v", updates)
+	}
+	if _, marked := updates[0]["deleted_by"]; marked {
+		t.Errorf("Expected archiving not to mark the project deleted, got %+v", updates[0])
+	}
+	if result.Meta["task_count"] != 2 || result.Meta["unarchived_tasks"] != 1 || result.Meta["already_archived"] != false {
+		t.Errorf("Unexpected counts in meta: %+v", result.Meta)
+	}
+
+	// Archiving again changes nothing
+	result, err = projectTools.HandleArchiveProject(context.Background(), &mcp.ServerSession{}, params)
+	if err != nil {
+		t.Fatalf("Repeated HandleArchiveProject failed: %v", err)
+	}
+	if len(updates) != 1 || result.Meta["already_archived"] != true {
+		t.Errorf("Expected repeated archive to be a no-op, got %d updates, meta %+v", len(updates), result.Meta)
+	}
+}
+
+func TestProjectTools_HandleUpdateProject(t *testing.T) {
+	project := Project{ProjectID: "proj-1", ProjectName: "Legacy", ProjectDescription: stringPtr("Old system")}
+	var updates []map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/v1/projects/proj-1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == "PUT" {
+			var update map[string]any
+			json.NewDecoder(r.Body).Decode(&update)
+			updates = append(updates, update)
+			if name, ok := update["project_name"].(string); ok {
+				project.ProjectName = name
+			}
+			if description, ok := update["project_description"]; ok {
+				if description == nil {
+					project.ProjectDescription = nil
+				} else {
+					project.ProjectDescription = stringPtr(description.(string))
+				}
+			}
+		}
+		json.NewEncoder(w).Encode(project)
+	}))
+	defer server.Close()
+
+	projectTools := NewProjectTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	update := func(args UpdateProjectParams) (*mcp.CallToolResultFor[map[string]any], error) {
+		args.ProjectID, args.UpdatedBy = "proj-1", "alice"
+		return projectTools.HandleUpdateProject(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[UpdateProjectParams]{Arguments: args})
+	}
+
+	// A name-only update leaves the description out of the request
+	result, err := update(UpdateProjectParams{ProjectName: "Modernised"})
+	if err != nil {
+		t.Fatalf("HandleUpdateProject failed: %v", err)
+	}
+	if len(updates) != 1 || updates[0]["project_name"] != "Modernised" || updates[0]["last_updated_by"] != "alice" {
+		t.Errorf("Expected one PUT renaming the project, got %+v", updates)
+	}
+	if _, sent := updates[0]["project_description"]; sent {
+		t.Errorf("Expected description to be left out of a name-only update, got %+v", updates[0])
+	}
+	updated := result.Meta["project"].(Project)
+	if updated.ProjectName != "Modernised" || updated.ProjectDescription == nil || *updated.ProjectDescription != "Old system" {
+		t.Errorf("Expected renamed project with its description, got %+v", updated)
+	}
+	if fields := result.Meta["changed_fields"].([]string); len(fields) != 1 || fields[0] != "project_name" {
+		t.Errorf("Expected only project_name changed, got %v", fields)
+	}
+	if text := result.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "- Name: Legacy → Modernised") {
+		t.Errorf("Expected name change in text, got:\n%s", text)
+	}
+
+	// Clearing the description sends an explicit null
+	result, err = update(UpdateProjectParams{ClearDescription: true})
+	if err != nil {
+		t.Fatalf("HandleUpdateProject failed: %v", err)
+	}
+	if len(updates) != 2 {
+		t.Fatalf("Expected a second PUT, got %+v", updates)
+	}
+	if description, sent := updates[1]["project_description"]; !sent || description != nil {
+		t.Errorf("Expected project_description cleared with null, got %+v", updates[1])
+	}
+	if _, sent := updates[1]["project_name"]; sent {
+		t.Errorf("Expected name to be left out when clearing the description, got %+v", updates[1])
+	}
+	if updated := result.Meta["project"].(Project); updated.ProjectDescription != nil {
+		t.Errorf("Expected description to be cleared, got %q", *updated.ProjectDescription)
+	}
+	if text := result.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "- Description: cleared") {
+		t.Errorf("Expected cleared description in text, got:\n%s", text)
+	}
+
+	// Unchanged values make no request
+	result, err = update(UpdateProjectParams{ProjectName: "Modernised", ClearDescription: true})
+	if err != nil {
+		t.Fatalf("HandleUpdateProject failed: %v", err)
+	}
+	if len(updates) != 2 || len(result.Meta["changed_fields"].([]string)) != 0 {
+		t.Errorf("Expected no PUT for unchanged values, got %d updates, meta %+v", len(updates), result.Meta)
+	}
+
+	if _, err := update(UpdateProjectParams{}); err == nil {
+		t.Error("Expected error when nothing is provided to update")
+	}
+	if _, err := update(UpdateProjectParams{ProjectDescription: "New", ClearDescription: true}); err == nil {
+		t.Error("Expected error when setting and clearing the description together")
+	}
+}
+
+func TestProjectTools_HandleRestoreProject(t *testing.T) {
+	deletedBy := "alice"
+	project := Project{ProjectID: "proj-1", ProjectName: "Legacy", Archived: true, DeletedBy: &deletedBy}
+	var update map[string]any
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path != "/api/v1/projects/proj-1" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Method == "PUT" {
+			json.NewDecoder(r.Body).Decode(&update)
+			project = Project{ProjectID: "proj-1", ProjectName: "Legacy"}
+		}
+		json.NewEncoder(w).Encode(project)
+	}))
+	defer server.Close()
+
+	projectTools := NewProjectTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	params := &mcp.CallToolParamsFor[RestoreProjectParams]{
+		Arguments: RestoreProjectParams{ProjectID: "proj-1", RestoredBy: "bob"},
+	}
+
+	result, err := projectTools.HandleRestoreProject(context.Background(), &mcp.ServerSession{}, params)
+	if err != nil {
+		t.Fatalf("HandleRestoreProject failed: %v", err)
+	}
+	if update["archived"] != false || update["deleted_by"] != nil {
+		t.Errorf("Expected deleted markers cleared, got %+v", update)
+	}
+	if restored := result.Meta["project"].(Project); restored.Archived {
+		t.Error("Expected restored project to be active")
+	}
+
+	// A second restore fails because the project is no longer deleted
+	if _, err := projectTools.HandleRestoreProject(context.Background(), &mcp.ServerSession{}, params); err == nil {
+		t.Error("Expected error restoring a project that is not deleted")
+	}
+}
+
+func TestProjectTools_HandleGetOverdueByProject(t *testing.T) {
+	past := func(days int) *string {
+		due := time.Now().Add(-time.Duration(days) * 24 * time.Hour).UTC().Format(time.RFC3339)
+		return &due
+	}
+	future := time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339)
+
+	projects := []Project{
+		{ProjectID: "proj-web", ProjectName: "Website"},
+		{ProjectID: "proj-ops", ProjectName: "Operations"},
+		{ProjectID: "proj-ok", ProjectName: "On Track"},
+	}
+	tasks := []Task{
+		{TaskID: "web-1", TaskName: "Fix footer", Status: "In Progress", ProjectID: stringPtr("proj-web"), DueDate: past(2)},
+		{TaskID: "ops-1", TaskName: "Rotate keys", Status: "Not Started", ProjectID: stringPtr("proj-ops"), DueDate: past(3)},
+		{TaskID: "ops-2", TaskName: "Patch hosts", Status: "Blocked", ProjectID: stringPtr("proj-ops"), DueDate: past(9)},
+		{TaskID: "ops-3", TaskName: "Old audit", Status: "Complete", ProjectID: stringPtr("proj-ops"), DueDate: past(20)},
+		{TaskID: "ok-1", TaskName: "Plan launch", Status: "In Progress", ProjectID: stringPtr("proj-ok"), DueDate: &future},
+		{TaskID: "loose-1", TaskName: "Expense report", Status: "Not Started", DueDate: past(1)},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects":
+			json.NewEncoder(w).Encode(projects)
+		case "/api/v1/tasks":
+			json.NewEncoder(w).Encode(tasks)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
+	projectTools := NewProjectTools(apiClient, config.Default())
+
+	result, err := projectTools.HandleGetOverdueByProject(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetOverdueByProjectParams]{})
+	if err != nil {
+		t.Fatalf("HandleGetOverdueByProject failed: %v", err)
+	}
+
+	ranked := result.Meta["projects"].([]map[string]any)
+	if len(ranked) != 2 {
+		t.Fatalf("Expected 2 projects with overdue tasks, got %d", len(ranked))
+	}
+	if ranked[0]["project_name"] != "Operations" || ranked[0]["overdue_count"] != 2 {
+		t.Errorf("Expected Operations ranked first with 2 overdue, got %+v", ranked[0])
+	}
+	if ranked[1]["project_name"] != "Website" || ranked[1]["overdue_count"] != 1 {
+		t.Errorf("Expected Website ranked second with 1 overdue, got %+v", ranked[1])
+	}
+	if ranked[0]["max_days_overdue"] != 9 {
+		t.Errorf("Expected Operations oldest overdue to be 9 days, got %v", ranked[0]["max_days_overdue"])
+	}
+
+	noProject := result.Meta["no_project"].(map[string]any)
+	if noProject["overdue_count"] != 1 {
+		t.Errorf("Expected 1 overdue task without a project, got %v", noProject["overdue_count"])
+	}
+	if result.Meta["total_overdue"] != 4 {
+		t.Errorf("Expected total_overdue 4, got %v", result.Meta["total_overdue"])
+	}
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	if !strings.Contains(text, "1. Operations: 2 overdue") {
+		t.Errorf("Expected Operations ranked first in response text, got: %s", text)
+	}
+}
+
+func TestProjectTools_HandleResumeProjectTasks(t *testing.T) {
+	var created []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/proj-1":
+			json.NewEncoder(w).Encode(Project{ProjectID: "proj-1", ProjectName: "Launch"})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/proj-1/tasks":
+			json.NewEncoder(w).Encode([]Task{
+				{TaskID: "t1", TaskName: "Write brief"},
+				{TaskID: "t2", TaskName: "Book venue"},
+			})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/tasks":
+			var req map[string]any
+			json.NewDecoder(r.Body).Decode(&req)
+			created = append(created, req["task_name"].(string))
+			json.NewEncoder(w).Encode(Task{TaskID: "new-" + req["task_name"].(string), TaskName: req["task_name"].(string)})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	projectTools := NewProjectTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	params := &mcp.CallToolParamsFor[ResumeProjectTasksParams]{
+		Arguments: ResumeProjectTasksParams{
+			ProjectID: "proj-1",
+			CreatedBy: "test.user",
+			Tasks: []InitialTaskSpec{
+				{TaskName: "Write brief"},
+				{TaskName: "book venue "},
+				{TaskName: "Send invites"},
+				{TaskName: "Order catering"},
+			},
+		},
+	}
+
+	result, err := projectTools.HandleResumeProjectTasks(context.Background(), &mcp.ServerSession{}, params)
+	if err != nil {
+		t.Fatalf("HandleResumeProjectTasks failed: %v", err)
+	}
+
+	if strings.Join(created, ",") != "Send invites,Order catering" {
+		t.Errorf("Expected only missing tasks to be created, got %v", created)
+	}
+	if result.Meta["total_created"] != 2 || result.Meta["total_skipped"] != 2 || result.Meta["total_failed"] != 0 {
+		t.Errorf("Expected 2 created and 2 skipped, got %v", result.Meta)
+	}
+	skipped := result.Meta["skipped_tasks"].([]InitialTaskSpec)
+	if len(skipped) != 2 || skipped[0].TaskName != "Write brief" {
+		t.Errorf("Unexpected skipped tasks: %+v", skipped)
+	}
+
+	params.Arguments.Tasks = nil
+	if _, err := projectTools.HandleResumeProjectTasks(context.Background(), &mcp.ServerSession{}, params); err == nil {
+		t.Error("Expected error when no tasks are given")
+	}
+}
+
+func TestProjectTools_HandleAddTasksToProject(t *testing.T) {
+	var created []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/proj-1":
+			json.NewEncoder(w).Encode(Project{ProjectID: "proj-1", ProjectName: "Launch", DefaultAssignee: stringPtr("dana")})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/tasks":
+			var req map[string]any
+			json.NewDecoder(r.Body).Decode(&req)
+			name := req["task_name"].(string)
+			if name == "Rejected" {
+				w.WriteHeader(http.StatusBadRequest)
+				json.NewEncoder(w).Encode(map[string]string{"error": "rejected"})
+				return
+			}
+			created = append(created, name)
+			assignee, _ := req["assigned_to"].(string)
+			json.NewEncoder(w).Encode(Task{TaskID: "new-" + name, TaskName: name, Status: "Not Started", AssignedTo: &assignee})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	projectTools := NewProjectTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	params := &mcp.CallToolParamsFor[AddTasksToProjectParams]{
+		Arguments: AddTasksToProjectParams{
+			ProjectID: "proj-1",
+			CreatedBy: "test.user",
+			Tasks: []InitialTaskSpec{
+				{TaskName: "Send invites"},
+				{TaskName: "Rejected"},
+				{TaskName: "Bad date", DueDate: "someday"},
+				{TaskName: "Order catering", AssignedTo: "erin"},
+			},
+		},
+	}
+
+	result, err := projectTools.HandleAddTasksToProject(context.Background(), &mcp.ServerSession{}, params)
+	if err != nil {
+		t.Fatalf("HandleAddTasksToProject failed: %v", err)
+	}
+
+	// Failures don't stop the remaining tasks
+	if strings.Join(created, ",") != "Send invites,Order catering" {
+		t.Errorf("Expected the valid tasks to be created, got %v", created)
+	}
+	if result.Meta["total_created"] != 2 || result.Meta["total_failed"] != 2 || result.Meta["success_rate"] != 50.0 {
+		t.Errorf("Expected 2 created, 2 failed at 50%%, got %v", result.Meta)
+	}
+	results := result.Meta["results"].([]taskCreationResult)
+	if len(results) != 4 {
+		t.Fatalf("Expected a result per task, got %+v", results)
+	}
+	for i, success := range []bool{true, false, false, true} {
+		if results[i].Success != success {
+			t.Errorf("Expected %s success=%v, got %+v", results[i].TaskName, success, results[i])
+		}
+	}
+	if results[0].TaskID != "new-Send invites" || !strings.Contains(results[2].Error, "invalid due_date") {
+		t.Errorf("Unexpected per-task results: %+v", results)
+	}
+
+	createdTasks := result.Meta["created_tasks"].([]Task)
+	if *createdTasks[0].AssignedTo != "dana" || *createdTasks[1].AssignedTo != "erin" {
+		t.Errorf("Expected the project default assignee only for unassigned tasks, got %s and %s", *createdTasks[0].AssignedTo, *createdTasks[1].AssignedTo)
+	}
+	text := result.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{"Success Rate: 50.0%", "- Rejected:", "- Bad date: invalid due_date"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected %q in text, got:\n%s", want, text)
+		}
+	}
+
+	// A missing project is reported before any task is created
+	created = nil
+	params.Arguments.ProjectID = "missing"
+	if _, err := projectTools.HandleAddTasksToProject(context.Background(), &mcp.ServerSession{}, params); err == nil {
+		t.Error("Expected error for a project that does not exist")
+	}
+	if len(created) != 0 {
+		t.Errorf("Expected no tasks created for a missing project, got %v", created)
+	}
+
+	params.Arguments.Tasks = nil
+	if _, err := projectTools.HandleAddTasksToProject(context.Background(), &mcp.ServerSession{}, params); err == nil {
+		t.Error("Expected error when no tasks are given")
+	}
+}
+
+func TestProjectTools_HandleGetProjectRiskScore(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects/proj-1":
+			json.NewEncoder(w).Encode(Project{ProjectID: "proj-1", ProjectName: "Launch"})
+		case "/api/v1/projects/proj-1/tasks":
+			json.NewEncoder(w).Encode([]Task{
+				{TaskID: "t1", Status: "Blocked", DueDate: stringPtr("2024-06-17"), LastUpdateDate: stringPtr("2024-06-11T09:00:00Z")},
+				{TaskID: "t2", Status: "Not Started", DueDate: stringPtr("2024-06-18"), LastUpdateDate: stringPtr("2024-06-11T09:00:00Z")},
+				{TaskID: "t3", Status: "Not Started", DueDate: stringPtr("2024-06-19"), LastUpdateDate: stringPtr("2024-06-11T09:00:00Z")},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	projectTools := NewProjectTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	projectTools.SetClock(clock.NewFixed(time.Date(2024, 6, 12, 12, 0, 0, 0, time.UTC)))
+
+	result, err := projectTools.HandleGetProjectRiskScore(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetProjectRiskScoreParams]{
+		Arguments: GetProjectRiskScoreParams{ProjectID: "proj-1"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetProjectRiskScore failed: %v", err)
+	}
+
+	// 1 of 3 blocked (25/3 pts) plus all 3 due the week of 2024-06-17 (15 pts)
+	if result.Meta["risk_score"] != 23 || result.Meta["risk_level"] != "low" {
+		t.Errorf("Expected risk score 23 (low), got %v (%v)", result.Meta["risk_score"], result.Meta["risk_level"])
+	}
+	factors := result.Meta["factors"].([]map[string]any)
+	if len(factors) != 5 || factors[4]["factor"] != "due_date_clustering" || factors[4]["points"] != 15.0 {
+		t.Errorf("Unexpected factors: %v", factors)
+	}
+	text := result.Content[0].(*mcp.TextContent).Text
+	if !strings.Contains(text, "Risk score: 23/100 (low)") || !strings.Contains(text, "week of 2024-06-17") {
+		t.Errorf("Unexpected response text: %s", text)
+	}
+
+	if _, err := projectTools.HandleGetProjectRiskScore(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetProjectRiskScoreParams]{}); err == nil {
+		t.Error("Expected error when project_id is missing")
+	}
+}
+
+func TestProjectTools_ProjectMilestones(t *testing.T) {
+	project := Project{ProjectID: "proj-1", ProjectName: "Launch"}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects/proj-1":
+			if r.Method == "PUT" {
+				var update struct {
+					Milestones []Milestone `json:"milestones"`
+				}
+				json.NewDecoder(r.Body).Decode(&update)
+				project.Milestones = update.Milestones
+			}
+			json.NewEncoder(w).Encode(project)
+		case "/api/v1/projects/proj-1/tasks":
+			json.NewEncoder(w).Encode([]Task{
+				{TaskID: "t1", Status: "Complete"},
+				{TaskID: "t2", Status: "Complete"},
+				{TaskID: "t3", Status: "Complete"},
+				{TaskID: "t4", Status: "In Progress"},
+				{TaskID: "t5", Status: "Not Started"},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	projectTools := NewProjectTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	projectTools.SetClock(clock.NewFixed(time.Date(2024, 6, 12, 12, 0, 0, 0, time.UTC)))
+	ctx := context.Background()
+	session := &mcp.ServerSession{}
+
+	add := func(args AddProjectMilestoneParams) error {
+		_, err := projectTools.HandleAddProjectMilestone(ctx, session, &mcp.CallToolParamsFor[AddProjectMilestoneParams]{Arguments: args})
+		return err
+	}
+	if err := add(AddProjectMilestoneParams{ProjectID: "proj-1", Name: "Beta", DueDate: "2024-06-10", TaskIDs: []string{"t1", "t2", "t3", "t4"}, UpdatedBy: "alice"}); err != nil {
+		t.Fatalf("HandleAddProjectMilestone failed: %v", err)
+	}
+	if err := add(AddProjectMilestoneParams{ProjectID: "proj-1", Name: "GA", DueDate: "2024-07-15", TaskIDs: []string{"t1", "t5"}, UpdatedBy: "alice"}); err != nil {
+		t.Fatalf("HandleAddProjectMilestone failed: %v", err)
+	}
+	if err := add(AddProjectMilestoneParams{ProjectID: "proj-1", Name: "RC", DueDate: "2024-06-20", TaskIDs: []string{"t1", "other-project"}, UpdatedBy: "alice"}); err == nil {
+		t.Error("Expected linking a task outside the project to fail")
+	}
+	if len(project.Milestones) != 2 {
+		t.Fatalf("Expected 2 stored milestones, got %+v", project.Milestones)
+	}
+
+	result, err := projectTools.HandleGetProjectMilestones(ctx, session, &mcp.CallToolParamsFor[GetProjectMilestonesParams]{
+		Arguments: GetProjectMilestonesParams{ProjectID: "proj-1"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetProjectMilestones failed: %v", err)
+	}
+
+	milestones := result.Meta["milestones"].([]MilestoneProgress)
+	if len(milestones) != 2 {
+		t.Fatalf("Expected 2 milestones, got %+v", milestones)
+	}
+	beta, ga := milestones[0], milestones[1]
+	if beta.Name != "Beta" || beta.CompletionPercent != 75 || !beta.Overdue || !beta.AtRisk {
+		t.Errorf("Expected Beta 75%% complete, overdue and at risk, got %+v", beta)
+	}
+	if ga.Name != "GA" || ga.CompletionPercent != 50 || ga.AtRisk {
+		t.Errorf("Expected GA 50%% complete and on track, got %+v", ga)
+	}
+	if result.Meta["at_risk_count"] != 1 {
+		t.Errorf("Expected 1 milestone at risk, got %v", result.Meta["at_risk_count"])
+	}
+}
+
+func TestProjectTools_HandleGetProjectStatus_DueDateClusters(t *testing.T) {
+	tasks := []Task{
+		{TaskID: "t1", Status: "Not Started", DueDate: stringPtr("2024-06-14")},
+		{TaskID: "t2", Status: "In Progress", DueDate: stringPtr("2024-06-14T10:00:00Z")},
+		{TaskID: "t3", Status: "Not Started", DueDate: stringPtr("2024-06-14T16:00:00Z")},
+		{TaskID: "t4", Status: "Not Started", DueDate: stringPtr("2024-06-21")},
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects/proj-1":
+			json.NewEncoder(w).Encode(Project{ProjectID: "proj-1", ProjectName: "Launch"})
+		case "/api/v1/projects/proj-1/tasks":
+			json.NewEncoder(w).Encode(tasks)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	status := func(threshold int) []string {
+		cfg := config.Default()
+		cfg.DueDateClusterThreshold = threshold
+		projectTools := NewProjectTools(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+		projectTools.SetClock(clock.NewFixed(time.Date(2024, 6, 10, 9, 0, 0, 0, time.UTC)))
+		result, err := projectTools.HandleGetProjectStatus(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetProjectStatusParams]{
+			Arguments: GetProjectStatusParams{ProjectID: "proj-1"},
+		})
+		if err != nil {
+			t.Fatalf("HandleGetProjectStatus failed: %v", err)
+		}
+		return result.Meta["insights"].([]string)
+	}
+
+	hasCrunch := func(insights []string) bool {
+		for _, insight := range insights {
+			if strings.Contains(insight, "Due-date crunch") && strings.Contains(insight, "2024-06-14 (3 tasks)") {
+				return true
+			}
+		}
+		return false
+	}
+
+	if insights := status(2); !hasCrunch(insights) {
+		t.Errorf("Expected a crunch warning for three tasks due 2024-06-14, got %v", insights)
+	}
+	if insights := status(3); hasCrunch(insights) {
+		t.Errorf("Expected no crunch warning at threshold 3, got %v", insights)
+	}
+}
+
+func TestProjectTools_HandleGetProjectStatus_SuppressedInsights(t *testing.T) {
+	server := createProjectMockAPIServer()
+	defer server.Close()
+
+	status := func(cfg *config.Config) []string {
+		t.Helper()
+		projectTools := NewProjectTools(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+		result, err := projectTools.HandleGetProjectStatus(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetProjectStatusParams]{
+			Arguments: GetProjectStatusParams{ProjectID: "proj-1"},
+		})
+		if err != nil {
+			t.Fatalf("HandleGetProjectStatus failed: %v", err)
+		}
+		insights, _ := result.Meta["insights"].([]string)
+		return insights
+	}
+
+	if len(status(config.Default())) == 0 {
+		t.Fatal("Expected the mock project to produce insights")
+	}
+
+	cfg := config.Default()
+	cfg.SuppressedInsights = []string{
+		insightProjectOverdue, insightProjectNearlyComplete, insightProjectFinalStretch, insightProjectHalfway,
+		insightProjectEarlyStages, insightProjectHighActivity, insightProjectStartMore, insightProjectDueDateCluster,
+	}
+	if insights := status(cfg); len(insights) != 0 {
+		t.Errorf("Expected every project insight to be suppressible, got %v", insights)
+	}
+}
@@ -0,0 +1,135 @@
+package tools
+
+import (
+	"sort"
+)
+
+// reassignmentSuggestion proposes moving one task between assignees
+type reassignmentSuggestion struct {
+	TaskID   string `json:"task_id"`
+	TaskName string `json:"task_name"`
+	Status   string `json:"status"`
+	From     string `json:"from"`
+	To       string `json:"to"`
+}
+
+// rebalancePlan is the outcome of planRebalancing
+type rebalancePlan struct {
+	Before      map[string]int
+	After       map[string]int
+	Average     float64
+	Overloaded  []string // above the average before any move, most loaded first
+	Underloaded []string // below the average before any move, least loaded first
+	Suggestions []reassignmentSuggestion
+}
+
+// isMovableTask reports whether a task may be suggested for reassignment.
+// Blocked tasks wait on someone else and Review tasks are nearly done, so
+// handing either over would lose context for little gain.
+func isMovableTask(task Task) bool {
+	return task.Status != "Blocked" && task.Status != "Review"
+}
+
+// planRebalancing balances active task counts across the people assigned
+// tasks in tasks. Anyone with an assigned task, even a completed one, is a
+// member who can take work. Tasks move one at a time from the most to the
+// least loaded member while that narrows the gap, preferring work that has
+// not started and then lower priorities. canonical maps an assignee to the
+// name used for grouping.
+func planRebalancing(tasks []Task, canonical func(string) string) rebalancePlan {
+	plan := rebalancePlan{Before: make(map[string]int), After: make(map[string]int)}
+	movable := make(map[string][]Task)
+
+	active := 0
+	for _, task := range tasks {
+		if task.AssignedTo == nil || *task.AssignedTo == "" || task.Archived {
+			continue
+		}
+		member := canonical(*task.AssignedTo)
+		if _, ok := plan.Before[member]; !ok {
+			plan.Before[member] = 0
+		}
+		if task.Status == "Complete" {
+			continue
+		}
+		plan.Before[member]++
+		active++
+		if isMovableTask(task) {
+			movable[member] = append(movable[member], task)
+		}
+	}
+	if len(plan.Before) == 0 {
+		return plan
+	}
+	plan.Average = float64(active) / float64(len(plan.Before))
+
+	members := make([]string, 0, len(plan.Before))
+	for member, load := range plan.Before {
+		members = append(members, member)
+		plan.After[member] = load
+	}
+	sort.Strings(members)
+
+	for _, member := range members {
+		load := float64(plan.Before[member])
+		if load > plan.Average {
+			plan.Overloaded = append(plan.Overloaded, member)
+		} else if load < plan.Average {
+			plan.Underloaded = append(plan.Underloaded, member)
+		}
+	}
+	sort.SliceStable(plan.Overloaded, func(i, j int) bool {
+		return plan.Before[plan.Overloaded[i]] > plan.Before[plan.Overloaded[j]]
+	})
+	sort.SliceStable(plan.Underloaded, func(i, j int) bool {
+		return plan.Before[plan.Underloaded[i]] < plan.Before[plan.Underloaded[j]]
+	})
+
+	// Least disruptive moves first: not started, then lowest priority
+	for _, member := range members {
+		candidates := movable[member]
+		sort.SliceStable(candidates, func(i, j int) bool {
+			startedI, startedJ := candidates[i].Status != "Not Started", candidates[j].Status != "Not Started"
+			if startedI != startedJ {
+				return !startedI
+			}
+			if rankI, rankJ := priorityRank(candidates[i].Priority), priorityRank(candidates[j].Priority); rankI != rankJ {
+				return rankI > rankJ
+			}
+			return candidates[i].TaskID < candidates[j].TaskID
+		})
+	}
+
+	for {
+		// The most loaded member that still has something movable
+		from := ""
+		for _, member := range members {
+			if len(movable[member]) > 0 && (from == "" || plan.After[member] > plan.After[from]) {
+				from = member
+			}
+		}
+		to := ""
+		for _, member := range members {
+			if to == "" || plan.After[member] < plan.After[to] {
+				to = member
+			}
+		}
+		if from == "" || plan.After[from]-plan.After[to] < 2 {
+			break
+		}
+
+		task := movable[from][0]
+		movable[from] = movable[from][1:]
+		plan.After[from]--
+		plan.After[to]++
+		plan.Suggestions = append(plan.Suggestions, reassignmentSuggestion{
+			TaskID:   task.TaskID,
+			TaskName: task.TaskName,
+			Status:   task.Status,
+			From:     from,
+			To:       to,
+		})
+	}
+
+	return plan
+}
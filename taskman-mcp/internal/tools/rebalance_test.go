@@ -0,0 +1,80 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestPlanRebalancing(t *testing.T) {
+	task := func(id, assignee, status, priority string) Task {
+		return Task{TaskID: id, TaskName: id, Status: status, AssignedTo: stringPtr(assignee), Priority: stringPtr(priority)}
+	}
+	identity := func(name string) string { return name }
+
+	t.Run("moves unstarted low-priority work first", func(t *testing.T) {
+		tasks := []Task{
+			task("a1", "alice", "In Progress", "High"),
+			task("a2", "alice", "Not Started", "Low"),
+			task("a3", "alice", "Not Started", "High"),
+			task("a4", "alice", "Blocked", "Medium"),
+			task("a5", "alice", "Review", "Medium"),
+			task("b1", "bob", "In Progress", "Medium"),
+			task("c1", "carol", "Complete", "Medium"),
+			{TaskID: "u1", Status: "Not Started"},
+			{TaskID: "x1", Status: "Not Started", AssignedTo: stringPtr("dave"), Archived: true},
+		}
+
+		plan := planRebalancing(tasks, identity)
+
+		var moves []string
+		for _, s := range plan.Suggestions {
+			moves = append(moves, fmt.Sprintf("%s:%s>%s", s.TaskID, s.From, s.To))
+		}
+		if got := strings.Join(moves, ","); got != "a2:alice>carol,a3:alice>bob,a1:alice>carol" {
+			t.Errorf("Unexpected suggestions %s", got)
+		}
+		if plan.Average != 2 || plan.After["alice"] != 2 || plan.After["bob"] != 2 || plan.After["carol"] != 2 {
+			t.Errorf("Expected an even 2/2/2 split, got average %v after %v", plan.Average, plan.After)
+		}
+		if plan.Before["alice"] != 5 || plan.Before["carol"] != 0 {
+			t.Errorf("Expected before counts to be untouched, got %v", plan.Before)
+		}
+		if _, ok := plan.Before["dave"]; ok {
+			t.Error("Expected assignees of archived tasks not to count as members")
+		}
+		if fmt.Sprint(plan.Overloaded) != "[alice]" || fmt.Sprint(plan.Underloaded) != "[carol bob]" {
+			t.Errorf("Unexpected over/under-loaded members %v %v", plan.Overloaded, plan.Underloaded)
+		}
+	})
+
+	t.Run("never moves blocked or review tasks", func(t *testing.T) {
+		tasks := []Task{
+			task("a1", "alice", "Blocked", "Low"),
+			task("a2", "alice", "Review", "Low"),
+			task("a3", "alice", "Blocked", "Low"),
+			task("b1", "bob", "Complete", "Low"),
+		}
+
+		plan := planRebalancing(tasks, identity)
+		if len(plan.Suggestions) != 0 {
+			t.Errorf("Expected no suggestions, got %+v", plan.Suggestions)
+		}
+		if fmt.Sprint(plan.Overloaded) != "[alice]" {
+			t.Errorf("Expected alice to still be reported overloaded, got %v", plan.Overloaded)
+		}
+	})
+
+	t.Run("groups assignee aliases", func(t *testing.T) {
+		tasks := []Task{
+			task("a1", "Alice", "Not Started", "Low"),
+			task("a2", "alice", "Not Started", "Low"),
+			task("b1", "bob", "Complete", "Low"),
+		}
+
+		plan := planRebalancing(tasks, strings.ToLower)
+		if plan.Before["alice"] != 2 || len(plan.Suggestions) != 1 || plan.Suggestions[0].To != "bob" {
+			t.Errorf("Expected aliases grouped and one task moved to bob, got %v %+v", plan.Before, plan.Suggestions)
+		}
+	})
+}
@@ -0,0 +1,136 @@
+package tools
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// Risk factor weights; they sum to the 100-point maximum score
+const (
+	riskWeightOverdue    = 30
+	riskWeightBlocked    = 25
+	riskWeightUnassigned = 15
+	riskWeightStale      = 15
+	riskWeightClustering = 15
+)
+
+// riskClusterMinimum is the fewest open tasks due in one week that count as a cluster
+const riskClusterMinimum = 3
+
+// riskUnassignedSaturation is the number of unassigned high-priority tasks that
+// earns the full unassigned_critical weight
+const riskUnassignedSaturation = 3
+
+// riskFactor is one explainable contribution to a project's risk score
+type riskFactor struct {
+	name      string
+	maxPoints int
+	value     float64 // 0..1 share of maxPoints earned
+	detail    string
+}
+
+// points is the factor's contribution to the score
+func (f riskFactor) points() float64 {
+	return f.value * float64(f.maxPoints)
+}
+
+// projectRisk is a project's forward-looking risk score from 0 (on track) to 100
+type projectRisk struct {
+	score     int
+	level     string // "low", "medium" or "high"
+	openTasks int
+	factors   []riskFactor
+}
+
+// scoreProjectRisk scores a project's tasks as of now. It has no side
+// effects; every point is attributed to a factor. Only open, unarchived
+// tasks count, so a finished project scores 0.
+func scoreProjectRisk(tasks []Task, now time.Time, staleAfter time.Duration) projectRisk {
+	var open []Task
+	for _, task := range tasks {
+		if task.Status != "Complete" && !task.Archived {
+			open = append(open, task)
+		}
+	}
+	risk := projectRisk{openTasks: len(open), level: "low"}
+	if len(open) == 0 {
+		return risk
+	}
+
+	var overdue, blocked, stale, unassignedHigh int
+	for _, task := range open {
+		if _, ok := overdueDuration(task, now); ok {
+			overdue++
+		}
+		if task.Status == "Blocked" {
+			blocked++
+		}
+		if isTaskStale(task, now, staleAfter) {
+			stale++
+		}
+		if task.Priority != nil && *task.Priority == "High" && (task.AssignedTo == nil || *task.AssignedTo == "") {
+			unassignedHigh++
+		}
+	}
+	total := float64(len(open))
+
+	week, clustered, upcoming := largestDueWeek(open, now)
+	clustering := 0.0
+	clusterDetail := "no week has many tasks due"
+	if clustered >= riskClusterMinimum {
+		clustering = float64(clustered) / float64(upcoming)
+		clusterDetail = fmt.Sprintf("%d of %d upcoming due dates fall in the week of %s", clustered, upcoming, week.Format("2006-01-02"))
+	}
+
+	risk.factors = []riskFactor{
+		{"overdue_ratio", riskWeightOverdue, float64(overdue) / total,
+			fmt.Sprintf("%d of %d open tasks overdue", overdue, len(open))},
+		{"blocked_ratio", riskWeightBlocked, float64(blocked) / total,
+			fmt.Sprintf("%d of %d open tasks blocked", blocked, len(open))},
+		{"unassigned_critical", riskWeightUnassigned, math.Min(float64(unassignedHigh)/riskUnassignedSaturation, 1),
+			fmt.Sprintf("%d high-priority tasks unassigned", unassignedHigh)},
+		{"staleness", riskWeightStale, float64(stale) / total,
+			fmt.Sprintf("%d of %d open tasks idle for %d+ days", stale, len(open), wholeDays(staleAfter))},
+		{"due_date_clustering", riskWeightClustering, clustering, clusterDetail},
+	}
+
+	var score float64
+	for _, factor := range risk.factors {
+		score += factor.points()
+	}
+	risk.score = int(math.Round(score))
+	switch {
+	case risk.score >= 60:
+		risk.level = "high"
+	case risk.score >= 30:
+		risk.level = "medium"
+	}
+	return risk
+}
+
+// largestDueWeek finds the Monday-started week holding the most upcoming due
+// dates among tasks, returning that week, its count and the upcoming total
+func largestDueWeek(tasks []Task, now time.Time) (week time.Time, count, upcoming int) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	weeks := make(map[time.Time]int)
+	for _, task := range tasks {
+		if task.DueDate == nil {
+			continue
+		}
+		due, err := parseDueDate(*task.DueDate)
+		if err != nil || due == nil || due.Before(today) {
+			continue
+		}
+		day := time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, now.Location())
+		monday := day.AddDate(0, 0, -((int(day.Weekday()) + 6) % 7))
+		weeks[monday]++
+		upcoming++
+	}
+	for start, n := range weeks {
+		if n > count || (n == count && start.Before(week)) {
+			week, count = start, n
+		}
+	}
+	return week, count, upcoming
+}
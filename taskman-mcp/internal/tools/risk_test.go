@@ -0,0 +1,58 @@
+package tools
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScoreProjectRisk(t *testing.T) {
+	now := time.Date(2024, 6, 12, 12, 0, 0, 0, time.UTC) // Wednesday
+	idle := stringPtr("2024-05-01T09:00:00Z")
+	fresh := stringPtr("2024-06-11T09:00:00Z")
+
+	troubled := []Task{
+		{TaskID: "t1", Status: "Blocked", DueDate: stringPtr("2024-06-05"), AssignedTo: stringPtr("alice"), LastUpdateDate: idle},
+		{TaskID: "t2", Status: "Blocked", DueDate: stringPtr("2024-06-08"), AssignedTo: stringPtr("bob"), LastUpdateDate: idle},
+		{TaskID: "t3", Status: "Blocked", DueDate: stringPtr("2024-06-17"), AssignedTo: stringPtr("alice"), LastUpdateDate: idle},
+		{TaskID: "t4", Status: "In Progress", DueDate: stringPtr("2024-06-18"), Priority: stringPtr("High"), LastUpdateDate: idle},
+		{TaskID: "t5", Status: "Not Started", DueDate: stringPtr("2024-06-19"), Priority: stringPtr("High"), LastUpdateDate: idle},
+		{TaskID: "t6", Status: "Not Started", DueDate: stringPtr("2024-06-21"), AssignedTo: stringPtr("bob"), LastUpdateDate: idle},
+		{TaskID: "t7", Status: "Complete", DueDate: stringPtr("2024-06-01"), LastUpdateDate: idle},
+	}
+	risk := scoreProjectRisk(troubled, now, 7*24*time.Hour)
+	if risk.level != "high" || risk.score < 60 {
+		t.Errorf("Expected clustered, blocked project to score high, got %d (%s)", risk.score, risk.level)
+	}
+	if risk.openTasks != 6 {
+		t.Errorf("Expected 6 open tasks, got %d", risk.openTasks)
+	}
+
+	// Every point is attributed to a factor
+	var total float64
+	byName := map[string]riskFactor{}
+	for _, factor := range risk.factors {
+		total += factor.points()
+		byName[factor.name] = factor
+	}
+	if int(total+0.5) != risk.score {
+		t.Errorf("Expected factors to add up to %d, got %.1f", risk.score, total)
+	}
+	if byName["due_date_clustering"].value != 1 || byName["blocked_ratio"].value != 0.5 {
+		t.Errorf("Unexpected factors: %+v", risk.factors)
+	}
+
+	onTrack := []Task{
+		{TaskID: "a", Status: "In Progress", DueDate: stringPtr("2024-06-14"), Priority: stringPtr("High"), AssignedTo: stringPtr("alice"), LastUpdateDate: fresh},
+		{TaskID: "b", Status: "Not Started", DueDate: stringPtr("2024-06-25"), AssignedTo: stringPtr("bob"), LastUpdateDate: fresh},
+		{TaskID: "c", Status: "Review", DueDate: stringPtr("2024-07-03"), AssignedTo: stringPtr("carol"), LastUpdateDate: fresh},
+		{TaskID: "d", Status: "Complete", DueDate: stringPtr("2024-06-01"), LastUpdateDate: idle},
+	}
+	risk = scoreProjectRisk(onTrack, now, 7*24*time.Hour)
+	if risk.level != "low" || risk.score != 0 {
+		t.Errorf("Expected on-track project to score 0, got %d (%s): %+v", risk.score, risk.level, risk.factors)
+	}
+
+	if risk := scoreProjectRisk(nil, now, 7*24*time.Hour); risk.score != 0 || len(risk.factors) != 0 {
+		t.Errorf("Expected empty project to score 0 with no factors, got %+v", risk)
+	}
+}
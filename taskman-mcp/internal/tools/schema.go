@@ -0,0 +1,190 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Tool arguments are validated declaratively from `validate` struct tags on
+// the params structs before the handler runs. Rules are comma separated:
+//
+//	required      string must be non-blank, slice non-empty
+//	min=N, max=N  rune length of strings, value of ints, length of slices
+//	enum=a|b|c    value must be one of the listed lowercase values,
+//	              ignoring case and surrounding spaces, so handlers must
+//	              normalize it with normalizeEnum; enum=status,
+//	              enum=priority and enum=source use canonicalStatuses,
+//	              validPriorities and taskSources, matched exactly as the
+//	              API stores them
+//	date          value must parse as YYYY-MM-DD or RFC3339
+//
+// Rules other than required are skipped for empty optional fields.
+
+// namedEnums are the value sets enum rules may refer to by name
+var namedEnums = map[string][]string{
+	"status":   canonicalStatuses,
+	"priority": validPriorities,
+	"source":   taskSources,
+}
+
+// FieldError is one argument that failed validation
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// ArgumentError lists every argument of a tool call that failed validation
+type ArgumentError struct {
+	Tool   string
+	Fields []FieldError
+}
+
+func (e *ArgumentError) Error() string {
+	messages := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		messages[i] = field.Message
+	}
+	if e.Tool == "" {
+		return "invalid arguments: " + strings.Join(messages, "; ")
+	}
+	return fmt.Sprintf("invalid arguments for %s: %s", e.Tool, strings.Join(messages, "; "))
+}
+
+// validateArguments checks args, a params struct, against its validate tags
+func validateArguments(args any) []FieldError {
+	v := reflect.ValueOf(args)
+	if v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var errs []FieldError
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		rules, ok := field.Tag.Lookup("validate")
+		if !ok || rules == "" {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" {
+			name = field.Name
+		}
+		if message := checkField(name, v.Field(i), rules); message != "" {
+			errs = append(errs, FieldError{Field: name, Message: message})
+		}
+	}
+	return errs
+}
+
+// checkField applies one field's rules, returning the first failure message
+func checkField(name string, value reflect.Value, rules string) string {
+	empty := value.IsZero()
+	switch value.Kind() {
+	case reflect.String:
+		empty = strings.TrimSpace(value.String()) == ""
+	case reflect.Slice, reflect.Map:
+		empty = value.Len() == 0
+	}
+
+	for _, rule := range strings.Split(rules, ",") {
+		key, arg, _ := strings.Cut(strings.TrimSpace(rule), "=")
+		if key == "required" {
+			if empty {
+				return fmt.Sprintf("%s is required", name)
+			}
+			continue
+		}
+		if empty {
+			continue
+		}
+
+		switch key {
+		case "min", "max":
+			limit, err := strconv.Atoi(arg)
+			if err != nil {
+				panic(fmt.Sprintf("invalid %s rule %q on %s", key, rule, name))
+			}
+			if message := checkBound(name, value, key, limit); message != "" {
+				return message
+			}
+		case "enum":
+			allowed, named := namedEnums[arg]
+			candidate := value.String()
+			if !named {
+				allowed, candidate = strings.Split(arg, "|"), normalizeEnum(candidate)
+			}
+			if !containsString(allowed, candidate) {
+				return fmt.Sprintf("%s must be one of: %s", name, strings.Join(allowed, ", "))
+			}
+		case "date":
+			if parsed, err := parseDueDate(value.String()); err != nil || parsed == nil {
+				return fmt.Sprintf("%s must be a date (YYYY-MM-DD or RFC3339)", name)
+			}
+		default:
+			panic(fmt.Sprintf("unknown validate rule %q on %s", rule, name))
+		}
+	}
+	return ""
+}
+
+// checkBound applies a min or max rule to a string, int or slice
+func checkBound(name string, value reflect.Value, key string, limit int) string {
+	size, verb, unit := 0, "be", ""
+	switch value.Kind() {
+	case reflect.String:
+		size, unit = utf8.RuneCountInString(value.String()), " characters"
+	case reflect.Slice, reflect.Map:
+		size, verb, unit = value.Len(), "have", " items"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		size = int(value.Int())
+	default:
+		panic(fmt.Sprintf("%s rule on unsupported field %s", key, name))
+	}
+
+	if key == "min" && size < limit {
+		return fmt.Sprintf("%s must %s at least %d%s", name, verb, limit, unit)
+	}
+	if key == "max" && size > limit {
+		return fmt.Sprintf("%s must %s at most %d%s", name, verb, limit, unit)
+	}
+	return ""
+}
+
+// normalizeEnum puts a value checked by a listed enum rule in the form the
+// rule accepts
+func normalizeEnum(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
+
+// containsString reports whether values contains s
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Validated wraps a tool handler so its arguments are checked against their
+// validate tags first, failing with an *ArgumentError that names every
+// invalid field
+func Validated[P any](handler mcp.ToolHandlerFor[P, map[string]any]) mcp.ToolHandlerFor[P, map[string]any] {
+	return func(ctx context.Context, session *mcp.ServerSession, params *mcp.CallToolParamsFor[P]) (*mcp.CallToolResultFor[map[string]any], error) {
+		if errs := validateArguments(params.Arguments); len(errs) > 0 {
+			return nil, &ArgumentError{Tool: params.Name, Fields: errs}
+		}
+		return handler(ctx, session, params)
+	}
+}
@@ -0,0 +1,113 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/bchamber/taskman-mcp/internal/client"
+	"github.com/bchamber/taskman-mcp/internal/config"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestValidated_ConsistentErrorsAcrossTools(t *testing.T) {
+	// The API is never reached: validation fails before the handlers run
+	taskTools := NewTaskTools(client.NewAPIClient("http://127.0.0.1:0", 0), config.Default())
+	createTask := Validated(taskTools.HandleCreateTaskWithContext)
+	updateTask := Validated(taskTools.HandleUpdateTaskProgress)
+	ctx := context.Background()
+
+	call := func(name string, run func() error) *ArgumentError {
+		t.Helper()
+		err := run()
+		var argErr *ArgumentError
+		if !errors.As(err, &argErr) {
+			t.Fatalf("%s: expected *ArgumentError, got %v", name, err)
+		}
+		if !strings.HasPrefix(err.Error(), "invalid arguments for "+name+": ") {
+			t.Errorf("%s: unexpected error format %q", name, err)
+		}
+		return argErr
+	}
+
+	// Missing required field
+	createErr := call("create_task_with_context", func() error {
+		_, err := createTask(ctx, nil, &mcp.CallToolParamsFor[CreateTaskWithContextParams]{
+			Name:      "create_task_with_context",
+			Arguments: CreateTaskWithContextParams{TaskName: "  ", CreatedBy: "alice"},
+		})
+		return err
+	})
+	updateErr := call("update_task_progress", func() error {
+		_, err := updateTask(ctx, nil, &mcp.CallToolParamsFor[UpdateTaskProgressParams]{
+			Name:      "update_task_progress",
+			Arguments: UpdateTaskProgressParams{ProgressNote: "done", UpdatedBy: "alice"},
+		})
+		return err
+	})
+	if got := createErr.Fields; len(got) != 1 || got[0] != (FieldError{Field: "task_name", Message: "task_name is required"}) {
+		t.Errorf("Unexpected create errors: %+v", got)
+	}
+	if got := updateErr.Fields; len(got) != 1 || got[0] != (FieldError{Field: "task_id", Message: "task_id is required"}) {
+		t.Errorf("Unexpected update errors: %+v", got)
+	}
+
+	// Out-of-range values, every invalid field reported at once
+	wantPriority := FieldError{Field: "priority", Message: "priority must be one of: Low, Medium, High"}
+	createErr = call("create_task_with_context", func() error {
+		_, err := createTask(ctx, nil, &mcp.CallToolParamsFor[CreateTaskWithContextParams]{
+			Name:      "create_task_with_context",
+			Arguments: CreateTaskWithContextParams{TaskName: strings.Repeat("x", 201), Priority: "Urgent", CreatedBy: "alice"},
+		})
+		return err
+	})
+	updateErr = call("update_task_progress", func() error {
+		_, err := updateTask(ctx, nil, &mcp.CallToolParamsFor[UpdateTaskProgressParams]{
+			Name:      "update_task_progress",
+			Arguments: UpdateTaskProgressParams{TaskID: "t1", Priority: "Urgent", ProgressNote: "bump", UpdatedBy: "alice"},
+		})
+		return err
+	})
+	if got := createErr.Fields; len(got) != 2 ||
+		got[0] != (FieldError{Field: "task_name", Message: "task_name must be at most 200 characters"}) || got[1] != wantPriority {
+		t.Errorf("Unexpected create errors: %+v", got)
+	}
+	if got := updateErr.Fields; len(got) != 1 || got[0] != wantPriority {
+		t.Errorf("Unexpected update errors: %+v", got)
+	}
+}
+
+func TestValidateArguments_Rules(t *testing.T) {
+	tests := []struct {
+		name string
+		args any
+		want []string
+	}{
+		{"valid search", SearchTasksParams{Status: "Blocked", SortOrder: "desc", Limit: 10}, nil},
+		{"empty optionals", SearchTasksParams{}, nil},
+		{"listed enums ignore case", SearchTasksParams{SearchMode: "Regex", TagMatchMode: " ALL ", SortBy: "Due_Date", SortOrder: "DESC"}, nil},
+		{"named enums are exact", SearchTasksParams{Status: "blocked"}, []string{
+			"status must be one of: Not Started, In Progress, Blocked, Review, Complete",
+		}},
+		{"bad search", SearchTasksParams{Status: "Done", DueDateFrom: "next week", Limit: -1}, []string{
+			"status must be one of: Not Started, In Progress, Blocked, Review, Complete",
+			"due_date_from must be a date (YYYY-MM-DD or RFC3339)",
+			"limit must be at least 1",
+		}},
+		{"short split", SplitTaskParams{TaskID: "t1", NewTaskNames: []string{"only one"}}, []string{
+			"new_task_names must have at least 2 items",
+		}},
+		{"missing slice", BulkTagTasksParams{}, []string{"task_ids is required"}},
+		{"untagged struct", GetAllTasksParams{}, nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, fieldErr := range validateArguments(tt.args) {
+			got = append(got, fieldErr.Message)
+		}
+		if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+			t.Errorf("%s: got %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
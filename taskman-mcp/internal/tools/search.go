@@ -0,0 +1,79 @@
+package tools
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+)
+
+// search_tasks text matching modes
+const (
+	searchModeSubstring = "substring" // search_text appears in the name or description, ignoring case (default)
+	searchModeRegex     = "regex"     // search_text is a case-insensitive regular expression
+	searchModeExact     = "exact"     // the name or description equals search_text, ignoring case
+)
+
+// Regex search limits. Go's RE2 engine matches in linear time, so these only
+// bound the size of a pattern and the text each match scans.
+const (
+	maxSearchPatternLength     = 512
+	maxSearchRegexInstructions = 10000
+	maxSearchFieldBytes        = 64 * 1024
+)
+
+// textMatcher reports whether a task field matches the search text
+type textMatcher func(field string) bool
+
+// newTextMatcher builds the matcher for a search mode. An empty mode is
+// substring. Invalid or overly complex regexes are rejected rather than
+// matching nothing.
+func newTextMatcher(mode, text string) (textMatcher, error) {
+	switch mode {
+	case "", searchModeSubstring:
+		want := strings.ToLower(text)
+		return func(field string) bool {
+			return strings.Contains(strings.ToLower(field), want)
+		}, nil
+	case searchModeExact:
+		want := strings.TrimSpace(text)
+		return func(field string) bool {
+			return strings.EqualFold(strings.TrimSpace(field), want)
+		}, nil
+	case searchModeRegex:
+		re, err := compileSearchRegex(text)
+		if err != nil {
+			return nil, err
+		}
+		return func(field string) bool {
+			if len(field) > maxSearchFieldBytes {
+				field = field[:maxSearchFieldBytes]
+			}
+			return re.MatchString(field)
+		}, nil
+	default:
+		return nil, fmt.Errorf("invalid search_mode '%s' (use substring, regex or exact)", mode)
+	}
+}
+
+// compileSearchRegex compiles pattern case-insensitively, rejecting patterns
+// that are too long or compile to too large a program
+func compileSearchRegex(pattern string) (*regexp.Regexp, error) {
+	if len(pattern) > maxSearchPatternLength {
+		return nil, fmt.Errorf("search_text regex is too long (%d bytes, max %d)", len(pattern), maxSearchPatternLength)
+	}
+
+	parsed, err := syntax.Parse(pattern, syntax.Perl|syntax.FoldCase)
+	if err != nil {
+		return nil, fmt.Errorf("invalid search_text regex '%s': %w", pattern, err)
+	}
+	prog, err := syntax.Compile(parsed.Simplify())
+	if err != nil {
+		return nil, fmt.Errorf("invalid search_text regex '%s': %w", pattern, err)
+	}
+	if len(prog.Inst) > maxSearchRegexInstructions {
+		return nil, fmt.Errorf("search_text regex '%s' is too complex", pattern)
+	}
+
+	return regexp.Compile("(?i)" + pattern)
+}
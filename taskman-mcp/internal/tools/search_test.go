@@ -0,0 +1,55 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewTextMatcher(t *testing.T) {
+	tests := []struct {
+		name  string
+		mode  string
+		text  string
+		field string
+		want  bool
+	}{
+		{"substring default", "", "deploy", "Nightly deploy job", true},
+		{"substring ignores case", searchModeSubstring, "Deploy", "nightly DEPLOY job", true},
+		{"substring matches acronym", searchModeSubstring, "api", "API Integration", true},
+		{"substring folds accented letters", searchModeSubstring, "CAFÉ", "Update the café menu", true},
+		{"substring multibyte mid-word", searchModeSubstring, "ÜBER", "Prüfung überall", true},
+		{"substring keeps accents distinct", searchModeSubstring, "cafe", "Update the café menu", false},
+		{"substring no match", searchModeSubstring, "deploy", "Nightly build job", false},
+		{"regex case-insensitive", searchModeRegex, `^fix (login|signup) bug$`, "Fix LOGIN bug", true},
+		{"regex no match", searchModeRegex, `^fix (login|signup) bug$`, "Fix logout bug", false},
+		{"exact ignores case and padding", searchModeExact, "Write docs", "  write DOCS ", true},
+		{"exact rejects substring", searchModeExact, "Write docs", "Write docs for API", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, err := newTextMatcher(tt.mode, tt.text)
+			if err != nil {
+				t.Fatalf("newTextMatcher(%q, %q) failed: %v", tt.mode, tt.text, err)
+			}
+			if got := match(tt.field); got != tt.want {
+				t.Errorf("match(%q) = %v, want %v", tt.field, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewTextMatcher_RejectsBadPatterns(t *testing.T) {
+	if _, err := newTextMatcher(searchModeRegex, "fix (login"); err == nil || !strings.Contains(err.Error(), "invalid search_text regex") {
+		t.Errorf("Expected invalid regex error, got %v", err)
+	}
+	if _, err := newTextMatcher(searchModeRegex, strings.Repeat("a", maxSearchPatternLength+1)); err == nil || !strings.Contains(err.Error(), "too long") {
+		t.Errorf("Expected overly long pattern to be rejected, got %v", err)
+	}
+	if _, err := newTextMatcher(searchModeRegex, "((a{1,100}){1,100}){1,100}"); err == nil {
+		t.Error("Expected overly complex pattern to be rejected")
+	}
+	if _, err := newTextMatcher("fuzzy", "x"); err == nil {
+		t.Error("Expected unknown search mode to be rejected")
+	}
+}
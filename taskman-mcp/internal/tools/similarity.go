@@ -0,0 +1,86 @@
+package tools
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// similarityStopWords are common words ignored when comparing task text
+var similarityStopWords = map[string]bool{
+	"the": true, "and": true, "for": true, "with": true, "from": true,
+	"into": true, "this": true, "that": true, "are": true, "was": true,
+	"will": true, "should": true, "add": true, "new": true, "task": true,
+}
+
+// ScoredTask pairs a task with its similarity score against a query
+type ScoredTask struct {
+	Task  Task    `json:"task"`
+	Score float64 `json:"score"`
+}
+
+// tokenize splits text into a set of lowercase word tokens, ignoring stop words and very short words
+func tokenize(text string) map[string]bool {
+	tokens := make(map[string]bool)
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for _, word := range words {
+		if len([]rune(word)) < 3 || similarityStopWords[word] {
+			continue
+		}
+		tokens[word] = true
+	}
+	return tokens
+}
+
+// tokenSimilarity returns the Jaccard similarity (0-1) of two token sets
+func tokenSimilarity(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for token := range a {
+		if b[token] {
+			shared++
+		}
+	}
+
+	union := len(a) + len(b) - shared
+	return float64(shared) / float64(union)
+}
+
+// taskText returns the searchable text of a task (name plus description)
+func taskText(task Task) string {
+	if task.TaskDescription != nil {
+		return task.TaskName + " " + *task.TaskDescription
+	}
+	return task.TaskName
+}
+
+// rankSimilarTasks scores tasks against the query text and returns the top N matches,
+// skipping the task with excludeID and any task sharing no tokens with the query
+func rankSimilarTasks(tasks []Task, query string, excludeID string, topN int) []ScoredTask {
+	queryTokens := tokenize(query)
+
+	var scored []ScoredTask
+	for _, task := range tasks {
+		if excludeID != "" && task.TaskID == excludeID {
+			continue
+		}
+		score := tokenSimilarity(queryTokens, tokenize(taskText(task)))
+		if score > 0 {
+			scored = append(scored, ScoredTask{Task: task, Score: score})
+		}
+	}
+
+	sort.SliceStable(scored, func(i, j int) bool {
+		return scored[i].Score > scored[j].Score
+	})
+
+	if topN > 0 && len(scored) > topN {
+		scored = scored[:topN]
+	}
+	return scored
+}
@@ -0,0 +1,62 @@
+package tools
+
+import (
+	"testing"
+)
+
+func TestTokenize(t *testing.T) {
+	tokens := tokenize("Fix the API authentication, add OAuth2 login!")
+
+	for _, expected := range []string{"fix", "api", "authentication", "oauth2", "login"} {
+		if !tokens[expected] {
+			t.Errorf("Expected token %q to be present", expected)
+		}
+	}
+	for _, ignored := range []string{"the", "add"} {
+		if tokens[ignored] {
+			t.Errorf("Expected stop word %q to be ignored", ignored)
+		}
+	}
+}
+
+func TestTokenSimilarity(t *testing.T) {
+	a := tokenize("database migration script")
+	b := tokenize("database migration rollback")
+
+	if score := tokenSimilarity(a, b); score != 0.5 {
+		t.Errorf("Expected similarity 0.5, got %f", score)
+	}
+	if score := tokenSimilarity(a, tokenize("")); score != 0 {
+		t.Errorf("Expected similarity 0 against empty text, got %f", score)
+	}
+}
+
+func TestRankSimilarTasks(t *testing.T) {
+	tasks := []Task{
+		{TaskID: "unrelated", TaskName: "Design marketing brochure"},
+		{TaskID: "related", TaskName: "Implement user authentication", TaskDescription: stringPtr("OAuth login flow for the API")},
+		{TaskID: "query", TaskName: "Implement OAuth authentication for API login"},
+		{TaskID: "partial", TaskName: "Write API documentation"},
+	}
+
+	ranked := rankSimilarTasks(tasks, "Implement OAuth authentication for API login", "query", 5)
+
+	if len(ranked) != 2 {
+		t.Fatalf("Expected 2 matching tasks, got %d", len(ranked))
+	}
+	if ranked[0].Task.TaskID != "related" {
+		t.Errorf("Expected related task to rank first, got %s", ranked[0].Task.TaskID)
+	}
+	for _, scored := range ranked {
+		if scored.Task.TaskID == "query" {
+			t.Error("Expected excluded task to be skipped")
+		}
+		if scored.Task.TaskID == "unrelated" {
+			t.Error("Expected unrelated task to be omitted")
+		}
+	}
+
+	if top := rankSimilarTasks(tasks, "API authentication", "", 1); len(top) != 1 {
+		t.Errorf("Expected top_n to limit results to 1, got %d", len(top))
+	}
+}
@@ -0,0 +1,66 @@
+package tools
+
+import (
+	"sort"
+	"strings"
+)
+
+// Task creation sources. Tasks created before sources were recorded have no
+// source and count as manual.
+const (
+	sourceManual   = "manual"    // created by a person through a tool like create_task_with_context
+	sourceQuickAdd = "quick_add" // created from a one-line quick-add entry
+	sourceImport   = "import"    // created by a batch import
+	sourceAPI      = "api"       // created directly against the REST API by an integration
+)
+
+// taskSources are the values accepted for a task's source
+var taskSources = []string{sourceManual, sourceQuickAdd, sourceImport, sourceAPI}
+
+// SourceGroup summarizes the incomplete tasks created through one source
+type SourceGroup struct {
+	Source string `json:"source"`
+	Count  int    `json:"count"`
+	Sample []Task `json:"sample"`
+}
+
+// taskSource returns where a task was created, defaulting to manual
+func taskSource(task Task) string {
+	if task.Source == nil || strings.TrimSpace(*task.Source) == "" {
+		return sourceManual
+	}
+	return strings.ToLower(strings.TrimSpace(*task.Source))
+}
+
+// groupTasksBySource groups incomplete, unarchived tasks by source, largest
+// group first, keeping up to sampleSize tasks per group
+func groupTasksBySource(tasks []Task, sampleSize int) []SourceGroup {
+	bySource := make(map[string]*SourceGroup)
+	for _, task := range tasks {
+		if task.Status == "Complete" || task.Archived {
+			continue
+		}
+		source := taskSource(task)
+		group, ok := bySource[source]
+		if !ok {
+			group = &SourceGroup{Source: source, Sample: []Task{}}
+			bySource[source] = group
+		}
+		group.Count++
+		if len(group.Sample) < sampleSize {
+			group.Sample = append(group.Sample, task)
+		}
+	}
+
+	groups := make([]SourceGroup, 0, len(bySource))
+	for _, group := range bySource {
+		groups = append(groups, *group)
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].Count != groups[j].Count {
+			return groups[i].Count > groups[j].Count
+		}
+		return groups[i].Source < groups[j].Source
+	})
+	return groups
+}
@@ -0,0 +1,30 @@
+package tools
+
+import "testing"
+
+func TestGroupTasksBySource(t *testing.T) {
+	tasks := []Task{
+		{TaskID: "i1", Status: "Not Started", Source: stringPtr("import")},
+		{TaskID: "i2", Status: "In Progress", Source: stringPtr("import")},
+		{TaskID: "i3", Status: "Blocked", Source: stringPtr("import")},
+		{TaskID: "q1", Status: "Not Started", Source: stringPtr("quick_add")},
+		{TaskID: "q2", Status: "Complete", Source: stringPtr("quick_add")},
+		{TaskID: "legacy", Status: "Review"},
+		{TaskID: "archived", Status: "Not Started", Source: stringPtr("api"), Archived: true},
+	}
+
+	groups := groupTasksBySource(tasks, 2)
+	if len(groups) != 3 {
+		t.Fatalf("Expected 3 source groups, got %+v", groups)
+	}
+
+	if groups[0].Source != "import" || groups[0].Count != 3 || len(groups[0].Sample) != 2 {
+		t.Errorf("Expected import group of 3 with 2 sampled, got %+v", groups[0])
+	}
+	if groups[1].Source != "manual" || groups[1].Count != 1 || groups[1].Sample[0].TaskID != "legacy" {
+		t.Errorf("Expected tasks without a source counted as manual, got %+v", groups[1])
+	}
+	if groups[2].Source != "quick_add" || groups[2].Count != 1 || groups[2].Sample[0].TaskID != "q1" {
+		t.Errorf("Expected only the incomplete quick-add task, got %+v", groups[2])
+	}
+}
@@ -0,0 +1,76 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// sprintWindow parses sprint boundaries into a half-open [start, end) window.
+// A date-only sprint_end covers that whole day; an omitted one ends the window
+// at now.
+func sprintWindow(startStr, endStr string, now time.Time) (time.Time, time.Time, error) {
+	start, err := parseDueDate(startStr)
+	if err != nil || start == nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid sprint_start %q: expected YYYY-MM-DD or RFC3339", startStr)
+	}
+
+	end := now
+	if endStr != "" {
+		parsed, err := parseDueDate(endStr)
+		if err != nil || parsed == nil {
+			return time.Time{}, time.Time{}, fmt.Errorf("invalid sprint_end %q: expected YYYY-MM-DD or RFC3339", endStr)
+		}
+		end = *parsed
+		if !strings.Contains(endStr, "T") {
+			end = end.Add(24 * time.Hour)
+		}
+	}
+
+	if !end.After(*start) {
+		return time.Time{}, time.Time{}, fmt.Errorf("sprint_end must be after sprint_start")
+	}
+	return *start, end, nil
+}
+
+// inWindow reports whether t falls within the half-open window [start, end)
+func inWindow(t, start, end time.Time) bool {
+	return !t.Before(start) && t.Before(end)
+}
+
+// completedInSprint reports whether a task was completed within the sprint window
+func completedInSprint(task Task, start, end time.Time) bool {
+	if task.Status != "Complete" || task.CompletionDate == nil {
+		return false
+	}
+	completed, err := parseDueDate(*task.CompletionDate)
+	return err == nil && completed != nil && inWindow(*completed, start, end)
+}
+
+// carriedOverFromSprint reports whether a task was due within the sprint but
+// not completed by its end. While the sprint is running only due dates already
+// passed at now count, since later ones can still be met.
+func carriedOverFromSprint(task Task, start, end, now time.Time) bool {
+	if task.DueDate == nil {
+		return false
+	}
+	// Finished work only carries over if it was completed after the sprint ended
+	if task.Status == "Complete" {
+		if task.CompletionDate == nil {
+			return false
+		}
+		completed, err := parseDueDate(*task.CompletionDate)
+		if err != nil || completed == nil || completed.Before(end) {
+			return false
+		}
+	}
+	due, err := parseDueDate(*task.DueDate)
+	if err != nil || due == nil {
+		return false
+	}
+	cutoff := end
+	if now.Before(cutoff) {
+		cutoff = now
+	}
+	return inWindow(*due, start, cutoff)
+}
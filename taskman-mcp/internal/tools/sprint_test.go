@@ -0,0 +1,92 @@
+package tools
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSprintWindow(t *testing.T) {
+	now := time.Date(2024, 6, 20, 12, 0, 0, 0, time.UTC)
+
+	start, end, err := sprintWindow("2024-06-01", "2024-06-14", now)
+	if err != nil {
+		t.Fatalf("sprintWindow failed: %v", err)
+	}
+	if !start.Equal(time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)) || !end.Equal(time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected date-only end to cover the whole last day, got %v to %v", start, end)
+	}
+
+	_, end, err = sprintWindow("2024-06-01", "2024-06-14T17:00:00Z", now)
+	if err != nil || !end.Equal(time.Date(2024, 6, 14, 17, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected timestamp end to be used as given, got %v (%v)", end, err)
+	}
+
+	_, end, err = sprintWindow("2024-06-15", "", now)
+	if err != nil || !end.Equal(now) {
+		t.Errorf("Expected omitted end to default to now, got %v (%v)", end, err)
+	}
+
+	for _, bad := range [][2]string{{"soon", ""}, {"2024-06-01", "later"}, {"2024-06-14", "2024-06-01"}} {
+		if _, _, err := sprintWindow(bad[0], bad[1], now); err == nil {
+			t.Errorf("Expected error for window %v", bad)
+		}
+	}
+}
+
+func TestSprintCompletionEdges(t *testing.T) {
+	now := time.Date(2024, 6, 20, 12, 0, 0, 0, time.UTC)
+	start, end, _ := sprintWindow("2024-06-01", "2024-06-14", now)
+
+	completedAt := func(when string) Task {
+		return Task{Status: "Complete", CompletionDate: stringPtr(when)}
+	}
+	if !completedInSprint(completedAt("2024-06-01T00:00:00Z"), start, end) {
+		t.Error("Expected completion at the first instant of the sprint to count")
+	}
+	if !completedInSprint(completedAt("2024-06-14T23:59:59Z"), start, end) {
+		t.Error("Expected completion late on the last day to count")
+	}
+	if completedInSprint(completedAt("2024-05-31T23:59:59Z"), start, end) {
+		t.Error("Expected completion just before the sprint not to count")
+	}
+	if completedInSprint(completedAt("2024-06-15T00:00:00Z"), start, end) {
+		t.Error("Expected completion at the window end not to count")
+	}
+	if completedInSprint(Task{Status: "In Progress", CompletionDate: stringPtr("2024-06-05")}, start, end) {
+		t.Error("Expected unfinished task not to count as completed")
+	}
+}
+
+func TestCarriedOverFromSprint(t *testing.T) {
+	now := time.Date(2024, 6, 20, 12, 0, 0, 0, time.UTC)
+	start, end, _ := sprintWindow("2024-06-01", "2024-06-14", now)
+
+	tests := []struct {
+		name string
+		task Task
+		want bool
+	}{
+		{"open and due in sprint", Task{Status: "In Progress", DueDate: stringPtr("2024-06-10")}, true},
+		{"due on last day", Task{Status: "Blocked", DueDate: stringPtr("2024-06-14")}, true},
+		{"done in sprint", Task{Status: "Complete", DueDate: stringPtr("2024-06-10"), CompletionDate: stringPtr("2024-06-09")}, false},
+		{"done early", Task{Status: "Complete", DueDate: stringPtr("2024-06-10"), CompletionDate: stringPtr("2024-05-30")}, false},
+		{"done after sprint", Task{Status: "Complete", DueDate: stringPtr("2024-06-10"), CompletionDate: stringPtr("2024-06-16")}, true},
+		{"due after sprint", Task{Status: "Not Started", DueDate: stringPtr("2024-06-15")}, false},
+		{"due before sprint", Task{Status: "Not Started", DueDate: stringPtr("2024-05-31")}, false},
+		{"no due date", Task{Status: "Not Started"}, false},
+	}
+	for _, tt := range tests {
+		if got := carriedOverFromSprint(tt.task, start, end, now); got != tt.want {
+			t.Errorf("%s: expected %v, got %v", tt.name, tt.want, got)
+		}
+	}
+
+	// Mid-sprint, work due later in the sprint is not carried over yet
+	midSprint := time.Date(2024, 6, 8, 12, 0, 0, 0, time.UTC)
+	if carriedOverFromSprint(Task{Status: "In Progress", DueDate: stringPtr("2024-06-10")}, start, end, midSprint) {
+		t.Error("Expected task due later in a running sprint not to be carried over")
+	}
+	if !carriedOverFromSprint(Task{Status: "In Progress", DueDate: stringPtr("2024-06-05")}, start, end, midSprint) {
+		t.Error("Expected missed due date in a running sprint to be carried over")
+	}
+}
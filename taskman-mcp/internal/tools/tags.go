@@ -0,0 +1,118 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+)
+
+// mergeTags removes and then adds tags, comparing case-insensitively. Existing
+// tags keep their casing and duplicates collapse to the first spelling seen.
+func mergeTags(tags, add, remove []string) (merged []string, changed bool) {
+	removeSet := make(map[string]bool, len(remove))
+	for _, tag := range remove {
+		removeSet[strings.ToLower(strings.TrimSpace(tag))] = true
+	}
+
+	seen := make(map[string]bool)
+	merged = []string{}
+	for _, tag := range tags {
+		key := strings.ToLower(strings.TrimSpace(tag))
+		if key == "" || removeSet[key] || seen[key] {
+			continue
+		}
+		seen[key] = true
+		merged = append(merged, tag)
+	}
+
+	for _, tag := range add {
+		tag = strings.TrimSpace(tag)
+		key := strings.ToLower(tag)
+		if key == "" || seen[key] {
+			continue
+		}
+		seen[key] = true
+		merged = append(merged, tag)
+	}
+
+	if len(merged) != len(tags) {
+		return merged, true
+	}
+	for i := range merged {
+		if merged[i] != tags[i] {
+			return merged, true
+		}
+	}
+	return merged, false
+}
+
+// Tag match modes for search_tasks
+const (
+	tagMatchAny = "any" // the task has at least one requested tag (default)
+	tagMatchAll = "all" // the task has every requested tag
+)
+
+// matchTags returns the task's tags that are among wanted, compared
+// case-insensitively, and whether they satisfy mode
+func matchTags(taskTags, wanted []string, mode string) (matched []string, ok bool) {
+	wantedSet := make(map[string]bool, len(wanted))
+	for _, tag := range wanted {
+		if key := strings.ToLower(strings.TrimSpace(tag)); key != "" {
+			wantedSet[key] = true
+		}
+	}
+
+	found := make(map[string]bool)
+	for _, tag := range taskTags {
+		key := strings.ToLower(strings.TrimSpace(tag))
+		if wantedSet[key] && !found[key] {
+			found[key] = true
+			matched = append(matched, tag)
+		}
+	}
+
+	if mode == tagMatchAll {
+		return matched, len(found) == len(wantedSet)
+	}
+	return matched, len(found) > 0
+}
+
+// conflictingTag returns a tag present in both add and remove, if any
+func conflictingTag(add, remove []string) (string, bool) {
+	removeSet := make(map[string]bool, len(remove))
+	for _, tag := range remove {
+		removeSet[strings.ToLower(strings.TrimSpace(tag))] = true
+	}
+	for _, tag := range add {
+		if removeSet[strings.ToLower(strings.TrimSpace(tag))] {
+			return tag, true
+		}
+	}
+	return "", false
+}
+
+// updateTaskTags applies tag additions and removals to a single task, skipping
+// the write when the tag set is already as requested
+func (t *TaskTools) updateTaskTags(ctx context.Context, taskID string, add, remove []string, updatedBy string) (tags []string, changed bool, err error) {
+	task, err := t.fetchTask(ctx, taskID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	tags, changed = mergeTags(task.Tags, add, remove)
+	if !changed {
+		return tags, false, nil
+	}
+
+	updateRequest := map[string]interface{}{
+		"tags":            tags,
+		"last_updated_by": updatedBy,
+	}
+	if _, err := t.apiClient.Put(ctx, fmt.Sprintf("/api/v1/tasks/%s", url.PathEscape(taskID)), updateRequest); err != nil {
+		slog.Error("Failed to update task tags", "error", err, "task_id", taskID)
+		return nil, false, fmt.Errorf("failed to update task tags: %w", err)
+	}
+	return tags, true, nil
+}
@@ -0,0 +1,73 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMergeTags(t *testing.T) {
+	tests := []struct {
+		name        string
+		tags        []string
+		add         []string
+		remove      []string
+		want        string
+		wantChanged bool
+	}{
+		{"add new tag", []string{"backend"}, []string{"q3-goals"}, nil, "backend,q3-goals", true},
+		{"add existing tag with other casing", []string{"Q3-Goals"}, []string{"q3-goals"}, nil, "Q3-Goals", false},
+		{"remove case-insensitively", []string{"Legacy", "api"}, nil, []string{"legacy"}, "api", true},
+		{"dedupe added tags", nil, []string{"ops", "OPS", " ops "}, nil, "ops", true},
+		{"nothing to do", []string{"api"}, nil, []string{"missing"}, "api", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, changed := mergeTags(tt.tags, tt.add, tt.remove)
+			if got := strings.Join(merged, ","); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+			if changed != tt.wantChanged {
+				t.Errorf("Expected changed %v, got %v", tt.wantChanged, changed)
+			}
+		})
+	}
+}
+
+func TestMatchTags(t *testing.T) {
+	tests := []struct {
+		name   string
+		tags   []string
+		wanted []string
+		mode   string
+		want   string
+		wantOK bool
+	}{
+		{"any with one match", []string{"backend", "Urgent"}, []string{"urgent", "frontend"}, tagMatchAny, "Urgent", true},
+		{"any with no match", []string{"backend"}, []string{"frontend"}, tagMatchAny, "", false},
+		{"all with every tag", []string{"api", "Q3", "ops"}, []string{"q3", " API "}, tagMatchAll, "api,Q3", true},
+		{"all missing one tag", []string{"api"}, []string{"api", "q3"}, tagMatchAll, "api", false},
+		{"all with duplicate wanted tags", []string{"api"}, []string{"api", "API"}, tagMatchAll, "api", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, ok := matchTags(tt.tags, tt.wanted, tt.mode)
+			if got := strings.Join(matched, ","); got != tt.want {
+				t.Errorf("Expected matched %q, got %q", tt.want, got)
+			}
+			if ok != tt.wantOK {
+				t.Errorf("Expected ok %v, got %v", tt.wantOK, ok)
+			}
+		})
+	}
+}
+
+func TestConflictingTag(t *testing.T) {
+	if tag, ok := conflictingTag([]string{"a", "Urgent"}, []string{"urgent"}); !ok || tag != "Urgent" {
+		t.Errorf("Expected Urgent to conflict, got %q %v", tag, ok)
+	}
+	if _, ok := conflictingTag([]string{"a"}, []string{"b"}); ok {
+		t.Error("Expected no conflict")
+	}
+}
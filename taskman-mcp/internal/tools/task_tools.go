@@ -6,102 +6,164 @@ import (
 	"fmt"
 	"log/slog"
 	"net/url"
+	"sort"
+	"strconv"
+	"strings"
 	"time"
 
+	"github.com/bchamber/taskman-mcp/internal/calendar"
 	"github.com/bchamber/taskman-mcp/internal/client"
+	"github.com/bchamber/taskman-mcp/internal/clock"
+	"github.com/bchamber/taskman-mcp/internal/config"
+	"github.com/bchamber/taskman-mcp/internal/identity"
+	"github.com/bchamber/taskman-mcp/internal/render"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // TaskTools handles task management MCP tools
 type TaskTools struct {
-	apiClient *client.APIClient
+	apiClient  *client.APIClient
+	settings   *config.Store
+	priorities *render.PriorityRenderer
+	assignees  *identity.Normalizer
+	calendar   *calendar.Calendar
+	clock      clock.Clock
 }
 
 // NewTaskTools creates a new task tools handler
-func NewTaskTools(apiClient *client.APIClient) *TaskTools {
+func NewTaskTools(apiClient *client.APIClient, cfg *config.Config) *TaskTools {
+	if cfg == nil {
+		cfg = config.Default()
+	}
 	return &TaskTools{
-		apiClient: apiClient,
+		apiClient:  apiClient,
+		settings:   config.NewStore(cfg),
+		priorities: render.NewPriorityRendererFromConfig(cfg),
+		assignees:  identity.NewNormalizerFromConfig(cfg),
+		calendar:   calendar.FromConfig(cfg),
+		clock:      clock.Default,
 	}
 }
 
+// SetClock replaces the clock used for date calculations and timestamps
+func (t *TaskTools) SetClock(c clock.Clock) {
+	t.clock = c
+}
+
+// SetConfigStore reads settings from store, so reloads published there apply
+func (t *TaskTools) SetConfigStore(store *config.Store) {
+	t.settings = store
+}
+
+// config returns the configuration in effect
+func (t *TaskTools) config() *config.Config {
+	return t.settings.Current()
+}
+
 // GetTaskOverviewParams defines input for get_task_overview tool
 type GetTaskOverviewParams struct {
-	Status     string `json:"status,omitempty"`
-	AssignedTo string `json:"assigned_to,omitempty"`
-	ProjectID  string `json:"project_id,omitempty"`
+	Status      string `json:"status,omitempty" validate:"enum=status"`
+	AssignedTo  string `json:"assigned_to,omitempty"`
+	ProjectID   string `json:"project_id,omitempty"`
+	AsOf        string `json:"as_of,omitempty" validate:"date"`         // report overdue and recent activity relative to this date
+	RecentHours int    `json:"recent_hours,omitempty" validate:"min=1"` // recent activity window; default 24, capped at maxRecentHours
 }
 
+// Recent activity window for get_task_overview
+const (
+	defaultRecentHours = 24
+	maxRecentHours     = 24 * 365
+)
+
 // CreateTaskWithContextParams defines input for create_task_with_context tool
 type CreateTaskWithContextParams struct {
-	TaskName        string `json:"task_name"`
+	TaskName        string `json:"task_name" validate:"required,max=200"`
 	TaskDescription string `json:"task_description,omitempty"`
-	Status          string `json:"status,omitempty"`
-	Priority        string `json:"priority,omitempty"`
+	Status          string `json:"status,omitempty" validate:"enum=status"`
+	Priority        string `json:"priority,omitempty" validate:"enum=priority"`
 	AssignedTo      string `json:"assigned_to,omitempty"`
 	ProjectID       string `json:"project_id,omitempty"`
-	DueDate         string `json:"due_date,omitempty"`
+	DueDate         string `json:"due_date,omitempty" validate:"date"`
 	InitialNote     string `json:"initial_note"`
 	CreatedBy       string `json:"created_by"`
+	Source          string `json:"source,omitempty" validate:"enum=source"` // defaults to manual
 }
 
 // GetTaskDetailsParams defines input for get_task_details tool
 type GetTaskDetailsParams struct {
-	TaskID string `json:"task_id"`
+	TaskID     string `json:"task_id" validate:"required"`
+	NotesLimit int    `json:"notes_limit,omitempty"` // capped by MaxNotesReturned
 }
 
+// detailsNotesShown is how many of the newest notes get_task_details lists in
+// its text; every fetched note, up to notes_limit, is in the metadata
+const detailsNotesShown = 5
+
 // UpdateTaskProgressParams defines input for update_task_progress tool
 type UpdateTaskProgressParams struct {
-	TaskID       string `json:"task_id"`
-	Status       string `json:"status,omitempty"`
-	Priority     string `json:"priority,omitempty"`
+	TaskID       string `json:"task_id" validate:"required"`
+	Status       string `json:"status,omitempty" validate:"enum=status"`
+	Priority     string `json:"priority,omitempty" validate:"enum=priority"`
 	AssignedTo   string `json:"assigned_to,omitempty"`
 	ProgressNote string `json:"progress_note"`
 	UpdatedBy    string `json:"updated_by"`
+	Force        bool   `json:"force,omitempty"`
 }
 
 // SearchTasksParams defines input for search_tasks tool
 type SearchTasksParams struct {
-	Status      string `json:"status,omitempty"`
-	Priority    string `json:"priority,omitempty"`
-	AssignedTo  string `json:"assigned_to,omitempty"`
-	ProjectID   string `json:"project_id,omitempty"`
-	CreatedBy   string `json:"created_by,omitempty"`
-	DueDateFrom string `json:"due_date_from,omitempty"`
-	DueDateTo   string `json:"due_date_to,omitempty"`
-	SearchText  string `json:"search_text,omitempty"`
-	Archived    string `json:"archived,omitempty"`
-	SortBy      string `json:"sort_by,omitempty"`
-	SortOrder   string `json:"sort_order,omitempty"`
-	Limit       int    `json:"limit,omitempty"`
+	Status       string   `json:"status,omitempty" validate:"enum=status"`
+	Priority     string   `json:"priority,omitempty" validate:"enum=priority"`
+	AssignedTo   string   `json:"assigned_to,omitempty"`
+	ProjectID    string   `json:"project_id,omitempty"`
+	CreatedBy    string   `json:"created_by,omitempty"`
+	DueDateFrom  string   `json:"due_date_from,omitempty" validate:"date"`
+	DueDateTo    string   `json:"due_date_to,omitempty" validate:"date"`
+	SearchText   string   `json:"search_text,omitempty"`
+	SearchMode   string   `json:"search_mode,omitempty" validate:"enum=substring|regex|exact"` // how search_text matches; default substring
+	Archived     string   `json:"archived,omitempty"`                                          // "true", "false" or "all"; unset uses the configured default
+	Tags         []string `json:"tags,omitempty"`
+	TagMatchMode string   `json:"tag_match_mode,omitempty" validate:"enum=any|all"` // default any
+	SortBy       string   `json:"sort_by,omitempty" validate:"enum=due_date|priority|creation_date|task_name|status"`
+	SortOrder    string   `json:"sort_order,omitempty" validate:"enum=asc|desc"` // default asc
+	Limit        int      `json:"limit,omitempty" validate:"min=1"`
 }
 
 // Task represents a task from the API
 type Task struct {
-	TaskID          string   `json:"task_id"`
-	TaskName        string   `json:"task_name"`
-	TaskDescription *string  `json:"task_description"`
-	Status          string   `json:"status"`
-	Priority        *string  `json:"priority"`
-	AssignedTo      *string  `json:"assigned_to"`
-	ProjectID       *string  `json:"project_id"`
-	DueDate         *string  `json:"due_date"`
-	StartDate       *string  `json:"start_date"`
-	CompletionDate  *string  `json:"completion_date"`
-	Tags            []string `json:"tags"`
-	Archived        bool     `json:"archived"`
-	CreatedBy       string   `json:"created_by"`
-	CreationDate    string   `json:"creation_date"`
-	LastUpdatedBy   *string  `json:"last_updated_by"`
-	LastUpdateDate  *string  `json:"last_update_date"`
+	TaskID          string     `json:"task_id"`
+	TaskName        string     `json:"task_name"`
+	TaskDescription *string    `json:"task_description"`
+	Status          string     `json:"status"`
+	Priority        *string    `json:"priority"`
+	AssignedTo      *string    `json:"assigned_to"`
+	ProjectID       *string    `json:"project_id"`
+	DueDate         *string    `json:"due_date"`
+	StartDate       *string    `json:"start_date"`
+	CompletionDate  *string    `json:"completion_date"`
+	Tags            []string   `json:"tags"`
+	Links           []TaskLink `json:"links,omitempty"`
+	BlockedBy       []string   `json:"blocked_by,omitempty"`
+	Archived        bool       `json:"archived"`
+	Source          *string    `json:"source,omitempty"` // how the task was created; nil means manual
+	CreatedBy       string     `json:"created_by"`
+	CreationDate    string     `json:"creation_date"`
+	LastUpdatedBy   *string    `json:"last_updated_by"`
+	LastUpdateDate  *string    `json:"last_update_date"`
 }
 
 // Project represents a project from the API
 type Project struct {
-	ProjectID          string  `json:"project_id"`
-	ProjectName        string  `json:"project_name"`
-	ProjectDescription *string `json:"project_description"`
-	CreatedBy          string  `json:"created_by"`
-	CreationDate       string  `json:"creation_date"`
+	ProjectID          string      `json:"project_id"`
+	ProjectName        string      `json:"project_name"`
+	ProjectDescription *string     `json:"project_description"`
+	DefaultAssignee    *string     `json:"default_assignee,omitempty"`
+	Milestones         []Milestone `json:"milestones,omitempty"`
+	Archived           bool        `json:"archived,omitempty"`
+	DeletedBy          *string     `json:"deleted_by,omitempty"`
+	DeletedAt          *string     `json:"deleted_at,omitempty"`
+	CreatedBy          string      `json:"created_by"`
+	CreationDate       string      `json:"creation_date"`
 }
 
 // TaskNote represents a task note from the API
@@ -115,7 +177,10 @@ type TaskNote struct {
 	LastUpdateDate *string `json:"last_update_date"`
 }
 
-// Helper function to parse due dates
+// acceptedDateFormats describes the date inputs parseDueDate understands
+const acceptedDateFormats = "YYYY-MM-DD or RFC3339 (e.g. 2024-06-30 or 2024-06-30T17:00:00Z)"
+
+// Helper function to parse due dates. An empty string means no date.
 func parseDueDate(dueDateStr string) (*time.Time, error) {
 	if dueDateStr == "" {
 		return nil, nil
@@ -135,21 +200,13 @@ func parseDueDate(dueDateStr string) (*time.Time, error) {
 		}
 	}
 
-	return nil, fmt.Errorf("unable to parse date: %s", dueDateStr)
+	return nil, fmt.Errorf("unable to parse date %q: use %s", dueDateStr, acceptedDateFormats)
 }
 
-// Helper function to check if a task is overdue
-func isTaskOverdue(task Task) bool {
-	if task.Status == "Complete" || task.DueDate == nil {
-		return false
-	}
-
-	dueTime, err := time.Parse(time.RFC3339, *task.DueDate)
-	if err != nil {
-		return false
-	}
-
-	return dueTime.Before(time.Now())
+// Helper function to check if a task is overdue as of now; see overdueDuration
+func isTaskOverdue(task Task, now time.Time) bool {
+	_, overdue := overdueDuration(task, now)
+	return overdue
 }
 
 // HandleGetTaskOverview implements the get_task_overview tool
@@ -160,6 +217,29 @@ func (t *TaskTools) HandleGetTaskOverview(
 ) (*mcp.CallToolResultFor[map[string]any], error) {
 	slog.Info("Executing get_task_overview tool", "params", params.Arguments)
 
+	cfg := t.config()
+
+	now := t.clock.Now()
+	if params.Arguments.AsOf != "" {
+		asOf, err := parseDueDate(params.Arguments.AsOf)
+		if err != nil {
+			return nil, fmt.Errorf("invalid as_of: %w", err)
+		}
+		now = *asOf
+	}
+
+	recentHours := params.Arguments.RecentHours
+	if recentHours < 0 {
+		return nil, fmt.Errorf("recent_hours must be positive")
+	}
+	if recentHours == 0 {
+		recentHours = defaultRecentHours
+	}
+	if recentHours > maxRecentHours {
+		slog.Warn("Clamping recent_hours", "requested", recentHours, "max", maxRecentHours)
+		recentHours = maxRecentHours
+	}
+
 	// Build query parameters
 	queryParams := ""
 	if params.Arguments.Status != "" {
@@ -170,7 +250,7 @@ func (t *TaskTools) HandleGetTaskOverview(
 		}
 		queryParams += fmt.Sprintf("status=%s", url.QueryEscape(params.Arguments.Status))
 	}
-	if params.Arguments.AssignedTo != "" {
+	if params.Arguments.AssignedTo != "" && !t.assignees.Enabled() {
 		if queryParams == "" {
 			queryParams += "?"
 		} else {
@@ -187,11 +267,17 @@ func (t *TaskTools) HandleGetTaskOverview(
 		queryParams += fmt.Sprintf("project_id=%s", url.QueryEscape(params.Arguments.ProjectID))
 	}
 
-	// Get tasks
-	tasksResp, err := t.apiClient.Get(ctx, "/api/v1/tasks"+queryParams)
-	if err != nil {
-		slog.Error("Failed to get tasks", "error", err)
-		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	// Tasks and projects are independent, so fetch them together
+	var tasksResp, projectsResp []byte
+	var tasksErr, projectsErr error
+	runConcurrently(
+		func() { tasksResp, tasksErr = t.apiClient.Get(ctx, "/api/v1/tasks"+queryParams) },
+		func() { projectsResp, projectsErr = t.apiClient.Get(ctx, "/api/v1/projects") },
+	)
+
+	if tasksErr != nil {
+		slog.Error("Failed to get tasks", "error", tasksErr)
+		return nil, fmt.Errorf("failed to get tasks: %w", tasksErr)
 	}
 
 	var tasks []Task
@@ -199,18 +285,21 @@ func (t *TaskTools) HandleGetTaskOverview(
 		slog.Error("Failed to parse tasks", "error", err)
 		return nil, fmt.Errorf("failed to parse tasks: %w", err)
 	}
+	tasks = filterAssignedTo(t.assignees, tasks, params.Arguments.AssignedTo)
 
-	// Get projects for context
-	projectsResp, err := t.apiClient.Get(ctx, "/api/v1/projects")
-	if err != nil {
-		slog.Error("Failed to get projects", "error", err)
+	// Projects add context
+	projectsAvailable := true
+	if projectsErr != nil {
+		slog.Error("Failed to get projects", "error", projectsErr)
 		// Continue without projects - not critical
+		projectsAvailable = false
 	}
 
 	var projects []Project
-	if err == nil {
+	if projectsErr == nil {
 		if err := json.Unmarshal(projectsResp, &projects); err != nil {
 			slog.Error("Failed to parse projects", "error", err)
+			projectsAvailable = false
 		}
 	}
 
@@ -220,21 +309,20 @@ func (t *TaskTools) HandleGetTaskOverview(
 	recentTasks := []Task{}
 	projectTaskCounts := make(map[string]int)
 
-	now := time.Now()
-	dayAgo := now.Add(-24 * time.Hour)
+	recentSince := now.Add(-time.Duration(recentHours) * time.Hour)
 
 	for _, task := range tasks {
 		// Count by status
 		statusCounts[task.Status]++
 
 		// Check if overdue
-		if isTaskOverdue(task) {
+		if isTaskOverdue(task, now) {
 			overdueTasks = append(overdueTasks, task)
 		}
 
 		// Check if recent
 		if created, err := time.Parse(time.RFC3339, task.CreationDate); err == nil {
-			if created.After(dayAgo) {
+			if created.After(recentSince) && !created.After(now) {
 				recentTasks = append(recentTasks, task)
 			}
 		}
@@ -252,39 +340,53 @@ func (t *TaskTools) HandleGetTaskOverview(
 		"overdue_count":    len(overdueTasks),
 		"overdue_tasks":    overdueTasks,
 		"recent_activity": map[string]any{
-			"tasks_created_24h": len(recentTasks),
-			"recent_tasks":      recentTasks,
+			"tasks_created": len(recentTasks),
+			"recent_tasks":  recentTasks,
 		},
-		"project_summary": projectTaskCounts,
-		"projects":        projects,
+		"project_summary":     projectTaskCounts,
+		"projects":            projects,
+		"projects_available":  projectsAvailable,
+		"as_of":               now.Format(time.RFC3339),
+		"recent_window_hours": recentHours,
 	}
 
 	// Generate insights
 	var insights []string
 
+	if !projectsAvailable {
+		insights = appendInsight(cfg, insights, insightOverviewProjectsUnavailable, "⚠️ Project context unavailable - project summary omitted")
+	}
+
 	if len(overdueTasks) > 0 {
-		insights = append(insights, fmt.Sprintf("⚠️ %d tasks are overdue and need immediate attention", len(overdueTasks)))
+		insights = appendInsight(cfg, insights, insightOverviewOverdue, fmt.Sprintf("⚠️ %d tasks are overdue and need immediate attention", len(overdueTasks)))
 	}
 
 	if notStarted, ok := statusCounts["Not Started"]; ok && notStarted > len(tasks)/2 {
-		insights = append(insights, "📋 More than half of tasks haven't been started yet")
+		insights = appendInsight(cfg, insights, insightOverviewMostlyNotStarted, "📋 More than half of tasks haven't been started yet")
 	}
 
 	if inProgress, ok := statusCounts["In Progress"]; ok && inProgress > 5 {
-		insights = append(insights, fmt.Sprintf("🔄 %d tasks are currently in progress - consider if any are blocked", inProgress))
+		insights = appendInsight(cfg, insights, insightOverviewManyInProgress, fmt.Sprintf("🔄 %d tasks are currently in progress - consider if any are blocked", inProgress))
 	}
 
 	if len(recentTasks) > 10 {
-		insights = append(insights, "📈 High activity: many new tasks created in the last 24 hours")
+		insights = appendInsight(cfg, insights, insightOverviewHighActivity, fmt.Sprintf("📈 High activity: many new tasks created in the last %dh", recentHours))
+	}
+
+	clusters := dueDateClusters(tasks, cfg.DueDateClusterThreshold)
+	if len(clusters) > 0 {
+		insights = appendInsight(cfg, insights, insightOverviewDueDateCluster, dueDateClusterInsight(clusters))
 	}
+	overview["due_date_clusters"] = clusters
 
 	overview["insights"] = insights
 
 	// Build response text
-	responseText := fmt.Sprintf(`Task Overview Dashboard
-=====================
-
-Total Tasks: %d
+	responseText := "Task Overview Dashboard\n=====================\n\n"
+	if params.Arguments.AsOf != "" {
+		responseText += fmt.Sprintf("As of: %s\n", now.Format("2006-01-02"))
+	}
+	responseText += fmt.Sprintf(`Total Tasks: %d
 
 Status Breakdown:
 `, len(tasks))
@@ -296,11 +398,21 @@ Status Breakdown:
 	if len(overdueTasks) > 0 {
 		responseText += fmt.Sprintf("\n⚠️ Overdue Tasks (%d):\n", len(overdueTasks))
 		for _, task := range overdueTasks {
-			responseText += fmt.Sprintf("- %s (Due: %s)\n", task.TaskName, *task.DueDate)
+			responseText += fmt.Sprintf("- %s (%s, Due: %s)\n", task.TaskName, t.priorities.Render(task.Priority), *task.DueDate)
 		}
 	}
 
-	responseText += fmt.Sprintf("\n📊 Recent Activity:\n- Tasks created in last 24h: %d\n", len(recentTasks))
+	responseText += fmt.Sprintf("\n📊 Recent Activity:\n- Tasks created in last %dh: %d\n", recentHours, len(recentTasks))
+
+	// Project summary is only meaningful when project names could be resolved
+	if projectsAvailable && len(projectTaskCounts) > 0 {
+		responseText += "\n📁 Project Summary:\n"
+		for _, project := range projects {
+			if count, ok := projectTaskCounts[project.ProjectID]; ok {
+				responseText += fmt.Sprintf("- %s: %d\n", project.ProjectName, count)
+			}
+		}
+	}
 
 	if len(insights) > 0 {
 		responseText += "\n💡 Insights:\n"
@@ -309,7 +421,7 @@ Status Breakdown:
 		}
 	}
 
-	slog.Info("Task overview generated", "total_tasks", len(tasks), "overdue", len(overdueTasks))
+	slog.Info("Task overview generated", "total_tasks", len(tasks), "overdue", len(overdueTasks), "projects_available", projectsAvailable)
 
 	return &mcp.CallToolResultFor[map[string]any]{
 		Content: []mcp.Content{
@@ -329,56 +441,40 @@ func (t *TaskTools) HandleCreateTaskWithContext(
 ) (*mcp.CallToolResultFor[map[string]any], error) {
 	slog.Info("Executing create_task_with_context tool", "params", params.Arguments)
 
+	cfg := t.config()
+
 	// Validate required fields
 	if params.Arguments.TaskName == "" {
 		return nil, fmt.Errorf("task_name is required")
 	}
-	if params.Arguments.InitialNote == "" {
-		return nil, fmt.Errorf("initial_note is required")
+	if err := validateNote(cfg, params.Arguments.InitialNote, "initial_note"); err != nil {
+		return nil, err
 	}
-	if params.Arguments.CreatedBy == "" {
-		return nil, fmt.Errorf("created_by is required")
+	createdBy, err := resolveActor(cfg, params.Arguments.CreatedBy, "created_by")
+	if err != nil {
+		return nil, err
 	}
+	params.Arguments.CreatedBy = createdBy
 
 	// Validate status if provided
-	validStatuses := []string{"Not Started", "In Progress", "Blocked", "Review", "Complete"}
 	if params.Arguments.Status != "" {
-		isValid := false
-		for _, validStatus := range validStatuses {
-			if params.Arguments.Status == validStatus {
-				isValid = true
-				break
-			}
-		}
-		if !isValid {
-			return nil, fmt.Errorf("invalid status '%s'. Valid statuses are: %v", params.Arguments.Status, validStatuses)
+		if err := validateStatus(params.Arguments.Status); err != nil {
+			return nil, err
 		}
 	}
 
 	// Validate priority if provided
-	validPriorities := []string{"Low", "Medium", "High"}
 	if params.Arguments.Priority != "" {
-		isValid := false
-		for _, validPriority := range validPriorities {
-			if params.Arguments.Priority == validPriority {
-				isValid = true
-				break
-			}
-		}
-		if !isValid {
-			return nil, fmt.Errorf("invalid priority '%s'. Valid priorities are: %v", params.Arguments.Priority, validPriorities)
+		if err := validatePriority(params.Arguments.Priority); err != nil {
+			return nil, err
 		}
 	}
 
-	// Parse due date if provided
-	var dueDate *time.Time
-	if params.Arguments.DueDate != "" {
-		parsed, err := parseDueDate(params.Arguments.DueDate)
-		if err != nil {
-			slog.Warn("Failed to parse due date", "due_date", params.Arguments.DueDate, "error", err)
-		} else {
-			dueDate = parsed
-		}
+	// Parse due date if provided; a date that cannot be parsed is an error
+	// rather than silently creating the task without one
+	dueDate, err := parseDueDate(params.Arguments.DueDate)
+	if err != nil {
+		return nil, fmt.Errorf("invalid due_date: %w", err)
 	}
 
 	// Build task creation request
@@ -398,6 +494,20 @@ func (t *TaskTools) HandleCreateTaskWithContext(
 	if params.Arguments.Priority != "" {
 		taskRequest["priority"] = params.Arguments.Priority
 	}
+	if params.Arguments.Source != "" {
+		taskRequest["source"] = params.Arguments.Source
+	} else {
+		taskRequest["source"] = sourceManual
+	}
+	// Unassigned tasks in a project go to the project's default assignee
+	var defaultAssigneeApplied bool
+	if params.Arguments.AssignedTo == "" && params.Arguments.ProjectID != "" {
+		if assignee := t.defaultAssigneeForProject(ctx, cfg, params.Arguments.ProjectID); assignee != "" {
+			params.Arguments.AssignedTo = assignee
+			defaultAssigneeApplied = true
+			slog.Info("Applied project default assignee", "project_id", params.Arguments.ProjectID, "assigned_to", assignee)
+		}
+	}
 	if params.Arguments.AssignedTo != "" {
 		taskRequest["assigned_to"] = params.Arguments.AssignedTo
 	}
@@ -467,10 +577,11 @@ func (t *TaskTools) HandleCreateTaskWithContext(
 
 	// Build response
 	result := map[string]any{
-		"task":         createdTask,
-		"initial_note": createdNote,
-		"next_steps":   nextSteps,
-		"success":      true,
+		"task":                     createdTask,
+		"initial_note":             createdNote,
+		"next_steps":               nextSteps,
+		"default_assignee_applied": defaultAssigneeApplied,
+		"success":                  true,
 	}
 
 	// Build response text
@@ -483,11 +594,15 @@ Status: %s
 `, createdTask.TaskName, createdTask.TaskID, createdTask.Status)
 
 	if createdTask.Priority != nil {
-		responseText += fmt.Sprintf("Priority: %s\n", *createdTask.Priority)
+		responseText += fmt.Sprintf("Priority: %s\n", t.priorities.Label(*createdTask.Priority))
 	}
 
 	if createdTask.AssignedTo != nil {
-		responseText += fmt.Sprintf("Assigned to: %s\n", *createdTask.AssignedTo)
+		if defaultAssigneeApplied {
+			responseText += fmt.Sprintf("Assigned to: %s (project default)\n", *createdTask.AssignedTo)
+		} else {
+			responseText += fmt.Sprintf("Assigned to: %s\n", *createdTask.AssignedTo)
+		}
 	}
 
 	if createdTask.DueDate != nil {
@@ -525,16 +640,29 @@ func (t *TaskTools) HandleGetTaskDetails(
 ) (*mcp.CallToolResultFor[map[string]any], error) {
 	slog.Info("Executing get_task_details tool", "params", params.Arguments)
 
+	cfg := t.config()
+
 	// Validate required fields
 	if params.Arguments.TaskID == "" {
 		return nil, fmt.Errorf("task_id is required")
 	}
 
-	// Get task details
-	taskResp, err := t.apiClient.Get(ctx, fmt.Sprintf("/api/v1/tasks/%s", params.Arguments.TaskID))
-	if err != nil {
-		slog.Error("Failed to get task", "error", err, "task_id", params.Arguments.TaskID)
-		return nil, fmt.Errorf("failed to get task: %w", err)
+	// The task and its notes are fetched together; the project needs the
+	// task's project_id so it follows
+	var taskResp, notesResp []byte
+	var taskErr, notesErr error
+	runConcurrently(
+		func() {
+			taskResp, taskErr = t.apiClient.Get(ctx, fmt.Sprintf("/api/v1/tasks/%s", params.Arguments.TaskID))
+		},
+		func() {
+			notesResp, notesErr = t.apiClient.Get(ctx, fmt.Sprintf("/api/v1/tasks/%s/notes", params.Arguments.TaskID))
+		},
+	)
+
+	if taskErr != nil {
+		slog.Error("Failed to get task", "error", taskErr, "task_id", params.Arguments.TaskID)
+		return nil, fmt.Errorf("failed to get task: %w", taskErr)
 	}
 
 	var task Task
@@ -543,20 +671,26 @@ func (t *TaskTools) HandleGetTaskDetails(
 		return nil, fmt.Errorf("failed to parse task: %w", err)
 	}
 
-	// Get task notes
-	notesResp, err := t.apiClient.Get(ctx, fmt.Sprintf("/api/v1/tasks/%s/notes", params.Arguments.TaskID))
-	if err != nil {
-		slog.Error("Failed to get task notes", "error", err, "task_id", params.Arguments.TaskID)
+	// A failed notes fetch is reported as unavailable rather than as an empty list
+	notesAvailable := true
+	if notesErr != nil {
+		slog.Error("Failed to get task notes", "error", notesErr, "task_id", params.Arguments.TaskID)
 		// Continue without notes - not critical for task details
+		notesAvailable = false
 	}
 
 	var notes []TaskNote
-	if err == nil {
+	if notesErr == nil {
 		if err := json.Unmarshal(notesResp, &notes); err != nil {
 			slog.Error("Failed to parse task notes", "error", err)
+			notesAvailable = false
 		}
 	}
 
+	// Keep only the newest notes so long-lived tasks don't blow up the response
+	totalNotes := len(notes)
+	notes = newestNotes(notes, effectiveNotesLimit(params.Arguments.NotesLimit, cfg.MaxNotesReturned))
+
 	// Get project details if task has a project
 	var project *Project
 	if task.ProjectID != nil && *task.ProjectID != "" {
@@ -578,38 +712,38 @@ func (t *TaskTools) HandleGetTaskDetails(
 	var insights []string
 
 	// Check if task is overdue
-	if isTaskOverdue(task) {
-		insights = append(insights, "⚠️ This task is overdue and needs immediate attention")
+	if isTaskOverdue(task, t.clock.Now()) {
+		insights = appendInsight(cfg, insights, insightDetailsOverdue, "⚠️ This task is overdue and needs immediate attention")
 	}
 
 	// Check if task has been idle
 	if task.LastUpdateDate != nil {
 		lastUpdate, err := time.Parse(time.RFC3339, *task.LastUpdateDate)
 		if err == nil && time.Since(lastUpdate) > 7*24*time.Hour {
-			insights = append(insights, "📅 Task hasn't been updated in over a week")
+			insights = appendInsight(cfg, insights, insightDetailsIdle, "📅 Task hasn't been updated in over a week")
 		}
 	}
 
 	// Check completion criteria
-	if task.Status == "In Progress" && len(notes) == 0 {
-		insights = append(insights, "📝 Consider adding progress notes to track work")
+	if task.Status == "In Progress" && notesAvailable && len(notes) == 0 {
+		insights = appendInsight(cfg, insights, insightDetailsNoNotes, "📝 Consider adding progress notes to track work")
 	}
 
 	if task.Priority == nil || *task.Priority == "" {
-		insights = append(insights, "🎯 Task priority is not set")
+		insights = appendInsight(cfg, insights, insightDetailsNoPriority, "🎯 Task priority is not set")
 	}
 
 	if task.AssignedTo == nil || *task.AssignedTo == "" {
-		insights = append(insights, "👤 Task is not assigned to anyone")
+		insights = appendInsight(cfg, insights, insightDetailsUnassigned, "👤 Task is not assigned to anyone")
 	}
 
 	if task.DueDate == nil {
-		insights = append(insights, "📅 No due date set for this task")
+		insights = appendInsight(cfg, insights, insightDetailsNoDueDate, "📅 No due date set for this task")
 	}
 
 	// Check if task is blocked
 	if task.Status == "Blocked" && len(notes) > 0 {
-		insights = append(insights, "🚫 Task is blocked - check latest notes for blocker details")
+		insights = appendInsight(cfg, insights, insightDetailsBlockedNotes, "🚫 Task is blocked - check latest notes for blocker details")
 	}
 
 	// Generate suggested next actions
@@ -635,13 +769,15 @@ func (t *TaskTools) HandleGetTaskDetails(
 
 	// Build comprehensive response
 	result := map[string]any{
-		"task":         task,
-		"notes":        notes,
-		"project":      project,
-		"insights":     insights,
-		"next_actions": nextActions,
-		"note_count":   len(notes),
-		"has_project":  project != nil,
+		"task":            task,
+		"notes":           notes,
+		"project":         project,
+		"insights":        insights,
+		"next_actions":    nextActions,
+		"note_count":      totalNotes,
+		"notes_shown":     len(notes),
+		"notes_available": notesAvailable,
+		"has_project":     project != nil,
 	}
 
 	// Build detailed response text
@@ -652,9 +788,7 @@ func (t *TaskTools) HandleGetTaskDetails(
 		responseText += fmt.Sprintf("Description: %s\n", *task.TaskDescription)
 	}
 
-	if task.Priority != nil {
-		responseText += fmt.Sprintf("Priority: %s\n", *task.Priority)
-	}
+	responseText += fmt.Sprintf("Priority: %s\n", t.priorities.Render(task.Priority))
 
 	if task.AssignedTo != nil {
 		responseText += fmt.Sprintf("Assigned to: %s\n", *task.AssignedTo)
@@ -676,6 +810,13 @@ func (t *TaskTools) HandleGetTaskDetails(
 		responseText += fmt.Sprintf("Tags: %v\n", task.Tags)
 	}
 
+	if len(task.Links) > 0 {
+		responseText += fmt.Sprintf("\n🔗 Links (%d):\n", len(task.Links))
+		for _, link := range task.Links {
+			responseText += fmt.Sprintf("- %s: %s\n", link.Label, link.URL)
+		}
+	}
+
 	if project != nil {
 		responseText += fmt.Sprintf("\n📁 Project: %s\nProject ID: %s\n",
 			project.ProjectName, project.ProjectID)
@@ -693,16 +834,24 @@ func (t *TaskTools) HandleGetTaskDetails(
 	}
 
 	if len(notes) > 0 {
-		responseText += fmt.Sprintf("\n📝 Notes (%d):\n", len(notes))
-		for i, note := range notes {
-			if i < 5 { // Show only latest 5 notes
-				responseText += fmt.Sprintf("- [%s] %s (by %s)\n",
-					note.CreationDate, note.Note, note.CreatedBy)
-			}
+		shown := notes
+		if len(shown) > detailsNotesShown {
+			shown = shown[:detailsNotesShown]
+		}
+		if len(shown) < totalNotes {
+			responseText += fmt.Sprintf("\n📝 Notes (showing %d of %d, newest first):\n", len(shown), totalNotes)
+		} else {
+			responseText += fmt.Sprintf("\n📝 Notes (%d):\n", totalNotes)
 		}
-		if len(notes) > 5 {
-			responseText += fmt.Sprintf("... and %d more notes\n", len(notes)-5)
+		for _, note := range shown {
+			responseText += fmt.Sprintf("- [%s] %s (by %s)\n",
+				note.CreationDate, note.Note, note.CreatedBy)
 		}
+		if len(notes) > len(shown) {
+			responseText += fmt.Sprintf("... and %d more in metadata\n", len(notes)-len(shown))
+		}
+	} else if !notesAvailable {
+		responseText += "\n⚠️ Notes could not be loaded\n"
 	} else {
 		responseText += "\n📝 No notes available\n"
 	}
@@ -741,44 +890,32 @@ func (t *TaskTools) HandleUpdateTaskProgress(
 ) (*mcp.CallToolResultFor[map[string]any], error) {
 	slog.Info("Executing update_task_progress tool", "params", params.Arguments)
 
+	cfg := t.config()
+
 	// Validate required fields
 	if params.Arguments.TaskID == "" {
 		return nil, fmt.Errorf("task_id is required")
 	}
-	if params.Arguments.ProgressNote == "" {
-		return nil, fmt.Errorf("progress_note is required")
+	if err := validateNote(cfg, params.Arguments.ProgressNote, "progress_note"); err != nil {
+		return nil, err
 	}
-	if params.Arguments.UpdatedBy == "" {
-		return nil, fmt.Errorf("updated_by is required")
+	updatedBy, err := resolveActor(cfg, params.Arguments.UpdatedBy, "updated_by")
+	if err != nil {
+		return nil, err
 	}
+	params.Arguments.UpdatedBy = updatedBy
 
 	// Validate status if provided
-	validStatuses := []string{"Not Started", "In Progress", "Blocked", "Review", "Complete"}
 	if params.Arguments.Status != "" {
-		isValid := false
-		for _, validStatus := range validStatuses {
-			if params.Arguments.Status == validStatus {
-				isValid = true
-				break
-			}
-		}
-		if !isValid {
-			return nil, fmt.Errorf("invalid status '%s'. Valid statuses are: %v", params.Arguments.Status, validStatuses)
+		if err := validateStatus(params.Arguments.Status); err != nil {
+			return nil, err
 		}
 	}
 
 	// Validate priority if provided
-	validPriorities := []string{"Low", "Medium", "High"}
 	if params.Arguments.Priority != "" {
-		isValid := false
-		for _, validPriority := range validPriorities {
-			if params.Arguments.Priority == validPriority {
-				isValid = true
-				break
-			}
-		}
-		if !isValid {
-			return nil, fmt.Errorf("invalid priority '%s'. Valid priorities are: %v", params.Arguments.Priority, validPriorities)
+		if err := validatePriority(params.Arguments.Priority); err != nil {
+			return nil, err
 		}
 	}
 
@@ -795,6 +932,17 @@ func (t *TaskTools) HandleUpdateTaskProgress(
 		return nil, fmt.Errorf("failed to parse current task: %w", err)
 	}
 
+	// Enforce hard WIP limits on moves into a limited status
+	if params.Arguments.Status != "" && params.Arguments.Status != currentTask.Status && !params.Arguments.Force {
+		assignee := params.Arguments.AssignedTo
+		if assignee == "" && currentTask.AssignedTo != nil {
+			assignee = *currentTask.AssignedTo
+		}
+		if err := t.checkWIPLimit(ctx, cfg, currentTask, params.Arguments.Status, assignee); err != nil {
+			return nil, err
+		}
+	}
+
 	// Build task update request
 	updateRequest := map[string]interface{}{
 		"last_updated_by": params.Arguments.UpdatedBy,
@@ -832,13 +980,13 @@ func (t *TaskTools) HandleUpdateTaskProgress(
 
 	// Set completion date if status is Complete
 	if params.Arguments.Status == "Complete" {
-		updateRequest["completion_date"] = time.Now().Format(time.RFC3339)
+		updateRequest["completion_date"] = t.clock.Now().Format(time.RFC3339)
 		changes = append(changes, "Completion date set")
 	}
 
 	// Set start date if status changed to In Progress and no start date exists
 	if params.Arguments.Status == "In Progress" && currentTask.StartDate == nil {
-		updateRequest["start_date"] = time.Now().Format(time.RFC3339)
+		updateRequest["start_date"] = t.clock.Now().Format(time.RFC3339)
 		changes = append(changes, "Start date set")
 	}
 
@@ -882,31 +1030,31 @@ func (t *TaskTools) HandleUpdateTaskProgress(
 	var insights []string
 
 	if params.Arguments.Status == "Complete" {
-		insights = append(insights, "🎉 Task marked as complete!")
+		insights = appendInsight(cfg, insights, insightProgressCompleted, "🎉 Task marked as complete!")
 
 		// Check completion time
 		if currentTask.DueDate != nil {
 			dueDate, err := time.Parse(time.RFC3339, *currentTask.DueDate)
 			if err == nil {
-				if time.Now().Before(dueDate) {
-					insights = append(insights, "✅ Task completed before due date")
+				if t.clock.Now().Before(dueDate) {
+					insights = appendInsight(cfg, insights, insightProgressCompletedEarly, "✅ Task completed before due date")
 				} else {
-					insights = append(insights, "⏰ Task completed after due date")
+					insights = appendInsight(cfg, insights, insightProgressCompletedLate, "⏰ Task completed after due date")
 				}
 			}
 		}
 	}
 
 	if params.Arguments.Status == "Blocked" {
-		insights = append(insights, "🚫 Task is now blocked - ensure blocker is documented in the note")
+		insights = appendInsight(cfg, insights, insightProgressBlocked, "🚫 Task is now blocked - ensure blocker is documented in the note")
 	}
 
 	if params.Arguments.Status == "In Progress" && currentTask.Status == "Not Started" {
-		insights = append(insights, "▶️ Work has begun on this task")
+		insights = appendInsight(cfg, insights, insightProgressStarted, "▶️ Work has begun on this task")
 	}
 
 	if params.Arguments.Priority == "High" && (currentTask.Priority == nil || *currentTask.Priority != "High") {
-		insights = append(insights, "🔥 Task priority elevated to High")
+		insights = appendInsight(cfg, insights, insightProgressEscalated, "🔥 Task priority elevated to High")
 	}
 
 	// Generate next steps based on new status
@@ -970,7 +1118,7 @@ func (t *TaskTools) HandleUpdateTaskProgress(
 
 	responseText += fmt.Sprintf("\nCurrent Status: %s\n", updatedTask.Status)
 	if updatedTask.Priority != nil {
-		responseText += fmt.Sprintf("Priority: %s\n", *updatedTask.Priority)
+		responseText += fmt.Sprintf("Priority: %s\n", t.priorities.Label(*updatedTask.Priority))
 	}
 	if updatedTask.AssignedTo != nil {
 		responseText += fmt.Sprintf("Assigned to: %s\n", *updatedTask.AssignedTo)
@@ -996,6 +1144,31 @@ func (t *TaskTools) HandleSearchTasks(
 ) (*mcp.CallToolResultFor[map[string]any], error) {
 	slog.Info("Executing search_tasks tool", "params", params.Arguments)
 
+	cfg := t.config()
+
+	archivedMode, err := normalizeArchivedFilter(params.Arguments.Archived, cfg.SearchIncludesArchivedByDefault)
+	if err != nil {
+		return nil, err
+	}
+
+	params.Arguments.SearchMode = normalizeEnum(params.Arguments.SearchMode)
+	params.Arguments.TagMatchMode = normalizeEnum(params.Arguments.TagMatchMode)
+	params.Arguments.SortBy = normalizeEnum(params.Arguments.SortBy)
+	params.Arguments.SortOrder = normalizeEnum(params.Arguments.SortOrder)
+	if params.Arguments.TagMatchMode == "" {
+		params.Arguments.TagMatchMode = tagMatchAny
+	}
+	if params.Arguments.TagMatchMode != tagMatchAny && params.Arguments.TagMatchMode != tagMatchAll {
+		return nil, fmt.Errorf("invalid tag_match_mode '%s' (use any or all)", params.Arguments.TagMatchMode)
+	}
+	var matchText textMatcher
+	if params.Arguments.SearchText != "" {
+		matchText, err = newTextMatcher(params.Arguments.SearchMode, params.Arguments.SearchText)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Build complex query parameters
 	queryParams := ""
 
@@ -1017,7 +1190,7 @@ func (t *TaskTools) HandleSearchTasks(
 		queryParams += fmt.Sprintf("priority=%s", url.QueryEscape(params.Arguments.Priority))
 	}
 
-	if params.Arguments.AssignedTo != "" {
+	if params.Arguments.AssignedTo != "" && !t.assignees.Enabled() {
 		if queryParams == "" {
 			queryParams += "?"
 		} else {
@@ -1044,13 +1217,13 @@ func (t *TaskTools) HandleSearchTasks(
 		queryParams += fmt.Sprintf("created_by=%s", url.QueryEscape(params.Arguments.CreatedBy))
 	}
 
-	if params.Arguments.Archived != "" {
+	if archivedMode != archivedAll {
 		if queryParams == "" {
 			queryParams += "?"
 		} else {
 			queryParams += "&"
 		}
-		queryParams += fmt.Sprintf("archived=%s", url.QueryEscape(params.Arguments.Archived))
+		queryParams += fmt.Sprintf("archived=%s", archivedMode)
 	}
 
 	// Add date range filters (note: these would need API support)
@@ -1072,8 +1245,9 @@ func (t *TaskTools) HandleSearchTasks(
 		queryParams += fmt.Sprintf("due_date_to=%s", url.QueryEscape(params.Arguments.DueDateTo))
 	}
 
-	// Add search text (note: would need API support for text search)
-	if params.Arguments.SearchText != "" {
+	// Add search text (note: would need API support for text search). The
+	// API only knows substrings, so other modes filter client-side alone.
+	if params.Arguments.SearchText != "" && (params.Arguments.SearchMode == "" || params.Arguments.SearchMode == searchModeSubstring) {
 		if queryParams == "" {
 			queryParams += "?"
 		} else {
@@ -1117,45 +1291,22 @@ func (t *TaskTools) HandleSearchTasks(
 		slog.Error("Failed to parse searched tasks", "error", err)
 		return nil, fmt.Errorf("failed to parse searched tasks: %w", err)
 	}
+	tasks = filterAssignedTo(t.assignees, tasks, params.Arguments.AssignedTo)
 
 	// Apply client-side filtering for fields not supported by API
 	var filteredTasks []Task
+	matchedTags := make(map[string][]string)
 
 	for _, task := range tasks {
-		include := true
+		// Archived filtering is enforced here whether or not the API honors it
+		include := matchesArchivedFilter(task, archivedMode)
 
 		// Text search in task name and description (client-side)
-		if params.Arguments.SearchText != "" {
-			searchText := params.Arguments.SearchText
-			found := false
-
-			// Search in task name
-			if task.TaskName != "" &&
-				fmt.Sprintf("%s", task.TaskName) != "" {
-				// Simple case-insensitive search
-				if len(searchText) <= len(task.TaskName) {
-					for i := 0; i <= len(task.TaskName)-len(searchText); i++ {
-						if task.TaskName[i:i+len(searchText)] == searchText {
-							found = true
-							break
-						}
-					}
-				}
-			}
-
-			// Search in task description
-			if !found && task.TaskDescription != nil && *task.TaskDescription != "" {
-				desc := *task.TaskDescription
-				if len(searchText) <= len(desc) {
-					for i := 0; i <= len(desc)-len(searchText); i++ {
-						if desc[i:i+len(searchText)] == searchText {
-							found = true
-							break
-						}
-					}
-				}
+		if include && matchText != nil {
+			found := matchText(task.TaskName)
+			if !found && task.TaskDescription != nil {
+				found = matchText(*task.TaskDescription)
 			}
-
 			if !found {
 				include = false
 			}
@@ -1182,15 +1333,24 @@ func (t *TaskTools) HandleSearchTasks(
 			}
 		}
 
+		// Tag filtering (client-side)
+		if include && len(params.Arguments.Tags) > 0 {
+			matched, ok := matchTags(task.Tags, params.Arguments.Tags, params.Arguments.TagMatchMode)
+			if ok {
+				matchedTags[task.TaskID] = matched
+			} else {
+				include = false
+			}
+		}
+
 		if include {
 			filteredTasks = append(filteredTasks, task)
 		}
 	}
 
-	// Apply sorting (client-side for unsupported sorts)
+	// Sort client-side too, in case the API ignores sort_by
 	if params.Arguments.SortBy != "" {
-		// Note: This is a simplified sorting implementation
-		// In a real implementation, you'd use sort.Slice with proper comparison functions
+		sortTasks(filteredTasks, params.Arguments.SortBy, params.Arguments.SortOrder)
 	}
 
 	// Apply limit (client-side)
@@ -1202,11 +1362,17 @@ func (t *TaskTools) HandleSearchTasks(
 	statusCounts := make(map[string]int)
 	priorityCounts := make(map[string]int)
 	projectCounts := make(map[string]int)
+	tagCounts := make(map[string]int)
 	overdueTasks := []Task{}
 
+	now := t.clock.Now()
 	for _, task := range filteredTasks {
 		statusCounts[task.Status]++
 
+		for _, tag := range task.Tags {
+			tagCounts[tag]++
+		}
+
 		if task.Priority != nil {
 			priorityCounts[*task.Priority]++
 		} else {
@@ -1219,7 +1385,7 @@ func (t *TaskTools) HandleSearchTasks(
 			projectCounts["No Project"]++
 		}
 
-		if isTaskOverdue(task) {
+		if isTaskOverdue(task, now) {
 			overdueTasks = append(overdueTasks, task)
 		}
 	}
@@ -1229,26 +1395,26 @@ func (t *TaskTools) HandleSearchTasks(
 
 	totalResults := len(filteredTasks)
 	if totalResults == 0 {
-		insights = append(insights, "🔍 No tasks match your search criteria")
+		insights = appendInsight(cfg, insights, insightSearchNoResults, "🔍 No tasks match your search criteria")
 	} else if totalResults == 1 {
-		insights = append(insights, "🎯 Found exactly one matching task")
+		insights = appendInsight(cfg, insights, insightSearchSingleResult, "🎯 Found exactly one matching task")
 	} else if totalResults > 100 {
-		insights = append(insights, "📊 Large result set - consider narrowing your search")
+		insights = appendInsight(cfg, insights, insightSearchLargeResult, "📊 Large result set - consider narrowing your search")
 	}
 
 	if len(overdueTasks) > 0 {
-		insights = append(insights, fmt.Sprintf("⚠️ %d of the results are overdue", len(overdueTasks)))
+		insights = appendInsight(cfg, insights, insightSearchOverdue, fmt.Sprintf("⚠️ %d of the results are overdue", len(overdueTasks)))
 	}
 
 	if len(statusCounts) == 1 {
 		for status := range statusCounts {
-			insights = append(insights, fmt.Sprintf("📋 All results have status: %s", status))
+			insights = appendInsight(cfg, insights, insightSearchSingleStatus, fmt.Sprintf("📋 All results have status: %s", status))
 		}
 	}
 
 	if len(priorityCounts) > 0 {
 		if high, exists := priorityCounts["High"]; exists && high > totalResults/2 {
-			insights = append(insights, "🔥 Most results are high priority")
+			insights = appendInsight(cfg, insights, insightSearchMostlyHigh, "🔥 Most results are high priority")
 		}
 	}
 
@@ -1280,18 +1446,25 @@ func (t *TaskTools) HandleSearchTasks(
 		"status_breakdown":   statusCounts,
 		"priority_breakdown": priorityCounts,
 		"project_breakdown":  projectCounts,
+		"tag_breakdown":      tagCounts,
 		"overdue_count":      len(overdueTasks),
 		"overdue_tasks":      overdueTasks,
 		"insights":           insights,
 		"suggestions":        suggestions,
+		"archived_filter":    archivedMode,
+	}
+	if len(params.Arguments.Tags) > 0 {
+		result["matched_tags"] = matchedTags
 	}
 
 	// Build response text
+	maxOverdueShown := cfg.SearchMaxOverdueShown
+	maxTasksShown := cfg.SearchMaxTasksShown
 	responseText := fmt.Sprintf(`Task Search Results\n==================\n\nFound: %d tasks\n`, totalResults)
 
 	// Show search criteria
 	if params.Arguments.Status != "" || params.Arguments.Priority != "" || params.Arguments.AssignedTo != "" ||
-		params.Arguments.ProjectID != "" || params.Arguments.SearchText != "" {
+		params.Arguments.ProjectID != "" || params.Arguments.SearchText != "" || len(params.Arguments.Tags) > 0 {
 		responseText += "\n🔍 Search Criteria:\n"
 
 		if params.Arguments.Status != "" {
@@ -1307,7 +1480,14 @@ func (t *TaskTools) HandleSearchTasks(
 			responseText += fmt.Sprintf("- Project ID: %s\n", params.Arguments.ProjectID)
 		}
 		if params.Arguments.SearchText != "" {
-			responseText += fmt.Sprintf("- Search text: %s\n", params.Arguments.SearchText)
+			if params.Arguments.SearchMode != "" && params.Arguments.SearchMode != searchModeSubstring {
+				responseText += fmt.Sprintf("- Search text (%s): %s\n", params.Arguments.SearchMode, params.Arguments.SearchText)
+			} else {
+				responseText += fmt.Sprintf("- Search text: %s\n", params.Arguments.SearchText)
+			}
+		}
+		if len(params.Arguments.Tags) > 0 {
+			responseText += fmt.Sprintf("- Tags (%s): %s\n", params.Arguments.TagMatchMode, strings.Join(params.Arguments.Tags, ", "))
 		}
 		if params.Arguments.DueDateFrom != "" {
 			responseText += fmt.Sprintf("- Due date from: %s\n", params.Arguments.DueDateFrom)
@@ -1326,31 +1506,32 @@ func (t *TaskTools) HandleSearchTasks(
 		if len(overdueTasks) > 0 {
 			responseText += fmt.Sprintf("\n⚠️ Overdue Tasks (%d):\n", len(overdueTasks))
 			for i, task := range overdueTasks {
-				if i < 5 { // Show only first 5
-					responseText += fmt.Sprintf("- %s (Due: %s)\n", task.TaskName, *task.DueDate)
+				if i < maxOverdueShown {
+					responseText += fmt.Sprintf("- %s (%s, Due: %s)\n", task.TaskName, t.priorities.Render(task.Priority), *task.DueDate)
 				}
 			}
-			if len(overdueTasks) > 5 {
-				responseText += fmt.Sprintf("... and %d more overdue tasks\n", len(overdueTasks)-5)
+			if len(overdueTasks) > maxOverdueShown {
+				responseText += fmt.Sprintf("... and %d more overdue tasks\n", len(overdueTasks)-maxOverdueShown)
 			}
 		}
 
 		responseText += fmt.Sprintf("\n📋 Tasks (showing %d):\n", len(filteredTasks))
 		for i, task := range filteredTasks {
-			if i < 10 { // Show only first 10
+			if i < maxTasksShown {
 				assignee := "Unassigned"
 				if task.AssignedTo != nil {
 					assignee = *task.AssignedTo
 				}
-				priority := "None"
-				if task.Priority != nil {
-					priority = *task.Priority
+				priority := t.priorities.Render(task.Priority)
+				line := fmt.Sprintf("- %s (%s, %s) - %s", task.TaskName, task.Status, priority, assignee)
+				if matched := matchedTags[task.TaskID]; len(matched) > 0 {
+					line += fmt.Sprintf(" [tags: %s]", strings.Join(matched, ", "))
 				}
-				responseText += fmt.Sprintf("- %s (%s, %s) - %s\n", task.TaskName, task.Status, priority, assignee)
+				responseText += line + "\n"
 			}
 		}
-		if len(filteredTasks) > 10 {
-			responseText += fmt.Sprintf("... and %d more tasks\n", len(filteredTasks)-10)
+		if len(filteredTasks) > maxTasksShown {
+			responseText += fmt.Sprintf("... and %d more tasks\n", len(filteredTasks)-maxTasksShown)
 		}
 	}
 
@@ -1368,7 +1549,12 @@ func (t *TaskTools) HandleSearchTasks(
 		}
 	}
 
-	slog.Info("Task search completed", "total_results", totalResults, "overdue_count", len(overdueTasks))
+	// Keep the rendered text within the configured size; Meta retains every result
+	var displayTruncated bool
+	responseText, displayTruncated = truncateDisplayText(responseText, cfg.SearchMaxTextBytes)
+	result["display_truncated"] = displayTruncated
+
+	slog.Info("Task search completed", "total_results", totalResults, "overdue_count", len(overdueTasks), "display_truncated", displayTruncated)
 
 	return &mcp.CallToolResultFor[map[string]any]{
 		Content: []mcp.Content{
@@ -1382,31 +1568,86 @@ func (t *TaskTools) HandleSearchTasks(
 
 // GetAllTasksParams defines input for get_all_tasks tool
 type GetAllTasksParams struct {
-	// No parameters needed for listing all tasks
+	Page     int `json:"page,omitempty" validate:"min=1"`                // default 1
+	PageSize int `json:"page_size,omitempty" validate:"min=1,max=500"` // default defaultTaskPageSize
 }
 
-// HandleGetAllTasks implements the get_all_tasks tool
+// defaultTaskPageSize is the get_all_tasks page size when none is given
+const defaultTaskPageSize = 50
+
+// HandleGetAllTasks implements the get_all_tasks tool. The API is asked for
+// one page plus one task, so has_more is known without a count; an API that
+// ignores limit and offset returns every task and is paged here instead.
+// That is seen when more than the limit comes back or, on a later page, when
+// the page starts with the first task of all (see offsetIgnored).
 func (t *TaskTools) HandleGetAllTasks(
 	ctx context.Context,
 	session *mcp.ServerSession,
 	params *mcp.CallToolParamsFor[GetAllTasksParams],
 ) (*mcp.CallToolResultFor[map[string]any], error) {
-	slog.Info("Executing get_all_tasks tool")
+	slog.Info("Executing get_all_tasks tool", "params", params.Arguments)
 
-	// Get all tasks from API
-	tasksResp, err := t.apiClient.Get(ctx, "/api/v1/tasks")
+	page := params.Arguments.Page
+	if page <= 0 {
+		page = 1
+	}
+	pageSize := params.Arguments.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultTaskPageSize
+	}
+	offset := (page - 1) * pageSize
+
+	// Get the page from API, following pagination up to the page cap
+	query := url.Values{"limit": {strconv.Itoa(pageSize + 1)}, "offset": {strconv.Itoa(offset)}}
+	tasksResp, truncated, err := t.apiClient.GetList(ctx, "/api/v1/tasks?"+query.Encode())
 	if err != nil {
 		slog.Error("Failed to get tasks", "error", err)
 		return nil, fmt.Errorf("failed to get tasks: %w", err)
 	}
 
-	var tasks []Task
-	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+	var fetched []Task
+	if err := json.Unmarshal(tasksResp, &fetched); err != nil {
 		slog.Error("Failed to parse tasks", "error", err)
 		return nil, fmt.Errorf("failed to parse tasks: %w", err)
 	}
 
-	// Analyze tasks for insights
+	pagingIgnored := len(fetched) > pageSize+1
+	if !pagingIgnored && offset > 0 && len(fetched) > 0 {
+		pagingIgnored, err = t.offsetIgnored(ctx, fetched[0].TaskID)
+		if err != nil {
+			slog.Error("Failed to get first task", "error", err)
+			return nil, fmt.Errorf("failed to get tasks: %w", err)
+		}
+	}
+
+	var tasks []Task
+	var totalCount int
+	var hasMore, totalExact bool
+	if pagingIgnored {
+		// The API ignored limit and offset, so every task is here
+		totalCount, totalExact = len(fetched), true
+		end := offset + pageSize
+		if end > len(fetched) {
+			end = len(fetched)
+		}
+		if offset < end {
+			tasks = fetched[offset:end]
+		}
+		hasMore = end < len(fetched)
+	} else {
+		hasMore = len(fetched) > pageSize
+		tasks = fetched
+		if hasMore {
+			tasks = fetched[:pageSize]
+		}
+		// Until the last page is reached only a lower bound on the total is known
+		totalCount, totalExact = offset+len(fetched), !hasMore
+	}
+	if tasks == nil {
+		tasks = []Task{}
+	}
+
+	// Analyze the page's tasks for insights
 	statusBreakdown := make(map[string]int)
 	priorityBreakdown := make(map[string]int)
 	projectBreakdown := make(map[string]int)
@@ -1431,17 +1672,24 @@ func (t *TaskTools) HandleGetAllTasks(
 		}
 
 		// Check if overdue
-		if isTaskOverdue(task) {
+		if isTaskOverdue(task, t.clock.Now()) {
 			overdueTasks = append(overdueTasks, task)
 		}
 	}
 
+	total := fmt.Sprintf("%d", totalCount)
+	if !totalExact {
+		total = fmt.Sprintf("at least %d", totalCount)
+	}
+
 	// Build response
 	var responseText string
-	if len(tasks) == 0 {
+	if len(tasks) == 0 && page == 1 {
 		responseText = "No tasks found.\n\nCreate your first task to get started!"
+	} else if len(tasks) == 0 {
+		responseText = fmt.Sprintf("No tasks on page %d (%s tasks in total).\n", page, total)
 	} else {
-		responseText = fmt.Sprintf("All Tasks (%d)\n", len(tasks))
+		responseText = fmt.Sprintf("All Tasks - Page %d (tasks %d-%d of %s)\n", page, offset+1, offset+len(tasks), total)
 		responseText += "=============\n\n"
 
 		// Status breakdown
@@ -1471,18 +1719,12 @@ func (t *TaskTools) HandleGetAllTasks(
 			responseText += "\n"
 		}
 
-		// Recent tasks (first 10)
-		responseText += "📋 Recent Tasks:\n"
-		displayCount := len(tasks)
-		if displayCount > 10 {
-			displayCount = 10
-		}
-		
-		for i := 0; i < displayCount; i++ {
-			task := tasks[i]
+		// Tasks on this page
+		responseText += "📋 Tasks:\n"
+		for _, task := range tasks {
 			responseText += fmt.Sprintf("- %s (%s", task.TaskName, task.Status)
 			if task.Priority != nil && *task.Priority != "" {
-				responseText += fmt.Sprintf(", %s", *task.Priority)
+				responseText += fmt.Sprintf(", %s", t.priorities.Label(*task.Priority))
 			}
 			if task.AssignedTo != nil && *task.AssignedTo != "" {
 				responseText += fmt.Sprintf(" - %s", *task.AssignedTo)
@@ -1490,23 +1732,31 @@ func (t *TaskTools) HandleGetAllTasks(
 			responseText += ")\n"
 		}
 
-		if len(tasks) > 10 {
-			responseText += fmt.Sprintf("\n... and %d more tasks\n", len(tasks)-10)
+		if hasMore {
+			responseText += fmt.Sprintf("\n➡️  More tasks on page %d\n", page+1)
 		}
 	}
+	if truncated {
+		responseText += "\n⚠️  The API has more pages of tasks than the configured page cap; counts cover only the pages fetched.\n"
+	}
 
 	result := map[string]any{
 		"tasks":             tasks,
-		"total_count":       len(tasks),
+		"page":              page,
+		"page_size":         pageSize,
+		"total_count":       totalCount,
+		"total_count_exact": totalExact,
+		"has_more":          hasMore,
 		"status_breakdown":  statusBreakdown,
 		"priority_breakdown": priorityBreakdown,
 		"project_breakdown": projectBreakdown,
 		"overdue_count":     len(overdueTasks),
 		"overdue_tasks":     overdueTasks,
 		"task_list":         tasks,
+		"more_pages":        truncated,
 	}
 
-	slog.Info("Tasks list retrieved", "total_tasks", len(tasks), "overdue_count", len(overdueTasks))
+	slog.Info("Tasks list retrieved", "page", page, "page_tasks", len(tasks), "has_more", hasMore, "overdue_count", len(overdueTasks))
 
 	return &mcp.CallToolResultFor[map[string]any]{
 		Content: []mcp.Content{
@@ -1518,9 +1768,25 @@ func (t *TaskTools) HandleGetAllTasks(
 	}, nil
 }
 
+// offsetIgnored reports whether the API ignored the offset of a later
+// get_all_tasks page that starts with firstID: task IDs are unique, so a page
+// starting with the same task as offset 0 was not offset at all
+func (t *TaskTools) offsetIgnored(ctx context.Context, firstID string) (bool, error) {
+	query := url.Values{"limit": {"1"}, "offset": {"0"}}
+	resp, _, err := t.apiClient.GetList(ctx, "/api/v1/tasks?"+query.Encode())
+	if err != nil {
+		return false, err
+	}
+	var first []Task
+	if err := json.Unmarshal(resp, &first); err != nil {
+		return false, err
+	}
+	return len(first) > 0 && first[0].TaskID == firstID, nil
+}
+
 // AddTaskNoteParams defines input for add_task_note tool
 type AddTaskNoteParams struct {
-	TaskID    string `json:"task_id"`
+	TaskID    string `json:"task_id" validate:"required"`
 	Note      string `json:"note"`
 	CreatedBy string `json:"created_by"`
 }
@@ -1533,16 +1799,20 @@ func (t *TaskTools) HandleAddTaskNote(
 ) (*mcp.CallToolResultFor[map[string]any], error) {
 	slog.Info("Executing add_task_note tool", "params", params.Arguments)
 
+	cfg := t.config()
+
 	// Validate required fields
 	if params.Arguments.TaskID == "" {
 		return nil, fmt.Errorf("task_id is required")
 	}
-	if params.Arguments.Note == "" {
-		return nil, fmt.Errorf("note is required")
+	if err := validateNote(cfg, params.Arguments.Note, "note"); err != nil {
+		return nil, err
 	}
-	if params.Arguments.CreatedBy == "" {
-		return nil, fmt.Errorf("created_by is required")
+	createdBy, err := resolveActor(cfg, params.Arguments.CreatedBy, "created_by")
+	if err != nil {
+		return nil, err
 	}
+	params.Arguments.CreatedBy = createdBy
 
 	// First, verify the task exists
 	taskResp, err := t.apiClient.Get(ctx, fmt.Sprintf("/api/v1/tasks/%s", params.Arguments.TaskID))
@@ -1618,3 +1888,4225 @@ func (t *TaskTools) HandleAddTaskNote(
 		Meta: result,
 	}, nil
 }
+
+// UpdateTaskNoteParams defines input for update_task_note tool
+type UpdateTaskNoteParams struct {
+	TaskID    string `json:"task_id" validate:"required"`
+	NoteID    string `json:"note_id" validate:"required"`
+	Note      string `json:"note"`
+	UpdatedBy string `json:"updated_by"`
+}
+
+// HandleUpdateTaskNote implements the update_task_note tool
+func (t *TaskTools) HandleUpdateTaskNote(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[UpdateTaskNoteParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing update_task_note tool", "params", params.Arguments)
+
+	cfg := t.config()
+
+	// Validate required fields
+	if params.Arguments.TaskID == "" {
+		return nil, fmt.Errorf("task_id is required")
+	}
+	if params.Arguments.NoteID == "" {
+		return nil, fmt.Errorf("note_id is required")
+	}
+	if err := validateNote(cfg, params.Arguments.Note, "note"); err != nil {
+		return nil, err
+	}
+	updatedBy, err := resolveActor(cfg, params.Arguments.UpdatedBy, "updated_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.UpdatedBy = updatedBy
+
+	// Verify the task exists before touching its notes
+	task, err := t.fetchTask(ctx, params.Arguments.TaskID)
+	if err != nil {
+		slog.Error("Failed to get task for note update", "error", err, "task_id", params.Arguments.TaskID)
+		return nil, fmt.Errorf("failed to verify task exists: %w", err)
+	}
+
+	noteRequest := map[string]interface{}{
+		"note":       params.Arguments.Note,
+		"updated_by": params.Arguments.UpdatedBy,
+	}
+	notePath := fmt.Sprintf("/api/v1/tasks/%s/notes/%s", url.PathEscape(task.TaskID), url.PathEscape(params.Arguments.NoteID))
+	noteResp, err := t.apiClient.Put(ctx, notePath, noteRequest)
+	if err != nil {
+		slog.Error("Failed to update note", "error", err, "task_id", task.TaskID, "note_id", params.Arguments.NoteID)
+		return nil, fmt.Errorf("failed to update note: %w", err)
+	}
+
+	var updatedNote TaskNote
+	if err := json.Unmarshal(noteResp, &updatedNote); err != nil {
+		slog.Error("Failed to parse updated note", "error", err)
+		return nil, fmt.Errorf("failed to parse updated note: %w", err)
+	}
+
+	responseText := "Note Updated Successfully\n"
+	responseText += "=========================\n\n"
+	responseText += fmt.Sprintf("Task: %s\n", task.TaskName)
+	responseText += fmt.Sprintf("Task ID: %s\n", task.TaskID)
+	responseText += fmt.Sprintf("Note ID: %s\n", updatedNote.NoteID)
+	responseText += fmt.Sprintf("Note: %s\n", updatedNote.Note)
+	responseText += fmt.Sprintf("Updated by: %s\n", params.Arguments.UpdatedBy)
+
+	result := map[string]any{
+		"success": true,
+		"task_id": task.TaskID,
+		"note_id": updatedNote.NoteID,
+		"note":    updatedNote,
+	}
+
+	slog.Info("Note updated successfully", "task_id", task.TaskID, "note_id", updatedNote.NoteID)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// DeleteTaskNoteParams defines input for delete_task_note tool
+type DeleteTaskNoteParams struct {
+	TaskID string `json:"task_id" validate:"required"`
+	NoteID string `json:"note_id" validate:"required"`
+}
+
+// HandleDeleteTaskNote implements the delete_task_note tool
+func (t *TaskTools) HandleDeleteTaskNote(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[DeleteTaskNoteParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing delete_task_note tool", "params", params.Arguments)
+
+	// Validate required fields
+	if params.Arguments.TaskID == "" {
+		return nil, fmt.Errorf("task_id is required")
+	}
+	if params.Arguments.NoteID == "" {
+		return nil, fmt.Errorf("note_id is required")
+	}
+
+	// Verify the task exists before touching its notes
+	task, err := t.fetchTask(ctx, params.Arguments.TaskID)
+	if err != nil {
+		slog.Error("Failed to get task for note deletion", "error", err, "task_id", params.Arguments.TaskID)
+		return nil, fmt.Errorf("failed to verify task exists: %w", err)
+	}
+
+	if err := t.deleteTaskNote(ctx, task.TaskID, params.Arguments.NoteID); err != nil {
+		slog.Error("Failed to delete note", "error", err, "task_id", task.TaskID, "note_id", params.Arguments.NoteID)
+		return nil, fmt.Errorf("failed to delete note: %w", err)
+	}
+
+	responseText := "Note Deleted\n"
+	responseText += "============\n\n"
+	responseText += fmt.Sprintf("Task: %s\n", task.TaskName)
+	responseText += fmt.Sprintf("Task ID: %s\n", task.TaskID)
+	responseText += fmt.Sprintf("Note ID: %s\n", params.Arguments.NoteID)
+
+	result := map[string]any{
+		"success": true,
+		"task_id": task.TaskID,
+		"note_id": params.Arguments.NoteID,
+	}
+
+	slog.Info("Note deleted successfully", "task_id", task.TaskID, "note_id", params.Arguments.NoteID)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetSimilarTasksParams defines input for get_similar_tasks tool
+type GetSimilarTasksParams struct {
+	TaskName    string `json:"task_name" validate:"max=200"`
+	Description string `json:"description,omitempty"`
+	TaskID      string `json:"task_id,omitempty"`
+	TopN        int    `json:"top_n,omitempty" validate:"min=1"`
+}
+
+// HandleGetSimilarTasks implements the get_similar_tasks tool
+func (t *TaskTools) HandleGetSimilarTasks(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetSimilarTasksParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_similar_tasks tool", "params", params.Arguments)
+
+	// Validate required fields
+	if params.Arguments.TaskName == "" && params.Arguments.Description == "" {
+		return nil, fmt.Errorf("task_name or description is required")
+	}
+
+	topN := params.Arguments.TopN
+	if topN <= 0 {
+		topN = 5
+	}
+
+	// Get all tasks to compare against
+	tasksResp, err := t.apiClient.Get(ctx, "/api/v1/tasks")
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+
+	query := params.Arguments.TaskName + " " + params.Arguments.Description
+	ranked := rankSimilarTasks(tasks, query, params.Arguments.TaskID, topN)
+
+	// Enrich matches with note counts and completion durations
+	similarTasks := []map[string]any{}
+	for _, scored := range ranked {
+		task := scored.Task
+		entry := map[string]any{
+			"task_id":   task.TaskID,
+			"task_name": task.TaskName,
+			"status":    task.Status,
+			"score":     scored.Score,
+		}
+
+		noteCount := -1
+		notesResp, err := t.apiClient.Get(ctx, fmt.Sprintf("/api/v1/tasks/%s/notes", url.PathEscape(task.TaskID)))
+		if err != nil {
+			slog.Warn("Failed to get notes for similar task", "error", err, "task_id", task.TaskID)
+		} else {
+			var notes []TaskNote
+			if err := json.Unmarshal(notesResp, &notes); err != nil {
+				slog.Warn("Failed to parse notes for similar task", "error", err, "task_id", task.TaskID)
+			} else {
+				noteCount = len(notes)
+			}
+		}
+		if noteCount >= 0 {
+			entry["note_count"] = noteCount
+		}
+
+		if task.Status == "Complete" && task.CompletionDate != nil {
+			created, createErr := time.Parse(time.RFC3339, task.CreationDate)
+			completed, completeErr := time.Parse(time.RFC3339, *task.CompletionDate)
+			if createErr == nil && completeErr == nil {
+				entry["days_to_complete"] = completed.Sub(created).Hours() / 24
+			}
+		}
+
+		similarTasks = append(similarTasks, entry)
+	}
+
+	result := map[string]any{
+		"similar_tasks": similarTasks,
+		"total_matches": len(similarTasks),
+		"compared":      len(tasks),
+		"query":         strings.TrimSpace(query),
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Similar Tasks\n=============\n\nQuery: %s\nCompared against %d tasks\n", strings.TrimSpace(query), len(tasks))
+
+	if len(similarTasks) == 0 {
+		responseText += "\n🔍 No similar tasks found\n"
+	} else {
+		responseText += fmt.Sprintf("\n📋 Top %d matches:\n", len(similarTasks))
+		for i, entry := range similarTasks {
+			responseText += fmt.Sprintf("%d. %s (%s) - similarity %.0f%%", i+1, entry["task_name"], entry["status"], entry["score"].(float64)*100)
+			if days, ok := entry["days_to_complete"]; ok {
+				responseText += fmt.Sprintf(", completed in %.1f days", days.(float64))
+			}
+			if notes, ok := entry["note_count"]; ok {
+				responseText += fmt.Sprintf(", %d notes", notes.(int))
+			}
+			responseText += "\n"
+		}
+	}
+
+	slog.Info("Similar tasks found", "matches", len(similarTasks), "compared", len(tasks))
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// ArchiveCompletedTasksParams defines input for archive_completed_tasks tool
+type ArchiveCompletedTasksParams struct {
+	ProjectID       string `json:"project_id,omitempty"`
+	CompletedBefore string `json:"completed_before,omitempty" validate:"date"`
+	ArchivedBy      string `json:"archived_by"`
+}
+
+// HandleArchiveCompletedTasks implements the archive_completed_tasks tool
+func (t *TaskTools) HandleArchiveCompletedTasks(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[ArchiveCompletedTasksParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing archive_completed_tasks tool", "params", params.Arguments)
+
+	cfg := t.config()
+
+	// Many API calls share one budget
+	ctx, cancel := client.WithTimeout(ctx, cfg.MultiCallTimeout)
+	defer cancel()
+
+	// Validate required fields
+	archivedBy, err := resolveActor(cfg, params.Arguments.ArchivedBy, "archived_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.ArchivedBy = archivedBy
+	if params.Arguments.ProjectID == "" && params.Arguments.CompletedBefore == "" {
+		return nil, fmt.Errorf("at least one scope (project_id or completed_before) is required")
+	}
+
+	var completedBefore *time.Time
+	if params.Arguments.CompletedBefore != "" {
+		parsed, err := parseDueDate(params.Arguments.CompletedBefore)
+		if err != nil {
+			return nil, fmt.Errorf("invalid completed_before: %w", err)
+		}
+		completedBefore = parsed
+	}
+
+	// Get completed tasks in scope
+	queryParams := fmt.Sprintf("?status=%s", url.QueryEscape("Complete"))
+	if params.Arguments.ProjectID != "" {
+		queryParams += fmt.Sprintf("&project_id=%s", url.QueryEscape(params.Arguments.ProjectID))
+	}
+
+	tasksResp, err := t.apiClient.Get(ctx, "/api/v1/tasks"+queryParams)
+	if err != nil {
+		slog.Error("Failed to get completed tasks", "error", err)
+		return nil, fmt.Errorf("failed to get completed tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse completed tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse completed tasks: %w", err)
+	}
+
+	// Select tasks matching the scope and age
+	var candidates []Task
+	for _, task := range tasks {
+		if task.Status != "Complete" || task.Archived {
+			continue
+		}
+		if params.Arguments.ProjectID != "" && (task.ProjectID == nil || *task.ProjectID != params.Arguments.ProjectID) {
+			continue
+		}
+		if completedBefore != nil {
+			if task.CompletionDate == nil {
+				continue
+			}
+			completed, err := time.Parse(time.RFC3339, *task.CompletionDate)
+			if err != nil || !completed.Before(*completedBefore) {
+				continue
+			}
+		}
+		candidates = append(candidates, task)
+	}
+
+	// Archive in bounded-concurrency batches
+	archived := make([]bool, len(candidates))
+	failures := make([]string, len(candidates))
+
+	runBounded(len(candidates), bulkConcurrency, func(i int) {
+		task := candidates[i]
+		updateRequest := map[string]interface{}{
+			"archived":        true,
+			"last_updated_by": params.Arguments.ArchivedBy,
+		}
+		if _, err := t.apiClient.Put(ctx, fmt.Sprintf("/api/v1/tasks/%s", url.PathEscape(task.TaskID)), updateRequest); err != nil {
+			slog.Error("Failed to archive task", "error", err, "task_id", task.TaskID)
+			failures[i] = err.Error()
+			return
+		}
+		archived[i] = true
+	})
+
+	var archivedTasks []Task
+	failedTasks := []map[string]any{}
+	for i, task := range candidates {
+		if archived[i] {
+			archivedTasks = append(archivedTasks, task)
+		} else {
+			failedTasks = append(failedTasks, map[string]any{
+				"task_id":   task.TaskID,
+				"task_name": task.TaskName,
+				"error":     failures[i],
+			})
+		}
+	}
+
+	result := map[string]any{
+		"archived_count": len(archivedTasks),
+		"archived_tasks": archivedTasks,
+		"failed_count":   len(failedTasks),
+		"failed_tasks":   failedTasks,
+		"matched_count":  len(candidates),
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Archive Completed Tasks\n=======================\n\nMatched: %d completed tasks\nArchived: %d\n",
+		len(candidates), len(archivedTasks))
+
+	if params.Arguments.ProjectID != "" {
+		responseText += fmt.Sprintf("Project: %s\n", params.Arguments.ProjectID)
+	}
+	if completedBefore != nil {
+		responseText += fmt.Sprintf("Completed before: %s\n", completedBefore.Format("2006-01-02"))
+	}
+
+	if len(archivedTasks) > 0 {
+		responseText += "\n🗄️ Archived Tasks:\n"
+		for i, task := range archivedTasks {
+			if i < 10 { // Show only first 10
+				responseText += fmt.Sprintf("- %s\n", task.TaskName)
+			}
+		}
+		if len(archivedTasks) > 10 {
+			responseText += fmt.Sprintf("... and %d more tasks\n", len(archivedTasks)-10)
+		}
+	}
+
+	if len(failedTasks) > 0 {
+		responseText += fmt.Sprintf("\n❌ Failed (%d):\n", len(failedTasks))
+		for _, failure := range failedTasks {
+			responseText += fmt.Sprintf("- %s: %s\n", failure["task_name"], failure["error"])
+		}
+	}
+
+	slog.Info("Completed tasks archived", "archived", len(archivedTasks), "failed", len(failedTasks))
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetBoardParams defines input for get_board tool
+type GetBoardParams struct {
+	ProjectID  string `json:"project_id,omitempty"`
+	AssignedTo string `json:"assigned_to,omitempty"`
+}
+
+// HandleGetBoard implements the get_board tool
+func (t *TaskTools) HandleGetBoard(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetBoardParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_board tool", "params", params.Arguments)
+
+	cfg := t.config()
+
+	// Build query parameters
+	queryParams := ""
+	if params.Arguments.ProjectID != "" {
+		queryParams += fmt.Sprintf("?project_id=%s", url.QueryEscape(params.Arguments.ProjectID))
+	}
+	if params.Arguments.AssignedTo != "" && !t.assignees.Enabled() {
+		if queryParams == "" {
+			queryParams += "?"
+		} else {
+			queryParams += "&"
+		}
+		queryParams += fmt.Sprintf("assigned_to=%s", url.QueryEscape(params.Arguments.AssignedTo))
+	}
+
+	// Get tasks
+	tasksResp, err := t.apiClient.Get(ctx, "/api/v1/tasks"+queryParams)
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+	tasks = filterAssignedTo(t.assignees, tasks, params.Arguments.AssignedTo)
+
+	// Group tasks into status columns
+	tasksByStatus := make(map[string][]Task)
+	for _, task := range tasks {
+		tasksByStatus[task.Status] = append(tasksByStatus[task.Status], task)
+	}
+
+	columns := []map[string]any{}
+	columnCounts := make(map[string]int)
+	for _, status := range orderedStatuses(tasksByStatus) {
+		columnTasks := tasksByStatus[status]
+		if columnTasks == nil {
+			columnTasks = []Task{}
+		}
+		sortTasksByPriorityAndDue(columnTasks)
+		columns = append(columns, map[string]any{
+			"status": status,
+			"count":  len(columnTasks),
+			"tasks":  columnTasks,
+		})
+		columnCounts[status] = len(columnTasks)
+	}
+
+	// Check work-in-progress limit
+	warnings := []string{}
+	wipCount := columnCounts["In Progress"]
+	wipLimit := cfg.WIPLimit
+	wipExceeded := wipLimit > 0 && wipCount > wipLimit
+	if wipExceeded {
+		warnings = append(warnings, fmt.Sprintf("WIP limit exceeded: %d tasks In Progress (limit %d)", wipCount, wipLimit))
+	}
+
+	result := map[string]any{
+		"columns":      columns,
+		"counts":       columnCounts,
+		"total_tasks":  len(tasks),
+		"wip_count":    wipCount,
+		"wip_limit":    wipLimit,
+		"wip_exceeded": wipExceeded,
+		"warnings":     warnings,
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Task Board\n==========\n\nTotal Tasks: %d\n", len(tasks))
+
+	for _, column := range columns {
+		status := column["status"].(string)
+		columnTasks := column["tasks"].([]Task)
+		responseText += fmt.Sprintf("\n📋 %s (%d):\n", status, len(columnTasks))
+		for i, task := range columnTasks {
+			if i < 10 { // Show only first 10
+				dueInfo := ""
+				if task.DueDate != nil {
+					dueInfo = fmt.Sprintf(" - Due: %s", *task.DueDate)
+				}
+				responseText += fmt.Sprintf("- %s (%s)%s\n", task.TaskName, t.priorities.Render(task.Priority), dueInfo)
+			}
+		}
+		if len(columnTasks) > 10 {
+			responseText += fmt.Sprintf("... and %d more tasks\n", len(columnTasks)-10)
+		}
+	}
+
+	if len(warnings) > 0 {
+		responseText += "\n⚠️ Warnings:\n"
+		for _, warning := range warnings {
+			responseText += fmt.Sprintf("- %s\n", warning)
+		}
+	}
+
+	slog.Info("Board built", "total_tasks", len(tasks), "wip_count", wipCount, "wip_exceeded", wipExceeded)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// RouteBlockedToBlockerOwnerParams defines input for route_blocked_to_blocker_owner tool
+type RouteBlockedToBlockerOwnerParams struct {
+	TaskID   string `json:"task_id" validate:"required"`
+	RoutedBy string `json:"routed_by"`
+}
+
+// HandleRouteBlockedToBlockerOwner implements the route_blocked_to_blocker_owner tool
+func (t *TaskTools) HandleRouteBlockedToBlockerOwner(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[RouteBlockedToBlockerOwnerParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing route_blocked_to_blocker_owner tool", "params", params.Arguments)
+
+	cfg := t.config()
+
+	// Validate required fields
+	if params.Arguments.TaskID == "" {
+		return nil, fmt.Errorf("task_id is required")
+	}
+	routedBy, err := resolveActor(cfg, params.Arguments.RoutedBy, "routed_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.RoutedBy = routedBy
+
+	task, err := t.fetchTask(ctx, params.Arguments.TaskID)
+	if err != nil {
+		slog.Error("Failed to get task", "error", err, "task_id", params.Arguments.TaskID)
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	if task.Status != "Blocked" {
+		return nil, fmt.Errorf("task %s is not Blocked (status: %s)", task.TaskID, task.Status)
+	}
+	if len(task.BlockedBy) == 0 {
+		return nil, fmt.Errorf("task %s has no recorded blocked_by dependency", task.TaskID)
+	}
+
+	blockers, missing := t.lookupBlockers(ctx, *task)
+
+	// Route to the first blocker that has an owner
+	var owningBlocker *Task
+	for i := range blockers {
+		if blockers[i].AssignedTo != nil && *blockers[i].AssignedTo != "" {
+			owningBlocker = &blockers[i]
+			break
+		}
+	}
+
+	previousAssignee := ""
+	if task.AssignedTo != nil {
+		previousAssignee = *task.AssignedTo
+	}
+
+	result := map[string]any{
+		"task_id":           task.TaskID,
+		"blocked_by":        task.BlockedBy,
+		"missing_blockers":  missing,
+		"previous_assignee": previousAssignee,
+		"routed":            false,
+	}
+
+	responseText := fmt.Sprintf("Blocked Task Routing\n====================\n\nTask: %s\nID: %s\n", task.TaskName, task.TaskID)
+
+	if owningBlocker == nil {
+		result["reason"] = "blocker has no assignee"
+		responseText += "\n⚠️ No blocking task has an assignee - task left unchanged\n"
+		for _, blocker := range blockers {
+			responseText += fmt.Sprintf("- %s (%s) is unassigned\n", blocker.TaskName, blocker.TaskID)
+		}
+		for _, blockerID := range missing {
+			responseText += fmt.Sprintf("- %s could not be found\n", blockerID)
+		}
+
+		slog.Info("Blocked task not routed", "task_id", task.TaskID, "reason", "blocker has no assignee")
+
+		return &mcp.CallToolResultFor[map[string]any]{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: responseText,
+				},
+			},
+			Meta: result,
+		}, nil
+	}
+
+	newAssignee := *owningBlocker.AssignedTo
+	result["blocker_task_id"] = owningBlocker.TaskID
+	result["new_assignee"] = newAssignee
+
+	if previousAssignee == newAssignee {
+		result["reason"] = "already assigned to blocker owner"
+		responseText += fmt.Sprintf("\n✅ Already assigned to %s, owner of blocking task %s\n", newAssignee, owningBlocker.TaskName)
+
+		return &mcp.CallToolResultFor[map[string]any]{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: responseText,
+				},
+			},
+			Meta: result,
+		}, nil
+	}
+
+	updateRequest := map[string]interface{}{
+		"assigned_to":     newAssignee,
+		"last_updated_by": params.Arguments.RoutedBy,
+	}
+	if _, err := t.apiClient.Put(ctx, fmt.Sprintf("/api/v1/tasks/%s", url.PathEscape(task.TaskID)), updateRequest); err != nil {
+		slog.Error("Failed to reassign blocked task", "error", err, "task_id", task.TaskID)
+		return nil, fmt.Errorf("failed to reassign task: %w", err)
+	}
+	result["routed"] = true
+
+	// Explain the reassignment on the task
+	from := previousAssignee
+	if from == "" {
+		from = "Unassigned"
+	}
+	noteText := fmt.Sprintf("Routed from %s to %s, owner of blocking task \"%s\" (%s)", from, newAssignee, owningBlocker.TaskName, owningBlocker.TaskID)
+	noteRequest := map[string]interface{}{
+		"note":       noteText,
+		"created_by": params.Arguments.RoutedBy,
+	}
+	if _, err := t.apiClient.Post(ctx, fmt.Sprintf("/api/v1/tasks/%s/notes", url.PathEscape(task.TaskID)), noteRequest); err != nil {
+		slog.Error("Failed to add routing note", "error", err, "task_id", task.TaskID)
+		// Continue - reassignment succeeded even if note failed
+	} else {
+		result["note"] = noteText
+	}
+
+	responseText += fmt.Sprintf("\n🔀 Reassigned: %s → %s\nBlocking task: %s (%s)\n", from, newAssignee, owningBlocker.TaskName, owningBlocker.TaskID)
+
+	slog.Info("Blocked task routed to blocker owner", "task_id", task.TaskID, "new_assignee", newAssignee, "blocker_id", owningBlocker.TaskID)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// ReassignTaskParams defines input for reassign_task tool
+type ReassignTaskParams struct {
+	TaskID     string `json:"task_id" validate:"required"`
+	AssignedTo string `json:"assigned_to" validate:"required"`
+	UpdatedBy  string `json:"updated_by"`
+	Reason     string `json:"reason,omitempty"`
+}
+
+// HandleReassignTask implements the reassign_task tool. Only the assignee
+// changes, so no progress note is needed; the handover is recorded as a note.
+func (t *TaskTools) HandleReassignTask(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[ReassignTaskParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing reassign_task tool", "params", params.Arguments)
+
+	cfg := t.config()
+
+	// Validate required fields
+	if params.Arguments.TaskID == "" {
+		return nil, fmt.Errorf("task_id is required")
+	}
+	newAssignee := strings.TrimSpace(params.Arguments.AssignedTo)
+	if newAssignee == "" {
+		return nil, fmt.Errorf("assigned_to is required")
+	}
+	updatedBy, err := resolveActor(cfg, params.Arguments.UpdatedBy, "updated_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.UpdatedBy = updatedBy
+
+	task, err := t.fetchTask(ctx, params.Arguments.TaskID)
+	if err != nil {
+		slog.Error("Failed to get task", "error", err, "task_id", params.Arguments.TaskID)
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	previousAssignee := ""
+	if task.AssignedTo != nil {
+		previousAssignee = *task.AssignedTo
+	}
+
+	result := map[string]any{
+		"task_id":           task.TaskID,
+		"previous_assignee": previousAssignee,
+		"new_assignee":      newAssignee,
+		"reassigned":        false,
+	}
+
+	responseText := fmt.Sprintf("Task Reassignment\n=================\n\nTask: %s\nID: %s\n", task.TaskName, task.TaskID)
+
+	if previousAssignee == newAssignee {
+		responseText += fmt.Sprintf("\n✅ Already assigned to %s - nothing changed\n", newAssignee)
+
+		return &mcp.CallToolResultFor[map[string]any]{
+			Content: []mcp.Content{
+				&mcp.TextContent{
+					Text: responseText,
+				},
+			},
+			Meta: result,
+		}, nil
+	}
+
+	updateRequest := map[string]interface{}{
+		"assigned_to":     newAssignee,
+		"last_updated_by": params.Arguments.UpdatedBy,
+	}
+	if _, err := t.apiClient.Put(ctx, fmt.Sprintf("/api/v1/tasks/%s", url.PathEscape(task.TaskID)), updateRequest); err != nil {
+		slog.Error("Failed to reassign task", "error", err, "task_id", task.TaskID)
+		return nil, fmt.Errorf("failed to reassign task: %w", err)
+	}
+	result["reassigned"] = true
+
+	// Record the handover on the task
+	from := previousAssignee
+	if from == "" {
+		from = "Unassigned"
+	}
+	noteText := fmt.Sprintf("Reassigned from %s to %s", from, newAssignee)
+	if reason := strings.TrimSpace(params.Arguments.Reason); reason != "" {
+		noteText += ": " + reason
+	}
+	noteRequest := map[string]interface{}{
+		"note":       noteText,
+		"created_by": params.Arguments.UpdatedBy,
+	}
+	if _, err := t.apiClient.Post(ctx, fmt.Sprintf("/api/v1/tasks/%s/notes", url.PathEscape(task.TaskID)), noteRequest); err != nil {
+		slog.Error("Failed to add reassignment note", "error", err, "task_id", task.TaskID)
+		// Continue - reassignment succeeded even if note failed
+	} else {
+		result["note"] = noteText
+	}
+
+	responseText += fmt.Sprintf("\n🔀 Reassigned: %s → %s\n", from, newAssignee)
+	if params.Arguments.Reason != "" {
+		responseText += fmt.Sprintf("Reason: %s\n", params.Arguments.Reason)
+	}
+	if task.Status == "Blocked" {
+		result["warning"] = "task is Blocked"
+		responseText += "\n⚠️ This task is Blocked - make sure the new assignee can resolve its blocker\n"
+	}
+
+	slog.Info("Task reassigned", "task_id", task.TaskID, "from", previousAssignee, "to", newAssignee)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetNoteContributionsParams defines input for get_note_contributions tool
+type GetNoteContributionsParams struct {
+	ProjectID string `json:"project_id,omitempty"`
+	Since     string `json:"since,omitempty"`
+}
+
+// HandleGetNoteContributions implements the get_note_contributions tool
+func (t *TaskTools) HandleGetNoteContributions(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetNoteContributionsParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_note_contributions tool", "params", params.Arguments)
+
+	since, err := parseDueDate(params.Arguments.Since)
+	if err != nil {
+		return nil, fmt.Errorf("invalid since date: %w", err)
+	}
+
+	queryParams := ""
+	if params.Arguments.ProjectID != "" {
+		queryParams = fmt.Sprintf("?project_id=%s", url.QueryEscape(params.Arguments.ProjectID))
+	}
+
+	tasksResp, err := t.apiClient.Get(ctx, "/api/v1/tasks"+queryParams)
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+
+	if params.Arguments.ProjectID != "" {
+		scoped := []Task{}
+		for _, task := range tasks {
+			if taskProjectID(task) == params.Arguments.ProjectID {
+				scoped = append(scoped, task)
+			}
+		}
+		tasks = scoped
+	}
+
+	notesByTask := fetchNotesForTasks(ctx, t.apiClient, tasks)
+
+	// Aggregate note counts per author within the window
+	noteCounts := make(map[string]int)
+	taskTouches := make(map[string]map[string]int)
+	totalNotes := 0
+	for i, notes := range notesByTask {
+		for _, note := range notes {
+			if since != nil {
+				created, err := parseDueDate(note.CreationDate)
+				if err != nil || created == nil || created.Before(*since) {
+					continue
+				}
+			}
+			author := note.CreatedBy
+			if author == "" {
+				author = "Unknown"
+			}
+			noteCounts[author]++
+			if taskTouches[author] == nil {
+				taskTouches[author] = make(map[string]int)
+			}
+			taskTouches[author][tasks[i].TaskID]++
+			totalNotes++
+		}
+	}
+
+	taskNames := make(map[string]string, len(tasks))
+	for _, task := range tasks {
+		taskNames[task.TaskID] = task.TaskName
+	}
+
+	authors := make([]string, 0, len(noteCounts))
+	for author := range noteCounts {
+		authors = append(authors, author)
+	}
+	sort.Slice(authors, func(i, j int) bool {
+		if noteCounts[authors[i]] != noteCounts[authors[j]] {
+			return noteCounts[authors[i]] > noteCounts[authors[j]]
+		}
+		return authors[i] < authors[j]
+	})
+
+	contributors := []map[string]any{}
+	for _, author := range authors {
+		taskIDs := make([]string, 0, len(taskTouches[author]))
+		for taskID := range taskTouches[author] {
+			taskIDs = append(taskIDs, taskID)
+		}
+		sort.Slice(taskIDs, func(i, j int) bool {
+			ci, cj := taskTouches[author][taskIDs[i]], taskTouches[author][taskIDs[j]]
+			if ci != cj {
+				return ci > cj
+			}
+			return taskIDs[i] < taskIDs[j]
+		})
+
+		topTasks := []map[string]any{}
+		for i, taskID := range taskIDs {
+			if i < 3 { // Show only top 3
+				topTasks = append(topTasks, map[string]any{
+					"task_id":    taskID,
+					"task_name":  taskNames[taskID],
+					"note_count": taskTouches[author][taskID],
+				})
+			}
+		}
+
+		contributors = append(contributors, map[string]any{
+			"author":        author,
+			"note_count":    noteCounts[author],
+			"tasks_touched": len(taskIDs),
+			"top_tasks":     topTasks,
+		})
+	}
+
+	result := map[string]any{
+		"contributors":  contributors,
+		"total_notes":   totalNotes,
+		"author_count":  len(contributors),
+		"tasks_scanned": len(tasks),
+		"project_id":    params.Arguments.ProjectID,
+		"since":         params.Arguments.Since,
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Note Contributions\n==================\n\nTasks scanned: %d\nNotes counted: %d\n", len(tasks), totalNotes)
+	if params.Arguments.ProjectID != "" {
+		responseText += fmt.Sprintf("Project ID: %s\n", params.Arguments.ProjectID)
+	}
+	if params.Arguments.Since != "" {
+		responseText += fmt.Sprintf("Since: %s\n", params.Arguments.Since)
+	}
+
+	if len(contributors) == 0 {
+		responseText += "\n📝 No notes found in this window\n"
+	} else {
+		responseText += fmt.Sprintf("\n✍️ Contributors (%d):\n", len(contributors))
+		for i, contributor := range contributors {
+			responseText += fmt.Sprintf("%d. %s - %d notes across %d tasks\n", i+1, contributor["author"], contributor["note_count"], contributor["tasks_touched"])
+			for _, task := range contributor["top_tasks"].([]map[string]any) {
+				responseText += fmt.Sprintf("   - %s (%d notes)\n", task["task_name"], task["note_count"])
+			}
+		}
+	}
+
+	slog.Info("Note contributions aggregated", "authors", len(contributors), "total_notes", totalNotes)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// SplitTaskParams defines input for split_task tool
+type SplitTaskParams struct {
+	TaskID       string   `json:"task_id" validate:"required"`
+	NewTaskNames []string `json:"new_task_names" validate:"min=2"`
+	SplitBy      string   `json:"split_by"`
+	MarkParent   bool     `json:"mark_parent,omitempty"`
+}
+
+// HandleSplitTask implements the split_task tool
+func (t *TaskTools) HandleSplitTask(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[SplitTaskParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing split_task tool", "params", params.Arguments)
+
+	cfg := t.config()
+
+	// Validate required fields
+	if params.Arguments.TaskID == "" {
+		return nil, fmt.Errorf("task_id is required")
+	}
+	splitBy, err := resolveActor(cfg, params.Arguments.SplitBy, "split_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.SplitBy = splitBy
+
+	names := []string{}
+	for _, name := range params.Arguments.NewTaskNames {
+		if trimmed := strings.TrimSpace(name); trimmed != "" {
+			names = append(names, trimmed)
+		}
+	}
+	if len(names) < 2 {
+		return nil, fmt.Errorf("at least two new_task_names are required to split a task")
+	}
+
+	source, err := t.fetchTask(ctx, params.Arguments.TaskID)
+	if err != nil {
+		slog.Error("Failed to get task", "error", err, "task_id", params.Arguments.TaskID)
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	// Create the split pieces
+	createdTasks := []Task{}
+	failedTasks := []map[string]any{}
+	for _, name := range names {
+		taskResp, err := t.apiClient.Post(ctx, "/api/v1/tasks", derivedTaskRequest(*source, name, params.Arguments.SplitBy))
+		if err != nil {
+			slog.Error("Failed to create split task", "error", err, "task_name", name)
+			failedTasks = append(failedTasks, map[string]any{"task_name": name, "error": err.Error()})
+			continue
+		}
+
+		var createdTask Task
+		if err := json.Unmarshal(taskResp, &createdTask); err != nil {
+			slog.Error("Failed to parse created split task", "error", err)
+			failedTasks = append(failedTasks, map[string]any{"task_name": name, "error": err.Error()})
+			continue
+		}
+		createdTasks = append(createdTasks, createdTask)
+	}
+
+	if len(createdTasks) == 0 {
+		return nil, fmt.Errorf("failed to create any split tasks")
+	}
+
+	createdIDs := []string{}
+	pieceDescriptions := []string{}
+	for _, task := range createdTasks {
+		createdIDs = append(createdIDs, task.TaskID)
+		pieceDescriptions = append(pieceDescriptions, fmt.Sprintf("%s (%s)", task.TaskName, task.TaskID))
+	}
+
+	// Record the split on the original task
+	noteRequest := map[string]interface{}{
+		"note":       fmt.Sprintf("Split into %d tasks: %s", len(createdTasks), strings.Join(pieceDescriptions, ", ")),
+		"created_by": params.Arguments.SplitBy,
+	}
+	noteAdded := true
+	if _, err := t.apiClient.Post(ctx, fmt.Sprintf("/api/v1/tasks/%s/notes", url.PathEscape(source.TaskID)), noteRequest); err != nil {
+		slog.Error("Failed to add split note", "error", err, "task_id", source.TaskID)
+		// Continue - split tasks were created even if note failed
+		noteAdded = false
+	}
+
+	markedParent := false
+	if params.Arguments.MarkParent {
+		tags := append([]string{}, source.Tags...)
+		hasParentTag := false
+		for _, tag := range tags {
+			if tag == "parent" {
+				hasParentTag = true
+				break
+			}
+		}
+		if !hasParentTag {
+			tags = append(tags, "parent")
+		}
+		updateRequest := map[string]interface{}{
+			"tags":            tags,
+			"last_updated_by": params.Arguments.SplitBy,
+		}
+		if _, err := t.apiClient.Put(ctx, fmt.Sprintf("/api/v1/tasks/%s", url.PathEscape(source.TaskID)), updateRequest); err != nil {
+			slog.Error("Failed to mark task as parent", "error", err, "task_id", source.TaskID)
+		} else {
+			markedParent = true
+		}
+	}
+
+	result := map[string]any{
+		"source_task":      source,
+		"created_tasks":    createdTasks,
+		"created_task_ids": createdIDs,
+		"failed_tasks":     failedTasks,
+		"note_added":       noteAdded,
+		"marked_parent":    markedParent,
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Task Split\n==========\n\nOriginal: %s\nID: %s\n", source.TaskName, source.TaskID)
+	responseText += fmt.Sprintf("\n✂️ Created %d tasks:\n", len(createdTasks))
+	for _, task := range createdTasks {
+		responseText += fmt.Sprintf("- %s (ID: %s)\n", task.TaskName, task.TaskID)
+	}
+	if len(failedTasks) > 0 {
+		responseText += fmt.Sprintf("\n❌ Failed to create %d tasks:\n", len(failedTasks))
+		for _, failed := range failedTasks {
+			responseText += fmt.Sprintf("- %s: %s\n", failed["task_name"], failed["error"])
+		}
+	}
+	if markedParent {
+		responseText += "\n🏷️ Original task tagged as parent\n"
+	}
+
+	slog.Info("Task split", "task_id", source.TaskID, "created", len(createdTasks), "failed", len(failedTasks))
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetEscalationDigestParams defines input for get_escalation_digest tool
+type GetEscalationDigestParams struct {
+	ProjectID string `json:"project_id,omitempty"`
+}
+
+// digestEntry is a task flagged in the escalation digest with how long it has been in that state
+type digestEntry struct {
+	Task Task
+	Days int
+}
+
+// HandleGetEscalationDigest implements the get_escalation_digest tool
+func (t *TaskTools) HandleGetEscalationDigest(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetEscalationDigestParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_escalation_digest tool", "params", params.Arguments)
+
+	cfg := t.config()
+
+	queryParams := ""
+	if params.Arguments.ProjectID != "" {
+		queryParams = fmt.Sprintf("?project_id=%s", url.QueryEscape(params.Arguments.ProjectID))
+	}
+
+	tasksResp, err := t.apiClient.Get(ctx, "/api/v1/tasks"+queryParams)
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+
+	// Each task appears once, in its most severe section: overdue, then blocked, then stale
+	now := t.clock.Now()
+	var overdue, blocked, stale []digestEntry
+	for _, task := range tasks {
+		if task.Archived || task.Status == "Complete" {
+			continue
+		}
+		if params.Arguments.ProjectID != "" && taskProjectID(task) != params.Arguments.ProjectID {
+			continue
+		}
+
+		if d, ok := overdueDuration(task, now); ok {
+			overdue = append(overdue, digestEntry{Task: task, Days: wholeDays(d)})
+			continue
+		}
+		if d, ok := blockedDuration(task, now); ok && d >= cfg.LongBlockedAfter {
+			blocked = append(blocked, digestEntry{Task: task, Days: wholeDays(d)})
+			continue
+		}
+		if task.Priority != nil && *task.Priority == "High" && isTaskStale(task, now, cfg.StaleAfter) {
+			last, _ := lastActivity(task)
+			stale = append(stale, digestEntry{Task: task, Days: wholeDays(now.Sub(last))})
+		}
+	}
+
+	// Most severe first within each section
+	for _, section := range [][]digestEntry{overdue, blocked, stale} {
+		sort.SliceStable(section, func(i, j int) bool {
+			pi, pj := priorityRank(section[i].Task.Priority), priorityRank(section[j].Task.Priority)
+			if pi != pj {
+				return pi < pj
+			}
+			return section[i].Days > section[j].Days
+		})
+	}
+
+	toMeta := func(entries []digestEntry) []map[string]any {
+		items := []map[string]any{}
+		for _, entry := range entries {
+			items = append(items, map[string]any{
+				"task_id":     entry.Task.TaskID,
+				"task_name":   entry.Task.TaskName,
+				"status":      entry.Task.Status,
+				"priority":    entry.Task.Priority,
+				"assigned_to": entry.Task.AssignedTo,
+				"days":        entry.Days,
+			})
+		}
+		return items
+	}
+
+	totalFlagged := len(overdue) + len(blocked) + len(stale)
+	result := map[string]any{
+		"overdue":       toMeta(overdue),
+		"long_blocked":  toMeta(blocked),
+		"stale_high":    toMeta(stale),
+		"overdue_count": len(overdue),
+		"blocked_count": len(blocked),
+		"stale_count":   len(stale),
+		"total_flagged": totalFlagged,
+		"tasks_scanned": len(tasks),
+		"project_id":    params.Arguments.ProjectID,
+		"generated_at":  now.Format(time.RFC3339),
+	}
+
+	// Build Markdown digest
+	assignee := func(task Task) string {
+		if task.AssignedTo != nil && *task.AssignedTo != "" {
+			return "@" + *task.AssignedTo
+		}
+		return "_unassigned_"
+	}
+
+	responseText := fmt.Sprintf("# Escalation Digest — %s\n\n", now.Format("2006-01-02"))
+	if params.Arguments.ProjectID != "" {
+		responseText += fmt.Sprintf("Project: `%s`\n\n", params.Arguments.ProjectID)
+	}
+	responseText += fmt.Sprintf("**Summary:** %d overdue · %d long-blocked · %d stale high-priority\n", len(overdue), len(blocked), len(stale))
+
+	if totalFlagged == 0 {
+		responseText += "\n✅ Nothing needs escalation today.\n"
+	}
+
+	if len(overdue) > 0 {
+		responseText += fmt.Sprintf("\n## 🚨 Overdue (%d)\n\n", len(overdue))
+		for _, entry := range overdue {
+			responseText += fmt.Sprintf("- **%s** — %d days overdue (due %s) · %s · %s\n",
+				entry.Task.TaskName, entry.Days, *entry.Task.DueDate, t.priorities.Render(entry.Task.Priority), assignee(entry.Task))
+		}
+	}
+
+	if len(blocked) > 0 {
+		responseText += fmt.Sprintf("\n## ⛔ Long-Blocked (%d)\n\n", len(blocked))
+		for _, entry := range blocked {
+			responseText += fmt.Sprintf("- **%s** — blocked for %d days · %s · %s\n",
+				entry.Task.TaskName, entry.Days, t.priorities.Render(entry.Task.Priority), assignee(entry.Task))
+		}
+	}
+
+	if len(stale) > 0 {
+		responseText += fmt.Sprintf("\n## 💤 Stale High Priority (%d)\n\n", len(stale))
+		for _, entry := range stale {
+			responseText += fmt.Sprintf("- **%s** — no updates for %d days · %s · %s\n",
+				entry.Task.TaskName, entry.Days, entry.Task.Status, assignee(entry.Task))
+		}
+	}
+
+	slog.Info("Escalation digest generated", "overdue", len(overdue), "blocked", len(blocked), "stale", len(stale))
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// DeleteTaskParams defines input for delete_task tool
+type DeleteTaskParams struct {
+	TaskID    string `json:"task_id" validate:"required"`
+	DeletedBy string `json:"deleted_by"`
+}
+
+// HandleDeleteTask implements the delete_task tool
+func (t *TaskTools) HandleDeleteTask(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[DeleteTaskParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing delete_task tool", "params", params.Arguments)
+
+	cfg := t.config()
+
+	// Validate required fields
+	if params.Arguments.TaskID == "" {
+		return nil, fmt.Errorf("task_id is required")
+	}
+	deletedBy, err := resolveActor(cfg, params.Arguments.DeletedBy, "deleted_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.DeletedBy = deletedBy
+
+	task, err := t.fetchTask(ctx, params.Arguments.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]any{
+		"task_id":    task.TaskID,
+		"task_name":  task.TaskName,
+		"deleted_by": deletedBy,
+		"policy":     cfg.DeletePolicy,
+	}
+
+	responseText := fmt.Sprintf("Task Deleted\n============\n\nTask: %s\nID: %s\nDeleted by: %s\n", task.TaskName, task.TaskID, deletedBy)
+
+	deletedAt := t.clock.Now()
+	if err := t.deleteTask(ctx, cfg, *task, deletedBy, deletedAt); err != nil {
+		return nil, err
+	}
+
+	if isHardDelete(cfg) {
+		result["recoverable"] = false
+		responseText += "\n⚠️ Task permanently deleted (hard delete policy)\n"
+	} else {
+		result["recoverable"] = true
+		result["deleted_at"] = deletedAt.UTC().Format(time.RFC3339)
+		responseText += "\n🗃️ Task archived and marked deleted (soft delete policy)\n"
+		responseText += "♻️ Unarchive the task and remove the 'deleted' tag to restore it\n"
+	}
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// RestoreTaskParams defines input for restore_task tool
+type RestoreTaskParams struct {
+	TaskID     string `json:"task_id" validate:"required"`
+	RestoredBy string `json:"restored_by"`
+}
+
+// HandleRestoreTask implements the restore_task tool
+func (t *TaskTools) HandleRestoreTask(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[RestoreTaskParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing restore_task tool", "params", params.Arguments)
+
+	cfg := t.config()
+
+	// Validate required fields
+	if params.Arguments.TaskID == "" {
+		return nil, fmt.Errorf("task_id is required")
+	}
+	restoredBy, err := resolveActor(cfg, params.Arguments.RestoredBy, "restored_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.RestoredBy = restoredBy
+
+	task, err := t.fetchTask(ctx, params.Arguments.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isSoftDeletedTask(*task) {
+		return nil, fmt.Errorf("task %s is not deleted (only soft-deleted tasks can be restored)", task.TaskID)
+	}
+
+	updateRequest := map[string]interface{}{
+		"archived":        false,
+		"tags":            withoutTag(task.Tags, deletedTag),
+		"last_updated_by": restoredBy,
+	}
+	updateResp, err := t.apiClient.Put(ctx, fmt.Sprintf("/api/v1/tasks/%s", url.PathEscape(task.TaskID)), updateRequest)
+	if err != nil {
+		slog.Error("Failed to restore task", "error", err, "task_id", task.TaskID)
+		return nil, fmt.Errorf("failed to restore task: %w", err)
+	}
+
+	var restored Task
+	if err := json.Unmarshal(updateResp, &restored); err != nil {
+		slog.Error("Failed to parse restored task", "error", err)
+		return nil, fmt.Errorf("failed to parse restored task: %w", err)
+	}
+
+	noteRequest := map[string]interface{}{
+		"note":       restoreNote(restoredBy, t.clock.Now()),
+		"created_by": restoredBy,
+	}
+	if _, err := t.apiClient.Post(ctx, fmt.Sprintf("/api/v1/tasks/%s/notes", url.PathEscape(task.TaskID)), noteRequest); err != nil {
+		slog.Error("Failed to add restore note", "error", err, "task_id", task.TaskID)
+		// Continue - the task is restored even if the note failed
+	}
+
+	result := map[string]any{
+		"task":        restored,
+		"restored_by": restoredBy,
+	}
+
+	responseText := fmt.Sprintf("Task Restored\n=============\n\nTask: %s\nID: %s\nStatus: %s\nRestored by: %s\n",
+		restored.TaskName, restored.TaskID, restored.Status, restoredBy)
+	responseText += "\n♻️ Task is active again\n"
+
+	slog.Info("Task restored", "task_id", task.TaskID, "restored_by", restoredBy)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetTaskDependencyTreeParams defines input for get_task_dependency_tree tool
+type GetTaskDependencyTreeParams struct {
+	TaskID string `json:"task_id" validate:"required"`
+	Depth  int    `json:"depth,omitempty"`
+}
+
+// HandleGetTaskDependencyTree implements the get_task_dependency_tree tool
+func (t *TaskTools) HandleGetTaskDependencyTree(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetTaskDependencyTreeParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_task_dependency_tree tool", "params", params.Arguments)
+
+	// Validate required fields
+	if params.Arguments.TaskID == "" {
+		return nil, fmt.Errorf("task_id is required")
+	}
+
+	depth := params.Arguments.Depth
+	if depth <= 0 {
+		depth = 3
+	}
+	if depth > 10 {
+		depth = 10
+	}
+
+	// Get all tasks so the full neighborhood can be resolved
+	tasksResp, err := t.apiClient.Get(ctx, "/api/v1/tasks")
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+
+	graph := newDependencyGraph(tasks)
+	root, ok := graph.tasks[params.Arguments.TaskID]
+	if !ok {
+		return nil, fmt.Errorf("task %s not found", params.Arguments.TaskID)
+	}
+
+	upstream, upstreamCycles := graph.upstream(root.TaskID, depth)
+	downstream, downstreamCycles := graph.downstream(root.TaskID, depth)
+	cycles := append(upstreamCycles, downstreamCycles...)
+
+	result := map[string]any{
+		"task_id":    root.TaskID,
+		"task_name":  root.TaskName,
+		"status":     root.Status,
+		"depth":      depth,
+		"upstream":   upstream,
+		"downstream": downstream,
+		"cycles":     cycles,
+		"has_cycles": len(cycles) > 0,
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Task Dependency Tree\n====================\n\nTask: %s\nID: %s\nStatus: %s\nDepth: %d\n",
+		root.TaskName, root.TaskID, root.Status, depth)
+
+	if len(upstream) == 0 {
+		responseText += "\n⬆️ Blocked by: nothing\n"
+	} else {
+		responseText += "\n⬆️ Blocked by:\n"
+		responseText += renderDependencyNodes(upstream, 1)
+	}
+
+	if len(downstream) == 0 {
+		responseText += "\n⬇️ Blocking: nothing\n"
+	} else {
+		responseText += "\n⬇️ Blocking:\n"
+		responseText += renderDependencyNodes(downstream, 1)
+	}
+
+	if len(cycles) > 0 {
+		responseText += fmt.Sprintf("\n🔁 Dependency cycles detected (%d):\n", len(cycles))
+		for _, cycle := range cycles {
+			responseText += fmt.Sprintf("- %s\n", cycle)
+		}
+	}
+
+	slog.Info("Task dependency tree built", "task_id", root.TaskID, "upstream", len(upstream), "downstream", len(downstream), "cycles", len(cycles))
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// renderDependencyNodes formats dependency trees as an indented list
+func renderDependencyNodes(nodes []dependencyNode, level int) string {
+	text := ""
+	for _, node := range nodes {
+		marker := "⏳"
+		if node.Satisfied {
+			marker = "✅"
+		}
+		line := fmt.Sprintf("%s- %s %s (%s) [%s]", strings.Repeat("  ", level), marker, node.TaskName, node.TaskID, node.Status)
+		if node.Cycle {
+			line += " 🔁 cycle"
+		}
+		text += line + "\n"
+		text += renderDependencyNodes(node.Children, level+1)
+	}
+	return text
+}
+
+// GetTaskTimelineParams defines input for get_task_timeline tool
+type GetTaskTimelineParams struct {
+	TaskID string `json:"task_id" validate:"required"`
+}
+
+// HandleGetTaskTimeline implements the get_task_timeline tool. The API has no
+// history endpoint, so events come from the task's dates and its notes.
+func (t *TaskTools) HandleGetTaskTimeline(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetTaskTimelineParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_task_timeline tool", "params", params.Arguments)
+
+	// Validate required fields
+	if params.Arguments.TaskID == "" {
+		return nil, fmt.Errorf("task_id is required")
+	}
+
+	task, err := t.fetchTask(ctx, params.Arguments.TaskID)
+	if err != nil {
+		slog.Error("Failed to get task", "error", err, "task_id", params.Arguments.TaskID)
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	notesResp, err := t.apiClient.Get(ctx, fmt.Sprintf("/api/v1/tasks/%s/notes", url.PathEscape(task.TaskID)))
+	if err != nil {
+		slog.Error("Failed to get task notes", "error", err, "task_id", task.TaskID)
+		return nil, fmt.Errorf("failed to get task notes: %w", err)
+	}
+
+	var notes []TaskNote
+	if err := json.Unmarshal(notesResp, &notes); err != nil {
+		slog.Error("Failed to parse task notes", "error", err)
+		return nil, fmt.Errorf("failed to parse task notes: %w", err)
+	}
+
+	events := buildTaskTimeline(*task, notes, t.clock.Now())
+
+	result := map[string]any{
+		"task_id":     task.TaskID,
+		"task":        task,
+		"events":      events,
+		"event_count": len(events),
+	}
+
+	responseText := fmt.Sprintf("Task Timeline: %s\n", task.TaskName)
+	responseText += "==============\n\n"
+	responseText += fmt.Sprintf("ID: %s\nStatus: %s\n", task.TaskID, task.Status)
+
+	if len(events) == 0 {
+		responseText += "\nNo dated activity recorded for this task.\n"
+	} else {
+		responseText += fmt.Sprintf("\n📜 Activity (%d events, oldest first):\n", len(events))
+		for _, event := range events {
+			when := event.Time
+			if event.Relative != "" {
+				when += fmt.Sprintf(" (%s)", event.Relative)
+			}
+
+			var line string
+			switch event.Type {
+			case timelineNote:
+				line = fmt.Sprintf("📝 Note by %s: %s", event.Actor, event.Description)
+			case timelineCreated:
+				line = fmt.Sprintf("🆕 %s by %s", event.Description, event.Actor)
+			case timelineStarted:
+				line = "▶️ " + event.Description
+			case timelineCompleted:
+				line = "✅ " + event.Description
+			}
+			responseText += fmt.Sprintf("- %s — %s\n", when, line)
+		}
+	}
+
+	slog.Info("Task timeline built", "task_id", task.TaskID, "events", len(events))
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetTasksChangedSinceParams defines input for get_tasks_changed_since tool
+type GetTasksChangedSinceParams struct {
+	Since string `json:"since" validate:"required"`
+}
+
+// changeCursorSeparator joins the change time and task ID in a
+// get_tasks_changed_since cursor
+const changeCursorSeparator = "|"
+
+// formatChangeCursor returns the cursor that resumes after the task changed
+// at changedAt. The task ID breaks ties between tasks changed at the same
+// instant, so none is returned twice or skipped.
+func formatChangeCursor(changedAt time.Time, taskID string) string {
+	return changedAt.UTC().Format(time.RFC3339Nano) + changeCursorSeparator + taskID
+}
+
+// parseChangeCursor parses a since value: a plain timestamp, which includes
+// tasks changed at that instant, or a next_cursor, which resumes after the
+// task it names
+func parseChangeCursor(since string) (time.Time, string, error) {
+	timestamp, afterID, _ := strings.Cut(since, changeCursorSeparator)
+	at, err := parseDueDate(timestamp)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+	if at == nil {
+		return time.Time{}, "", fmt.Errorf("missing timestamp")
+	}
+	return *at, afterID, nil
+}
+
+// HandleGetTasksChangedSince implements the get_tasks_changed_since tool
+func (t *TaskTools) HandleGetTasksChangedSince(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetTasksChangedSinceParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_tasks_changed_since tool", "params", params.Arguments)
+
+	cfg := t.config()
+
+	// Validate required fields
+	if params.Arguments.Since == "" {
+		return nil, fmt.Errorf("since is required")
+	}
+	since, afterID, err := parseChangeCursor(params.Arguments.Since)
+	if err != nil {
+		return nil, fmt.Errorf("invalid since timestamp: %w", err)
+	}
+
+	tasksResp, err := t.apiClient.Get(ctx, "/api/v1/tasks")
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+
+	// A task's last change is its last update, or its creation if never updated
+	type changedTask struct {
+		task      Task
+		changedAt time.Time
+	}
+	changed := []changedTask{}
+	skipped := 0
+	for _, task := range tasks {
+		changedAt, ok := lastActivity(task)
+		if !ok {
+			skipped++
+			continue
+		}
+		if changedAt.After(since) || (changedAt.Equal(since) && task.TaskID > afterID) {
+			changed = append(changed, changedTask{task: task, changedAt: changedAt})
+		}
+	}
+
+	sort.Slice(changed, func(i, j int) bool {
+		if !changed[i].changedAt.Equal(changed[j].changedAt) {
+			return changed[i].changedAt.Before(changed[j].changedAt)
+		}
+		return changed[i].task.TaskID < changed[j].task.TaskID
+	})
+
+	nextCursor := params.Arguments.Since
+	changedTasks := make([]Task, 0, len(changed))
+	for _, c := range changed {
+		changedTasks = append(changedTasks, c.task)
+	}
+	if len(changed) > 0 {
+		last := changed[len(changed)-1]
+		nextCursor = formatChangeCursor(last.changedAt, last.task.TaskID)
+	}
+
+	result := map[string]any{
+		"since":         params.Arguments.Since,
+		"tasks":         changedTasks,
+		"changed_count": len(changedTasks),
+		"next_cursor":   nextCursor,
+		"skipped_count": skipped,
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Tasks Changed Since %s\n=====================\n\nChanged tasks: %d\nNext cursor: %s\n",
+		params.Arguments.Since, len(changedTasks), nextCursor)
+
+	if len(changed) == 0 {
+		responseText += "\n✅ No tasks changed in this window\n"
+	} else {
+		responseText += "\n🔄 Changes (oldest first):\n"
+		for i, c := range changed {
+			if i < cfg.SearchMaxTasksShown {
+				responseText += fmt.Sprintf("- [%s] %s (%s) - %s\n",
+					c.changedAt.UTC().Format(time.RFC3339), c.task.TaskName, c.task.TaskID, c.task.Status)
+			}
+		}
+		if len(changed) > cfg.SearchMaxTasksShown {
+			responseText += fmt.Sprintf("... and %d more (full list in metadata)\n", len(changed)-cfg.SearchMaxTasksShown)
+		}
+	}
+
+	if skipped > 0 {
+		responseText += fmt.Sprintf("\n⚠️ %d tasks skipped: no parseable creation or update date\n", skipped)
+	}
+
+	slog.Info("Changed tasks retrieved", "since", params.Arguments.Since, "changed", len(changedTasks), "next_cursor", nextCursor)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// BulkTagTasksParams defines input for bulk_tag_tasks tool
+type BulkTagTasksParams struct {
+	TaskIDs    []string `json:"task_ids" validate:"required"`
+	AddTags    []string `json:"add_tags,omitempty"`
+	RemoveTags []string `json:"remove_tags,omitempty"`
+	UpdatedBy  string   `json:"updated_by"`
+}
+
+// HandleBulkTagTasks implements the bulk_tag_tasks tool
+func (t *TaskTools) HandleBulkTagTasks(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[BulkTagTasksParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing bulk_tag_tasks tool", "params", params.Arguments)
+
+	cfg := t.config()
+
+	// Many API calls share one budget
+	ctx, cancel := client.WithTimeout(ctx, cfg.MultiCallTimeout)
+	defer cancel()
+
+	// Validate required fields
+	if len(params.Arguments.TaskIDs) == 0 {
+		return nil, fmt.Errorf("task_ids is required")
+	}
+	if len(params.Arguments.AddTags) == 0 && len(params.Arguments.RemoveTags) == 0 {
+		return nil, fmt.Errorf("add_tags or remove_tags is required")
+	}
+	if tag, ok := conflictingTag(params.Arguments.AddTags, params.Arguments.RemoveTags); ok {
+		return nil, fmt.Errorf("tag %q is in both add_tags and remove_tags", tag)
+	}
+	updatedBy, err := resolveActor(cfg, params.Arguments.UpdatedBy, "updated_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.UpdatedBy = updatedBy
+
+	// Dedupe task IDs, preserving order
+	taskIDs := []string{}
+	seen := make(map[string]bool)
+	for _, taskID := range params.Arguments.TaskIDs {
+		if taskID == "" || seen[taskID] {
+			continue
+		}
+		seen[taskID] = true
+		taskIDs = append(taskIDs, taskID)
+	}
+
+	// Tag tasks in bounded-concurrency batches
+	results := make([]map[string]any, len(taskIDs))
+	runBounded(len(taskIDs), bulkConcurrency, func(i int) {
+		tags, changed, err := t.updateTaskTags(ctx, taskIDs[i], params.Arguments.AddTags, params.Arguments.RemoveTags, updatedBy)
+		switch {
+		case err != nil:
+			results[i] = map[string]any{"task_id": taskIDs[i], "status": "failed", "error": err.Error()}
+		case changed:
+			results[i] = map[string]any{"task_id": taskIDs[i], "status": "updated", "tags": tags}
+		default:
+			results[i] = map[string]any{"task_id": taskIDs[i], "status": "unchanged", "tags": tags}
+		}
+	})
+
+	counts := make(map[string]int)
+	for _, r := range results {
+		counts[r["status"].(string)]++
+	}
+
+	result := map[string]any{
+		"results":         results,
+		"updated_count":   counts["updated"],
+		"unchanged_count": counts["unchanged"],
+		"failed_count":    counts["failed"],
+		"add_tags":        params.Arguments.AddTags,
+		"remove_tags":     params.Arguments.RemoveTags,
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Bulk Tag Tasks\n==============\n\nTasks: %d\nUpdated: %d\nUnchanged: %d\nFailed: %d\n",
+		len(taskIDs), counts["updated"], counts["unchanged"], counts["failed"])
+	if len(params.Arguments.AddTags) > 0 {
+		responseText += fmt.Sprintf("Added: %s\n", strings.Join(params.Arguments.AddTags, ", "))
+	}
+	if len(params.Arguments.RemoveTags) > 0 {
+		responseText += fmt.Sprintf("Removed: %s\n", strings.Join(params.Arguments.RemoveTags, ", "))
+	}
+
+	responseText += "\n🏷️ Results:\n"
+	for _, r := range results {
+		if r["status"] == "failed" {
+			responseText += fmt.Sprintf("- ❌ %s: %s\n", r["task_id"], r["error"])
+			continue
+		}
+		responseText += fmt.Sprintf("- %s (%s): [%s]\n", r["task_id"], r["status"], strings.Join(r["tags"].([]string), ", "))
+	}
+
+	slog.Info("Bulk tagging complete", "tasks", len(taskIDs), "updated", counts["updated"], "failed", counts["failed"])
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// TaskTagsParams defines input for add_task_tags and remove_task_tags tools
+type TaskTagsParams struct {
+	TaskID    string   `json:"task_id" validate:"required"`
+	Tags      []string `json:"tags" validate:"required"`
+	UpdatedBy string   `json:"updated_by"`
+}
+
+// HandleAddTaskTags implements the add_task_tags tool. Tags already on the
+// task, in any case, are left as they are.
+func (t *TaskTools) HandleAddTaskTags(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[TaskTagsParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing add_task_tags tool", "params", params.Arguments)
+	return t.changeTaskTags(ctx, params.Arguments, true)
+}
+
+// HandleRemoveTaskTags implements the remove_task_tags tool. Removing a tag
+// the task does not have is a no-op.
+func (t *TaskTools) HandleRemoveTaskTags(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[TaskTagsParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing remove_task_tags tool", "params", params.Arguments)
+	return t.changeTaskTags(ctx, params.Arguments, false)
+}
+
+// changeTaskTags adds or removes tags on one task for add_task_tags and remove_task_tags
+func (t *TaskTools) changeTaskTags(ctx context.Context, args TaskTagsParams, add bool) (*mcp.CallToolResultFor[map[string]any], error) {
+	cfg := t.config()
+
+	// Validate required fields
+	if args.TaskID == "" {
+		return nil, fmt.Errorf("task_id is required")
+	}
+	if len(args.Tags) == 0 {
+		return nil, fmt.Errorf("tags is required")
+	}
+	updatedBy, err := resolveActor(cfg, args.UpdatedBy, "updated_by")
+	if err != nil {
+		return nil, err
+	}
+
+	var addTags, removeTags []string
+	title := "Task Tags Removed\n=================\n\n"
+	if add {
+		addTags = args.Tags
+		title = "Task Tags Added\n===============\n\n"
+	} else {
+		removeTags = args.Tags
+	}
+
+	tags, changed, err := t.updateTaskTags(ctx, args.TaskID, addTags, removeTags, updatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	result := map[string]any{
+		"task_id": args.TaskID,
+		"tags":    tags,
+		"changed": changed,
+	}
+
+	responseText := title + fmt.Sprintf("Task ID: %s\n", args.TaskID)
+	if !changed {
+		responseText += "\n✅ Tags already as requested - nothing changed\n"
+	}
+	if len(tags) == 0 {
+		responseText += "\n🏷️ Tags: none\n"
+	} else {
+		responseText += fmt.Sprintf("\n🏷️ Tags: %s\n", strings.Join(tags, ", "))
+	}
+
+	slog.Info("Task tags updated", "task_id", args.TaskID, "add", add, "changed", changed, "tags", tags)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// BulkDeleteTasksParams defines input for bulk_delete_tasks tool
+type BulkDeleteTasksParams struct {
+	TaskIDs             []string `json:"task_ids" validate:"required"`
+	RequireConfirmation bool     `json:"require_confirmation,omitempty"` // only preview what would be deleted; deletes when false or omitted
+	DeletedBy           string   `json:"deleted_by"`
+}
+
+// HandleBulkDeleteTasks implements the bulk_delete_tasks tool. Each task is
+// deleted under the configured policy; failures are collected rather than
+// aborting the batch. With require_confirmation set nothing is deleted and
+// the batch is only previewed.
+func (t *TaskTools) HandleBulkDeleteTasks(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[BulkDeleteTasksParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing bulk_delete_tasks tool", "params", params.Arguments)
+
+	cfg := t.config()
+
+	// Many API calls share one budget
+	ctx, cancel := client.WithTimeout(ctx, cfg.MultiCallTimeout)
+	defer cancel()
+
+	// Validate required fields
+	if len(params.Arguments.TaskIDs) == 0 {
+		return nil, fmt.Errorf("task_ids is required")
+	}
+	deletedBy, err := resolveActor(cfg, params.Arguments.DeletedBy, "deleted_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.DeletedBy = deletedBy
+
+	// Dedupe task IDs, preserving order
+	taskIDs := []string{}
+	seen := make(map[string]bool)
+	for _, taskID := range params.Arguments.TaskIDs {
+		if taskID == "" || seen[taskID] {
+			continue
+		}
+		seen[taskID] = true
+		taskIDs = append(taskIDs, taskID)
+	}
+
+	preview := params.Arguments.RequireConfirmation
+	deletedAt := t.clock.Now()
+	succeeded := make([]map[string]any, len(taskIDs))
+	failures := make([]map[string]any, len(taskIDs))
+	runBounded(len(taskIDs), bulkConcurrency, func(i int) {
+		task, err := t.fetchTask(ctx, taskIDs[i])
+		if err == nil && !preview {
+			err = t.deleteTask(ctx, cfg, *task, deletedBy, deletedAt)
+		}
+		if err != nil {
+			failures[i] = map[string]any{"task_id": taskIDs[i], "error": err.Error()}
+			return
+		}
+		succeeded[i] = map[string]any{"task_id": task.TaskID, "task_name": task.TaskName}
+	})
+
+	deleted := []map[string]any{}
+	failed := []map[string]any{}
+	for i := range taskIDs {
+		if failures[i] != nil {
+			failed = append(failed, failures[i])
+		} else {
+			deleted = append(deleted, succeeded[i])
+		}
+	}
+
+	result := map[string]any{
+		"failed":       failed,
+		"failed_count": len(failed),
+		"total":        len(taskIDs),
+		"deleted_by":   deletedBy,
+		"policy":       cfg.DeletePolicy,
+		"recoverable":  !isHardDelete(cfg),
+	}
+
+	// Build response text
+	var responseText string
+	if preview {
+		result["confirmation_required"] = true
+		result["would_delete"] = deleted
+		result["deleted"] = []map[string]any{}
+		result["deleted_count"] = 0
+		responseText = fmt.Sprintf("Bulk Delete Preview\n===================\n\nWould delete %d of %d tasks (%s delete policy)\n", len(deleted), len(taskIDs), cfg.DeletePolicy)
+		responseText += "Nothing was deleted. Call again without require_confirmation to delete.\n"
+	} else {
+		result["deleted"] = deleted
+		result["deleted_count"] = len(deleted)
+		responseText = fmt.Sprintf("Bulk Delete Tasks\n=================\n\nDeleted %d of %d tasks (%s delete policy)\n", len(deleted), len(taskIDs), cfg.DeletePolicy)
+		if !isHardDelete(cfg) && len(deleted) > 0 {
+			responseText += "♻️ Deleted tasks are archived and tagged 'deleted'; use restore_task to bring one back\n"
+		}
+	}
+
+	if len(deleted) > 0 {
+		responseText += "\n🗑️ Tasks:\n"
+		for _, entry := range deleted {
+			responseText += fmt.Sprintf("- %s (%s)\n", entry["task_name"], entry["task_id"])
+		}
+	}
+	if len(failed) > 0 {
+		responseText += fmt.Sprintf("\n❌ Failed (%d):\n", len(failed))
+		for _, entry := range failed {
+			responseText += fmt.Sprintf("- %s: %s\n", entry["task_id"], entry["error"])
+		}
+	}
+
+	slog.Info("Bulk delete complete", "tasks", len(taskIDs), "deleted", len(deleted), "failed", len(failed), "preview", preview)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// ValidateTaskDataParams defines input for validate_task_data tool
+type ValidateTaskDataParams struct {
+	Task            map[string]any   `json:"task,omitempty"`
+	Tasks           []map[string]any `json:"tasks,omitempty"`
+	CheckReferences bool             `json:"check_references,omitempty"`
+}
+
+// HandleValidateTaskData implements the validate_task_data tool
+func (t *TaskTools) HandleValidateTaskData(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[ValidateTaskDataParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing validate_task_data tool", "params", params.Arguments)
+
+	cfg := t.config()
+
+	// Validate required fields
+	payloads := params.Arguments.Tasks
+	if params.Arguments.Task != nil {
+		payloads = append([]map[string]any{params.Arguments.Task}, payloads...)
+	}
+	if len(payloads) == 0 {
+		return nil, fmt.Errorf("task or tasks is required")
+	}
+
+	// Load known projects and people only when reference checks are requested
+	var refs taskReferences
+	if params.Arguments.CheckReferences {
+		projectsResp, err := t.apiClient.Get(ctx, "/api/v1/projects")
+		if err != nil {
+			slog.Error("Failed to get projects", "error", err)
+			return nil, fmt.Errorf("failed to get projects: %w", err)
+		}
+
+		var projects []Project
+		if err := json.Unmarshal(projectsResp, &projects); err != nil {
+			slog.Error("Failed to parse projects", "error", err)
+			return nil, fmt.Errorf("failed to parse projects: %w", err)
+		}
+
+		tasksResp, err := t.apiClient.Get(ctx, "/api/v1/tasks")
+		if err != nil {
+			slog.Error("Failed to get tasks", "error", err)
+			return nil, fmt.Errorf("failed to get tasks: %w", err)
+		}
+
+		var tasks []Task
+		if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+			slog.Error("Failed to parse tasks", "error", err)
+			return nil, fmt.Errorf("failed to parse tasks: %w", err)
+		}
+
+		refs.projects = make(map[string]bool, len(projects))
+		for _, project := range projects {
+			refs.projects[project.ProjectID] = true
+		}
+		refs.people = make(map[string]bool)
+		refs.assignees = t.assignees
+		for _, task := range tasks {
+			refs.people[t.assignees.Canonical(task.CreatedBy)] = true
+			if task.AssignedTo != nil {
+				refs.people[t.assignees.Canonical(*task.AssignedTo)] = true
+			}
+		}
+	}
+
+	now := t.clock.Now()
+	results := make([]map[string]any, 0, len(payloads))
+	validCount, errorCount, warningCount := 0, 0, 0
+
+	for i, payload := range payloads {
+		errs, warnings := validateTaskPayload(cfg, payload, refs, now)
+		if errs == nil {
+			errs = []map[string]any{}
+		}
+		if warnings == nil {
+			warnings = []map[string]any{}
+		}
+		taskName, _, _ := payloadString(payload, "task_name")
+
+		if len(errs) == 0 {
+			validCount++
+		}
+		errorCount += len(errs)
+		warningCount += len(warnings)
+
+		results = append(results, map[string]any{
+			"index":     i,
+			"task_name": taskName,
+			"valid":     len(errs) == 0,
+			"errors":    errs,
+			"warnings":  warnings,
+		})
+	}
+
+	result := map[string]any{
+		"results":          results,
+		"valid":            validCount == len(payloads),
+		"valid_count":      validCount,
+		"invalid_count":    len(payloads) - validCount,
+		"error_count":      errorCount,
+		"warning_count":    warningCount,
+		"check_references": params.Arguments.CheckReferences,
+	}
+
+	// Build response text
+	responseText := "Task Data Validation\n====================\n\n"
+	responseText += fmt.Sprintf("Payloads: %d\nValid: %d\nInvalid: %d\n", len(payloads), validCount, len(payloads)-validCount)
+
+	for _, entry := range results {
+		label := fmt.Sprintf("#%d", entry["index"].(int)+1)
+		if name := entry["task_name"].(string); name != "" {
+			label += " " + name
+		}
+
+		if entry["valid"].(bool) {
+			responseText += fmt.Sprintf("\n✅ %s: valid\n", label)
+		} else {
+			responseText += fmt.Sprintf("\n❌ %s:\n", label)
+		}
+		for _, issue := range entry["errors"].([]map[string]any) {
+			responseText += fmt.Sprintf("- %s: %s\n", issue["field"], issue["message"])
+		}
+		for _, issue := range entry["warnings"].([]map[string]any) {
+			responseText += fmt.Sprintf("- ⚠️ %s: %s\n", issue["field"], issue["message"])
+		}
+	}
+
+	if !params.Arguments.CheckReferences {
+		responseText += "\n💡 Project and assignee references were not checked (set check_references to verify them)\n"
+	}
+
+	slog.Info("Task data validated", "payloads", len(payloads), "valid", validCount, "errors", errorCount, "warnings", warningCount)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetStatusFlowParams defines input for get_status_flow tool
+type GetStatusFlowParams struct {
+	ProjectID  string `json:"project_id,omitempty"`
+	AssignedTo string `json:"assigned_to,omitempty"`
+}
+
+// HandleGetStatusFlow implements the get_status_flow tool
+func (t *TaskTools) HandleGetStatusFlow(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetStatusFlowParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_status_flow tool", "params", params.Arguments)
+
+	transitions := make([]map[string]any, 0, len(canonicalStatuses))
+	for _, status := range canonicalStatuses {
+		transitions = append(transitions, map[string]any{
+			"from": status,
+			"to":   statusTransitions[status],
+		})
+	}
+
+	result := map[string]any{
+		"statuses":    canonicalStatuses,
+		"transitions": transitions,
+		"mermaid":     mermaidStatusFlow(),
+		"graphviz":    graphvizStatusFlow(),
+	}
+
+	// Count tasks per status only when the caller scopes the request
+	scoped := params.Arguments.ProjectID != "" || params.Arguments.AssignedTo != ""
+	var statusCounts map[string]int
+	if scoped {
+		query := url.Values{}
+		if params.Arguments.ProjectID != "" {
+			query.Set("project_id", params.Arguments.ProjectID)
+		}
+		if params.Arguments.AssignedTo != "" && !t.assignees.Enabled() {
+			query.Set("assigned_to", params.Arguments.AssignedTo)
+		}
+
+		tasksResp, err := t.apiClient.Get(ctx, "/api/v1/tasks?"+query.Encode())
+		if err != nil {
+			slog.Error("Failed to get tasks", "error", err)
+			return nil, fmt.Errorf("failed to get tasks: %w", err)
+		}
+
+		var tasks []Task
+		if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+			slog.Error("Failed to parse tasks", "error", err)
+			return nil, fmt.Errorf("failed to parse tasks: %w", err)
+		}
+		tasks = filterAssignedTo(t.assignees, tasks, params.Arguments.AssignedTo)
+
+		statusCounts = make(map[string]int, len(canonicalStatuses))
+		for _, status := range canonicalStatuses {
+			statusCounts[status] = 0
+		}
+		for _, task := range tasks {
+			statusCounts[task.Status]++
+		}
+		result["status_counts"] = statusCounts
+		result["total_tasks"] = len(tasks)
+	}
+
+	// Build response text
+	responseText := "Status Flow\n===========\n\n"
+	responseText += "📋 Statuses:\n"
+	for i, status := range canonicalStatuses {
+		if scoped {
+			responseText += fmt.Sprintf("%d. %s (%d tasks)\n", i+1, status, statusCounts[status])
+		} else {
+			responseText += fmt.Sprintf("%d. %s\n", i+1, status)
+		}
+	}
+
+	responseText += "\n🔀 Allowed Transitions:\n"
+	for _, status := range canonicalStatuses {
+		responseText += fmt.Sprintf("- %s → %s\n", status, strings.Join(statusTransitions[status], ", "))
+	}
+
+	responseText += "\n📈 Diagram (Mermaid):\n```mermaid\n" + mermaidStatusFlow() + "```\n"
+
+	slog.Info("Status flow generated", "statuses", len(canonicalStatuses), "scoped", scoped)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// AddTaskLinkParams defines input for add_task_link tool
+type AddTaskLinkParams struct {
+	TaskID    string `json:"task_id" validate:"required"`
+	URL       string `json:"url" validate:"required"`
+	Label     string `json:"label,omitempty"`
+	UpdatedBy string `json:"updated_by"`
+}
+
+// HandleAddTaskLink implements the add_task_link tool
+func (t *TaskTools) HandleAddTaskLink(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[AddTaskLinkParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing add_task_link tool", "params", params.Arguments)
+
+	cfg := t.config()
+
+	// Validate required fields
+	if params.Arguments.TaskID == "" {
+		return nil, fmt.Errorf("task_id is required")
+	}
+	linkURL, err := validateLinkURL(params.Arguments.URL)
+	if err != nil {
+		return nil, err
+	}
+	updatedBy, err := resolveActor(cfg, params.Arguments.UpdatedBy, "updated_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.UpdatedBy = updatedBy
+
+	label := strings.TrimSpace(params.Arguments.Label)
+	if label == "" {
+		parsed, _ := url.Parse(linkURL)
+		label = parsed.Host
+	}
+	link := TaskLink{Label: label, URL: linkURL}
+
+	task, err := t.fetchTask(ctx, params.Arguments.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	links, changed := withLink(task.Links, link)
+	if changed {
+		updateRequest := map[string]interface{}{
+			"links":           links,
+			"last_updated_by": updatedBy,
+		}
+		if _, err := t.apiClient.Put(ctx, fmt.Sprintf("/api/v1/tasks/%s", url.PathEscape(task.TaskID)), updateRequest); err != nil {
+			slog.Error("Failed to update task links", "error", err, "task_id", task.TaskID)
+			return nil, fmt.Errorf("failed to update task links: %w", err)
+		}
+	}
+
+	result := map[string]any{
+		"task_id": task.TaskID,
+		"link":    link,
+		"links":   links,
+		"changed": changed,
+	}
+
+	responseText := fmt.Sprintf("Task Link Added\n===============\n\nTask: %s\nID: %s\n", task.TaskName, task.TaskID)
+	if changed {
+		responseText += fmt.Sprintf("\n🔗 %s: %s\n", link.Label, link.URL)
+	} else {
+		responseText += "\n✅ Task already has this link - nothing changed\n"
+	}
+	responseText += fmt.Sprintf("\nLinks on task: %d\n", len(links))
+
+	slog.Info("Task link added", "task_id", task.TaskID, "url", link.URL, "changed", changed)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// RemoveTaskLinkParams defines input for remove_task_link tool
+type RemoveTaskLinkParams struct {
+	TaskID    string `json:"task_id" validate:"required"`
+	URL       string `json:"url" validate:"required"`
+	UpdatedBy string `json:"updated_by"`
+}
+
+// HandleRemoveTaskLink implements the remove_task_link tool
+func (t *TaskTools) HandleRemoveTaskLink(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[RemoveTaskLinkParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing remove_task_link tool", "params", params.Arguments)
+
+	cfg := t.config()
+
+	// Validate required fields
+	if params.Arguments.TaskID == "" {
+		return nil, fmt.Errorf("task_id is required")
+	}
+	linkURL := strings.TrimSpace(params.Arguments.URL)
+	if linkURL == "" {
+		return nil, fmt.Errorf("url is required")
+	}
+	updatedBy, err := resolveActor(cfg, params.Arguments.UpdatedBy, "updated_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.UpdatedBy = updatedBy
+
+	task, err := t.fetchTask(ctx, params.Arguments.TaskID)
+	if err != nil {
+		return nil, err
+	}
+
+	links, removed := withoutLink(task.Links, linkURL)
+	if !removed {
+		return nil, fmt.Errorf("task %s has no link to %s", task.TaskID, linkURL)
+	}
+
+	updateRequest := map[string]interface{}{
+		"links":           links,
+		"last_updated_by": updatedBy,
+	}
+	if _, err := t.apiClient.Put(ctx, fmt.Sprintf("/api/v1/tasks/%s", url.PathEscape(task.TaskID)), updateRequest); err != nil {
+		slog.Error("Failed to update task links", "error", err, "task_id", task.TaskID)
+		return nil, fmt.Errorf("failed to update task links: %w", err)
+	}
+
+	result := map[string]any{
+		"task_id":     task.TaskID,
+		"removed_url": linkURL,
+		"links":       links,
+	}
+
+	responseText := fmt.Sprintf("Task Link Removed\n=================\n\nTask: %s\nID: %s\nRemoved: %s\n", task.TaskName, task.TaskID, linkURL)
+	responseText += fmt.Sprintf("\nLinks on task: %d\n", len(links))
+
+	slog.Info("Task link removed", "task_id", task.TaskID, "url", linkURL)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// MoveNoteParams defines input for move_note tool
+type MoveNoteParams struct {
+	FromTaskID string `json:"from_task_id" validate:"required"`
+	NoteID     string `json:"note_id" validate:"required"`
+	ToTaskID   string `json:"to_task_id" validate:"required"`
+	MovedBy    string `json:"moved_by"`
+}
+
+// HandleMoveNote implements the move_note tool. The API has no endpoint to
+// re-parent a note, so the note is recreated on the target (keeping its
+// author and original timestamp) and then deleted from the source.
+func (t *TaskTools) HandleMoveNote(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[MoveNoteParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing move_note tool", "params", params.Arguments)
+
+	cfg := t.config()
+
+	// Validate required fields
+	if params.Arguments.FromTaskID == "" {
+		return nil, fmt.Errorf("from_task_id is required")
+	}
+	if params.Arguments.NoteID == "" {
+		return nil, fmt.Errorf("note_id is required")
+	}
+	if params.Arguments.ToTaskID == "" {
+		return nil, fmt.Errorf("to_task_id is required")
+	}
+	if params.Arguments.FromTaskID == params.Arguments.ToTaskID {
+		return nil, fmt.Errorf("from_task_id and to_task_id must be different tasks")
+	}
+	movedBy, err := resolveActor(cfg, params.Arguments.MovedBy, "moved_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.MovedBy = movedBy
+
+	// Verify both tasks exist
+	source, err := t.fetchTask(ctx, params.Arguments.FromTaskID)
+	if err != nil {
+		slog.Error("Failed to get source task", "error", err, "task_id", params.Arguments.FromTaskID)
+		return nil, fmt.Errorf("failed to get source task: %w", err)
+	}
+	target, err := t.fetchTask(ctx, params.Arguments.ToTaskID)
+	if err != nil {
+		slog.Error("Failed to get target task", "error", err, "task_id", params.Arguments.ToTaskID)
+		return nil, fmt.Errorf("failed to get target task: %w", err)
+	}
+
+	// Find the note on the source task
+	sourceNotesPath := fmt.Sprintf("/api/v1/tasks/%s/notes", url.PathEscape(source.TaskID))
+	notesResp, err := t.apiClient.Get(ctx, sourceNotesPath)
+	if err != nil {
+		slog.Error("Failed to get task notes", "error", err, "task_id", source.TaskID)
+		return nil, fmt.Errorf("failed to get task notes: %w", err)
+	}
+
+	var sourceNotes []TaskNote
+	if err := json.Unmarshal(notesResp, &sourceNotes); err != nil {
+		slog.Error("Failed to parse task notes", "error", err)
+		return nil, fmt.Errorf("failed to parse task notes: %w", err)
+	}
+
+	var note *TaskNote
+	for i := range sourceNotes {
+		if sourceNotes[i].NoteID == params.Arguments.NoteID {
+			note = &sourceNotes[i]
+			break
+		}
+	}
+	if note == nil {
+		return nil, fmt.Errorf("note %s not found on task %s", params.Arguments.NoteID, source.TaskID)
+	}
+
+	// Recreate on the target before deleting so a failure never loses the note
+	targetNotesPath := fmt.Sprintf("/api/v1/tasks/%s/notes", url.PathEscape(target.TaskID))
+	createResp, err := t.apiClient.Post(ctx, targetNotesPath, map[string]interface{}{
+		"note":          note.Note,
+		"created_by":    note.CreatedBy,
+		"creation_date": note.CreationDate,
+	})
+	if err != nil {
+		slog.Error("Failed to recreate note on target task", "error", err, "task_id", target.TaskID)
+		return nil, fmt.Errorf("failed to add note to target task: %w", err)
+	}
+
+	var movedNote TaskNote
+	if err := json.Unmarshal(createResp, &movedNote); err != nil {
+		slog.Error("Failed to parse moved note", "error", err)
+		return nil, fmt.Errorf("failed to parse moved note: %w", err)
+	}
+
+	if err := t.deleteTaskNote(ctx, source.TaskID, note.NoteID); err != nil {
+		slog.Error("Failed to delete note from source task", "error", err, "task_id", source.TaskID, "note_id", note.NoteID)
+		return nil, fmt.Errorf("note copied to task %s as %s but could not be removed from task %s: %w", target.TaskID, movedNote.NoteID, source.TaskID, err)
+	}
+
+	// Leave a trace on both tasks; failures here don't undo the move
+	traces := []struct {
+		path string
+		text string
+	}{
+		{sourceNotesPath, movedNoteTraceFrom(*note, *target, movedBy)},
+		{targetNotesPath, movedNoteTraceTo(*note, *source, movedBy)},
+	}
+	for _, trace := range traces {
+		if _, err := t.apiClient.Post(ctx, trace.path, map[string]interface{}{
+			"note":       trace.text,
+			"created_by": movedBy,
+		}); err != nil {
+			slog.Error("Failed to add move trace note", "error", err, "path", trace.path)
+		}
+	}
+
+	result := map[string]any{
+		"from_task_id":     source.TaskID,
+		"to_task_id":       target.TaskID,
+		"original_note_id": note.NoteID,
+		"note":             movedNote,
+		"moved_by":         movedBy,
+	}
+
+	responseText := "Note Moved\n==========\n\n"
+	responseText += fmt.Sprintf("From: %s (%s)\nTo: %s (%s)\n", source.TaskName, source.TaskID, target.TaskName, target.TaskID)
+	responseText += fmt.Sprintf("Author: %s\nWritten: %s\nMoved by: %s\n", note.CreatedBy, note.CreationDate, movedBy)
+	responseText += fmt.Sprintf("\n📝 %s\n", note.Note)
+	if movedNote.NoteID != note.NoteID {
+		responseText += fmt.Sprintf("\n💡 The note has a new ID on the target task: %s\n", movedNote.NoteID)
+	}
+
+	slog.Info("Note moved", "note_id", note.NoteID, "new_note_id", movedNote.NoteID, "from_task_id", source.TaskID, "to_task_id", target.TaskID)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetAttentionNeededParams defines input for get_attention_needed tool
+type GetAttentionNeededParams struct {
+	AssignedTo string `json:"assigned_to,omitempty"`
+	ProjectID  string `json:"project_id,omitempty"`
+}
+
+// HandleGetAttentionNeeded implements the get_attention_needed tool
+func (t *TaskTools) HandleGetAttentionNeeded(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetAttentionNeededParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_attention_needed tool", "params", params.Arguments)
+
+	query := url.Values{}
+	if params.Arguments.AssignedTo != "" && !t.assignees.Enabled() {
+		query.Set("assigned_to", params.Arguments.AssignedTo)
+	}
+	if params.Arguments.ProjectID != "" {
+		query.Set("project_id", params.Arguments.ProjectID)
+	}
+	path := "/api/v1/tasks"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	tasksResp, err := t.apiClient.Get(ctx, path)
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+	tasks = filterAssignedTo(t.assignees, tasks, params.Arguments.AssignedTo)
+
+	// Each task appears once, carrying every reason it needs attention
+	now := t.clock.Now()
+	var attention []Task
+	reasonsByTask := make(map[string][]string)
+	categoryCounts := map[string]int{attentionOverdue: 0, attentionBlocked: 0, attentionDueToday: 0}
+	for _, task := range tasks {
+		if task.Archived {
+			continue
+		}
+		reasons := attentionReasons(task, now)
+		if len(reasons) == 0 {
+			continue
+		}
+		if _, seen := reasonsByTask[task.TaskID]; seen {
+			continue
+		}
+		reasonsByTask[task.TaskID] = reasons
+		for _, reason := range reasons {
+			categoryCounts[reason]++
+		}
+		attention = append(attention, task)
+	}
+	sortTasksByPriorityAndDue(attention)
+
+	entries := make([]map[string]any, 0, len(attention))
+	for _, task := range attention {
+		entries = append(entries, map[string]any{
+			"task_id":     task.TaskID,
+			"task_name":   task.TaskName,
+			"status":      task.Status,
+			"priority":    task.Priority,
+			"assigned_to": task.AssignedTo,
+			"due_date":    task.DueDate,
+			"reasons":     reasonsByTask[task.TaskID],
+		})
+	}
+
+	result := map[string]any{
+		"tasks":           entries,
+		"total_count":     len(entries),
+		"category_counts": categoryCounts,
+		"as_of":           now.Format(time.RFC3339),
+	}
+
+	// Build response text
+	responseText := "Attention Needed\n================\n\n"
+	if params.Arguments.AssignedTo != "" {
+		responseText += fmt.Sprintf("Assigned to: %s\n", params.Arguments.AssignedTo)
+	}
+	if params.Arguments.ProjectID != "" {
+		responseText += fmt.Sprintf("Project: %s\n", params.Arguments.ProjectID)
+	}
+	responseText += fmt.Sprintf("Overdue: %d\nBlocked: %d\nDue today: %d\n",
+		categoryCounts[attentionOverdue], categoryCounts[attentionBlocked], categoryCounts[attentionDueToday])
+
+	if len(attention) == 0 {
+		responseText += "\n✅ Nothing needs attention right now\n"
+	} else {
+		responseText += fmt.Sprintf("\n🚨 Tasks (%d):\n", len(attention))
+		for i, task := range attention {
+			responseText += fmt.Sprintf("%d. %s (%s, %s) - %s\n", i+1, task.TaskName, task.Status,
+				t.priorities.Render(task.Priority), strings.Join(reasonsByTask[task.TaskID], ", "))
+		}
+	}
+
+	slog.Info("Attention needed generated", "total", len(attention), "overdue", categoryCounts[attentionOverdue], "blocked", categoryCounts[attentionBlocked], "due_today", categoryCounts[attentionDueToday])
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetSprintCompletionsParams defines input for get_sprint_completions tool
+type GetSprintCompletionsParams struct {
+	SprintStart string `json:"sprint_start" validate:"required,date"`
+	SprintEnd   string `json:"sprint_end,omitempty" validate:"date"`
+	ProjectID   string `json:"project_id,omitempty"`
+}
+
+// HandleGetSprintCompletions implements the get_sprint_completions tool
+func (t *TaskTools) HandleGetSprintCompletions(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetSprintCompletionsParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_sprint_completions tool", "params", params.Arguments)
+
+	// Validate required fields
+	if params.Arguments.SprintStart == "" {
+		return nil, fmt.Errorf("sprint_start is required")
+	}
+
+	now := t.clock.Now()
+	start, end, err := sprintWindow(params.Arguments.SprintStart, params.Arguments.SprintEnd, now)
+	if err != nil {
+		return nil, err
+	}
+
+	path := "/api/v1/tasks"
+	if params.Arguments.ProjectID != "" {
+		path = fmt.Sprintf("/api/v1/projects/%s/tasks", url.PathEscape(params.Arguments.ProjectID))
+	}
+	tasksResp, err := t.apiClient.Get(ctx, path)
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err, "project_id", params.Arguments.ProjectID)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+
+	completed := []Task{}
+	carriedOver := []Task{}
+	for _, task := range tasks {
+		switch {
+		case completedInSprint(task, start, end):
+			completed = append(completed, task)
+		case carriedOverFromSprint(task, start, end, now):
+			carriedOver = append(carriedOver, task)
+		}
+	}
+	sortTasksByPriorityAndDue(carriedOver)
+
+	inProgress := now.Before(end)
+	result := map[string]any{
+		"sprint_start":       start.Format(time.RFC3339),
+		"sprint_end":         end.Format(time.RFC3339),
+		"sprint_in_progress": inProgress,
+		"completed_tasks":    completed,
+		"completed_count":    len(completed),
+		"carried_over_tasks": carriedOver,
+		"carried_over_count": len(carriedOver),
+	}
+
+	// Build response text
+	responseText := "Sprint Completions\n==================\n\n"
+	responseText += fmt.Sprintf("Window: %s to %s\n", start.Format(time.RFC3339), end.Format(time.RFC3339))
+	if params.Arguments.ProjectID != "" {
+		responseText += fmt.Sprintf("Project: %s\n", params.Arguments.ProjectID)
+	}
+	if inProgress {
+		responseText += "Sprint is still in progress\n"
+	}
+
+	responseText += fmt.Sprintf("\n✅ Completed (%d):\n", len(completed))
+	if len(completed) == 0 {
+		responseText += "No tasks completed in this sprint\n"
+	}
+	for _, task := range completed {
+		responseText += fmt.Sprintf("- %s (Completed: %s)\n", task.TaskName, *task.CompletionDate)
+	}
+
+	responseText += fmt.Sprintf("\n↪️ Carried Over (%d):\n", len(carriedOver))
+	if len(carriedOver) == 0 {
+		responseText += "Nothing due in this sprint was left unfinished\n"
+	}
+	for _, task := range carriedOver {
+		responseText += fmt.Sprintf("- %s (%s, Due: %s)\n", task.TaskName, task.Status, *task.DueDate)
+	}
+
+	slog.Info("Sprint completions generated", "completed", len(completed), "carried_over", len(carriedOver), "project_id", params.Arguments.ProjectID)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetBlockedDurationReportParams defines input for get_blocked_duration_report tool
+type GetBlockedDurationReportParams struct {
+	ProjectID string `json:"project_id,omitempty"`
+	Since     string `json:"since,omitempty"`
+}
+
+// HandleGetBlockedDurationReport implements the get_blocked_duration_report tool
+func (t *TaskTools) HandleGetBlockedDurationReport(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetBlockedDurationReportParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_blocked_duration_report tool", "params", params.Arguments)
+
+	var since time.Time
+	if params.Arguments.Since != "" {
+		parsed, err := parseDueDate(params.Arguments.Since)
+		if err != nil || parsed == nil {
+			return nil, fmt.Errorf("invalid since %q: expected YYYY-MM-DD or RFC3339", params.Arguments.Since)
+		}
+		since = *parsed
+	}
+
+	path := "/api/v1/tasks"
+	if params.Arguments.ProjectID != "" {
+		path = fmt.Sprintf("/api/v1/projects/%s/tasks", url.PathEscape(params.Arguments.ProjectID))
+	}
+	tasksResp, err := t.apiClient.Get(ctx, path)
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err, "project_id", params.Arguments.ProjectID)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+
+	// Blocked periods are inferred from each task's notes
+	now := t.clock.Now()
+	notesByTask := fetchNotesForTasks(ctx, t.apiClient, tasks)
+
+	type blockedEntry struct {
+		task        Task
+		duration    time.Duration
+		periods     int
+		approximate bool
+	}
+	var entries []blockedEntry
+	var total time.Duration
+	approximateCount := 0
+	for i, task := range tasks {
+		periods := inferBlockedPeriods(task, notesByTask[i], now)
+		duration, approximate := blockedTimeSince(periods, since)
+		if duration <= 0 {
+			continue
+		}
+		entries = append(entries, blockedEntry{task: task, duration: duration, periods: len(periods), approximate: approximate})
+		total += duration
+		if approximate {
+			approximateCount++
+		}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].duration > entries[j].duration
+	})
+
+	var average time.Duration
+	if len(entries) > 0 {
+		average = total / time.Duration(len(entries))
+	}
+
+	blockedTasks := make([]map[string]any, 0, len(entries))
+	for _, entry := range entries {
+		blockedTasks = append(blockedTasks, map[string]any{
+			"task_id":       entry.task.TaskID,
+			"task_name":     entry.task.TaskName,
+			"status":        entry.task.Status,
+			"assigned_to":   entry.task.AssignedTo,
+			"blocked_hours": entry.duration.Hours(),
+			"periods":       entry.periods,
+			"approximate":   entry.approximate,
+		})
+	}
+
+	result := map[string]any{
+		"blocked_tasks":         blockedTasks,
+		"tasks_blocked":         len(entries),
+		"total_blocked_hours":   total.Hours(),
+		"average_blocked_hours": average.Hours(),
+		"approximate_count":     approximateCount,
+		"tasks_analyzed":        len(tasks),
+		"as_of":                 now.Format(time.RFC3339),
+	}
+	if !since.IsZero() {
+		result["since"] = since.Format(time.RFC3339)
+	}
+
+	// Build response text
+	responseText := "Blocked Duration Report\n=======================\n\n"
+	if params.Arguments.ProjectID != "" {
+		responseText += fmt.Sprintf("Project: %s\n", params.Arguments.ProjectID)
+	}
+	if !since.IsZero() {
+		responseText += fmt.Sprintf("Since: %s\n", since.Format(time.RFC3339))
+	}
+	responseText += fmt.Sprintf("Tasks analyzed: %d\nTasks blocked: %d\n", len(tasks), len(entries))
+
+	if len(entries) == 0 {
+		responseText += "\n✅ No blocked time found\n"
+	} else {
+		responseText += fmt.Sprintf("Total blocked: %.1f days\nAverage blocked: %.1f days\n",
+			total.Hours()/24, average.Hours()/24)
+
+		limit := len(entries)
+		if limit > 10 {
+			limit = 10
+		}
+		responseText += fmt.Sprintf("\n⏳ Longest Blocked (%d):\n", limit)
+		for _, entry := range entries[:limit] {
+			marker := ""
+			if entry.approximate {
+				marker = " (approx.)"
+			}
+			responseText += fmt.Sprintf("- %s (%s): %.1f days%s\n", entry.task.TaskName, entry.task.Status, entry.duration.Hours()/24, marker)
+		}
+		if len(entries) > limit {
+			responseText += fmt.Sprintf("... and %d more blocked tasks\n", len(entries)-limit)
+		}
+	}
+
+	if approximateCount > 0 {
+		responseText += fmt.Sprintf("\n⚠️ %d durations are approximate: with no \"blocked\"/\"unblocked\" note to mark a transition, the task's last update was used\n", approximateCount)
+	}
+
+	slog.Info("Blocked duration report generated", "tasks_blocked", len(entries), "average_hours", average.Hours(), "approximate", approximateCount)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetOverviewDeltaParams defines input for get_overview_delta tool
+type GetOverviewDeltaParams struct {
+	Since string `json:"since" validate:"required"`
+}
+
+// HandleGetOverviewDelta implements the get_overview_delta tool
+func (t *TaskTools) HandleGetOverviewDelta(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetOverviewDeltaParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_overview_delta tool", "params", params.Arguments)
+
+	// Validate required fields
+	if params.Arguments.Since == "" {
+		return nil, fmt.Errorf("since is required")
+	}
+
+	now := t.clock.Now()
+	since, err := parseSince(params.Arguments.Since, now)
+	if err != nil {
+		return nil, err
+	}
+	if since.After(now) {
+		return nil, fmt.Errorf("since must not be in the future")
+	}
+
+	tasksResp, err := t.apiClient.Get(ctx, "/api/v1/tasks")
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+
+	created := []Task{}
+	completed := []Task{}
+	overdue := []Task{}
+	changed := []map[string]any{}
+	for _, task := range tasks {
+		isNew := createdSince(task, since)
+		if isNew {
+			created = append(created, task)
+		}
+		if completedSince(task, since) {
+			completed = append(completed, task)
+		}
+		if becameOverdueSince(task, since, now) {
+			overdue = append(overdue, task)
+		}
+		// New tasks are reported as created rather than as changed
+		if evidence, ok := statusChangeSince(task, since); ok && !isNew {
+			changed = append(changed, map[string]any{
+				"task_id":   task.TaskID,
+				"task_name": task.TaskName,
+				"status":    task.Status,
+				"evidence":  evidence,
+			})
+		}
+	}
+
+	counts := map[string]int{
+		"created":        len(created),
+		"completed":      len(completed),
+		"newly_overdue":  len(overdue),
+		"status_changes": len(changed),
+	}
+	result := map[string]any{
+		"since":           since.Format(time.RFC3339),
+		"as_of":           now.Format(time.RFC3339),
+		"counts":          counts,
+		"created_tasks":   created,
+		"completed_tasks": completed,
+		"overdue_tasks":   overdue,
+		"changed_tasks":   changed,
+	}
+
+	// Build response text
+	responseText := "Overview Delta\n==============\n\n"
+	responseText += fmt.Sprintf("Since: %s\nAs of: %s\n", since.Format(time.RFC3339), now.Format(time.RFC3339))
+
+	if len(created)+len(completed)+len(overdue)+len(changed) == 0 {
+		responseText += "\n😴 No changes since the cutoff\n"
+	}
+
+	if len(created) > 0 {
+		responseText += fmt.Sprintf("\n🆕 Created (%d):\n", len(created))
+		for _, task := range created {
+			responseText += fmt.Sprintf("- %s (%s)\n", task.TaskName, task.Status)
+		}
+	}
+
+	if len(completed) > 0 {
+		responseText += fmt.Sprintf("\n✅ Completed (%d):\n", len(completed))
+		for _, task := range completed {
+			responseText += fmt.Sprintf("- %s\n", task.TaskName)
+		}
+	}
+
+	if len(overdue) > 0 {
+		responseText += fmt.Sprintf("\n⚠️ Newly Overdue (%d):\n", len(overdue))
+		for _, task := range overdue {
+			responseText += fmt.Sprintf("- %s (Due: %s)\n", task.TaskName, *task.DueDate)
+		}
+	}
+
+	if len(changed) > 0 {
+		responseText += fmt.Sprintf("\n🔄 Changed (%d, inferred from timestamps):\n", len(changed))
+		for _, change := range changed {
+			responseText += fmt.Sprintf("- %s → %s (%s)\n", change["task_name"], change["status"], change["evidence"])
+		}
+	}
+
+	slog.Info("Overview delta generated", "since", since, "created", len(created), "completed", len(completed), "newly_overdue", len(overdue), "changed", len(changed))
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// RunPriorityEscalationParams defines input for run_priority_escalation tool
+type RunPriorityEscalationParams struct {
+	ProjectID   string `json:"project_id,omitempty"`
+	EscalatedBy string `json:"escalated_by"`
+}
+
+// HandleRunPriorityEscalation implements the run_priority_escalation tool
+func (t *TaskTools) HandleRunPriorityEscalation(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[RunPriorityEscalationParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing run_priority_escalation tool", "params", params.Arguments)
+
+	cfg := t.config()
+
+	// Validate required fields
+	escalatedBy, err := resolveActor(cfg, params.Arguments.EscalatedBy, "escalated_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.EscalatedBy = escalatedBy
+
+	policy := escalationPolicyFromConfig(cfg)
+	if len(policy.ages) == 0 {
+		return nil, fmt.Errorf("priority escalation is not configured; set TASKMAN_ESCALATION_AGES")
+	}
+
+	path := "/api/v1/tasks"
+	if params.Arguments.ProjectID != "" {
+		path = fmt.Sprintf("/api/v1/projects/%s/tasks", url.PathEscape(params.Arguments.ProjectID))
+	}
+	tasksResp, err := t.apiClient.Get(ctx, path)
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err, "project_id", params.Arguments.ProjectID)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+
+	// Decide every escalation before writing anything
+	now := t.clock.Now()
+	var candidates []Task
+	var decisions []escalation
+	for _, task := range tasks {
+		if decision, ok := evaluateEscalation(task, policy, now); ok {
+			candidates = append(candidates, task)
+			decisions = append(decisions, decision)
+		}
+	}
+
+	// Apply in bounded-concurrency batches
+	escalated := make([]bool, len(candidates))
+	failures := make([]string, len(candidates))
+
+	runBounded(len(candidates), bulkConcurrency, func(i int) {
+		task := candidates[i]
+		updateRequest := map[string]interface{}{
+			"priority":        decisions[i].to,
+			"last_updated_by": params.Arguments.EscalatedBy,
+		}
+		if _, err := t.apiClient.Put(ctx, fmt.Sprintf("/api/v1/tasks/%s", url.PathEscape(task.TaskID)), updateRequest); err != nil {
+			slog.Error("Failed to escalate task priority", "error", err, "task_id", task.TaskID)
+			failures[i] = err.Error()
+			return
+		}
+		escalated[i] = true
+
+		noteRequest := map[string]interface{}{
+			"note":       escalationAuditNote(decisions[i]),
+			"created_by": params.Arguments.EscalatedBy,
+		}
+		if _, err := t.apiClient.Post(ctx, fmt.Sprintf("/api/v1/tasks/%s/notes", url.PathEscape(task.TaskID)), noteRequest); err != nil {
+			slog.Warn("Failed to add escalation audit note", "error", err, "task_id", task.TaskID)
+		}
+	})
+
+	escalatedTasks := []map[string]any{}
+	failedTasks := []map[string]any{}
+	for i, task := range candidates {
+		if escalated[i] {
+			escalatedTasks = append(escalatedTasks, map[string]any{
+				"task_id":      task.TaskID,
+				"task_name":    task.TaskName,
+				"old_priority": decisions[i].from,
+				"new_priority": decisions[i].to,
+				"age_days":     wholeDays(decisions[i].age),
+			})
+		} else {
+			failedTasks = append(failedTasks, map[string]any{
+				"task_id":   task.TaskID,
+				"task_name": task.TaskName,
+				"error":     failures[i],
+			})
+		}
+	}
+
+	result := map[string]any{
+		"escalated_count": len(escalatedTasks),
+		"escalated_tasks": escalatedTasks,
+		"failed_count":    len(failedTasks),
+		"failed_tasks":    failedTasks,
+		"tasks_evaluated": len(tasks),
+		"max_priority":    policy.maxPriority,
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Priority Escalation\n===================\n\nEvaluated: %d tasks\nEscalated: %d\n",
+		len(tasks), len(escalatedTasks))
+	if params.Arguments.ProjectID != "" {
+		responseText += fmt.Sprintf("Project: %s\n", params.Arguments.ProjectID)
+	}
+
+	if len(escalatedTasks) > 0 {
+		responseText += "\n⬆️ Escalated Tasks:\n"
+		for _, entry := range escalatedTasks {
+			responseText += fmt.Sprintf("- %s: %s → %s (open %d days)\n",
+				entry["task_name"], entry["old_priority"], entry["new_priority"], entry["age_days"])
+		}
+	} else {
+		responseText += "\n✅ No tasks are due for escalation\n"
+	}
+
+	if len(failedTasks) > 0 {
+		responseText += fmt.Sprintf("\n❌ Failed (%d):\n", len(failedTasks))
+		for _, failure := range failedTasks {
+			responseText += fmt.Sprintf("- %s: %s\n", failure["task_name"], failure["error"])
+		}
+	}
+
+	slog.Info("Priority escalation run", "escalated", len(escalatedTasks), "failed", len(failedTasks))
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetNewOverdueSinceParams defines input for get_new_overdue_since tool
+type GetNewOverdueSinceParams struct {
+	Since string `json:"since" validate:"required"`
+}
+
+// HandleGetNewOverdueSince implements the get_new_overdue_since tool. Only
+// tasks that crossed their due date after since are returned, so a scheduler
+// passing its previous run time alerts once per task.
+func (t *TaskTools) HandleGetNewOverdueSince(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetNewOverdueSinceParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_new_overdue_since tool", "params", params.Arguments)
+
+	// Validate required fields
+	if params.Arguments.Since == "" {
+		return nil, fmt.Errorf("since is required")
+	}
+
+	now := t.clock.Now()
+	since, err := parseSince(params.Arguments.Since, now)
+	if err != nil {
+		return nil, err
+	}
+	if since.After(now) {
+		return nil, fmt.Errorf("since must not be in the future")
+	}
+
+	tasksResp, err := t.apiClient.Get(ctx, "/api/v1/tasks")
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+
+	newlyOverdue := []Task{}
+	stillOverdue := 0
+	for _, task := range tasks {
+		if task.Archived {
+			continue
+		}
+		if becameOverdueSince(task, since, now) {
+			newlyOverdue = append(newlyOverdue, task)
+		} else if _, overdue := overdueDuration(task, now); overdue {
+			stillOverdue++
+		}
+	}
+	sortTasksByPriorityAndDue(newlyOverdue)
+
+	result := map[string]any{
+		"tasks":                    newlyOverdue,
+		"new_overdue_count":        len(newlyOverdue),
+		"previously_overdue_count": stillOverdue,
+		"since":                    since.UTC().Format(time.RFC3339),
+		"as_of":                    now.UTC().Format(time.RFC3339),
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Newly Overdue Tasks\n===================\n\nSince: %s\nAs of: %s\n",
+		since.UTC().Format(time.RFC3339), now.UTC().Format(time.RFC3339))
+
+	if len(newlyOverdue) == 0 {
+		responseText += "\n✅ No tasks became overdue in this window\n"
+	} else {
+		responseText += fmt.Sprintf("\n🔔 Became Overdue (%d):\n", len(newlyOverdue))
+		for _, task := range newlyOverdue {
+			line := fmt.Sprintf("- %s (ID: %s, due %s", task.TaskName, task.TaskID, *task.DueDate)
+			if task.AssignedTo != nil && *task.AssignedTo != "" {
+				line += fmt.Sprintf(", assigned to %s", *task.AssignedTo)
+			}
+			responseText += line + ")\n"
+		}
+	}
+	if stillOverdue > 0 {
+		responseText += fmt.Sprintf("\nℹ️ %d tasks were already overdue before the window and are not repeated\n", stillOverdue)
+	}
+
+	slog.Info("Newly overdue tasks found", "since", since, "count", len(newlyOverdue), "previously_overdue", stillOverdue)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetOverdueTasksParams defines input for get_overdue_tasks tool
+type GetOverdueTasksParams struct {
+	AssignedTo string `json:"assigned_to,omitempty"`
+	ProjectID  string `json:"project_id,omitempty"`
+	GraceDays  int    `json:"grace_days,omitempty" validate:"min=0"` // days past due before a task counts as overdue
+}
+
+// HandleGetOverdueTasks implements the get_overdue_tasks tool
+func (t *TaskTools) HandleGetOverdueTasks(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetOverdueTasksParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_overdue_tasks tool", "params", params.Arguments)
+
+	// Validate required fields
+	graceDays := params.Arguments.GraceDays
+	if graceDays < 0 {
+		return nil, fmt.Errorf("grace_days must not be negative")
+	}
+
+	query := url.Values{}
+	if params.Arguments.AssignedTo != "" && !t.assignees.Enabled() {
+		query.Set("assigned_to", params.Arguments.AssignedTo)
+	}
+	if params.Arguments.ProjectID != "" {
+		query.Set("project_id", params.Arguments.ProjectID)
+	}
+	path := "/api/v1/tasks"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	tasksResp, err := t.apiClient.Get(ctx, path)
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+	tasks = filterAssignedTo(t.assignees, tasks, params.Arguments.AssignedTo)
+
+	now := t.clock.Now()
+	var overdue []digestEntry
+	overdueFor := make(map[string]time.Duration)
+	withinGrace := 0
+	for _, task := range tasks {
+		if task.Archived {
+			continue
+		}
+		if params.Arguments.ProjectID != "" && taskProjectID(task) != params.Arguments.ProjectID {
+			continue
+		}
+		d, ok := overdueBeyondGrace(task, now, graceDays)
+		if !ok {
+			if isTaskOverdue(task, now) {
+				withinGrace++
+			}
+			continue
+		}
+		overdueFor[task.TaskID] = d
+		overdue = append(overdue, digestEntry{Task: task, Days: wholeDays(d)})
+	}
+
+	// Most overdue first
+	sort.SliceStable(overdue, func(i, j int) bool {
+		return overdueFor[overdue[i].Task.TaskID] > overdueFor[overdue[j].Task.TaskID]
+	})
+
+	entries := make([]map[string]any, 0, len(overdue))
+	for _, entry := range overdue {
+		entries = append(entries, map[string]any{
+			"task_id":      entry.Task.TaskID,
+			"task_name":    entry.Task.TaskName,
+			"status":       entry.Task.Status,
+			"priority":     entry.Task.Priority,
+			"assigned_to":  entry.Task.AssignedTo,
+			"due_date":     entry.Task.DueDate,
+			"days_overdue": entry.Days,
+		})
+	}
+
+	result := map[string]any{
+		"tasks":              entries,
+		"total_count":        len(entries),
+		"within_grace_count": withinGrace,
+		"grace_days":         graceDays,
+		"as_of":              now.UTC().Format(time.RFC3339),
+	}
+
+	// Build response text
+	responseText := "Overdue Tasks\n=============\n\n"
+	if params.Arguments.AssignedTo != "" {
+		responseText += fmt.Sprintf("Assigned to: %s\n", params.Arguments.AssignedTo)
+	}
+	if params.Arguments.ProjectID != "" {
+		responseText += fmt.Sprintf("Project: %s\n", params.Arguments.ProjectID)
+	}
+	if graceDays > 0 {
+		responseText += fmt.Sprintf("Grace window: %d days\n", graceDays)
+	}
+
+	if len(overdue) == 0 {
+		responseText += "\n✅ No overdue tasks\n"
+	} else {
+		responseText += fmt.Sprintf("\n🚨 Overdue (%d, most overdue first):\n", len(overdue))
+		for i, entry := range overdue {
+			line := fmt.Sprintf("%d. %s (ID: %s) - %d days overdue (due %s, %s",
+				i+1, entry.Task.TaskName, entry.Task.TaskID, entry.Days, *entry.Task.DueDate, t.priorities.Render(entry.Task.Priority))
+			if entry.Task.AssignedTo != nil && *entry.Task.AssignedTo != "" {
+				line += fmt.Sprintf(", assigned to %s", *entry.Task.AssignedTo)
+			}
+			responseText += line + ")\n"
+		}
+	}
+	if withinGrace > 0 {
+		responseText += fmt.Sprintf("\nℹ️ %d more tasks are past due but still within the grace window\n", withinGrace)
+	}
+
+	slog.Info("Overdue tasks listed", "count", len(overdue), "within_grace", withinGrace, "grace_days", graceDays)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetTasksDueSoonParams defines input for get_tasks_due_soon tool
+type GetTasksDueSoonParams struct {
+	AssignedTo string `json:"assigned_to,omitempty"`
+	Days       int    `json:"days,omitempty" validate:"min=1,max=365"` // default defaultDueSoonDays
+}
+
+// HandleGetTasksDueSoon implements the get_tasks_due_soon tool. The horizon
+// is in working days (every day unless BusinessDaysOnly is set) and includes
+// today, so a task due earlier today is listed as due today rather than left
+// to get_overdue_tasks.
+func (t *TaskTools) HandleGetTasksDueSoon(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetTasksDueSoonParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_tasks_due_soon tool", "params", params.Arguments)
+
+	days := params.Arguments.Days
+	if days <= 0 {
+		days = defaultDueSoonDays
+	}
+
+	query := url.Values{}
+	if params.Arguments.AssignedTo != "" && !t.assignees.Enabled() {
+		query.Set("assigned_to", params.Arguments.AssignedTo)
+	}
+	path := "/api/v1/tasks"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	tasksResp, err := t.apiClient.Get(ctx, path)
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+	tasks = filterAssignedTo(t.assignees, tasks, params.Arguments.AssignedTo)
+
+	now := t.clock.Now()
+	dueSoon := tasksDueSoon(tasks, now, days, t.calendar)
+
+	var dueToday, dueTomorrow, dueThisWeek int
+	entries := make([]map[string]any, 0, len(dueSoon))
+	for _, entry := range dueSoon {
+		switch entry.Days {
+		case 0:
+			dueToday++
+		case 1:
+			dueTomorrow++
+		}
+		if entry.Days < 7 {
+			dueThisWeek++
+		}
+		entries = append(entries, map[string]any{
+			"task_id":        entry.Task.TaskID,
+			"task_name":      entry.Task.TaskName,
+			"status":         entry.Task.Status,
+			"priority":       entry.Task.Priority,
+			"assigned_to":    entry.Task.AssignedTo,
+			"due_date":       entry.Task.DueDate,
+			"days_until_due": entry.Days,
+		})
+	}
+
+	result := map[string]any{
+		"tasks":         entries,
+		"total_count":   len(entries),
+		"due_today":     dueToday,
+		"due_tomorrow":  dueTomorrow,
+		"due_this_week": dueThisWeek, // within 7 days, today included
+		"days":          days,
+		"business_days": t.calendar.BusinessDaysOnly(),
+		"as_of":         now.UTC().Format(time.RFC3339),
+	}
+
+	// Build response text
+	responseText := "Tasks Due Soon\n==============\n\n"
+	if params.Arguments.AssignedTo != "" {
+		responseText += fmt.Sprintf("Assigned to: %s\n", params.Arguments.AssignedTo)
+	}
+	if t.calendar.BusinessDaysOnly() {
+		responseText += fmt.Sprintf("Window: today and the next %d working days\n", days)
+	} else {
+		responseText += fmt.Sprintf("Window: today and the next %d days\n", days)
+	}
+
+	if len(dueSoon) == 0 {
+		responseText += "\n✅ Nothing due in this window\n"
+	} else {
+		responseText += fmt.Sprintf("Due today: %d, tomorrow: %d, this week: %d\n", dueToday, dueTomorrow, dueThisWeek)
+		for i, entry := range dueSoon {
+			if i == 0 || entry.Days != dueSoon[i-1].Days {
+				day := now.AddDate(0, 0, entry.Days)
+				label := day.Format("Monday, Jan 2")
+				switch entry.Days {
+				case 0:
+					label = "Today (" + label + ")"
+				case 1:
+					label = "Tomorrow (" + label + ")"
+				}
+				responseText += fmt.Sprintf("\n📅 %s:\n", label)
+			}
+			line := fmt.Sprintf("- %s (ID: %s) - %s, %s", entry.Task.TaskName, entry.Task.TaskID, entry.Task.Status, t.priorities.Render(entry.Task.Priority))
+			if entry.Task.AssignedTo != nil && *entry.Task.AssignedTo != "" {
+				line += fmt.Sprintf(", assigned to %s", *entry.Task.AssignedTo)
+			}
+			responseText += line + "\n"
+		}
+	}
+
+	slog.Info("Tasks due soon listed", "count", len(dueSoon), "days", days)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetBlockedTasksParams defines input for get_blocked_tasks tool
+type GetBlockedTasksParams struct {
+	ProjectID  string `json:"project_id,omitempty"`
+	AssignedTo string `json:"assigned_to,omitempty"`
+}
+
+// blockedCriticalDays is how long a task may stay Blocked before get_blocked_tasks flags it critical
+const blockedCriticalDays = 7
+
+// HandleGetBlockedTasks implements the get_blocked_tasks tool. How long a
+// task has been blocked is measured from its last update, and its newest
+// note is shown since that usually explains the blocker.
+func (t *TaskTools) HandleGetBlockedTasks(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetBlockedTasksParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_blocked_tasks tool", "params", params.Arguments)
+
+	query := url.Values{"status": {"Blocked"}}
+	if params.Arguments.AssignedTo != "" && !t.assignees.Enabled() {
+		query.Set("assigned_to", params.Arguments.AssignedTo)
+	}
+	if params.Arguments.ProjectID != "" {
+		query.Set("project_id", params.Arguments.ProjectID)
+	}
+
+	tasksResp, err := t.apiClient.Get(ctx, "/api/v1/tasks?"+query.Encode())
+	if err != nil {
+		slog.Error("Failed to get blocked tasks", "error", err)
+		return nil, fmt.Errorf("failed to get blocked tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse blocked tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse blocked tasks: %w", err)
+	}
+	tasks = filterAssignedTo(t.assignees, tasks, params.Arguments.AssignedTo)
+
+	now := t.clock.Now()
+	var blocked []Task
+	blockedFor := make(map[string]time.Duration)
+	for _, task := range tasks {
+		if task.Status != "Blocked" || task.Archived {
+			continue
+		}
+		if params.Arguments.ProjectID != "" && taskProjectID(task) != params.Arguments.ProjectID {
+			continue
+		}
+		if d, ok := blockedDuration(task, now); ok {
+			blockedFor[task.TaskID] = d
+		}
+		blocked = append(blocked, task)
+	}
+
+	// Oldest blocked first; tasks with no usable dates last
+	sort.SliceStable(blocked, func(i, j int) bool {
+		di, okI := blockedFor[blocked[i].TaskID]
+		dj, okJ := blockedFor[blocked[j].TaskID]
+		if okI != okJ {
+			return okI
+		}
+		return di > dj
+	})
+
+	notesByTask := fetchNotesForTasks(ctx, t.apiClient, blocked)
+
+	entries := make([]map[string]any, 0, len(blocked))
+	criticalCount := 0
+	for i, task := range blocked {
+		entry := map[string]any{
+			"task_id":     task.TaskID,
+			"task_name":   task.TaskName,
+			"priority":    task.Priority,
+			"assigned_to": task.AssignedTo,
+			"blocked_by":  task.BlockedBy,
+			"critical":    false,
+		}
+		if d, ok := blockedFor[task.TaskID]; ok {
+			days := wholeDays(d)
+			entry["days_blocked"] = days
+			if days > blockedCriticalDays {
+				entry["critical"] = true
+				criticalCount++
+			}
+		}
+		if latest := newestNotes(notesByTask[i], 1); len(latest) > 0 {
+			entry["latest_note"] = latest[0]
+		}
+		entries = append(entries, entry)
+	}
+
+	result := map[string]any{
+		"tasks":          entries,
+		"total_count":    len(entries),
+		"critical_count": criticalCount,
+		"critical_after": blockedCriticalDays,
+		"as_of":          now.UTC().Format(time.RFC3339),
+	}
+
+	// Build response text
+	responseText := "Blocked Tasks\n=============\n\n"
+	if params.Arguments.AssignedTo != "" {
+		responseText += fmt.Sprintf("Assigned to: %s\n", params.Arguments.AssignedTo)
+	}
+	if params.Arguments.ProjectID != "" {
+		responseText += fmt.Sprintf("Project: %s\n", params.Arguments.ProjectID)
+	}
+
+	if len(blocked) == 0 {
+		responseText += "\n✅ No blocked tasks\n"
+	} else {
+		responseText += fmt.Sprintf("\n⛔ Blocked (%d, oldest first", len(blocked))
+		if criticalCount > 0 {
+			responseText += fmt.Sprintf(", %d critical", criticalCount)
+		}
+		responseText += "):\n"
+		for i, entry := range entries {
+			task := blocked[i]
+			marker := ""
+			if entry["critical"] == true {
+				marker = "🚨 "
+			}
+			age := "blocked for an unknown time"
+			if days, ok := entry["days_blocked"].(int); ok {
+				age = fmt.Sprintf("blocked %d days", days)
+			}
+			line := fmt.Sprintf("%d. %s%s (ID: %s) - %s (%s", i+1, marker, task.TaskName, task.TaskID, age, t.priorities.Render(task.Priority))
+			if task.AssignedTo != nil && *task.AssignedTo != "" {
+				line += fmt.Sprintf(", assigned to %s", *task.AssignedTo)
+			}
+			responseText += line + ")\n"
+			if note, ok := entry["latest_note"].(TaskNote); ok {
+				responseText += fmt.Sprintf("   Latest note (%s, %s): %s\n", note.CreatedBy, note.CreationDate, note.Note)
+			}
+		}
+	}
+	if criticalCount > 0 {
+		responseText += fmt.Sprintf("\n🚨 %d tasks have been blocked for more than %d days - escalate or reassign\n", criticalCount, blockedCriticalDays)
+	}
+
+	slog.Info("Blocked tasks listed", "count", len(blocked), "critical", criticalCount)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// FindDuplicateNotesParams defines input for find_duplicate_notes tool
+type FindDuplicateNotesParams struct {
+	TaskID           string `json:"task_id" validate:"required"`
+	RemoveDuplicates bool   `json:"remove_duplicates,omitempty"`
+}
+
+// HandleFindDuplicateNotes implements the find_duplicate_notes tool. Notes
+// with identical text after folding case and whitespace are grouped; with
+// remove_duplicates set, every note but the earliest in each group is deleted.
+func (t *TaskTools) HandleFindDuplicateNotes(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[FindDuplicateNotesParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing find_duplicate_notes tool", "params", params.Arguments)
+
+	// Validate required fields
+	if params.Arguments.TaskID == "" {
+		return nil, fmt.Errorf("task_id is required")
+	}
+
+	task, err := t.fetchTask(ctx, params.Arguments.TaskID)
+	if err != nil {
+		slog.Error("Failed to get task", "error", err, "task_id", params.Arguments.TaskID)
+		return nil, fmt.Errorf("failed to get task: %w", err)
+	}
+
+	notesResp, err := t.apiClient.Get(ctx, fmt.Sprintf("/api/v1/tasks/%s/notes", url.PathEscape(task.TaskID)))
+	if err != nil {
+		slog.Error("Failed to get task notes", "error", err, "task_id", task.TaskID)
+		return nil, fmt.Errorf("failed to get task notes: %w", err)
+	}
+
+	var notes []TaskNote
+	if err := json.Unmarshal(notesResp, &notes); err != nil {
+		slog.Error("Failed to parse task notes", "error", err)
+		return nil, fmt.Errorf("failed to parse task notes: %w", err)
+	}
+
+	groups := duplicateNoteGroups(notes)
+	duplicateCount := 0
+	for _, group := range groups {
+		duplicateCount += len(group) - 1
+	}
+
+	// Keep the earliest note of each group; a failed delete is reported, not fatal
+	removed := []string{}
+	failed := []string{}
+	if params.Arguments.RemoveDuplicates {
+		for _, group := range groups {
+			for _, note := range group[1:] {
+				if err := t.deleteTaskNote(ctx, task.TaskID, note.NoteID); err != nil {
+					slog.Error("Failed to delete duplicate note", "error", err, "task_id", task.TaskID, "note_id", note.NoteID)
+					failed = append(failed, note.NoteID)
+					continue
+				}
+				removed = append(removed, note.NoteID)
+			}
+		}
+	}
+
+	result := map[string]any{
+		"task_id":          task.TaskID,
+		"groups":           groups,
+		"group_count":      len(groups),
+		"duplicate_count":  duplicateCount,
+		"removed_note_ids": removed,
+		"failed_note_ids":  failed,
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Duplicate Notes: %s\n", task.TaskName)
+	responseText += strings.Repeat("=", len(responseText)-1) + "\n\n"
+	responseText += fmt.Sprintf("Task ID: %s\nNotes checked: %d\n", task.TaskID, len(notes))
+
+	if len(groups) == 0 {
+		responseText += "\n✅ No duplicate notes found\n"
+	} else {
+		responseText += fmt.Sprintf("\n🔁 Duplicate Groups (%d, %d extra notes):\n", len(groups), duplicateCount)
+		for _, group := range groups {
+			responseText += fmt.Sprintf("\n- \"%s\" ×%d\n", group[0].Note, len(group))
+			for i, note := range group {
+				marker := "duplicate"
+				if i == 0 {
+					marker = "kept"
+				}
+				responseText += fmt.Sprintf("  • %s by %s at %s (%s)\n", note.NoteID, note.CreatedBy, note.CreationDate, marker)
+			}
+		}
+	}
+
+	if params.Arguments.RemoveDuplicates {
+		responseText += fmt.Sprintf("\n🗑️ Removed %d duplicate notes\n", len(removed))
+		if len(failed) > 0 {
+			responseText += fmt.Sprintf("⚠️ Could not remove %d notes: %s\n", len(failed), strings.Join(failed, ", "))
+		}
+	} else if duplicateCount > 0 {
+		responseText += "\n💡 Call again with remove_duplicates to keep only the earliest note in each group\n"
+	}
+
+	slog.Info("Duplicate notes checked", "task_id", task.TaskID, "groups", len(groups), "duplicates", duplicateCount, "removed", len(removed))
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetTasksBySourceParams defines input for get_tasks_by_source tool
+type GetTasksBySourceParams struct {
+	ProjectID  string `json:"project_id,omitempty"`
+	SampleSize int    `json:"sample_size,omitempty" validate:"min=1"`
+}
+
+// HandleGetTasksBySource implements the get_tasks_by_source tool. Incomplete
+// tasks are grouped by how they were created; tasks without a recorded
+// source count as manual.
+func (t *TaskTools) HandleGetTasksBySource(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetTasksBySourceParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_tasks_by_source tool", "params", params.Arguments)
+
+	sampleSize := params.Arguments.SampleSize
+	if sampleSize <= 0 {
+		sampleSize = 5
+	}
+
+	endpoint := "/api/v1/tasks"
+	if params.Arguments.ProjectID != "" {
+		endpoint += "?" + url.Values{"project_id": {params.Arguments.ProjectID}}.Encode()
+	}
+
+	tasksResp, err := t.apiClient.Get(ctx, endpoint)
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+
+	groups := groupTasksBySource(tasks, sampleSize)
+	counts := make(map[string]int, len(groups))
+	total := 0
+	for _, group := range groups {
+		counts[group.Source] = group.Count
+		total += group.Count
+	}
+
+	result := map[string]any{
+		"groups":           groups,
+		"counts":           counts,
+		"incomplete_count": total,
+	}
+	if params.Arguments.ProjectID != "" {
+		result["project_id"] = params.Arguments.ProjectID
+	}
+
+	// Build response text
+	responseText := "Incomplete Tasks by Source\n==========================\n\n"
+	if params.Arguments.ProjectID != "" {
+		responseText += fmt.Sprintf("Project: %s\n", params.Arguments.ProjectID)
+	}
+	responseText += fmt.Sprintf("Incomplete tasks: %d\n", total)
+
+	if len(groups) == 0 {
+		responseText += "\n✅ No incomplete tasks\n"
+	}
+	for _, group := range groups {
+		responseText += fmt.Sprintf("\n📥 %s: %d (%.0f%%)\n", group.Source, group.Count, float64(group.Count)/float64(total)*100)
+		for _, task := range group.Sample {
+			responseText += fmt.Sprintf("- %s (ID: %s, %s)\n", task.TaskName, task.TaskID, task.Status)
+		}
+		if more := group.Count - len(group.Sample); more > 0 {
+			responseText += fmt.Sprintf("  ...and %d more\n", more)
+		}
+	}
+
+	slog.Info("Tasks grouped by source", "sources", len(groups), "incomplete", total)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// ExportTasksParams defines input for export_tasks tool
+type ExportTasksParams struct {
+	Status     string `json:"status,omitempty" validate:"enum=status"`
+	ProjectID  string `json:"project_id,omitempty"`
+	AssignedTo string `json:"assigned_to,omitempty"`
+	Format     string `json:"format,omitempty" validate:"enum=csv|json"` // defaults to csv
+}
+
+// HandleExportTasks implements the export_tasks tool. The export itself is
+// the text content, so it can be saved or pasted into a spreadsheet as is;
+// archived tasks are left out.
+func (t *TaskTools) HandleExportTasks(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[ExportTasksParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing export_tasks tool", "params", params.Arguments)
+
+	format := normalizeEnum(params.Arguments.Format)
+	if format == "" {
+		format = exportCSV
+	}
+
+	query := url.Values{}
+	if params.Arguments.Status != "" {
+		query.Set("status", params.Arguments.Status)
+	}
+	if params.Arguments.AssignedTo != "" && !t.assignees.Enabled() {
+		query.Set("assigned_to", params.Arguments.AssignedTo)
+	}
+	if params.Arguments.ProjectID != "" {
+		query.Set("project_id", params.Arguments.ProjectID)
+	}
+	path := "/api/v1/tasks"
+	if len(query) > 0 {
+		path += "?" + query.Encode()
+	}
+
+	tasksResp, err := t.apiClient.Get(ctx, path)
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var fetched []Task
+	if err := json.Unmarshal(tasksResp, &fetched); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+	fetched = filterAssignedTo(t.assignees, fetched, params.Arguments.AssignedTo)
+
+	// Filters are enforced here whether or not the API honors them
+	tasks := []Task{}
+	for _, task := range fetched {
+		if task.Archived {
+			continue
+		}
+		if params.Arguments.Status != "" && task.Status != params.Arguments.Status {
+			continue
+		}
+		if params.Arguments.ProjectID != "" && taskProjectID(task) != params.Arguments.ProjectID {
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+
+	var responseText string
+	if format == exportJSON {
+		encoded, err := json.MarshalIndent(tasks, "", "  ")
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode tasks: %w", err)
+		}
+		responseText = string(encoded)
+	} else {
+		responseText, err = tasksCSV(tasks)
+		if err != nil {
+			return nil, fmt.Errorf("failed to write CSV: %w", err)
+		}
+	}
+
+	result := map[string]any{
+		"format":    format,
+		"row_count": len(tasks),
+		"filters": map[string]any{
+			"status":      params.Arguments.Status,
+			"project_id":  params.Arguments.ProjectID,
+			"assigned_to": params.Arguments.AssignedTo,
+		},
+	}
+	if format == exportCSV {
+		result["columns"] = exportColumns
+	}
+
+	slog.Info("Tasks exported", "format", format, "rows", len(tasks))
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// ImportTasksParams defines input for import_tasks tool
+type ImportTasksParams struct {
+	Format    string `json:"format" validate:"required,enum=csv|json"`
+	Data      string `json:"data" validate:"required"` // CSV with a header row, or a JSON array of tasks
+	CreatedBy string `json:"created_by"`
+	ProjectID string `json:"project_id,omitempty"` // used for rows that name no project
+}
+
+// HandleImportTasks implements the import_tasks tool. A batch that cannot be
+// parsed creates nothing; otherwise each row is checked with the
+// validate_task_payload rules, valid rows are created with source import and
+// a row that fails does not stop the rest. Unassigned rows get their
+// project's default assignee. Batches larger than the configured
+// MaxInitialTasks are rejected.
+func (t *TaskTools) HandleImportTasks(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[ImportTasksParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing import_tasks tool", "format", params.Arguments.Format, "data_size", len(params.Arguments.Data))
+
+	cfg := t.config()
+
+	// Many API calls share one budget
+	ctx, cancel := client.WithTimeout(ctx, cfg.MultiCallTimeout)
+	defer cancel()
+
+	// Validate required fields
+	createdBy, err := resolveActor(cfg, params.Arguments.CreatedBy, "created_by")
+	if err != nil {
+		return nil, err
+	}
+	params.Arguments.Format = normalizeEnum(params.Arguments.Format)
+	records, err := parseImportBatch(params.Arguments.Format, params.Arguments.Data)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, fmt.Errorf("data contains no tasks")
+	}
+	if maxTasks := cfg.MaxInitialTasks; maxTasks > 0 && len(records) > maxTasks {
+		return nil, fmt.Errorf("too many rows: %d in the batch, maximum is %d", len(records), maxTasks)
+	}
+
+	// Unassigned rows go to their project's default assignee, looked up
+	// once per project
+	payloads := make([]map[string]any, len(records))
+	defaultAssignees := make(map[string]string)
+	for i, record := range records {
+		payloads[i] = importPayload(record, params.Arguments.ProjectID, createdBy)
+		projectID, _ := payloads[i]["project_id"].(string)
+		if _, assigned := payloads[i]["assigned_to"]; assigned || projectID == "" {
+			continue
+		}
+		defaultAssignee, ok := defaultAssignees[projectID]
+		if !ok {
+			defaultAssignee = t.defaultAssigneeForProject(ctx, cfg, projectID)
+			defaultAssignees[projectID] = defaultAssignee
+		}
+		if defaultAssignee != "" {
+			payloads[i]["assigned_to"] = defaultAssignee
+		}
+	}
+
+	now := t.clock.Now()
+	created := make([]*Task, len(records))
+	errs := make([]error, len(records))
+	runBounded(len(records), bulkConcurrency, func(i int) {
+		taskRequest, err := validateImportRecord(cfg, records[i], payloads[i], now)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		taskResp, err := t.apiClient.Post(ctx, "/api/v1/tasks", taskRequest)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		var task Task
+		if err := json.Unmarshal(taskResp, &task); err != nil {
+			errs[i] = fmt.Errorf("failed to parse created task: %w", err)
+			return
+		}
+		created[i] = &task
+	})
+
+	createdTasks := []Task{}
+	rowErrors := []importRowError{}
+	for i, err := range errs {
+		if err != nil {
+			slog.Warn("Failed to import task row", "row", i+1, "task_name", records[i].TaskName, "error", err)
+			rowErrors = append(rowErrors, importRowError{Row: i + 1, TaskName: records[i].TaskName, Error: err.Error()})
+			continue
+		}
+		createdTasks = append(createdTasks, *created[i])
+	}
+
+	result := map[string]any{
+		"format":        params.Arguments.Format,
+		"total_rows":    len(records),
+		"created_count": len(createdTasks),
+		"failed_count":  len(rowErrors),
+		"created_tasks": createdTasks,
+		"row_errors":    rowErrors,
+		"created_by":    createdBy,
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Task Import\n===========\n\nRows: %d\nCreated: %d\nFailed: %d\n", len(records), len(createdTasks), len(rowErrors))
+	if len(createdTasks) > 0 {
+		responseText += "\n✅ Created Tasks:\n"
+		for _, task := range createdTasks {
+			responseText += fmt.Sprintf("- %s (%s)\n", task.TaskName, task.TaskID)
+		}
+	}
+	if len(rowErrors) > 0 {
+		responseText += "\n❌ Failed Rows:\n"
+		for _, rowError := range rowErrors {
+			responseText += fmt.Sprintf("- Row %d", rowError.Row)
+			if rowError.TaskName != "" {
+				responseText += fmt.Sprintf(" (%s)", rowError.TaskName)
+			}
+			responseText += fmt.Sprintf(": %s\n", rowError.Error)
+		}
+	}
+
+	slog.Info("Tasks imported", "rows", len(records), "created", len(createdTasks), "failed", len(rowErrors))
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
@@ -3,12 +3,18 @@ package tools
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/bchamber/taskman-mcp/internal/client"
+	"github.com/bchamber/taskman-mcp/internal/clock"
+	"github.com/bchamber/taskman-mcp/internal/config"
+	"github.com/bchamber/taskman-mcp/internal/tenant"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -170,7 +176,7 @@ func TestTaskTools_HandleGetTaskOverview(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskTools := NewTaskTools(apiClient)
+	taskTools := NewTaskTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -224,7 +230,7 @@ func TestTaskTools_HandleCreateTaskWithContext(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskTools := NewTaskTools(apiClient)
+	taskTools := NewTaskTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -280,7 +286,7 @@ func TestTaskTools_HandleGetTaskDetails(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskTools := NewTaskTools(apiClient)
+	taskTools := NewTaskTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -337,7 +343,7 @@ func TestTaskTools_HandleUpdateTaskProgress(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskTools := NewTaskTools(apiClient)
+	taskTools := NewTaskTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -395,7 +401,7 @@ func TestTaskTools_HandleUpdateTaskProgress_InvalidStatus(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskTools := NewTaskTools(apiClient)
+	taskTools := NewTaskTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -424,7 +430,7 @@ func TestTaskTools_HandleUpdateTaskProgress_InvalidPriority(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskTools := NewTaskTools(apiClient)
+	taskTools := NewTaskTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -453,7 +459,7 @@ func TestTaskTools_HandleGetTaskOverview_EmptyParams(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskTools := NewTaskTools(apiClient)
+	taskTools := NewTaskTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -471,12 +477,105 @@ func TestTaskTools_HandleGetTaskOverview_EmptyParams(t *testing.T) {
 	}
 }
 
+func TestTaskTools_HandleGetTaskOverview_ProjectsUnavailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v1/tasks":
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode([]Task{
+				{TaskID: "task-1", TaskName: "Test Task", Status: "In Progress", ProjectID: stringPtr("proj-1"), CreationDate: "2024-01-01T00:00:00Z"},
+			})
+		case "/api/v1/projects":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"error": "database unavailable"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
+	taskTools := NewTaskTools(apiClient, config.Default())
+
+	result, err := taskTools.HandleGetTaskOverview(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTaskOverviewParams]{
+		Arguments: GetTaskOverviewParams{},
+	})
+	if err != nil {
+		t.Fatalf("Expected overview to succeed without projects, got: %v", err)
+	}
+
+	if result.Meta["projects_available"] != false {
+		t.Errorf("Expected projects_available false, got %v", result.Meta["projects_available"])
+	}
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	if !strings.Contains(text, "Project context unavailable") {
+		t.Errorf("Expected project context warning, got: %s", text)
+	}
+	if strings.Contains(text, "Project Summary") {
+		t.Errorf("Expected project summary to be suppressed, got: %s", text)
+	}
+}
+
+func TestTaskTools_FetchesIndependentEndpointsConcurrently(t *testing.T) {
+	const delay = 200 * time.Millisecond
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(delay)
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/tasks":
+			json.NewEncoder(w).Encode([]Task{{TaskID: "task-1", TaskName: "Test Task", Status: "In Progress", CreationDate: "2024-01-01T00:00:00Z"}})
+		case "/api/v1/projects":
+			json.NewEncoder(w).Encode([]Project{{ProjectID: "proj-1", ProjectName: "Project"}})
+		case "/api/v1/tasks/task-1":
+			json.NewEncoder(w).Encode(Task{TaskID: "task-1", TaskName: "Test Task", Status: "In Progress", ProjectID: stringPtr("proj-1")})
+		case "/api/v1/tasks/task-1/notes":
+			json.NewEncoder(w).Encode([]TaskNote{{NoteID: "n1", Note: "Started"}})
+		case "/api/v1/projects/proj-1":
+			json.NewEncoder(w).Encode(Project{ProjectID: "proj-1", ProjectName: "Project"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+
+	// Tasks and projects overlap: about one delay rather than two
+	start := time.Now()
+	result, err := taskTools.HandleGetTaskOverview(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTaskOverviewParams]{})
+	if err != nil {
+		t.Fatalf("HandleGetTaskOverview failed: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed >= 2*delay-delay/4 {
+		t.Errorf("Expected overview fetches to overlap, took %v for two %v calls", elapsed, delay)
+	}
+	if result.Meta["projects_available"] != true {
+		t.Errorf("Expected projects to be available, got %v", result.Meta["projects_available"])
+	}
+
+	// The task and notes overlap; the project follows the task
+	start = time.Now()
+	result, err = taskTools.HandleGetTaskDetails(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTaskDetailsParams]{
+		Arguments: GetTaskDetailsParams{TaskID: "task-1"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetTaskDetails failed: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed >= 3*delay-delay/4 {
+		t.Errorf("Expected task and notes fetches to overlap, took %v for three %v calls", elapsed, delay)
+	}
+	if project, _ := result.Meta["project"].(*Project); result.Meta["notes_available"] != true || project == nil {
+		t.Errorf("Expected notes and project in details, got %+v", result.Meta)
+	}
+}
+
 func TestTaskTools_HandleCreateTaskWithContext_MissingRequiredFields(t *testing.T) {
 	server := createMockAPIServer()
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskTools := NewTaskTools(apiClient)
+	taskTools := NewTaskTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -518,7 +617,7 @@ func TestTaskTools_HandleCreateTaskWithContext_InvalidStatus(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskTools := NewTaskTools(apiClient)
+	taskTools := NewTaskTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -547,7 +646,7 @@ func TestTaskTools_HandleCreateTaskWithContext_InvalidPriority(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskTools := NewTaskTools(apiClient)
+	taskTools := NewTaskTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -571,12 +670,65 @@ func TestTaskTools_HandleCreateTaskWithContext_InvalidPriority(t *testing.T) {
 	}
 }
 
+func TestTaskTools_HandleCreateTaskWithContext_DueDate(t *testing.T) {
+	tests := []struct {
+		name    string
+		dueDate string
+		want    any // due_date sent to the API; nil when none is sent
+		wantErr bool
+	}{
+		{name: "date only", dueDate: "2024-06-30", want: "2024-06-30T00:00:00Z"},
+		{name: "RFC3339", dueDate: "2024-06-30T17:00:00+02:00", want: "2024-06-30T17:00:00+02:00"},
+		{name: "empty means no due date", dueDate: "", want: nil},
+		{name: "garbage", dueDate: "next tuesday", wantErr: true},
+		{name: "impossible date", dueDate: "2024-02-30", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var posted map[string]any
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "application/json")
+				if r.Method == "POST" && r.URL.Path == "/api/v1/tasks" {
+					json.NewDecoder(r.Body).Decode(&posted)
+				}
+				json.NewEncoder(w).Encode(Task{TaskID: "task-new", TaskName: "Dated task", Status: "Not Started"})
+			}))
+			defer server.Close()
+
+			taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+			_, err := taskTools.HandleCreateTaskWithContext(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[CreateTaskWithContextParams]{
+				Arguments: CreateTaskWithContextParams{TaskName: "Dated task", DueDate: tt.dueDate, InitialNote: "Scheduled", CreatedBy: "test.user"},
+			})
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Expected error for unparseable due_date")
+				}
+				if !strings.Contains(err.Error(), "invalid due_date") || !strings.Contains(err.Error(), "YYYY-MM-DD or RFC3339") {
+					t.Errorf("Expected error naming the accepted formats, got %q", err.Error())
+				}
+				if posted != nil {
+					t.Error("Expected no task to be created for an unparseable due_date")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("HandleCreateTaskWithContext failed: %v", err)
+			}
+			if posted["due_date"] != tt.want {
+				t.Errorf("Expected due_date %v to be sent, got %v", tt.want, posted["due_date"])
+			}
+		})
+	}
+}
+
 func TestTaskTools_HandleGetTaskDetails_MissingTaskID(t *testing.T) {
 	server := createMockAPIServer()
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskTools := NewTaskTools(apiClient)
+	taskTools := NewTaskTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -597,7 +749,7 @@ func TestTaskTools_HandleUpdateTaskProgress_MissingRequiredFields(t *testing.T)
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskTools := NewTaskTools(apiClient)
+	taskTools := NewTaskTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -639,7 +791,7 @@ func TestTaskTools_HandleSearchTasks(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskTools := NewTaskTools(apiClient)
+	taskTools := NewTaskTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -702,7 +854,7 @@ func TestTaskTools_HandleSearchTasks_EmptyParams(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskTools := NewTaskTools(apiClient)
+	taskTools := NewTaskTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -731,7 +883,7 @@ func TestTaskTools_HandleSearchTasks_WithTextSearch(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	taskTools := NewTaskTools(apiClient)
+	taskTools := NewTaskTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -756,3 +908,2724 @@ func TestTaskTools_HandleSearchTasks_WithTextSearch(t *testing.T) {
 		t.Error("Meta missing total_results")
 	}
 }
+
+func TestTaskTools_HandleSearchTasks_SearchModes(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		queries = append(queries, r.URL.RawQuery)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Task{
+			{TaskID: "t1", TaskName: "Fix login bug", Status: "Not Started"},
+			{TaskID: "t2", TaskName: "Fix signup bug", Status: "In Progress"},
+			{TaskID: "t3", TaskName: "Write docs", Status: "Not Started", TaskDescription: stringPtr("Document the login flow")},
+			{TaskID: "t4", TaskName: "Write docs for API", Status: "Not Started"},
+		})
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	search := func(args SearchTasksParams) ([]string, error) {
+		result, err := taskTools.HandleSearchTasks(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[SearchTasksParams]{Arguments: args})
+		if err != nil {
+			return nil, err
+		}
+		var ids []string
+		for _, task := range result.Meta["tasks"].([]Task) {
+			ids = append(ids, task.TaskID)
+		}
+		return ids, nil
+	}
+
+	ids, err := search(SearchTasksParams{SearchText: `^FIX (login|signup) bug$`, SearchMode: "regex"})
+	if err != nil {
+		t.Fatalf("Regex search failed: %v", err)
+	}
+	if strings.Join(ids, ",") != "t1,t2" {
+		t.Errorf("Expected regex to match t1 and t2, got %v", ids)
+	}
+	if strings.Contains(queries[len(queries)-1], "search=") {
+		t.Errorf("Expected regex not to be sent to the API as a substring, got %q", queries[len(queries)-1])
+	}
+
+	if _, err := search(SearchTasksParams{SearchText: "fix (login", SearchMode: "regex"}); err == nil || !strings.Contains(err.Error(), "invalid search_text regex") {
+		t.Errorf("Expected a clear error for an invalid pattern, got %v", err)
+	}
+
+	ids, err = search(SearchTasksParams{SearchText: "write docs", SearchMode: "exact"})
+	if err != nil {
+		t.Fatalf("Exact search failed: %v", err)
+	}
+	if strings.Join(ids, ",") != "t3" {
+		t.Errorf("Expected exact mode to match only t3, got %v", ids)
+	}
+
+	ids, err = search(SearchTasksParams{SearchText: "login"})
+	if err != nil {
+		t.Fatalf("Substring search failed: %v", err)
+	}
+	if strings.Join(ids, ",") != "t1,t3" {
+		t.Errorf("Expected substring default to match names and descriptions, got %v", ids)
+	}
+}
+
+func TestTaskTools_HandleSearchTasks_SortsClientSide(t *testing.T) {
+	// The API ignores sort_by and limit here, so both must be applied locally
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Task{
+			{TaskID: "low", TaskName: "Low", Status: "Not Started", Priority: stringPtr("Low"), DueDate: stringPtr("2024-01-01")},
+			{TaskID: "unset", TaskName: "Unset", Status: "Not Started"},
+			{TaskID: "high", TaskName: "High", Status: "Not Started", Priority: stringPtr("High"), DueDate: stringPtr("2024-03-01")},
+			{TaskID: "medium", TaskName: "Medium", Status: "Not Started", Priority: stringPtr("Medium"), DueDate: stringPtr("2024-02-01")},
+		})
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	search := func(args SearchTasksParams) []string {
+		t.Helper()
+		result, err := taskTools.HandleSearchTasks(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[SearchTasksParams]{Arguments: args})
+		if err != nil {
+			t.Fatalf("HandleSearchTasks failed: %v", err)
+		}
+		var ids []string
+		for _, task := range result.Meta["tasks"].([]Task) {
+			ids = append(ids, task.TaskID)
+		}
+		return ids
+	}
+
+	if ids := search(SearchTasksParams{SortBy: "priority", SortOrder: "desc", Limit: 2}); strings.Join(ids, ",") != "high,medium" {
+		t.Errorf("Expected the two highest priorities after sorting, got %v", ids)
+	}
+	if ids := search(SearchTasksParams{SortBy: "due_date", SortOrder: "desc"}); strings.Join(ids, ",") != "high,medium,low,unset" {
+		t.Errorf("Expected latest due first with no due date last, got %v", ids)
+	}
+
+	// Listed enum values pass validation in any case and sort the same
+	result, err := Validated(taskTools.HandleSearchTasks)(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[SearchTasksParams]{
+		Arguments: SearchTasksParams{SortBy: "Due_Date", SortOrder: "DESC"},
+	})
+	if err != nil {
+		t.Fatalf("HandleSearchTasks failed: %v", err)
+	}
+	var ids []string
+	for _, task := range result.Meta["tasks"].([]Task) {
+		ids = append(ids, task.TaskID)
+	}
+	if strings.Join(ids, ",") != "high,medium,low,unset" {
+		t.Errorf("Expected mixed-case sort arguments to sort like lowercase ones, got %v", ids)
+	}
+}
+
+func TestTaskTools_HandleArchiveCompletedTasks(t *testing.T) {
+	var mutex sync.Mutex
+	archivedIDs := map[string]bool{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v1/tasks":
+			tasks := []Task{
+				{TaskID: "old-1", TaskName: "Old Task 1", Status: "Complete", ProjectID: stringPtr("proj-1"), CompletionDate: stringPtr("2024-01-05T10:00:00Z")},
+				{TaskID: "old-2", TaskName: "Old Task 2", Status: "Complete", ProjectID: stringPtr("proj-1"), CompletionDate: stringPtr("2024-01-20T10:00:00Z")},
+				{TaskID: "recent", TaskName: "Recent Task", Status: "Complete", ProjectID: stringPtr("proj-1"), CompletionDate: stringPtr("2024-03-01T10:00:00Z")},
+				{TaskID: "already", TaskName: "Already Archived", Status: "Complete", Archived: true, CompletionDate: stringPtr("2024-01-01T10:00:00Z")},
+			}
+			json.NewEncoder(w).Encode(tasks)
+
+		case r.Method == "PUT" && strings.HasPrefix(r.URL.Path, "/api/v1/tasks/"):
+			var req map[string]interface{}
+			json.NewDecoder(r.Body).Decode(&req)
+			if req["archived"] != true {
+				t.Errorf("Expected archived=true in update, got %v", req["archived"])
+			}
+
+			mutex.Lock()
+			archivedIDs[strings.TrimPrefix(r.URL.Path, "/api/v1/tasks/")] = true
+			mutex.Unlock()
+			json.NewEncoder(w).Encode(Task{})
+
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
+	taskTools := NewTaskTools(apiClient, config.Default())
+
+	ctx := context.Background()
+	session := &mcp.ServerSession{}
+
+	// Refuse to archive without a scope
+	_, err := taskTools.HandleArchiveCompletedTasks(ctx, session, &mcp.CallToolParamsFor[ArchiveCompletedTasksParams]{
+		Arguments: ArchiveCompletedTasksParams{ArchivedBy: "test.user"},
+	})
+	if err == nil {
+		t.Fatal("Expected error when no scope is provided")
+	}
+
+	params := &mcp.CallToolParamsFor[ArchiveCompletedTasksParams]{
+		Arguments: ArchiveCompletedTasksParams{
+			CompletedBefore: "2024-02-01",
+			ArchivedBy:      "test.user",
+		},
+	}
+
+	result, err := taskTools.HandleArchiveCompletedTasks(ctx, session, params)
+	if err != nil {
+		t.Fatalf("HandleArchiveCompletedTasks failed: %v", err)
+	}
+
+	if count := result.Meta["archived_count"].(int); count != 2 {
+		t.Errorf("Expected 2 tasks archived, got %d", count)
+	}
+	if !archivedIDs["old-1"] || !archivedIDs["old-2"] {
+		t.Errorf("Expected old-1 and old-2 archived, got %v", archivedIDs)
+	}
+	if archivedIDs["recent"] {
+		t.Error("Expected recently completed task to be left alone")
+	}
+	if archivedIDs["already"] {
+		t.Error("Expected already-archived task to be skipped")
+	}
+}
+
+func TestTaskTools_PriorityLabelsAppliedAcrossTools(t *testing.T) {
+	server := createMockAPIServer()
+	defer server.Close()
+
+	cfg := config.Default()
+	cfg.PriorityStyle = "decorated"
+	cfg.PriorityLabels = map[string]string{"High": "[P1]"}
+
+	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
+	taskTools := NewTaskTools(apiClient, cfg)
+
+	ctx := context.Background()
+	session := &mcp.ServerSession{}
+
+	detailsResult, err := taskTools.HandleGetTaskDetails(ctx, session, &mcp.CallToolParamsFor[GetTaskDetailsParams]{
+		Arguments: GetTaskDetailsParams{TaskID: "task-1"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetTaskDetails failed: %v", err)
+	}
+	detailsText := detailsResult.Content[0].(*mcp.TextContent).Text
+	if !strings.Contains(detailsText, "Priority: [P1]") {
+		t.Errorf("Expected details to render High as [P1], got: %s", detailsText)
+	}
+
+	searchResult, err := taskTools.HandleSearchTasks(ctx, session, &mcp.CallToolParamsFor[SearchTasksParams]{
+		Arguments: SearchTasksParams{Priority: "High"},
+	})
+	if err != nil {
+		t.Fatalf("HandleSearchTasks failed: %v", err)
+	}
+	searchText := searchResult.Content[0].(*mcp.TextContent).Text
+	if !strings.Contains(searchText, "[P1]") {
+		t.Errorf("Expected search results to render High as [P1], got: %s", searchText)
+	}
+	if strings.Contains(searchText, "(In Progress, High)") {
+		t.Errorf("Expected no undecorated High priority in search results, got: %s", searchText)
+	}
+}
+
+func TestTaskTools_HandleGetBoard(t *testing.T) {
+	tasks := []Task{
+		{TaskID: "t1", TaskName: "Done thing", Status: "Complete"},
+		{TaskID: "t2", TaskName: "Low work", Status: "In Progress", Priority: stringPtr("Low")},
+		{TaskID: "t3", TaskName: "Urgent work", Status: "In Progress", Priority: stringPtr("High")},
+		{TaskID: "t4", TaskName: "More work", Status: "In Progress", Priority: stringPtr("Medium")},
+		{TaskID: "t5", TaskName: "Waiting", Status: "Blocked"},
+		{TaskID: "t6", TaskName: "Backlog item", Status: "Not Started"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "GET" && r.URL.Path == "/api/v1/tasks" {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(tasks)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	cfg := config.Default()
+	cfg.WIPLimit = 2
+
+	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
+	taskTools := NewTaskTools(apiClient, cfg)
+
+	ctx := context.Background()
+	session := &mcp.ServerSession{}
+	params := &mcp.CallToolParamsFor[GetBoardParams]{
+		Arguments: GetBoardParams{},
+	}
+
+	result, err := taskTools.HandleGetBoard(ctx, session, params)
+	if err != nil {
+		t.Fatalf("HandleGetBoard failed: %v", err)
+	}
+
+	columns, ok := result.Meta["columns"].([]map[string]any)
+	if !ok {
+		t.Fatal("Expected columns in result meta")
+	}
+
+	expectedOrder := []string{"Not Started", "In Progress", "Blocked", "Review", "Complete"}
+	if len(columns) != len(expectedOrder) {
+		t.Fatalf("Expected %d columns, got %d", len(expectedOrder), len(columns))
+	}
+	for i, status := range expectedOrder {
+		if columns[i]["status"] != status {
+			t.Errorf("Expected column %d to be %s, got %v", i, status, columns[i]["status"])
+		}
+	}
+
+	inProgress := columns[1]["tasks"].([]Task)
+	if len(inProgress) != 3 || inProgress[0].TaskID != "t3" || inProgress[1].TaskID != "t4" || inProgress[2].TaskID != "t2" {
+		t.Errorf("Expected In Progress column sorted by priority, got %+v", inProgress)
+	}
+
+	if result.Meta["wip_exceeded"] != true {
+		t.Error("Expected WIP limit to be exceeded")
+	}
+
+	textContent := result.Content[0].(*mcp.TextContent)
+	if !strings.Contains(textContent.Text, "WIP limit exceeded: 3 tasks In Progress (limit 2)") {
+		t.Errorf("Expected WIP warning in response text, got: %s", textContent.Text)
+	}
+	if strings.Index(textContent.Text, "Not Started") > strings.Index(textContent.Text, "Complete (") {
+		t.Error("Expected columns to be rendered in canonical order")
+	}
+
+	// At the limit no warning is raised
+	cfg.WIPLimit = 3
+	result, err = taskTools.HandleGetBoard(ctx, session, params)
+	if err != nil {
+		t.Fatalf("HandleGetBoard failed: %v", err)
+	}
+	if result.Meta["wip_exceeded"] != false {
+		t.Error("Expected WIP limit not to be exceeded at the limit")
+	}
+}
+
+func TestTaskTools_HandleRouteBlockedToBlockerOwner(t *testing.T) {
+	var mu sync.Mutex
+	var updates []map[string]any
+	var notes []map[string]any
+
+	tasks := map[string]Task{
+		"blocked": {TaskID: "blocked", TaskName: "Ship release", Status: "Blocked", AssignedTo: stringPtr("alice"), BlockedBy: []string{"blocker"}},
+		"blocker": {TaskID: "blocker", TaskName: "Fix infra", Status: "In Progress", AssignedTo: stringPtr("bob")},
+		"orphan":  {TaskID: "orphan", TaskName: "Waiting on nobody", Status: "Blocked", AssignedTo: stringPtr("alice"), BlockedBy: []string{"unowned"}},
+		"unowned": {TaskID: "unowned", TaskName: "Unowned work", Status: "Not Started"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/tasks/")
+		switch {
+		case r.Method == "GET" && !strings.Contains(id, "/"):
+			task, ok := tasks[id]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(task)
+		case r.Method == "PUT":
+			var body map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			updates = append(updates, body)
+			task := tasks[id]
+			assignee := body["assigned_to"].(string)
+			task.AssignedTo = &assignee
+			tasks[id] = task
+			json.NewEncoder(w).Encode(task)
+		case r.Method == "POST" && strings.HasSuffix(id, "/notes"):
+			var body map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			notes = append(notes, body)
+			json.NewEncoder(w).Encode(TaskNote{NoteID: "note-1", Note: body["note"].(string)})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
+	taskTools := NewTaskTools(apiClient, config.Default())
+	ctx := context.Background()
+	session := &mcp.ServerSession{}
+
+	t.Run("routes to blocker owner", func(t *testing.T) {
+		result, err := taskTools.HandleRouteBlockedToBlockerOwner(ctx, session, &mcp.CallToolParamsFor[RouteBlockedToBlockerOwnerParams]{
+			Arguments: RouteBlockedToBlockerOwnerParams{TaskID: "blocked", RoutedBy: "lead"},
+		})
+		if err != nil {
+			t.Fatalf("HandleRouteBlockedToBlockerOwner failed: %v", err)
+		}
+
+		if result.Meta["routed"] != true || result.Meta["new_assignee"] != "bob" {
+			t.Errorf("Expected task routed to bob, got meta %+v", result.Meta)
+		}
+		if len(updates) != 1 || updates[0]["assigned_to"] != "bob" || updates[0]["last_updated_by"] != "lead" {
+			t.Errorf("Expected one reassignment to bob by lead, got %+v", updates)
+		}
+		if len(notes) != 1 || !strings.Contains(notes[0]["note"].(string), "Fix infra") {
+			t.Errorf("Expected explanatory note referencing the blocker, got %+v", notes)
+		}
+	})
+
+	t.Run("leaves task when blocker is unassigned", func(t *testing.T) {
+		updates, notes = nil, nil
+		result, err := taskTools.HandleRouteBlockedToBlockerOwner(ctx, session, &mcp.CallToolParamsFor[RouteBlockedToBlockerOwnerParams]{
+			Arguments: RouteBlockedToBlockerOwnerParams{TaskID: "orphan", RoutedBy: "lead"},
+		})
+		if err != nil {
+			t.Fatalf("HandleRouteBlockedToBlockerOwner failed: %v", err)
+		}
+		if result.Meta["routed"] != false || result.Meta["reason"] != "blocker has no assignee" {
+			t.Errorf("Expected no routing, got meta %+v", result.Meta)
+		}
+		if len(updates) != 0 || len(notes) != 0 {
+			t.Errorf("Expected no writes, got updates %+v notes %+v", updates, notes)
+		}
+	})
+
+	t.Run("rejects tasks that are not blocked", func(t *testing.T) {
+		_, err := taskTools.HandleRouteBlockedToBlockerOwner(ctx, session, &mcp.CallToolParamsFor[RouteBlockedToBlockerOwnerParams]{
+			Arguments: RouteBlockedToBlockerOwnerParams{TaskID: "blocker", RoutedBy: "lead"},
+		})
+		if err == nil || !strings.Contains(err.Error(), "not Blocked") {
+			t.Errorf("Expected not Blocked error, got %v", err)
+		}
+	})
+}
+
+func TestTaskTools_HandleReassignTask(t *testing.T) {
+	var mu sync.Mutex
+	var updates []map[string]any
+	var notes []map[string]any
+
+	tasks := map[string]Task{
+		"active":  {TaskID: "active", TaskName: "Write docs", Status: "In Progress", AssignedTo: stringPtr("alice")},
+		"blocked": {TaskID: "blocked", TaskName: "Ship release", Status: "Blocked", BlockedBy: []string{"active"}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+
+		id := strings.TrimPrefix(r.URL.Path, "/api/v1/tasks/")
+		switch {
+		case r.Method == "GET" && !strings.Contains(id, "/"):
+			task, ok := tasks[id]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			json.NewEncoder(w).Encode(task)
+		case r.Method == "PUT":
+			var body map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			updates = append(updates, body)
+			task := tasks[id]
+			assignee := body["assigned_to"].(string)
+			task.AssignedTo = &assignee
+			tasks[id] = task
+			json.NewEncoder(w).Encode(task)
+		case r.Method == "POST" && strings.HasSuffix(id, "/notes"):
+			var body map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			notes = append(notes, body)
+			json.NewEncoder(w).Encode(TaskNote{NoteID: "note-1", Note: body["note"].(string)})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	ctx := context.Background()
+	session := &mcp.ServerSession{}
+	reassign := func(args ReassignTaskParams) *mcp.CallToolResultFor[map[string]any] {
+		t.Helper()
+		result, err := taskTools.HandleReassignTask(ctx, session, &mcp.CallToolParamsFor[ReassignTaskParams]{Arguments: args})
+		if err != nil {
+			t.Fatalf("HandleReassignTask failed: %v", err)
+		}
+		return result
+	}
+
+	t.Run("updates only the assignee and records a note", func(t *testing.T) {
+		result := reassign(ReassignTaskParams{TaskID: "active", AssignedTo: "bob", UpdatedBy: "lead", Reason: "alice is on leave"})
+
+		if result.Meta["previous_assignee"] != "alice" || result.Meta["new_assignee"] != "bob" || result.Meta["reassigned"] != true {
+			t.Errorf("Expected alice → bob in meta, got %+v", result.Meta)
+		}
+		if len(updates) != 1 || len(updates[0]) != 2 || updates[0]["assigned_to"] != "bob" || updates[0]["last_updated_by"] != "lead" {
+			t.Errorf("Expected a PUT of only assigned_to and last_updated_by, got %+v", updates)
+		}
+		if len(notes) != 1 || notes[0]["note"] != "Reassigned from alice to bob: alice is on leave" || notes[0]["created_by"] != "lead" {
+			t.Errorf("Expected a reassignment note with the reason, got %+v", notes)
+		}
+		if _, warned := result.Meta["warning"]; warned {
+			t.Errorf("Expected no warning for an In Progress task, got %v", result.Meta["warning"])
+		}
+	})
+
+	t.Run("warns when the task is Blocked", func(t *testing.T) {
+		updates, notes = nil, nil
+		result := reassign(ReassignTaskParams{TaskID: "blocked", AssignedTo: "carol", UpdatedBy: "lead"})
+
+		if result.Meta["previous_assignee"] != "" || result.Meta["warning"] == nil {
+			t.Errorf("Expected a Blocked warning for an unassigned task, got %+v", result.Meta)
+		}
+		if len(notes) != 1 || notes[0]["note"] != "Reassigned from Unassigned to carol" {
+			t.Errorf("Expected a note without a reason, got %+v", notes)
+		}
+		if text := result.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "Blocked") {
+			t.Errorf("Expected a Blocked warning in text, got:\n%s", text)
+		}
+	})
+
+	t.Run("same assignee is a no-op", func(t *testing.T) {
+		updates, notes = nil, nil
+		result := reassign(ReassignTaskParams{TaskID: "active", AssignedTo: "bob", UpdatedBy: "lead"})
+
+		if result.Meta["reassigned"] != false || len(updates) != 0 || len(notes) != 0 {
+			t.Errorf("Expected no writes, got meta %+v updates %+v notes %+v", result.Meta, updates, notes)
+		}
+	})
+}
+
+func TestTaskTools_HandleSearchTasks_TextSizeCap(t *testing.T) {
+	tasks := make([]Task, 0, 500)
+	for i := 0; i < 500; i++ {
+		tasks = append(tasks, Task{
+			TaskID:     fmt.Sprintf("task-%d", i),
+			TaskName:   fmt.Sprintf("Generated task number %d with a reasonably long name", i),
+			Status:     "In Progress",
+			Priority:   stringPtr("Medium"),
+			AssignedTo: stringPtr("john.doe"),
+		})
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(tasks)
+	}))
+	defer server.Close()
+
+	cfg := config.Default()
+	cfg.SearchMaxTasksShown = 1000
+	cfg.SearchMaxTextBytes = 2048
+
+	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
+	taskTools := NewTaskTools(apiClient, cfg)
+
+	result, err := taskTools.HandleSearchTasks(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[SearchTasksParams]{
+		Arguments: SearchTasksParams{Status: "In Progress"},
+	})
+	if err != nil {
+		t.Fatalf("HandleSearchTasks failed: %v", err)
+	}
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	if len(text) > cfg.SearchMaxTextBytes {
+		t.Errorf("Expected text within %d bytes, got %d", cfg.SearchMaxTextBytes, len(text))
+	}
+	if !strings.Contains(text, "results truncated in display") {
+		t.Error("Expected truncation note in response text")
+	}
+	if result.Meta["display_truncated"] != true {
+		t.Error("Expected display_truncated in meta")
+	}
+
+	metaTasks, ok := result.Meta["tasks"].([]Task)
+	if !ok || len(metaTasks) != 500 {
+		t.Errorf("Expected all 500 tasks in meta, got %d", len(metaTasks))
+	}
+}
+
+func TestTaskTools_HandleGetNoteContributions(t *testing.T) {
+	tasks := []Task{
+		{TaskID: "t1", TaskName: "Design API", Status: "In Progress", ProjectID: stringPtr("proj-1")},
+		{TaskID: "t2", TaskName: "Write docs", Status: "Not Started", ProjectID: stringPtr("proj-1")},
+	}
+	notes := map[string][]TaskNote{
+		"t1": {
+			{NoteID: "n1", TaskID: "t1", CreatedBy: "alice", CreationDate: "2024-03-02T10:00:00Z"},
+			{NoteID: "n2", TaskID: "t1", CreatedBy: "alice", CreationDate: "2024-03-05T10:00:00Z"},
+			{NoteID: "n3", TaskID: "t1", CreatedBy: "bob", CreationDate: "2024-02-20T10:00:00Z"},
+		},
+		"t2": {
+			{NoteID: "n4", TaskID: "t2", CreatedBy: "bob", CreationDate: "2024-03-03T10:00:00Z"},
+			{NoteID: "n5", TaskID: "t2", CreatedBy: "alice", CreationDate: "2024-01-15T10:00:00Z"},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/v1/tasks" {
+			json.NewEncoder(w).Encode(tasks)
+			return
+		}
+		if strings.HasSuffix(r.URL.Path, "/notes") {
+			taskID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v1/tasks/"), "/notes")
+			json.NewEncoder(w).Encode(notes[taskID])
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
+	taskTools := NewTaskTools(apiClient, config.Default())
+
+	result, err := taskTools.HandleGetNoteContributions(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetNoteContributionsParams]{
+		Arguments: GetNoteContributionsParams{ProjectID: "proj-1", Since: "2024-03-01"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetNoteContributions failed: %v", err)
+	}
+
+	if result.Meta["total_notes"] != 3 {
+		t.Errorf("Expected 3 notes within the window, got %v", result.Meta["total_notes"])
+	}
+
+	contributors := result.Meta["contributors"].([]map[string]any)
+	if len(contributors) != 2 {
+		t.Fatalf("Expected 2 contributors, got %d", len(contributors))
+	}
+	if contributors[0]["author"] != "alice" || contributors[0]["note_count"] != 2 {
+		t.Errorf("Expected alice first with 2 notes, got %+v", contributors[0])
+	}
+	if contributors[1]["author"] != "bob" || contributors[1]["note_count"] != 1 {
+		t.Errorf("Expected bob second with 1 note, got %+v", contributors[1])
+	}
+
+	topTasks := contributors[0]["top_tasks"].([]map[string]any)
+	if len(topTasks) != 1 || topTasks[0]["task_name"] != "Design API" {
+		t.Errorf("Expected alice's most-touched task to be Design API, got %+v", topTasks)
+	}
+
+	if _, err := taskTools.HandleGetNoteContributions(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetNoteContributionsParams]{
+		Arguments: GetNoteContributionsParams{Since: "not-a-date"},
+	}); err == nil {
+		t.Error("Expected error for invalid since date")
+	}
+}
+
+func TestTaskTools_HandleSplitTask(t *testing.T) {
+	var mu sync.Mutex
+	var created []map[string]any
+	var notes []map[string]any
+	var updates []map[string]any
+
+	source := Task{
+		TaskID:     "task-big",
+		TaskName:   "Build reporting",
+		Status:     "In Progress",
+		Priority:   stringPtr("High"),
+		AssignedTo: stringPtr("alice"),
+		ProjectID:  stringPtr("proj-1"),
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v1/tasks/task-big":
+			json.NewEncoder(w).Encode(source)
+		case r.Method == "POST" && r.URL.Path == "/api/v1/tasks":
+			var body map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			created = append(created, body)
+			task := Task{TaskID: fmt.Sprintf("split-%d", len(created)), TaskName: body["task_name"].(string), Status: "Not Started"}
+			if v, ok := body["project_id"].(string); ok {
+				task.ProjectID = &v
+			}
+			if v, ok := body["priority"].(string); ok {
+				task.Priority = &v
+			}
+			json.NewEncoder(w).Encode(task)
+		case r.Method == "POST" && r.URL.Path == "/api/v1/tasks/task-big/notes":
+			var body map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			notes = append(notes, body)
+			json.NewEncoder(w).Encode(TaskNote{NoteID: "note-1"})
+		case r.Method == "PUT" && r.URL.Path == "/api/v1/tasks/task-big":
+			var body map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			updates = append(updates, body)
+			json.NewEncoder(w).Encode(source)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
+	taskTools := NewTaskTools(apiClient, config.Default())
+	ctx := context.Background()
+	session := &mcp.ServerSession{}
+
+	result, err := taskTools.HandleSplitTask(ctx, session, &mcp.CallToolParamsFor[SplitTaskParams]{
+		Arguments: SplitTaskParams{
+			TaskID:       "task-big",
+			NewTaskNames: []string{"Reporting backend", "Reporting UI"},
+			SplitBy:      "bob",
+			MarkParent:   true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("HandleSplitTask failed: %v", err)
+	}
+
+	if len(created) != 2 {
+		t.Fatalf("Expected 2 created tasks, got %d", len(created))
+	}
+	for _, body := range created {
+		if body["project_id"] != "proj-1" || body["priority"] != "High" || body["assigned_to"] != "alice" {
+			t.Errorf("Expected split task to inherit project, priority and assignee, got %+v", body)
+		}
+	}
+
+	createdIDs := result.Meta["created_task_ids"].([]string)
+	if len(createdIDs) != 2 || createdIDs[0] != "split-1" || createdIDs[1] != "split-2" {
+		t.Errorf("Expected created task IDs in meta, got %v", createdIDs)
+	}
+
+	if len(notes) != 1 || !strings.Contains(notes[0]["note"].(string), "split-1") {
+		t.Errorf("Expected split note referencing the new tasks, got %+v", notes)
+	}
+	if len(updates) != 1 || result.Meta["marked_parent"] != true {
+		t.Errorf("Expected original to be marked as parent, got %+v", updates)
+	}
+
+	// Fewer than two pieces is rejected
+	_, err = taskTools.HandleSplitTask(ctx, session, &mcp.CallToolParamsFor[SplitTaskParams]{
+		Arguments: SplitTaskParams{TaskID: "task-big", NewTaskNames: []string{"Only one", " "}, SplitBy: "bob"},
+	})
+	if err == nil || !strings.Contains(err.Error(), "at least two") {
+		t.Errorf("Expected at least two pieces error, got %v", err)
+	}
+}
+
+func TestTaskTools_HandleGetEscalationDigest(t *testing.T) {
+	now := time.Now().UTC()
+	daysAgo := func(days int) *string {
+		value := now.Add(-time.Duration(days) * 24 * time.Hour).Format(time.RFC3339)
+		return &value
+	}
+	created := now.Add(-60 * 24 * time.Hour).Format(time.RFC3339)
+
+	tasks := []Task{
+		{TaskID: "o1", TaskName: "Overdue report", Status: "In Progress", Priority: stringPtr("High"), DueDate: daysAgo(4), CreationDate: created, LastUpdateDate: daysAgo(1)},
+		{TaskID: "o2", TaskName: "Overdue review", Status: "Review", DueDate: daysAgo(2), CreationDate: created, LastUpdateDate: daysAgo(1)},
+		{TaskID: "b1", TaskName: "Waiting on vendor", Status: "Blocked", CreationDate: created, LastUpdateDate: daysAgo(10)},
+		{TaskID: "b2", TaskName: "Recently blocked", Status: "Blocked", CreationDate: created, LastUpdateDate: daysAgo(1)},
+		{TaskID: "s1", TaskName: "Forgotten priority", Status: "Not Started", Priority: stringPtr("High"), CreationDate: created, LastUpdateDate: daysAgo(20)},
+		{TaskID: "c1", TaskName: "Done", Status: "Complete", DueDate: daysAgo(30), CreationDate: created},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(tasks)
+	}))
+	defer server.Close()
+
+	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
+	taskTools := NewTaskTools(apiClient, config.Default())
+
+	result, err := taskTools.HandleGetEscalationDigest(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetEscalationDigestParams]{
+		Arguments: GetEscalationDigestParams{},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetEscalationDigest failed: %v", err)
+	}
+
+	if result.Meta["overdue_count"] != 2 {
+		t.Errorf("Expected 2 overdue tasks, got %v", result.Meta["overdue_count"])
+	}
+	if result.Meta["blocked_count"] != 1 {
+		t.Errorf("Expected 1 long-blocked task, got %v", result.Meta["blocked_count"])
+	}
+	if result.Meta["stale_count"] != 1 {
+		t.Errorf("Expected 1 stale high-priority task, got %v", result.Meta["stale_count"])
+	}
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	for _, expected := range []string{
+		"**Summary:** 2 overdue · 1 long-blocked · 1 stale high-priority",
+		"## 🚨 Overdue (2)",
+		"## ⛔ Long-Blocked (1)",
+		"**Waiting on vendor** — blocked for 10 days",
+	} {
+		if !strings.Contains(text, expected) {
+			t.Errorf("Expected digest to contain %q, got:\n%s", expected, text)
+		}
+	}
+	if strings.Index(text, "Overdue report") > strings.Index(text, "Overdue review") {
+		t.Error("Expected high-priority overdue task listed first")
+	}
+	if strings.Contains(text, "Recently blocked") {
+		t.Error("Expected recently blocked task to be excluded")
+	}
+}
+
+func TestTaskTools_ActorFieldRequirement(t *testing.T) {
+	var mu sync.Mutex
+	var noteAuthors []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v1/tasks/task-1":
+			json.NewEncoder(w).Encode(Task{TaskID: "task-1", TaskName: "Test Task", Status: "In Progress"})
+		case r.Method == "POST" && r.URL.Path == "/api/v1/tasks/task-1/notes":
+			var body map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			author, _ := body["created_by"].(string)
+			noteAuthors = append(noteAuthors, author)
+			json.NewEncoder(w).Encode(TaskNote{NoteID: "note-1", TaskID: "task-1", CreatedBy: author})
+		case r.Method == "PUT" && r.URL.Path == "/api/v1/tasks/task-1":
+			json.NewEncoder(w).Encode(Task{TaskID: "task-1", TaskName: "Test Task", Status: "Review"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
+	ctx := context.Background()
+	session := &mcp.ServerSession{}
+
+	addNote := func(tools *TaskTools, createdBy string) error {
+		_, err := tools.HandleAddTaskNote(ctx, session, &mcp.CallToolParamsFor[AddTaskNoteParams]{
+			Arguments: AddTaskNoteParams{TaskID: "task-1", Note: "Progress update", CreatedBy: createdBy},
+		})
+		return err
+	}
+	updateProgress := func(tools *TaskTools, updatedBy string) error {
+		_, err := tools.HandleUpdateTaskProgress(ctx, session, &mcp.CallToolParamsFor[UpdateTaskProgressParams]{
+			Arguments: UpdateTaskProgressParams{TaskID: "task-1", Status: "Review", ProgressNote: "Ready", UpdatedBy: updatedBy},
+		})
+		return err
+	}
+
+	t.Run("strict mode", func(t *testing.T) {
+		noteAuthors = nil
+		strictTools := NewTaskTools(apiClient, config.Default())
+
+		if err := addNote(strictTools, ""); err == nil || !strings.Contains(err.Error(), "created_by is required") {
+			t.Errorf("Expected created_by is required, got %v", err)
+		}
+		if err := updateProgress(strictTools, ""); err == nil || !strings.Contains(err.Error(), "updated_by is required") {
+			t.Errorf("Expected updated_by is required, got %v", err)
+		}
+		if err := addNote(strictTools, "alice"); err != nil {
+			t.Errorf("Expected note with actor to succeed, got %v", err)
+		}
+		if len(noteAuthors) != 1 || noteAuthors[0] != "alice" {
+			t.Errorf("Expected note recorded by alice, got %v", noteAuthors)
+		}
+	})
+
+	t.Run("optional mode", func(t *testing.T) {
+		noteAuthors = nil
+		cfg := config.Default()
+		cfg.RequireActorFields = false
+		cfg.DefaultCreatedBy = "upstream-gateway"
+		lenientTools := NewTaskTools(apiClient, cfg)
+
+		if err := addNote(lenientTools, ""); err != nil {
+			t.Errorf("Expected note without actor to succeed, got %v", err)
+		}
+		if err := addNote(lenientTools, "alice"); err != nil {
+			t.Errorf("Expected note with actor to succeed, got %v", err)
+		}
+		if err := updateProgress(lenientTools, ""); err != nil {
+			t.Errorf("Expected update without actor to succeed, got %v", err)
+		}
+		if len(noteAuthors) != 3 || noteAuthors[0] != "upstream-gateway" || noteAuthors[1] != "alice" || noteAuthors[2] != "upstream-gateway" {
+			t.Errorf("Expected fallback and explicit actors to be recorded, got %v", noteAuthors)
+		}
+	})
+}
+
+func TestTaskTools_HandleGetTaskDetails_CapsNotes(t *testing.T) {
+	const totalNotes = 8
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/tasks/task-1":
+			json.NewEncoder(w).Encode(Task{TaskID: "task-1", TaskName: "Long-lived task", Status: "In Progress"})
+		case "/api/v1/tasks/task-1/notes":
+			var notes []TaskNote
+			for i := 1; i <= totalNotes; i++ {
+				notes = append(notes, TaskNote{
+					NoteID:       fmt.Sprintf("note-%d", i),
+					TaskID:       "task-1",
+					Note:         fmt.Sprintf("Update %d", i),
+					CreatedBy:    "alice",
+					CreationDate: fmt.Sprintf("2024-01-%02dT10:00:00Z", i),
+				})
+			}
+			json.NewEncoder(w).Encode(notes)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	cfg := config.Default()
+	cfg.MaxNotesReturned = 3
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+
+	params := &mcp.CallToolParamsFor[GetTaskDetailsParams]{
+		Arguments: GetTaskDetailsParams{TaskID: "task-1", NotesLimit: 100},
+	}
+
+	result, err := taskTools.HandleGetTaskDetails(context.Background(), &mcp.ServerSession{}, params)
+	if err != nil {
+		t.Fatalf("HandleGetTaskDetails failed: %v", err)
+	}
+
+	notes, ok := result.Meta["notes"].([]TaskNote)
+	if !ok {
+		t.Fatalf("Expected notes to be []TaskNote, got %T", result.Meta["notes"])
+	}
+	if len(notes) != 3 {
+		t.Fatalf("Expected 3 notes after cap, got %d", len(notes))
+	}
+	if notes[0].NoteID != "note-8" || notes[2].NoteID != "note-6" {
+		t.Errorf("Expected newest notes first, got %s..%s", notes[0].NoteID, notes[2].NoteID)
+	}
+	if result.Meta["note_count"] != totalNotes {
+		t.Errorf("Expected note_count %d, got %v", totalNotes, result.Meta["note_count"])
+	}
+	if result.Meta["notes_shown"] != 3 {
+		t.Errorf("Expected notes_shown 3, got %v", result.Meta["notes_shown"])
+	}
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	if !strings.Contains(text, "showing 3 of 8") {
+		t.Errorf("Expected 'showing 3 of 8' indicator in text, got:\n%s", text)
+	}
+	if strings.Contains(text, "Update 1 ") || strings.Contains(text, "Update 5 ") {
+		t.Error("Expected older notes to be omitted from text")
+	}
+
+	// A higher cap returns more notes in metadata, but the text lists only a few
+	cfg.MaxNotesReturned = 50
+	taskTools = NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+	result, err = taskTools.HandleGetTaskDetails(context.Background(), &mcp.ServerSession{}, params)
+	if err != nil {
+		t.Fatalf("HandleGetTaskDetails failed: %v", err)
+	}
+	if notes := result.Meta["notes"].([]TaskNote); len(notes) != totalNotes {
+		t.Errorf("Expected all %d notes in metadata, got %d", totalNotes, len(notes))
+	}
+	text = result.Content[0].(*mcp.TextContent).Text
+	if !strings.Contains(text, "showing 5 of 8") || !strings.Contains(text, "... and 3 more in metadata") {
+		t.Errorf("Expected the text to list the newest 5 notes, got:\n%s", text)
+	}
+	if strings.Contains(text, "Update 3 ") {
+		t.Error("Expected notes past the display cap to be omitted from text")
+	}
+}
+
+func TestTaskTools_HandleDeleteTask(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      string
+		wantMethods []string
+	}{
+		{"soft policy archives", "soft", []string{"GET", "PUT", "POST"}},
+		{"hard policy deletes", "hard", []string{"GET", "DELETE"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			var methods []string
+			var update map[string]any
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				mu.Lock()
+				defer mu.Unlock()
+				methods = append(methods, r.Method)
+				w.Header().Set("Content-Type", "application/json")
+
+				switch {
+				case r.Method == "GET" && r.URL.Path == "/api/v1/tasks/task-1":
+					json.NewEncoder(w).Encode(Task{TaskID: "task-1", TaskName: "Old task", Status: "Complete", Tags: []string{"backend"}})
+				case r.Method == "PUT" && r.URL.Path == "/api/v1/tasks/task-1":
+					json.NewDecoder(r.Body).Decode(&update)
+					json.NewEncoder(w).Encode(Task{TaskID: "task-1", Archived: true})
+				case r.Method == "POST" && r.URL.Path == "/api/v1/tasks/task-1/notes":
+					json.NewEncoder(w).Encode(TaskNote{NoteID: "note-1"})
+				case r.Method == "DELETE" && r.URL.Path == "/api/v1/tasks/task-1":
+					w.WriteHeader(http.StatusNoContent)
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			cfg := config.Default()
+			cfg.DeletePolicy = tt.policy
+			taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+
+			result, err := taskTools.HandleDeleteTask(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[DeleteTaskParams]{
+				Arguments: DeleteTaskParams{TaskID: "task-1", DeletedBy: "alice"},
+			})
+			if err != nil {
+				t.Fatalf("HandleDeleteTask failed: %v", err)
+			}
+
+			if strings.Join(methods, ",") != strings.Join(tt.wantMethods, ",") {
+				t.Errorf("Expected API calls %v, got %v", tt.wantMethods, methods)
+			}
+
+			if tt.policy == "soft" {
+				if update["archived"] != true {
+					t.Errorf("Expected task to be archived, got %+v", update)
+				}
+				tags, _ := update["tags"].([]any)
+				if len(tags) != 2 || tags[1] != deletedTag {
+					t.Errorf("Expected deleted tag to be added, got %v", update["tags"])
+				}
+				if result.Meta["recoverable"] != true {
+					t.Error("Expected soft delete to be recoverable")
+				}
+			} else if result.Meta["recoverable"] != false {
+				t.Error("Expected hard delete to be unrecoverable")
+			}
+		})
+	}
+}
+
+func TestTaskTools_HandleRestoreTask(t *testing.T) {
+	var mu sync.Mutex
+	var notes []string
+	task := Task{TaskID: "task-1", TaskName: "Recoverable task", Status: "In Progress", Tags: []string{"backend"}}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v1/tasks/task-1":
+			json.NewEncoder(w).Encode(task)
+		case r.Method == "PUT" && r.URL.Path == "/api/v1/tasks/task-1":
+			var body struct {
+				Archived bool     `json:"archived"`
+				Tags     []string `json:"tags"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			task.Archived = body.Archived
+			task.Tags = body.Tags
+			json.NewEncoder(w).Encode(task)
+		case r.Method == "POST" && r.URL.Path == "/api/v1/tasks/task-1/notes":
+			var body map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			notes = append(notes, body["note"].(string))
+			json.NewEncoder(w).Encode(TaskNote{NoteID: fmt.Sprintf("note-%d", len(notes))})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	ctx := context.Background()
+
+	// Restoring an active task is rejected
+	if _, err := taskTools.HandleRestoreTask(ctx, &mcp.ServerSession{}, &mcp.CallToolParamsFor[RestoreTaskParams]{
+		Arguments: RestoreTaskParams{TaskID: "task-1", RestoredBy: "alice"},
+	}); err == nil || !strings.Contains(err.Error(), "not deleted") {
+		t.Fatalf("Expected not-deleted error, got %v", err)
+	}
+
+	if _, err := taskTools.HandleDeleteTask(ctx, &mcp.ServerSession{}, &mcp.CallToolParamsFor[DeleteTaskParams]{
+		Arguments: DeleteTaskParams{TaskID: "task-1", DeletedBy: "alice"},
+	}); err != nil {
+		t.Fatalf("HandleDeleteTask failed: %v", err)
+	}
+	if !isSoftDeletedTask(task) {
+		t.Fatalf("Expected task to be soft-deleted, got %+v", task)
+	}
+
+	result, err := taskTools.HandleRestoreTask(ctx, &mcp.ServerSession{}, &mcp.CallToolParamsFor[RestoreTaskParams]{
+		Arguments: RestoreTaskParams{TaskID: "task-1", RestoredBy: "bob"},
+	})
+	if err != nil {
+		t.Fatalf("HandleRestoreTask failed: %v", err)
+	}
+
+	restored, ok := result.Meta["task"].(Task)
+	if !ok {
+		t.Fatalf("Expected restored task in meta, got %T", result.Meta["task"])
+	}
+	if restored.Archived {
+		t.Error("Expected restored task to be unarchived")
+	}
+	if len(restored.Tags) != 1 || restored.Tags[0] != "backend" {
+		t.Errorf("Expected deleted tag removed and other tags kept, got %v", restored.Tags)
+	}
+	if len(notes) != 2 || !strings.Contains(notes[1], "Restored by bob") {
+		t.Errorf("Expected a restore note, got %v", notes)
+	}
+}
+
+func TestTaskTools_HandleGetTaskOverview_SuppressedInsights(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/tasks":
+			json.NewEncoder(w).Encode([]Task{
+				{TaskID: "task-1", TaskName: "First", Status: "Not Started", DueDate: stringPtr("2020-01-01T00:00:00Z"), CreationDate: "2024-01-01T00:00:00Z"},
+				{TaskID: "task-2", TaskName: "Second", Status: "Not Started", CreationDate: "2024-01-01T00:00:00Z"},
+				{TaskID: "task-3", TaskName: "Third", Status: "Not Started", CreationDate: "2024-01-01T00:00:00Z"},
+			})
+		case "/api/v1/projects":
+			json.NewEncoder(w).Encode([]Project{})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	run := func(cfg *config.Config) (string, []string) {
+		taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+		result, err := taskTools.HandleGetTaskOverview(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTaskOverviewParams]{})
+		if err != nil {
+			t.Fatalf("HandleGetTaskOverview failed: %v", err)
+		}
+		insights, _ := result.Meta["insights"].([]string)
+		return result.Content[0].(*mcp.TextContent).Text, insights
+	}
+
+	const nag = "More than half of tasks haven't been started yet"
+
+	text, _ := run(config.Default())
+	if !strings.Contains(text, nag) {
+		t.Fatalf("Expected not-started insight without suppression, got: %s", text)
+	}
+
+	cfg := config.Default()
+	cfg.SuppressedInsights = []string{insightOverviewMostlyNotStarted}
+	text, insights := run(cfg)
+	if strings.Contains(text, nag) {
+		t.Errorf("Expected suppressed insight to be hidden, got: %s", text)
+	}
+	for _, insight := range insights {
+		if strings.Contains(insight, nag) {
+			t.Errorf("Expected suppressed insight to be absent from meta, got %v", insights)
+		}
+	}
+	if !strings.Contains(text, "tasks are overdue") {
+		t.Errorf("Expected other insights to remain, got: %s", text)
+	}
+}
+
+func TestTaskTools_HandleGetTaskTimeline(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/tasks/task-1":
+			json.NewEncoder(w).Encode(Task{
+				TaskID: "task-1", TaskName: "Ship release", Status: "Complete",
+				CreatedBy: "alice", CreationDate: "2024-06-10T12:00:00Z",
+				CompletionDate: stringPtr("2024-06-14T12:00:00Z"),
+			})
+		case "/api/v1/tasks/task-1/notes":
+			json.NewEncoder(w).Encode([]TaskNote{
+				{NoteID: "n2", Note: "Release tagged", CreatedBy: "bob", CreationDate: "2024-06-13T12:00:00Z"},
+				{NoteID: "n1", Note: "Started packaging", CreatedBy: "alice", CreationDate: "2024-06-11T12:00:00Z"},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	taskTools.SetClock(clock.NewFixed(time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)))
+
+	result, err := taskTools.HandleGetTaskTimeline(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTaskTimelineParams]{
+		Arguments: GetTaskTimelineParams{TaskID: "task-1"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetTaskTimeline failed: %v", err)
+	}
+
+	events := result.Meta["events"].([]timelineEvent)
+	var order []string
+	for _, event := range events {
+		order = append(order, event.Type+" "+event.Relative)
+	}
+	want := "created 5 days ago,note 4 days ago,note 2 days ago,completed 1 day ago"
+	if got := strings.Join(order, ","); got != want {
+		t.Errorf("Expected %s, got %s", want, got)
+	}
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	for _, line := range []string{"🆕 Task created by alice", "📝 Note by bob: Release tagged", "(1 day ago) — ✅ Task completed"} {
+		if !strings.Contains(text, line) {
+			t.Errorf("Expected %q in timeline text, got:\n%s", line, text)
+		}
+	}
+	if strings.Index(text, "Started packaging") > strings.Index(text, "Release tagged") {
+		t.Errorf("Expected notes in chronological order, got:\n%s", text)
+	}
+
+	if _, err := taskTools.HandleGetTaskTimeline(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTaskTimelineParams]{
+		Arguments: GetTaskTimelineParams{TaskID: "missing"},
+	}); err == nil {
+		t.Error("Expected an error for a missing task")
+	}
+}
+
+func TestTaskTools_HandleGetTaskDependencyTree(t *testing.T) {
+	// d <- c <- b <- root <- e <- f <- g
+	tasks := []Task{
+		{TaskID: "root", TaskName: "Root", Status: "Blocked", BlockedBy: []string{"b"}},
+		{TaskID: "b", TaskName: "B", Status: "In Progress", BlockedBy: []string{"c"}},
+		{TaskID: "c", TaskName: "C", Status: "Complete", BlockedBy: []string{"d"}},
+		{TaskID: "d", TaskName: "D", Status: "Complete"},
+		{TaskID: "e", TaskName: "E", Status: "Not Started", BlockedBy: []string{"root"}},
+		{TaskID: "f", TaskName: "F", Status: "Not Started", BlockedBy: []string{"e"}},
+		{TaskID: "g", TaskName: "G", Status: "Not Started", BlockedBy: []string{"f"}},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/v1/tasks" {
+			json.NewEncoder(w).Encode(tasks)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+
+	result, err := taskTools.HandleGetTaskDependencyTree(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTaskDependencyTreeParams]{
+		Arguments: GetTaskDependencyTreeParams{TaskID: "root", Depth: 2},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetTaskDependencyTree failed: %v", err)
+	}
+
+	upstream := result.Meta["upstream"].([]dependencyNode)
+	if len(upstream) != 1 || upstream[0].TaskID != "b" || upstream[0].Satisfied {
+		t.Fatalf("Expected unsatisfied blocker b, got %+v", upstream)
+	}
+	if len(upstream[0].Children) != 1 || upstream[0].Children[0].TaskID != "c" || !upstream[0].Children[0].Satisfied {
+		t.Fatalf("Expected satisfied blocker c at depth 2, got %+v", upstream[0].Children)
+	}
+	if len(upstream[0].Children[0].Children) != 0 {
+		t.Errorf("Expected depth limit to stop before d, got %+v", upstream[0].Children[0].Children)
+	}
+
+	downstream := result.Meta["downstream"].([]dependencyNode)
+	if len(downstream) != 1 || downstream[0].TaskID != "e" {
+		t.Fatalf("Expected dependent e, got %+v", downstream)
+	}
+	if len(downstream[0].Children) != 1 || downstream[0].Children[0].TaskID != "f" {
+		t.Fatalf("Expected dependent f at depth 2, got %+v", downstream[0].Children)
+	}
+	if len(downstream[0].Children[0].Children) != 0 {
+		t.Errorf("Expected depth limit to stop before g, got %+v", downstream[0].Children[0].Children)
+	}
+
+	if result.Meta["has_cycles"] != false {
+		t.Errorf("Expected no cycles, got %v", result.Meta["cycles"])
+	}
+
+	if _, err := taskTools.HandleGetTaskDependencyTree(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTaskDependencyTreeParams]{
+		Arguments: GetTaskDependencyTreeParams{TaskID: "missing"},
+	}); err == nil {
+		t.Error("Expected error for unknown task")
+	}
+}
+
+func TestTaskTools_HandleGetTasksChangedSince(t *testing.T) {
+	var mu sync.Mutex
+	tasks := []Task{
+		{TaskID: "old", TaskName: "Old", Status: "Complete", CreationDate: "2024-01-01T00:00:00Z", LastUpdateDate: stringPtr("2024-01-05T00:00:00Z")},
+		{TaskID: "updated", TaskName: "Updated", Status: "In Progress", CreationDate: "2024-01-01T00:00:00Z", LastUpdateDate: stringPtr("2024-03-02T00:00:00Z")},
+		{TaskID: "created", TaskName: "Created", Status: "Not Started", CreationDate: "2024-03-01T00:00:00Z"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(tasks)
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	changedSince := func(since string) map[string]any {
+		result, err := taskTools.HandleGetTasksChangedSince(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTasksChangedSinceParams]{
+			Arguments: GetTasksChangedSinceParams{Since: since},
+		})
+		if err != nil {
+			t.Fatalf("HandleGetTasksChangedSince failed: %v", err)
+		}
+		return result.Meta
+	}
+
+	meta := changedSince("2024-02-01T00:00:00Z")
+	changed := meta["tasks"].([]Task)
+	if len(changed) != 2 || changed[0].TaskID != "created" || changed[1].TaskID != "updated" {
+		t.Fatalf("Expected created then updated, got %+v", changed)
+	}
+	cursor := meta["next_cursor"].(string)
+	if cursor != "2024-03-02T00:00:00Z|updated" {
+		t.Errorf("Expected cursor at latest change, got %s", cursor)
+	}
+
+	// Feeding the cursor back with nothing changed returns nothing
+	meta = changedSince(cursor)
+	if changed := meta["tasks"].([]Task); len(changed) != 0 {
+		t.Errorf("Expected no changes after the cursor, got %+v", changed)
+	}
+	if next := meta["next_cursor"].(string); next != cursor {
+		t.Errorf("Expected cursor to stay at %s, got %s", cursor, next)
+	}
+
+	// A task changed at the cursor instant but sorting after it is not skipped
+	mu.Lock()
+	tasks = append(tasks, Task{TaskID: "xyz", TaskName: "Same instant", Status: "Not Started", CreationDate: "2024-03-02T00:00:00Z"})
+	mu.Unlock()
+	meta = changedSince(cursor)
+	if changed := meta["tasks"].([]Task); len(changed) != 1 || changed[0].TaskID != "xyz" {
+		t.Errorf("Expected only the task tied with the cursor, got %+v", changed)
+	}
+	cursor = meta["next_cursor"].(string)
+
+	// A later update moves only that task past the cursor
+	mu.Lock()
+	tasks[0].LastUpdateDate = stringPtr("2024-03-10T00:00:00Z")
+	mu.Unlock()
+
+	meta = changedSince(cursor)
+	changed = meta["tasks"].([]Task)
+	for _, task := range changed {
+		if task.TaskID == "created" {
+			t.Errorf("Expected tasks changed before the cursor to be excluded, got %+v", changed)
+		}
+	}
+	if last := changed[len(changed)-1]; last.TaskID != "old" {
+		t.Errorf("Expected newly updated task last, got %s", last.TaskID)
+	}
+	if next := meta["next_cursor"].(string); next != "2024-03-10T00:00:00Z|old" {
+		t.Errorf("Expected cursor to advance to 2024-03-10, got %s", next)
+	}
+
+	if _, err := taskTools.HandleGetTasksChangedSince(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTasksChangedSinceParams]{}); err == nil {
+		t.Error("Expected error for missing since")
+	}
+}
+
+func TestTaskTools_HandleSearchTasks_ArchivedFilter(t *testing.T) {
+	// The mock ignores the archived query parameter, so filtering must happen client-side
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Task{
+			{TaskID: "active", TaskName: "Active", Status: "In Progress"},
+			{TaskID: "archived", TaskName: "Archived", Status: "Complete", Archived: true},
+		})
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name             string
+		archived         string
+		includeByDefault bool
+		wantIDs          []string
+	}{
+		{"unset excludes archived", "", false, []string{"active"}},
+		{"unset with include default", "", true, []string{"active", "archived"}},
+		{"true returns only archived", "true", false, []string{"archived"}},
+		{"false excludes archived", "false", true, []string{"active"}},
+		{"all returns both", "all", false, []string{"active", "archived"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.Default()
+			cfg.SearchIncludesArchivedByDefault = tt.includeByDefault
+			taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+
+			result, err := taskTools.HandleSearchTasks(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[SearchTasksParams]{
+				Arguments: SearchTasksParams{Archived: tt.archived},
+			})
+			if err != nil {
+				t.Fatalf("HandleSearchTasks failed: %v", err)
+			}
+
+			tasks := result.Meta["tasks"].([]Task)
+			var ids []string
+			for _, task := range tasks {
+				ids = append(ids, task.TaskID)
+			}
+			if strings.Join(ids, ",") != strings.Join(tt.wantIDs, ",") {
+				t.Errorf("Expected %v, got %v", tt.wantIDs, ids)
+			}
+		})
+	}
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	if _, err := taskTools.HandleSearchTasks(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[SearchTasksParams]{
+		Arguments: SearchTasksParams{Archived: "sometimes"},
+	}); err == nil {
+		t.Error("Expected error for invalid archived value")
+	}
+}
+
+func TestTaskTools_HandleValidateTaskData(t *testing.T) {
+	var writes int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != "GET" {
+			writes++
+		}
+		switch r.URL.Path {
+		case "/api/v1/projects":
+			json.NewEncoder(w).Encode([]Project{{ProjectID: "proj-1", ProjectName: "Known"}})
+		case "/api/v1/tasks":
+			json.NewEncoder(w).Encode([]Task{{TaskID: "t1", CreatedBy: "alice", AssignedTo: stringPtr("bob")}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg := config.Default()
+	cfg.RequireActorFields = true
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+
+	result, err := taskTools.HandleValidateTaskData(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[ValidateTaskDataParams]{
+		Arguments: ValidateTaskDataParams{
+			Tasks: []map[string]any{
+				{
+					"status":      "Done",
+					"priority":    "Urgent",
+					"due_date":    "next tuesday-ish",
+					"project_id":  "proj-missing",
+					"assigned_to": "mallory",
+				},
+				{
+					"task_name":  "Write docs",
+					"status":     "In Progress",
+					"priority":   "High",
+					"project_id": "proj-1",
+					"created_by": "alice",
+				},
+			},
+			CheckReferences: true,
+		},
+	})
+	if err != nil {
+		t.Fatalf("HandleValidateTaskData failed: %v", err)
+	}
+	if writes != 0 {
+		t.Errorf("Expected validation to make no writes, got %d", writes)
+	}
+
+	results := result.Meta["results"].([]map[string]any)
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(results))
+	}
+
+	fields := make(map[string]bool)
+	for _, issue := range results[0]["errors"].([]map[string]any) {
+		fields[issue["field"].(string)] = true
+	}
+	for _, field := range []string{"task_name", "status", "priority", "due_date", "created_by", "project_id"} {
+		if !fields[field] {
+			t.Errorf("Expected an error for %s, got %+v", field, results[0]["errors"])
+		}
+	}
+	warnings := results[0]["warnings"].([]map[string]any)
+	if len(warnings) != 1 || warnings[0]["field"] != "assigned_to" {
+		t.Errorf("Expected an unknown-assignee warning, got %+v", warnings)
+	}
+
+	if results[1]["valid"] != true {
+		t.Errorf("Expected second payload to be valid, got errors %+v", results[1]["errors"])
+	}
+	if result.Meta["valid_count"] != 1 || result.Meta["invalid_count"] != 1 {
+		t.Errorf("Expected 1 valid and 1 invalid payload, got %v/%v", result.Meta["valid_count"], result.Meta["invalid_count"])
+	}
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	if !strings.Contains(text, "- status: invalid status 'Done'") || !strings.Contains(text, "✅ #2 Write docs: valid") {
+		t.Errorf("Expected per-field errors in response text, got: %s", text)
+	}
+
+	if _, err := taskTools.HandleValidateTaskData(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[ValidateTaskDataParams]{}); err == nil {
+		t.Error("Expected error when no payload is given")
+	}
+}
+
+func TestTaskTools_HandleGetStatusFlow(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		query = r.URL.RawQuery
+		json.NewEncoder(w).Encode([]Task{
+			{TaskID: "t1", Status: "In Progress"},
+			{TaskID: "t2", Status: "In Progress"},
+			{TaskID: "t3", Status: "Review"},
+		})
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+
+	result, err := taskTools.HandleGetStatusFlow(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetStatusFlowParams]{})
+	if err != nil {
+		t.Fatalf("HandleGetStatusFlow failed: %v", err)
+	}
+
+	mermaid := result.Meta["mermaid"].(string)
+	for _, status := range canonicalStatuses {
+		if !strings.Contains(mermaid, statusNodeID(status)+": "+status) {
+			t.Errorf("Expected diagram to include status %s, got: %s", status, mermaid)
+		}
+	}
+	if !strings.Contains(mermaid, "In_Progress --> Review") {
+		t.Errorf("Expected diagram to include In Progress -> Review edge, got: %s", mermaid)
+	}
+	if _, ok := result.Meta["status_counts"]; ok {
+		t.Error("Expected no status counts without a scope")
+	}
+	if query != "" {
+		t.Errorf("Expected no task fetch without a scope, got query %q", query)
+	}
+
+	result, err = taskTools.HandleGetStatusFlow(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetStatusFlowParams]{
+		Arguments: GetStatusFlowParams{ProjectID: "proj-1"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetStatusFlow with scope failed: %v", err)
+	}
+	if query != "project_id=proj-1" {
+		t.Errorf("Expected project-scoped query, got %q", query)
+	}
+
+	counts := result.Meta["status_counts"].(map[string]int)
+	if counts["In Progress"] != 2 || counts["Review"] != 1 || counts["Blocked"] != 0 {
+		t.Errorf("Unexpected status counts: %v", counts)
+	}
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	if !strings.Contains(text, "2. In Progress (2 tasks)") {
+		t.Errorf("Expected counts in response text, got: %s", text)
+	}
+}
+
+func TestTaskTools_HandleGetTaskOverview_FixedClock(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/tasks":
+			json.NewEncoder(w).Encode([]Task{
+				{TaskID: "t1", TaskName: "Due yesterday", Status: "In Progress", DueDate: stringPtr("2024-05-31T12:00:00Z"), CreationDate: "2024-05-01T10:00:00Z"},
+				{TaskID: "t2", TaskName: "Due tomorrow", Status: "Not Started", DueDate: stringPtr("2024-06-02T12:00:00Z"), CreationDate: "2024-05-31T20:00:00Z"},
+				{TaskID: "t3", TaskName: "Done late", Status: "Complete", DueDate: stringPtr("2024-05-01T12:00:00Z"), CreationDate: "2024-04-01T10:00:00Z"},
+			})
+		case "/api/v1/projects":
+			json.NewEncoder(w).Encode([]Project{})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	taskTools.SetClock(clock.NewFixed(time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)))
+
+	result, err := taskTools.HandleGetTaskOverview(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTaskOverviewParams]{})
+	if err != nil {
+		t.Fatalf("HandleGetTaskOverview failed: %v", err)
+	}
+	if result.Meta["overdue_count"] != 1 {
+		t.Errorf("Expected 1 overdue task at the fixed instant, got %v", result.Meta["overdue_count"])
+	}
+	overdue := result.Meta["overdue_tasks"].([]Task)
+	if len(overdue) != 1 || overdue[0].TaskID != "t1" {
+		t.Errorf("Expected only t1 to be overdue, got %+v", overdue)
+	}
+	recent := result.Meta["recent_activity"].(map[string]any)
+	if recent["tasks_created"] != 1 || result.Meta["recent_window_hours"] != 24 {
+		t.Errorf("Expected 1 task created in the default 24h before the fixed instant, got %v (window %v)", recent["tasks_created"], result.Meta["recent_window_hours"])
+	}
+
+	// A wider recent window picks up older tasks
+	result, err = taskTools.HandleGetTaskOverview(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTaskOverviewParams]{
+		Arguments: GetTaskOverviewParams{RecentHours: 32 * 24},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetTaskOverview with recent_hours failed: %v", err)
+	}
+	recent = result.Meta["recent_activity"].(map[string]any)
+	if recent["tasks_created"] != 2 || result.Meta["recent_window_hours"] != 32*24 {
+		t.Errorf("Expected 2 tasks created in the last 32 days, got %v (window %v)", recent["tasks_created"], result.Meta["recent_window_hours"])
+	}
+	if text := result.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "Tasks created in last 768h: 2") {
+		t.Errorf("Expected the chosen window in the text, got:\n%s", text)
+	}
+
+	// Absurd windows are clamped, negative ones rejected
+	result, err = taskTools.HandleGetTaskOverview(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTaskOverviewParams]{
+		Arguments: GetTaskOverviewParams{RecentHours: 1_000_000},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetTaskOverview with huge recent_hours failed: %v", err)
+	}
+	if result.Meta["recent_window_hours"] != maxRecentHours {
+		t.Errorf("Expected window clamped to %d, got %v", maxRecentHours, result.Meta["recent_window_hours"])
+	}
+	if _, err := taskTools.HandleGetTaskOverview(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTaskOverviewParams]{
+		Arguments: GetTaskOverviewParams{RecentHours: -5},
+	}); err == nil {
+		t.Error("Expected negative recent_hours to be rejected")
+	}
+
+	// as_of overrides the clock for historical reporting
+	result, err = taskTools.HandleGetTaskOverview(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTaskOverviewParams]{
+		Arguments: GetTaskOverviewParams{AsOf: "2024-06-03"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetTaskOverview with as_of failed: %v", err)
+	}
+	if result.Meta["overdue_count"] != 2 {
+		t.Errorf("Expected 2 overdue tasks as of 2024-06-03, got %v", result.Meta["overdue_count"])
+	}
+	text := result.Content[0].(*mcp.TextContent).Text
+	if !strings.Contains(text, "As of: 2024-06-03") {
+		t.Errorf("Expected as_of date in response text, got: %s", text)
+	}
+
+	if _, err := taskTools.HandleGetTaskOverview(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTaskOverviewParams]{
+		Arguments: GetTaskOverviewParams{AsOf: "whenever"},
+	}); err == nil {
+		t.Error("Expected error for unparseable as_of")
+	}
+}
+
+func TestTaskTools_MinNoteLength(t *testing.T) {
+	var posted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/api/v1/tasks/task-1":
+			json.NewEncoder(w).Encode(Task{TaskID: "task-1", TaskName: "Test Task", Status: "In Progress"})
+		case r.Method == "POST" && r.URL.Path == "/api/v1/tasks/task-1/notes":
+			var body map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			note, _ := body["note"].(string)
+			posted = append(posted, note)
+			json.NewEncoder(w).Encode(TaskNote{NoteID: "note-1", TaskID: "task-1", Note: note})
+		case r.Method == "PUT" && r.URL.Path == "/api/v1/tasks/task-1":
+			json.NewEncoder(w).Encode(Task{TaskID: "task-1", TaskName: "Test Task", Status: "In Progress"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg := config.Default()
+	cfg.MinNoteLength = 10
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+	ctx := context.Background()
+
+	addNote := func(note string) error {
+		_, err := taskTools.HandleAddTaskNote(ctx, &mcp.ServerSession{}, &mcp.CallToolParamsFor[AddTaskNoteParams]{
+			Arguments: AddTaskNoteParams{TaskID: "task-1", Note: note, CreatedBy: "alice"},
+		})
+		return err
+	}
+
+	if err := addNote("   wip     "); err == nil || !strings.Contains(err.Error(), "note is too short (3 characters, minimum 10)") {
+		t.Errorf("Expected short note to be rejected, got %v", err)
+	}
+	if err := addNote("     "); err == nil || !strings.Contains(err.Error(), "note is required") {
+		t.Errorf("Expected whitespace-only note to be treated as empty, got %v", err)
+	}
+	if _, err := taskTools.HandleUpdateTaskProgress(ctx, &mcp.ServerSession{}, &mcp.CallToolParamsFor[UpdateTaskProgressParams]{
+		Arguments: UpdateTaskProgressParams{TaskID: "task-1", ProgressNote: "done", UpdatedBy: "alice"},
+	}); err == nil || !strings.Contains(err.Error(), "progress_note is too short") {
+		t.Errorf("Expected short progress note to be rejected, got %v", err)
+	}
+	if len(posted) != 0 {
+		t.Fatalf("Expected rejected notes not to be written, got %v", posted)
+	}
+
+	if err := addNote("Finished the API client retry logic"); err != nil {
+		t.Errorf("Expected sufficient note to pass, got %v", err)
+	}
+	if len(posted) != 1 {
+		t.Errorf("Expected 1 note written, got %d", len(posted))
+	}
+}
+
+func TestTaskTools_HandleGetTaskDetails_NotesUnavailable(t *testing.T) {
+	notesFail := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/tasks/task-1":
+			json.NewEncoder(w).Encode(Task{TaskID: "task-1", TaskName: "Test Task", Status: "In Progress"})
+		case "/api/v1/tasks/task-1/notes":
+			if notesFail {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			json.NewEncoder(w).Encode([]TaskNote{})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	getDetails := func() *mcp.CallToolResultFor[map[string]any] {
+		result, err := taskTools.HandleGetTaskDetails(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTaskDetailsParams]{
+			Arguments: GetTaskDetailsParams{TaskID: "task-1"},
+		})
+		if err != nil {
+			t.Fatalf("HandleGetTaskDetails failed: %v", err)
+		}
+		return result
+	}
+
+	result := getDetails()
+	text := result.Content[0].(*mcp.TextContent).Text
+	if result.Meta["notes_available"] != false {
+		t.Errorf("Expected notes_available false when the notes fetch fails, got %v", result.Meta["notes_available"])
+	}
+	if !strings.Contains(text, "⚠️ Notes could not be loaded") || strings.Contains(text, "No notes available") {
+		t.Errorf("Expected unavailable notes rather than empty notes, got: %s", text)
+	}
+	if strings.Contains(text, "Consider adding progress notes") {
+		t.Errorf("Expected no missing-notes insight when notes could not be loaded, got: %s", text)
+	}
+
+	notesFail = false
+	result = getDetails()
+	text = result.Content[0].(*mcp.TextContent).Text
+	if result.Meta["notes_available"] != true {
+		t.Errorf("Expected notes_available true for an empty notes list, got %v", result.Meta["notes_available"])
+	}
+	if !strings.Contains(text, "📝 No notes available") {
+		t.Errorf("Expected genuine empty notes message, got: %s", text)
+	}
+}
+
+func TestTaskTools_HandleGetAttentionNeeded(t *testing.T) {
+	var query string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/tasks":
+			query = r.URL.RawQuery
+			json.NewEncoder(w).Encode([]Task{
+				{TaskID: "t1", TaskName: "Late and stuck", Status: "Blocked", Priority: stringPtr("Low"), DueDate: stringPtr("2024-05-30"), CreationDate: "2024-05-01T10:00:00Z"},
+				{TaskID: "t2", TaskName: "Due this afternoon", Status: "In Progress", Priority: stringPtr("High"), DueDate: stringPtr("2024-06-01T17:00:00Z"), CreationDate: "2024-05-01T10:00:00Z"},
+				{TaskID: "t3", TaskName: "Waiting on vendor", Status: "Blocked", Priority: stringPtr("Medium"), CreationDate: "2024-05-01T10:00:00Z"},
+				{TaskID: "t4", TaskName: "On track", Status: "In Progress", DueDate: stringPtr("2024-06-05"), CreationDate: "2024-05-01T10:00:00Z"},
+				{TaskID: "t5", TaskName: "Done late", Status: "Complete", DueDate: stringPtr("2024-05-01"), CreationDate: "2024-04-01T10:00:00Z"},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	taskTools.SetClock(clock.NewFixed(time.Date(2024, 6, 1, 9, 0, 0, 0, time.UTC)))
+
+	result, err := taskTools.HandleGetAttentionNeeded(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetAttentionNeededParams]{
+		Arguments: GetAttentionNeededParams{AssignedTo: "alice"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetAttentionNeeded failed: %v", err)
+	}
+	if query != "assigned_to=alice" {
+		t.Errorf("Expected assigned_to filter to be forwarded, got %q", query)
+	}
+
+	entries := result.Meta["tasks"].([]map[string]any)
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 tasks needing attention, got %d: %+v", len(entries), entries)
+	}
+	order := []string{entries[0]["task_id"].(string), entries[1]["task_id"].(string), entries[2]["task_id"].(string)}
+	if order[0] != "t2" || order[1] != "t3" || order[2] != "t1" {
+		t.Errorf("Expected priority order t2, t3, t1, got %v", order)
+	}
+
+	reasons := entries[2]["reasons"].([]string)
+	if len(reasons) != 2 || reasons[0] != "overdue" || reasons[1] != "blocked" {
+		t.Errorf("Expected overdue and blocked task once with both reasons, got %v", reasons)
+	}
+	if got := entries[0]["reasons"].([]string); len(got) != 1 || got[0] != "due today" {
+		t.Errorf("Expected task due later today to be due today, got %v", got)
+	}
+
+	counts := result.Meta["category_counts"].(map[string]int)
+	if counts["overdue"] != 1 || counts["blocked"] != 2 || counts["due today"] != 1 {
+		t.Errorf("Unexpected category counts: %v", counts)
+	}
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	if strings.Count(text, "Late and stuck") != 1 || !strings.Contains(text, "overdue, blocked") {
+		t.Errorf("Expected deduplicated task with both reasons in text, got: %s", text)
+	}
+}
+
+func TestTaskTools_CreateTaskWithContext_ProjectDefaultAssignee(t *testing.T) {
+	var posted map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/proj-1":
+			json.NewEncoder(w).Encode(Project{ProjectID: "proj-1", ProjectName: "Launch", DefaultAssignee: stringPtr("lead")})
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/projects/proj-2":
+			json.NewEncoder(w).Encode(Project{ProjectID: "proj-2", ProjectName: "Ops"})
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v1/tasks":
+			posted = nil
+			json.NewDecoder(r.Body).Decode(&posted)
+			task := Task{TaskID: "task-new", TaskName: posted["task_name"].(string), Status: "Not Started"}
+			if assignee, ok := posted["assigned_to"].(string); ok {
+				task.AssignedTo = &assignee
+			}
+			json.NewEncoder(w).Encode(task)
+		case r.Method == http.MethodPost:
+			json.NewEncoder(w).Encode(TaskNote{NoteID: "note-1"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg := config.Default()
+	cfg.ProjectDefaultAssignees = map[string]string{"proj-1": "ignored", "proj-2": "oncall"}
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+	create := func(args CreateTaskWithContextParams) *mcp.CallToolResultFor[map[string]any] {
+		args.TaskName = "New task"
+		args.InitialNote = "Planning note"
+		args.CreatedBy = "admin"
+		result, err := taskTools.HandleCreateTaskWithContext(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[CreateTaskWithContextParams]{Arguments: args})
+		if err != nil {
+			t.Fatalf("HandleCreateTaskWithContext failed: %v", err)
+		}
+		return result
+	}
+
+	result := create(CreateTaskWithContextParams{ProjectID: "proj-1"})
+	if posted["assigned_to"] != "lead" {
+		t.Errorf("Expected project's stored default assignee to be applied, got %v", posted["assigned_to"])
+	}
+	if result.Meta["default_assignee_applied"] != true {
+		t.Errorf("Expected default_assignee_applied true, got %v", result.Meta["default_assignee_applied"])
+	}
+	if text := result.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "Assigned to: lead (project default)") {
+		t.Errorf("Expected default assignee in response text, got: %s", text)
+	}
+
+	create(CreateTaskWithContextParams{ProjectID: "proj-2"})
+	if posted["assigned_to"] != "oncall" {
+		t.Errorf("Expected configured default assignee for project without one, got %v", posted["assigned_to"])
+	}
+
+	result = create(CreateTaskWithContextParams{ProjectID: "proj-1", AssignedTo: "bob"})
+	if posted["assigned_to"] != "bob" || result.Meta["default_assignee_applied"] != false {
+		t.Errorf("Expected explicit assignee to be kept, got %v", posted["assigned_to"])
+	}
+
+	create(CreateTaskWithContextParams{})
+	if _, ok := posted["assigned_to"]; ok {
+		t.Errorf("Expected no assignee for a task outside any project, got %v", posted["assigned_to"])
+	}
+}
+
+func TestTaskTools_HandleGetSprintCompletions(t *testing.T) {
+	var requested string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		requested = r.URL.Path
+		json.NewEncoder(w).Encode([]Task{
+			{TaskID: "t1", TaskName: "Shipped", Status: "Complete", DueDate: stringPtr("2024-06-10"), CompletionDate: stringPtr("2024-06-09T15:00:00Z")},
+			{TaskID: "t2", TaskName: "Slipped", Status: "In Progress", DueDate: stringPtr("2024-06-12")},
+			{TaskID: "t3", TaskName: "Next sprint", Status: "Not Started", DueDate: stringPtr("2024-06-20")},
+			{TaskID: "t4", TaskName: "Old win", Status: "Complete", CompletionDate: stringPtr("2024-05-20T10:00:00Z")},
+		})
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	taskTools.SetClock(clock.NewFixed(time.Date(2024, 6, 18, 9, 0, 0, 0, time.UTC)))
+
+	result, err := taskTools.HandleGetSprintCompletions(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetSprintCompletionsParams]{
+		Arguments: GetSprintCompletionsParams{SprintStart: "2024-06-01", SprintEnd: "2024-06-14", ProjectID: "proj-1"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetSprintCompletions failed: %v", err)
+	}
+	if requested != "/api/v1/projects/proj-1/tasks" {
+		t.Errorf("Expected project tasks to be fetched, got %s", requested)
+	}
+	if result.Meta["completed_count"] != 1 || result.Meta["carried_over_count"] != 1 {
+		t.Errorf("Expected 1 completed and 1 carried over, got %v and %v", result.Meta["completed_count"], result.Meta["carried_over_count"])
+	}
+	if carried := result.Meta["carried_over_tasks"].([]Task); len(carried) != 1 || carried[0].TaskID != "t2" {
+		t.Errorf("Expected t2 to be carried over, got %+v", carried)
+	}
+	if result.Meta["sprint_in_progress"] != false {
+		t.Errorf("Expected finished sprint, got %v", result.Meta["sprint_in_progress"])
+	}
+
+	if _, err := taskTools.HandleGetSprintCompletions(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetSprintCompletionsParams]{}); err == nil {
+		t.Error("Expected error when sprint_start is missing")
+	}
+}
+
+func TestTaskTools_HandleGetBlockedDurationReport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/projects/proj-1/tasks":
+			json.NewEncoder(w).Encode([]Task{
+				{TaskID: "t1", TaskName: "Waited on vendor", Status: "Complete", LastUpdateDate: stringPtr("2024-06-05T12:00:00Z")},
+				{TaskID: "t2", TaskName: "Still stuck", Status: "Blocked", LastUpdateDate: stringPtr("2024-06-06T12:00:00Z")},
+				{TaskID: "t3", TaskName: "Smooth sailing", Status: "In Progress", LastUpdateDate: stringPtr("2024-06-09T12:00:00Z")},
+			})
+		case "/api/v1/tasks/t1/notes":
+			json.NewEncoder(w).Encode([]TaskNote{
+				{NoteID: "n1", Note: "Blocked on vendor contract", CreationDate: "2024-06-01T12:00:00Z"},
+				{NoteID: "n2", Note: "Unblocked, contract signed", CreationDate: "2024-06-03T12:00:00Z"},
+			})
+		case "/api/v1/tasks/t2/notes":
+			json.NewEncoder(w).Encode([]TaskNote{
+				{NoteID: "n3", Note: "Blocked by outage upstream", CreationDate: "2024-06-06T12:00:00Z"},
+			})
+		case "/api/v1/tasks/t3/notes":
+			json.NewEncoder(w).Encode([]TaskNote{{NoteID: "n4", Note: "Halfway there", CreationDate: "2024-06-08T12:00:00Z"}})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	taskTools.SetClock(clock.NewFixed(time.Date(2024, 6, 10, 12, 0, 0, 0, time.UTC)))
+
+	result, err := taskTools.HandleGetBlockedDurationReport(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetBlockedDurationReportParams]{
+		Arguments: GetBlockedDurationReportParams{ProjectID: "proj-1"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetBlockedDurationReport failed: %v", err)
+	}
+
+	// t1 was blocked 2 days, t2 has been blocked 4 days
+	if result.Meta["tasks_blocked"] != 2 {
+		t.Errorf("Expected 2 blocked tasks, got %v", result.Meta["tasks_blocked"])
+	}
+	if result.Meta["total_blocked_hours"] != 144.0 {
+		t.Errorf("Expected 144 total blocked hours, got %v", result.Meta["total_blocked_hours"])
+	}
+	if result.Meta["average_blocked_hours"] != 72.0 {
+		t.Errorf("Expected 72 average blocked hours, got %v", result.Meta["average_blocked_hours"])
+	}
+	longest := result.Meta["blocked_tasks"].([]map[string]any)
+	if longest[0]["task_id"] != "t2" || longest[0]["approximate"] != false {
+		t.Errorf("Expected exact t2 to be longest blocked, got %+v", longest[0])
+	}
+
+	// since clips blocked time before the cutoff
+	result, err = taskTools.HandleGetBlockedDurationReport(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetBlockedDurationReportParams]{
+		Arguments: GetBlockedDurationReportParams{ProjectID: "proj-1", Since: "2024-06-08T12:00:00Z"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetBlockedDurationReport with since failed: %v", err)
+	}
+	if result.Meta["tasks_blocked"] != 1 || result.Meta["average_blocked_hours"] != 48.0 {
+		t.Errorf("Expected only t2's last 48h since the cutoff, got %v tasks averaging %v", result.Meta["tasks_blocked"], result.Meta["average_blocked_hours"])
+	}
+}
+
+func TestTaskTools_HandleUpdateTaskProgress_WIPLimit(t *testing.T) {
+	tasks := map[string]*Task{
+		"t1": {TaskID: "t1", TaskName: "First", Status: "In Progress", AssignedTo: stringPtr("alice"), ProjectID: stringPtr("proj-1")},
+		"t2": {TaskID: "t2", TaskName: "Second", Status: "In Progress", AssignedTo: stringPtr("alice"), ProjectID: stringPtr("proj-1")},
+		"t3": {TaskID: "t3", TaskName: "Third", Status: "Not Started", AssignedTo: stringPtr("alice"), ProjectID: stringPtr("proj-1")},
+	}
+	var puts int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tasks":
+			query := r.URL.Query()
+			matched := []Task{}
+			for _, id := range []string{"t1", "t2", "t3"} {
+				task := tasks[id]
+				if task.Status != query.Get("status") {
+					continue
+				}
+				if a := query.Get("assigned_to"); a != "" && *task.AssignedTo != a {
+					continue
+				}
+				if p := query.Get("project_id"); p != "" && *task.ProjectID != p {
+					continue
+				}
+				matched = append(matched, *task)
+			}
+			json.NewEncoder(w).Encode(matched)
+		case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, "/api/v1/tasks/"):
+			json.NewEncoder(w).Encode(tasks[strings.TrimPrefix(r.URL.Path, "/api/v1/tasks/")])
+		case r.Method == http.MethodPut:
+			puts++
+			task := tasks[strings.TrimPrefix(r.URL.Path, "/api/v1/tasks/")]
+			var update map[string]any
+			json.NewDecoder(r.Body).Decode(&update)
+			if status, ok := update["status"].(string); ok {
+				task.Status = status
+			}
+			json.NewEncoder(w).Encode(task)
+		case r.Method == http.MethodPost:
+			json.NewEncoder(w).Encode(TaskNote{NoteID: "note-1"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg := config.Default()
+	cfg.WIPLimits = map[string]int{"In Progress": 2}
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+	start := func(force bool) error {
+		_, err := taskTools.HandleUpdateTaskProgress(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[UpdateTaskProgressParams]{
+			Arguments: UpdateTaskProgressParams{TaskID: "t3", Status: "In Progress", ProgressNote: "Starting", UpdatedBy: "alice", Force: force},
+		})
+		return err
+	}
+
+	err := start(false)
+	if err == nil {
+		t.Fatal("Expected WIP limit error when starting a third task at a limit of two")
+	}
+	if !strings.Contains(err.Error(), "WIP limit reached") || !strings.Contains(err.Error(), "First (t1)") || !strings.Contains(err.Error(), "Second (t2)") {
+		t.Errorf("Expected error to name the occupying tasks, got: %v", err)
+	}
+	if puts != 0 {
+		t.Errorf("Expected no update when the WIP limit is reached, got %d", puts)
+	}
+
+	cfg.WIPLimitScope = "project"
+	if err := start(false); err == nil || !strings.Contains(err.Error(), "project proj-1") {
+		t.Errorf("Expected project-scoped WIP limit error, got: %v", err)
+	}
+
+	if err := start(true); err != nil {
+		t.Fatalf("Expected force to bypass the WIP limit, got: %v", err)
+	}
+	if tasks["t3"].Status != "In Progress" {
+		t.Errorf("Expected forced move to update the task, got %s", tasks["t3"].Status)
+	}
+}
+
+func TestTaskTools_HandleGetOverviewDelta(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Task{
+			{TaskID: "t1", TaskName: "Old and done", Status: "Complete", CreationDate: "2024-06-01T09:00:00Z", CompletionDate: stringPtr("2024-06-09T15:00:00Z"), LastUpdateDate: stringPtr("2024-06-09T15:00:00Z")},
+			{TaskID: "t2", TaskName: "Brand new", Status: "Not Started", CreationDate: "2024-06-09T10:00:00Z"},
+			{TaskID: "t3", TaskName: "Done long ago", Status: "Complete", CreationDate: "2024-05-01T09:00:00Z", CompletionDate: stringPtr("2024-05-20T09:00:00Z")},
+			{TaskID: "t4", TaskName: "Slipped overnight", Status: "In Progress", CreationDate: "2024-06-01T09:00:00Z", DueDate: stringPtr("2024-06-09T18:00:00Z")},
+			{TaskID: "t5", TaskName: "Long overdue", Status: "In Progress", CreationDate: "2024-05-01T09:00:00Z", DueDate: stringPtr("2024-06-01T18:00:00Z")},
+			{TaskID: "t6", TaskName: "Created and finished", Status: "Complete", CreationDate: "2024-06-09T11:00:00Z", CompletionDate: stringPtr("2024-06-09T16:00:00Z")},
+		})
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	taskTools.SetClock(clock.NewFixed(time.Date(2024, 6, 10, 9, 0, 0, 0, time.UTC)))
+
+	result, err := taskTools.HandleGetOverviewDelta(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetOverviewDeltaParams]{
+		Arguments: GetOverviewDeltaParams{Since: "24h"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetOverviewDelta failed: %v", err)
+	}
+
+	ids := func(key string) []string {
+		var out []string
+		for _, task := range result.Meta[key].([]Task) {
+			out = append(out, task.TaskID)
+		}
+		return out
+	}
+	if got := ids("created_tasks"); len(got) != 2 || got[0] != "t2" || got[1] != "t6" {
+		t.Errorf("Expected t2 and t6 newly created, got %v", got)
+	}
+	if got := ids("completed_tasks"); len(got) != 2 || got[0] != "t1" || got[1] != "t6" {
+		t.Errorf("Expected t1 and t6 newly completed, got %v", got)
+	}
+	if got := ids("overdue_tasks"); len(got) != 1 || got[0] != "t4" {
+		t.Errorf("Expected only t4 newly overdue, got %v", got)
+	}
+	changed := result.Meta["changed_tasks"].([]map[string]any)
+	if len(changed) != 1 || changed[0]["task_id"] != "t1" || changed[0]["evidence"] != "completion_date" {
+		t.Errorf("Expected only pre-existing t1 as a status change, got %+v", changed)
+	}
+
+	if _, err := taskTools.HandleGetOverviewDelta(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetOverviewDeltaParams]{
+		Arguments: GetOverviewDeltaParams{Since: "2024-07-01"},
+	}); err == nil {
+		t.Error("Expected error for a cutoff in the future")
+	}
+}
+
+func TestTaskTools_HandleRunPriorityEscalation(t *testing.T) {
+	updates := make(map[string]map[string]any)
+	var notes []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/api/v1/tasks":
+			json.NewEncoder(w).Encode([]Task{
+				{TaskID: "t1", TaskName: "Forgotten chore", Status: "Not Started", Priority: stringPtr("Low"), CreationDate: "2024-05-01T12:00:00Z"},
+				{TaskID: "t2", TaskName: "Fresh chore", Status: "Not Started", Priority: stringPtr("Low"), CreationDate: "2024-06-28T12:00:00Z"},
+			})
+		case r.Method == http.MethodPut:
+			var update map[string]any
+			json.NewDecoder(r.Body).Decode(&update)
+			updates[strings.TrimPrefix(r.URL.Path, "/api/v1/tasks/")] = update
+			json.NewEncoder(w).Encode(Task{})
+		case r.Method == http.MethodPost:
+			var note map[string]any
+			json.NewDecoder(r.Body).Decode(&note)
+			notes = append(notes, note["note"].(string))
+			json.NewEncoder(w).Encode(TaskNote{NoteID: "note-1"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	cfg := config.Default()
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+	taskTools.SetClock(clock.NewFixed(time.Date(2024, 6, 30, 12, 0, 0, 0, time.UTC)))
+	run := func() (*mcp.CallToolResultFor[map[string]any], error) {
+		return taskTools.HandleRunPriorityEscalation(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[RunPriorityEscalationParams]{
+			Arguments: RunPriorityEscalationParams{EscalatedBy: "scheduler"},
+		})
+	}
+
+	if _, err := run(); err == nil {
+		t.Error("Expected error when no escalation policy is configured")
+	}
+
+	cfg.EscalationAges = map[string]time.Duration{"Low": 30 * 24 * time.Hour}
+	result, err := run()
+	if err != nil {
+		t.Fatalf("HandleRunPriorityEscalation failed: %v", err)
+	}
+
+	escalated := result.Meta["escalated_tasks"].([]map[string]any)
+	if len(escalated) != 1 || escalated[0]["task_id"] != "t1" || escalated[0]["old_priority"] != "Low" || escalated[0]["new_priority"] != "Medium" {
+		t.Errorf("Expected only t1 escalated Low → Medium, got %+v", escalated)
+	}
+	if updates["t1"]["priority"] != "Medium" || updates["t1"]["last_updated_by"] != "scheduler" {
+		t.Errorf("Expected t1 priority updated to Medium, got %v", updates["t1"])
+	}
+	if _, touched := updates["t2"]; touched {
+		t.Error("Expected recent Low task to be untouched")
+	}
+	if len(notes) != 1 || !strings.Contains(notes[0], "escalated from Low to Medium") {
+		t.Errorf("Expected one escalation audit note, got %v", notes)
+	}
+}
+
+func TestTaskTools_HandleGetAllTasks_TenantScoping(t *testing.T) {
+	// The API ignores the tenant filter, so the client must enforce it
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`[
+			{"task_id":"a1","task_name":"Team A task","status":"Not Started","tenant":"team-a"},
+			{"task_id":"b1","task_name":"Team B task","status":"Not Started","tenant":"team-b"},
+			{"task_id":"b2","task_name":"Another team B task","status":"In Progress","tenant":"team-b"}
+		]`))
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	list := func(ctx context.Context) []string {
+		t.Helper()
+		result, err := taskTools.HandleGetAllTasks(ctx, &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetAllTasksParams]{})
+		if err != nil {
+			t.Fatalf("HandleGetAllTasks failed: %v", err)
+		}
+		var ids []string
+		for _, task := range result.Meta["tasks"].([]Task) {
+			ids = append(ids, task.TaskID)
+		}
+		return ids
+	}
+
+	if got := list(tenant.WithTenant(context.Background(), "team-a")); strings.Join(got, ",") != "a1" {
+		t.Errorf("Expected team-a to see only a1, got %v", got)
+	}
+	if got := list(tenant.WithTenant(context.Background(), "team-b")); strings.Join(got, ",") != "b1,b2" {
+		t.Errorf("Expected team-b to see only b1,b2, got %v", got)
+	}
+	if got := list(context.Background()); len(got) != 3 {
+		t.Errorf("Expected single-tenant default to see every task, got %v", got)
+	}
+}
+
+func TestTaskTools_HandleGetAllTasks_PagesWhenAPIIgnoresLimit(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		tasks := make([]Task, 7)
+		for i := range tasks {
+			tasks[i] = Task{TaskID: fmt.Sprintf("t%d", i+1), TaskName: "Task", Status: "Not Started"}
+		}
+		json.NewEncoder(w).Encode(tasks)
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	result, err := taskTools.HandleGetAllTasks(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetAllTasksParams]{
+		Arguments: GetAllTasksParams{Page: 2, PageSize: 3},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetAllTasks failed: %v", err)
+	}
+
+	var ids []string
+	for _, task := range result.Meta["tasks"].([]Task) {
+		ids = append(ids, task.TaskID)
+	}
+	if strings.Join(ids, ",") != "t4,t5,t6" {
+		t.Errorf("Expected the second page sliced client-side, got %v", ids)
+	}
+	if result.Meta["total_count"] != 7 || result.Meta["total_count_exact"] != true || result.Meta["has_more"] != true {
+		t.Errorf("Expected exact total 7 with more pages, got %+v", result.Meta)
+	}
+}
+
+func TestTaskTools_HandleGetAllTasks_DetectsIgnoredOffset(t *testing.T) {
+	// Fewer tasks than a page plus one, so only the offset gives it away
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Task{
+			{TaskID: "t1", TaskName: "Task", Status: "Not Started"},
+			{TaskID: "t2", TaskName: "Task", Status: "Not Started"},
+			{TaskID: "t3", TaskName: "Task", Status: "Not Started"},
+			{TaskID: "t4", TaskName: "Task", Status: "Not Started"},
+		})
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	result, err := taskTools.HandleGetAllTasks(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetAllTasksParams]{
+		Arguments: GetAllTasksParams{Page: 2, PageSize: 3},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetAllTasks failed: %v", err)
+	}
+
+	var ids []string
+	for _, task := range result.Meta["tasks"].([]Task) {
+		ids = append(ids, task.TaskID)
+	}
+	if strings.Join(ids, ",") != "t4" {
+		t.Errorf("Expected only t4 on the second page, got %v", ids)
+	}
+	if result.Meta["total_count"] != 4 || result.Meta["total_count_exact"] != true || result.Meta["has_more"] != false {
+		t.Errorf("Expected exact total 4 with no more pages, got %+v", result.Meta)
+	}
+}
+
+func TestTaskTools_HandleGetNewOverdueSince(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Task{
+			{TaskID: "old", TaskName: "Went overdue before the cutoff", Status: "In Progress", DueDate: stringPtr("2024-06-05T17:00:00Z")},
+			{TaskID: "new", TaskName: "Crossed after the cutoff", Status: "Not Started", DueDate: stringPtr("2024-06-10T17:00:00Z")},
+			{TaskID: "future", TaskName: "Not yet due", Status: "Not Started", DueDate: stringPtr("2024-06-20T17:00:00Z")},
+			{TaskID: "done", TaskName: "Finished late", Status: "Complete", DueDate: stringPtr("2024-06-10T17:00:00Z")},
+		})
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	taskTools.SetClock(clock.NewFixed(time.Date(2024, 6, 12, 9, 0, 0, 0, time.UTC)))
+
+	result, err := taskTools.HandleGetNewOverdueSince(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetNewOverdueSinceParams]{
+		Arguments: GetNewOverdueSinceParams{Since: "2024-06-08T00:00:00Z"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetNewOverdueSince failed: %v", err)
+	}
+
+	tasks := result.Meta["tasks"].([]Task)
+	if len(tasks) != 1 || tasks[0].TaskID != "new" {
+		t.Errorf("Expected only the task that crossed its due date after the cutoff, got %+v", tasks)
+	}
+	if result.Meta["previously_overdue_count"] != 1 {
+		t.Errorf("Expected 1 previously overdue task, got %v", result.Meta["previously_overdue_count"])
+	}
+
+	// A duration window ending now works the same way
+	result, err = taskTools.HandleGetNewOverdueSince(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetNewOverdueSinceParams]{
+		Arguments: GetNewOverdueSinceParams{Since: "24h"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetNewOverdueSince failed: %v", err)
+	}
+	if result.Meta["new_overdue_count"] != 0 {
+		t.Errorf("Expected nothing newly overdue in the last 24h, got %v", result.Meta["new_overdue_count"])
+	}
+
+	if _, err := taskTools.HandleGetNewOverdueSince(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetNewOverdueSinceParams]{}); err == nil {
+		t.Error("Expected error when since is missing")
+	}
+}
+
+func TestTaskTools_HandleGetTasksBySource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Task{
+			{TaskID: "imported-1", TaskName: "Migrate backlog item 1", Status: "Not Started", Source: stringPtr("import")},
+			{TaskID: "imported-2", TaskName: "Migrate backlog item 2", Status: "In Progress", Source: stringPtr("import")},
+			{TaskID: "quick-1", TaskName: "Call vendor", Status: "Not Started", Source: stringPtr("quick_add")},
+			{TaskID: "quick-done", TaskName: "Book room", Status: "Complete", Source: stringPtr("quick_add")},
+		})
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	result, err := taskTools.HandleGetTasksBySource(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTasksBySourceParams]{})
+	if err != nil {
+		t.Fatalf("HandleGetTasksBySource failed: %v", err)
+	}
+
+	counts := result.Meta["counts"].(map[string]int)
+	if counts["import"] != 2 || counts["quick_add"] != 1 || len(counts) != 2 {
+		t.Errorf("Expected 2 imported and 1 quick-added incomplete task, got %v", counts)
+	}
+
+	groups := result.Meta["groups"].([]SourceGroup)
+	if groups[0].Source != "import" || groups[0].Sample[0].TaskID != "imported-1" {
+		t.Errorf("Expected imported tasks grouped first, got %+v", groups[0])
+	}
+	if groups[1].Source != "quick_add" || len(groups[1].Sample) != 1 || groups[1].Sample[0].TaskID != "quick-1" {
+		t.Errorf("Expected only the open quick-added task in its group, got %+v", groups[1])
+	}
+}
+
+func TestTaskTools_HandleSearchTasks_SubstringIgnoresCase(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Task{
+			{TaskID: "name", TaskName: "API Integration", Status: "Not Started"},
+			{TaskID: "description", TaskName: "Wire up billing", Status: "Not Started", TaskDescription: stringPtr("Call the payments Api nightly")},
+			{TaskID: "accented", TaskName: "Résumé parser", Status: "Not Started"},
+			{TaskID: "other", TaskName: "Write docs", Status: "Not Started"},
+		})
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	search := func(text string) []string {
+		t.Helper()
+		result, err := taskTools.HandleSearchTasks(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[SearchTasksParams]{
+			Arguments: SearchTasksParams{SearchText: text},
+		})
+		if err != nil {
+			t.Fatalf("HandleSearchTasks failed: %v", err)
+		}
+		var ids []string
+		for _, task := range result.Meta["tasks"].([]Task) {
+			ids = append(ids, task.TaskID)
+		}
+		return ids
+	}
+
+	if ids := search("api"); strings.Join(ids, ",") != "name,description" {
+		t.Errorf("Expected \"api\" to match the name and description in any case, got %v", ids)
+	}
+	if ids := search("RÉSUMÉ"); strings.Join(ids, ",") != "accented" {
+		t.Errorf("Expected accented text to match ignoring case, got %v", ids)
+	}
+}
+
+func TestTaskTools_HandleSearchTasks_FiltersByTags(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Task{
+			{TaskID: "both", TaskName: "Both tags", Status: "Not Started", Tags: []string{"Backend", "urgent"}},
+			{TaskID: "backend", TaskName: "Backend only", Status: "In Progress", Tags: []string{"backend", "api"}},
+			{TaskID: "urgent", TaskName: "Urgent only", Status: "Not Started", Tags: []string{"urgent"}},
+			{TaskID: "untagged", TaskName: "No tags", Status: "Not Started"},
+		})
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	search := func(mode string) *mcp.CallToolResultFor[map[string]any] {
+		t.Helper()
+		result, err := taskTools.HandleSearchTasks(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[SearchTasksParams]{
+			Arguments: SearchTasksParams{Tags: []string{"backend", "urgent"}, TagMatchMode: mode},
+		})
+		if err != nil {
+			t.Fatalf("HandleSearchTasks failed: %v", err)
+		}
+		return result
+	}
+	ids := func(result *mcp.CallToolResultFor[map[string]any]) string {
+		var ids []string
+		for _, task := range result.Meta["tasks"].([]Task) {
+			ids = append(ids, task.TaskID)
+		}
+		return strings.Join(ids, ",")
+	}
+
+	t.Run("any", func(t *testing.T) {
+		result := search("")
+		if got := ids(result); got != "both,backend,urgent" {
+			t.Errorf("Expected tasks with either tag, got %s", got)
+		}
+		matched := result.Meta["matched_tags"].(map[string][]string)
+		if got := strings.Join(matched["both"], ","); got != "Backend,urgent" {
+			t.Errorf("Expected both tags matched on 'both', got %q", got)
+		}
+		if got := strings.Join(matched["backend"], ","); got != "backend" {
+			t.Errorf("Expected only backend matched on 'backend', got %q", got)
+		}
+		breakdown := result.Meta["tag_breakdown"].(map[string]int)
+		if breakdown["urgent"] != 2 || breakdown["api"] != 1 {
+			t.Errorf("Expected tag counts across results, got %v", breakdown)
+		}
+		text := result.Content[0].(*mcp.TextContent).Text
+		if !strings.Contains(text, "Tags (any): backend, urgent") || !strings.Contains(text, "[tags: Backend, urgent]") {
+			t.Errorf("Expected tag criteria and matched tags in text, got:\n%s", text)
+		}
+	})
+
+	t.Run("all", func(t *testing.T) {
+		result := search("all")
+		if got := ids(result); got != "both" {
+			t.Errorf("Expected only the task with every tag, got %s", got)
+		}
+		breakdown := result.Meta["tag_breakdown"].(map[string]int)
+		if len(breakdown) != 2 || breakdown["Backend"] != 1 || breakdown["urgent"] != 1 {
+			t.Errorf("Expected tag counts for the single result, got %v", breakdown)
+		}
+	})
+}
+
+func TestTaskTools_HandleGetOverdueTasks(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(days float64) *string {
+		value := now.Add(-time.Duration(days * float64(24*time.Hour))).Format(time.RFC3339)
+		return &value
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Task{
+			{TaskID: "slightly", TaskName: "Slightly late", Status: "In Progress", DueDate: daysAgo(1)},
+			{TaskID: "very", TaskName: "Very late", Status: "Not Started", DueDate: daysAgo(10)},
+			{TaskID: "edge", TaskName: "Exactly at grace", Status: "Blocked", DueDate: daysAgo(2)},
+			{TaskID: "moderately", TaskName: "Moderately late", Status: "In Progress", DueDate: daysAgo(4.5)},
+			{TaskID: "done", TaskName: "Done late", Status: "Complete", DueDate: daysAgo(20)},
+			{TaskID: "future", TaskName: "Not due yet", Status: "Not Started", DueDate: daysAgo(-3)},
+			{TaskID: "archived", TaskName: "Archived late", Status: "Not Started", DueDate: daysAgo(30), Archived: true},
+		})
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	taskTools.SetClock(clock.NewFixed(now))
+
+	overdue := func(graceDays int) *mcp.CallToolResultFor[map[string]any] {
+		t.Helper()
+		result, err := taskTools.HandleGetOverdueTasks(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetOverdueTasksParams]{
+			Arguments: GetOverdueTasksParams{GraceDays: graceDays},
+		})
+		if err != nil {
+			t.Fatalf("HandleGetOverdueTasks failed: %v", err)
+		}
+		return result
+	}
+
+	result := overdue(0)
+	var got []string
+	for _, entry := range result.Meta["tasks"].([]map[string]any) {
+		got = append(got, fmt.Sprintf("%s:%d", entry["task_id"], entry["days_overdue"]))
+	}
+	if strings.Join(got, ",") != "very:10,moderately:4,edge:2,slightly:1" {
+		t.Errorf("Expected open overdue tasks most overdue first with days overdue, got %v", got)
+	}
+
+	result = overdue(2)
+	got = nil
+	for _, entry := range result.Meta["tasks"].([]map[string]any) {
+		got = append(got, entry["task_id"].(string))
+	}
+	if strings.Join(got, ",") != "very,moderately" {
+		t.Errorf("Expected only tasks more than 2 days overdue, got %v", got)
+	}
+	if result.Meta["within_grace_count"] != 2 {
+		t.Errorf("Expected 2 tasks within the grace window, got %v", result.Meta["within_grace_count"])
+	}
+
+	if _, err := taskTools.HandleGetOverdueTasks(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetOverdueTasksParams]{
+		Arguments: GetOverdueTasksParams{GraceDays: -1},
+	}); err == nil {
+		t.Error("Expected error for negative grace_days")
+	}
+}
+
+func TestTaskTools_HandleExportTasks(t *testing.T) {
+	description := "Needs \"care\", lots of it"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("project_id") != "proj-1" {
+			t.Errorf("Expected the project filter to be sent, got %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Task{
+			{TaskID: "t1", TaskName: "Export me", TaskDescription: &description, Status: "In Progress", ProjectID: stringPtr("proj-1")},
+			{TaskID: "t2", TaskName: "Other project", Status: "In Progress", ProjectID: stringPtr("proj-2")},
+			{TaskID: "t3", TaskName: "Archived", Status: "In Progress", ProjectID: stringPtr("proj-1"), Archived: true},
+		})
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+
+	export := func(format string) *mcp.CallToolResultFor[map[string]any] {
+		t.Helper()
+		result, err := taskTools.HandleExportTasks(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[ExportTasksParams]{
+			Arguments: ExportTasksParams{ProjectID: "proj-1", Format: format},
+		})
+		if err != nil {
+			t.Fatalf("HandleExportTasks failed: %v", err)
+		}
+		return result
+	}
+
+	result := export("")
+	if result.Meta["format"] != "csv" || result.Meta["row_count"] != 1 {
+		t.Errorf("Expected 1 CSV row, got format %v and %v rows", result.Meta["format"], result.Meta["row_count"])
+	}
+	text := result.Content[0].(*mcp.TextContent).Text
+	if !strings.HasPrefix(text, "task_id,task_name,") || !strings.Contains(text, `t1,Export me,"Needs ""care"", lots of it",`) {
+		t.Errorf("Unexpected CSV: %q", text)
+	}
+
+	result = export("json")
+	var exported []Task
+	if err := json.Unmarshal([]byte(result.Content[0].(*mcp.TextContent).Text), &exported); err != nil {
+		t.Fatalf("Expected JSON text content: %v", err)
+	}
+	if len(exported) != 1 || exported[0].TaskID != "t1" {
+		t.Errorf("Expected only t1 in the JSON export, got %+v", exported)
+	}
+}
+
+func TestTaskTools_HandleImportTasks(t *testing.T) {
+	var mu sync.Mutex
+	var posted []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method == "GET" {
+			json.NewEncoder(w).Encode(Project{ProjectID: strings.TrimPrefix(r.URL.Path, "/api/v1/projects/")})
+			return
+		}
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		if body["task_name"] == "API rejects" {
+			http.Error(w, "bad task", http.StatusBadRequest)
+			return
+		}
+		mu.Lock()
+		posted = append(posted, body)
+		mu.Unlock()
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(Task{TaskID: "id-" + body["task_name"].(string), TaskName: body["task_name"].(string), Status: body["status"].(string)})
+	}))
+	defer server.Close()
+
+	cfg := config.Default()
+	cfg.ProjectDefaultAssignees = map[string]string{"proj-1": "bob"}
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+	importTasks := func(format, data string) (*mcp.CallToolResultFor[map[string]any], error) {
+		return taskTools.HandleImportTasks(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[ImportTasksParams]{
+			Arguments: ImportTasksParams{Format: format, Data: data, CreatedBy: "alice", ProjectID: "proj-1"},
+		})
+	}
+
+	result, err := importTasks("csv", "task_name,task_description,priority\n"+
+		"First,\"Has, commas and \"\"quotes\"\"\",High\n"+
+		",Missing name,Low\n"+
+		"API rejects,,\n"+
+		"Last,,Urgent\n")
+	if err != nil {
+		t.Fatalf("HandleImportTasks failed: %v", err)
+	}
+	if result.Meta["created_count"] != 1 || result.Meta["failed_count"] != 3 || result.Meta["total_rows"] != 4 {
+		t.Errorf("Expected 1 created and 3 failed of 4 rows, got %v, %v and %v", result.Meta["created_count"], result.Meta["failed_count"], result.Meta["total_rows"])
+	}
+	var failedRows []int
+	for _, rowError := range result.Meta["row_errors"].([]importRowError) {
+		failedRows = append(failedRows, rowError.Row)
+	}
+	if fmt.Sprint(failedRows) != "[2 3 4]" {
+		t.Errorf("Expected rows 2, 3 and 4 to fail, got %v", failedRows)
+	}
+	if len(posted) != 1 || posted[0]["task_description"] != `Has, commas and "quotes"` || posted[0]["source"] != "import" || posted[0]["project_id"] != "proj-1" {
+		t.Errorf("Unexpected created task request: %+v", posted)
+	}
+
+	posted = nil
+	result, err = importTasks("json", `[{"task_name": "From JSON", "status": "In Progress"}, {"status": "Blocked"}]`)
+	if err != nil {
+		t.Fatalf("HandleImportTasks failed: %v", err)
+	}
+	if result.Meta["created_count"] != 1 || result.Meta["failed_count"] != 1 {
+		t.Errorf("Expected 1 created and 1 failed, got %v and %v", result.Meta["created_count"], result.Meta["failed_count"])
+	}
+	if len(posted) != 1 || posted[0]["status"] != "In Progress" {
+		t.Errorf("Unexpected created task request: %+v", posted)
+	}
+
+	// Unassigned rows go to the project's default assignee; assigned rows keep theirs
+	posted = nil
+	result, err = importTasks("json", `[{"task_name": "Unassigned"}, {"task_name": "Assigned", "assigned_to": "carol"}]`)
+	if err != nil {
+		t.Fatalf("HandleImportTasks failed: %v", err)
+	}
+	if result.Meta["created_count"] != 2 {
+		t.Fatalf("Expected 2 created, got %v", result.Meta["created_count"])
+	}
+	assignedTo := map[any]any{}
+	for _, body := range posted {
+		assignedTo[body["task_name"]] = body["assigned_to"]
+	}
+	if assignedTo["Unassigned"] != "bob" || assignedTo["Assigned"] != "carol" {
+		t.Errorf("Expected bob and carol as assignees, got %v", assignedTo)
+	}
+
+	// A malformed batch creates nothing
+	posted = nil
+	if _, err := importTasks("json", `[{"task_name": "A"}, {"task_name": `); err == nil {
+		t.Error("Expected a malformed JSON batch to be rejected")
+	}
+	if _, err := importTasks("csv", "task_name\n\"Unclosed\n"); err == nil {
+		t.Error("Expected a malformed CSV batch to be rejected")
+	}
+	if len(posted) != 0 {
+		t.Errorf("Expected no tasks created from malformed batches, got %d", len(posted))
+	}
+}
+
+func TestTaskTools_HandleGetTasksDueSoon(t *testing.T) {
+	now := time.Date(2024, 6, 12, 9, 0, 0, 0, time.UTC)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("assigned_to") != "alice" {
+			t.Errorf("Expected the assignee filter to be sent, got %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode([]Task{
+			{TaskID: "friday", TaskName: "Friday task", Status: "Not Started", AssignedTo: stringPtr("alice"), DueDate: stringPtr("2024-06-14")},
+			{TaskID: "today", TaskName: "Today task", Status: "In Progress", AssignedTo: stringPtr("alice"), DueDate: stringPtr("2024-06-12T17:00:00Z")},
+			{TaskID: "tomorrow", TaskName: "Tomorrow task", Status: "In Progress", AssignedTo: stringPtr("alice"), DueDate: stringPtr("2024-06-13T10:00:00Z")},
+			{TaskID: "in-nine-days", TaskName: "Later task", Status: "Not Started", AssignedTo: stringPtr("alice"), DueDate: stringPtr("2024-06-21")},
+			{TaskID: "overdue", TaskName: "Overdue task", Status: "In Progress", AssignedTo: stringPtr("alice"), DueDate: stringPtr("2024-06-10")},
+		})
+	}))
+	defer server.Close()
+
+	taskTools := NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	taskTools.SetClock(clock.NewFixed(now))
+
+	dueSoon := func(days int) *mcp.CallToolResultFor[map[string]any] {
+		t.Helper()
+		result, err := taskTools.HandleGetTasksDueSoon(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTasksDueSoonParams]{
+			Arguments: GetTasksDueSoonParams{AssignedTo: "alice", Days: days},
+		})
+		if err != nil {
+			t.Fatalf("HandleGetTasksDueSoon failed: %v", err)
+		}
+		return result
+	}
+
+	result := dueSoon(0)
+	var got []string
+	for _, entry := range result.Meta["tasks"].([]map[string]any) {
+		got = append(got, entry["task_id"].(string))
+	}
+	if strings.Join(got, ",") != "today,tomorrow,friday" {
+		t.Errorf("Expected tasks due within 7 days soonest first, got %v", got)
+	}
+	if result.Meta["days"] != 7 || result.Meta["due_today"] != 1 || result.Meta["due_tomorrow"] != 1 || result.Meta["due_this_week"] != 3 {
+		t.Errorf("Unexpected counts: days %v, today %v, tomorrow %v, this week %v",
+			result.Meta["days"], result.Meta["due_today"], result.Meta["due_tomorrow"], result.Meta["due_this_week"])
+	}
+	text := result.Content[0].(*mcp.TextContent).Text
+	for _, heading := range []string{"📅 Today (Wednesday, Jun 12):", "📅 Tomorrow (Thursday, Jun 13):", "📅 Friday, Jun 14:"} {
+		if !strings.Contains(text, heading) {
+			t.Errorf("Expected day heading %q in %q", heading, text)
+		}
+	}
+
+	// A longer horizon reaches further but due_this_week stays at 7 days
+	result = dueSoon(10)
+	if result.Meta["total_count"] != 4 || result.Meta["due_this_week"] != 3 {
+		t.Errorf("Expected 4 tasks with 3 this week, got %v and %v", result.Meta["total_count"], result.Meta["due_this_week"])
+	}
+
+	// With business days the horizon counts working days: two of them
+	// reach Friday when Thursday is a holiday
+	cfg := config.Default()
+	cfg.BusinessDaysOnly = true
+	cfg.Holidays = []string{"2024-06-13"}
+	taskTools = NewTaskTools(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+	taskTools.SetClock(clock.NewFixed(now))
+	result = dueSoon(1)
+	got = nil
+	for _, entry := range result.Meta["tasks"].([]map[string]any) {
+		got = append(got, entry["task_id"].(string))
+	}
+	if strings.Join(got, ",") != "today,tomorrow,friday" || result.Meta["business_days"] != true {
+		t.Errorf("Expected the holiday skipped with business days, got %v (business_days %v)", got, result.Meta["business_days"])
+	}
+	if text := result.Content[0].(*mcp.TextContent).Text; !strings.Contains(text, "next 1 working days") {
+		t.Errorf("Expected the window in working days, got %q", text)
+	}
+}
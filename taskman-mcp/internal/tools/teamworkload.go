@@ -0,0 +1,84 @@
+package tools
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/bchamber/taskman-mcp/internal/identity"
+)
+
+// MemberWorkload is one assignee's share of the system-wide workload
+type MemberWorkload struct {
+	Assignee          string `json:"assignee"`
+	Rank              int    `json:"rank,omitempty"` // 1 is the most loaded; the unassigned bucket is not ranked
+	ActiveCount       int    `json:"active_count"`   // open, unarchived tasks
+	OverdueCount      int    `json:"overdue_count"`
+	HighPriorityCount int    `json:"high_priority_count"`
+	CompletedCount    int    `json:"completed_count"` // only counted when completed tasks are included
+}
+
+// teamWorkload groups unarchived tasks by assignee, with aliases of the same
+// person rolled up together. Open tasks with no assignee go to the separate
+// unassigned bucket. Members are ranked by active load, then overdue, then
+// high-priority counts, most loaded first. Completed tasks are skipped unless
+// includeComplete, when they add to each member's completed count.
+func teamWorkload(tasks []Task, now time.Time, assignees *identity.Normalizer, includeComplete bool) (members []MemberWorkload, unassigned MemberWorkload) {
+	unassigned.Assignee = "Unassigned"
+	byAssignee := make(map[string]*MemberWorkload)
+	var order []string
+
+	for _, task := range tasks {
+		if task.Archived {
+			continue
+		}
+		complete := task.Status == "Complete"
+		if complete && !includeComplete {
+			continue
+		}
+
+		workload := &unassigned
+		if task.AssignedTo != nil && strings.TrimSpace(*task.AssignedTo) != "" {
+			name := assignees.Canonical(strings.TrimSpace(*task.AssignedTo))
+			if byAssignee[name] == nil {
+				byAssignee[name] = &MemberWorkload{Assignee: name}
+				order = append(order, name)
+			}
+			workload = byAssignee[name]
+		}
+
+		if complete {
+			workload.CompletedCount++
+			continue
+		}
+		workload.ActiveCount++
+		if isTaskOverdue(task, now) {
+			workload.OverdueCount++
+		}
+		if priorityRank(task.Priority) == 0 {
+			workload.HighPriorityCount++
+		}
+	}
+
+	members = make([]MemberWorkload, 0, len(order))
+	for _, name := range order {
+		members = append(members, *byAssignee[name])
+	}
+	sort.SliceStable(members, func(i, j int) bool {
+		a, b := members[i], members[j]
+		if a.ActiveCount != b.ActiveCount {
+			return a.ActiveCount > b.ActiveCount
+		}
+		if a.OverdueCount != b.OverdueCount {
+			return a.OverdueCount > b.OverdueCount
+		}
+		if a.HighPriorityCount != b.HighPriorityCount {
+			return a.HighPriorityCount > b.HighPriorityCount
+		}
+		return a.Assignee < b.Assignee
+	})
+	for i := range members {
+		members[i].Rank = i + 1
+	}
+	return members, unassigned
+}
@@ -0,0 +1,82 @@
+package tools
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bchamber/taskman-mcp/internal/identity"
+)
+
+func TestTeamWorkload(t *testing.T) {
+	now := time.Date(2024, 6, 12, 12, 0, 0, 0, time.UTC)
+	past := stringPtr("2024-06-01T00:00:00Z")
+	future := stringPtr("2024-07-01T00:00:00Z")
+
+	tasks := []Task{
+		{TaskID: "a1", Status: "In Progress", AssignedTo: stringPtr("Alice"), Priority: stringPtr("High"), DueDate: past},
+		{TaskID: "a2", Status: "Not Started", AssignedTo: stringPtr("alice "), Priority: stringPtr("Low"), DueDate: future},
+		{TaskID: "a3", Status: "Complete", AssignedTo: stringPtr("alice"), DueDate: past},
+		{TaskID: "b1", Status: "Blocked", AssignedTo: stringPtr("bob"), Priority: stringPtr("High")},
+		{TaskID: "b2", Status: "Review", AssignedTo: stringPtr("bob"), DueDate: past},
+		{TaskID: "c1", Status: "In Progress", AssignedTo: stringPtr("carol"), Archived: true},
+		{TaskID: "d1", Status: "Complete", AssignedTo: stringPtr("dave")},
+		{TaskID: "u1", Status: "Not Started", Priority: stringPtr("High"), DueDate: past},
+		{TaskID: "u2", Status: "Not Started", AssignedTo: stringPtr("  ")},
+	}
+	assignees := identity.NewNormalizer(nil, true)
+
+	members, unassigned := teamWorkload(tasks, now, assignees, false)
+
+	// alice's aliases roll up together; alice and bob tie on every count, so
+	// the name decides
+	expected := []MemberWorkload{
+		{Assignee: "alice", Rank: 1, ActiveCount: 2, OverdueCount: 1, HighPriorityCount: 1},
+		{Assignee: "bob", Rank: 2, ActiveCount: 2, OverdueCount: 1, HighPriorityCount: 1},
+	}
+	if len(members) != len(expected) {
+		t.Fatalf("Expected %d members, got %+v", len(expected), members)
+	}
+	for i := range expected {
+		if members[i] != expected[i] {
+			t.Errorf("Member %d: expected %+v, got %+v", i, expected[i], members[i])
+		}
+	}
+	if unassigned.ActiveCount != 2 || unassigned.OverdueCount != 1 || unassigned.HighPriorityCount != 1 || unassigned.Rank != 0 {
+		t.Errorf("Unexpected unassigned bucket: %+v", unassigned)
+	}
+
+	members, _ = teamWorkload(tasks, now, assignees, true)
+	if len(members) != 3 {
+		t.Fatalf("Expected dave to appear once completed tasks are included, got %+v", members)
+	}
+	if members[0].CompletedCount != 1 || members[0].ActiveCount != 2 {
+		t.Errorf("Expected alice's completed task to count separately, got %+v", members[0])
+	}
+	if last := members[2]; last.Assignee != "dave" || last.ActiveCount != 0 || last.CompletedCount != 1 || last.Rank != 3 {
+		t.Errorf("Expected dave ranked last with only a completed task, got %+v", last)
+	}
+}
+
+func TestTeamWorkload_RanksByLoadThenOverdue(t *testing.T) {
+	now := time.Date(2024, 6, 12, 12, 0, 0, 0, time.UTC)
+	past := stringPtr("2024-06-01T00:00:00Z")
+
+	tasks := []Task{
+		{TaskID: "1", Status: "In Progress", AssignedTo: stringPtr("zoe")},
+		{TaskID: "2", Status: "In Progress", AssignedTo: stringPtr("amy")},
+		{TaskID: "3", Status: "In Progress", AssignedTo: stringPtr("zoe"), DueDate: past},
+		{TaskID: "4", Status: "In Progress", AssignedTo: stringPtr("max")},
+		{TaskID: "5", Status: "In Progress", AssignedTo: stringPtr("max")},
+		{TaskID: "6", Status: "In Progress", AssignedTo: stringPtr("max")},
+	}
+
+	members, _ := teamWorkload(tasks, now, nil, false)
+
+	var order []string
+	for _, member := range members {
+		order = append(order, member.Assignee)
+	}
+	if len(order) != 3 || order[0] != "max" || order[1] != "zoe" || order[2] != "amy" {
+		t.Errorf("Expected ranking [max zoe amy], got %v", order)
+	}
+}
@@ -0,0 +1,168 @@
+package tools
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// Timeline event types reported by get_task_timeline and get_project_timeline
+const (
+	timelineCreated   = "created"
+	timelineStarted   = "started"
+	timelineNote      = "note"
+	timelineCompleted = "completed"
+	timelineFirstTask = "first_task"
+	timelineMilestone = "milestone"
+)
+
+// timelineEvent is one entry in a task's or project's derived timeline
+type timelineEvent struct {
+	Type        string `json:"type"`
+	Time        string `json:"time"`
+	Relative    string `json:"relative,omitempty"`
+	Actor       string `json:"actor,omitempty"`
+	Description string `json:"description"`
+	NoteID      string `json:"note_id,omitempty"`
+	TaskID      string `json:"task_id,omitempty"`
+
+	at    time.Time
+	dated bool
+}
+
+// buildTaskTimeline derives a task's history from its creation, start and
+// completion dates and its notes, since the API keeps no change log. Events
+// are oldest first; events whose time cannot be parsed follow in input order.
+func buildTaskTimeline(task Task, notes []TaskNote, now time.Time) []timelineEvent {
+	var events []timelineEvent
+	add := func(eventType, when, actor, description, noteID string) {
+		if when == "" {
+			return
+		}
+		event := timelineEvent{Type: eventType, Time: when, Actor: actor, Description: description, NoteID: noteID}
+		events = append(events, datedEvent(event, now))
+	}
+
+	add(timelineCreated, task.CreationDate, task.CreatedBy, "Task created", "")
+	if task.StartDate != nil {
+		add(timelineStarted, *task.StartDate, "", "Work started", "")
+	}
+	for _, note := range notes {
+		add(timelineNote, note.CreationDate, note.CreatedBy, note.Note, note.NoteID)
+	}
+	if task.CompletionDate != nil {
+		add(timelineCompleted, *task.CompletionDate, "", "Task completed", "")
+	}
+
+	sortTimeline(events)
+	return events
+}
+
+// buildProjectTimeline derives a project's history from its creation date,
+// its earliest task, the completion dates of its completed tasks and its
+// milestone due dates, oldest first
+func buildProjectTimeline(project Project, tasks []Task, now time.Time) []timelineEvent {
+	var events []timelineEvent
+	if project.CreationDate != "" {
+		events = append(events, datedEvent(timelineEvent{
+			Type: timelineCreated, Time: project.CreationDate, Actor: project.CreatedBy, Description: "Project created",
+		}, now))
+	}
+
+	var first *timelineEvent
+	for _, task := range tasks {
+		if task.CreationDate == "" {
+			continue
+		}
+		event := datedEvent(timelineEvent{
+			Type: timelineFirstTask, Time: task.CreationDate, Actor: task.CreatedBy,
+			Description: fmt.Sprintf("First task created: %s", task.TaskName), TaskID: task.TaskID,
+		}, now)
+		if event.dated && (first == nil || event.at.Before(first.at)) {
+			first = &event
+		}
+	}
+	if first != nil {
+		events = append(events, *first)
+	}
+
+	for _, task := range tasks {
+		if task.Status != "Complete" || task.CompletionDate == nil || *task.CompletionDate == "" {
+			continue
+		}
+		events = append(events, datedEvent(timelineEvent{
+			Type: timelineCompleted, Time: *task.CompletionDate,
+			Description: fmt.Sprintf("Task completed: %s", task.TaskName), TaskID: task.TaskID,
+		}, now))
+	}
+
+	for _, milestone := range project.Milestones {
+		events = append(events, datedEvent(timelineEvent{
+			Type: timelineMilestone, Time: milestone.DueDate,
+			Description: fmt.Sprintf("Milestone due: %s", milestone.Name),
+		}, now))
+	}
+
+	sortTimeline(events)
+	return events
+}
+
+// datedEvent parses event.Time, filling in the event's relative time when
+// the time can be parsed
+func datedEvent(event timelineEvent, now time.Time) timelineEvent {
+	if at, err := parseDueDate(event.Time); err == nil && at != nil {
+		event.at, event.dated = *at, true
+		event.Relative = relativeTime(*at, now)
+	}
+	return event
+}
+
+// sortTimeline orders events oldest first, leaving undated events at the end
+// in their original order
+func sortTimeline(events []timelineEvent) {
+	sort.SliceStable(events, func(i, j int) bool {
+		if events[i].dated != events[j].dated {
+			return events[i].dated
+		}
+		return events[i].dated && events[i].at.Before(events[j].at)
+	})
+}
+
+// relativeTime describes t relative to now, e.g. "3 days ago" or "in 2 hours"
+func relativeTime(t, now time.Time) string {
+	d := now.Sub(t)
+	future := d < 0
+	if future {
+		d = -d
+	}
+	if d < time.Minute {
+		return "just now"
+	}
+
+	var amount string
+	switch {
+	case d < time.Hour:
+		amount = countUnit(int(d.Minutes()), "minute")
+	case d < 24*time.Hour:
+		amount = countUnit(int(d.Hours()), "hour")
+	case d < 30*24*time.Hour:
+		amount = countUnit(wholeDays(d), "day")
+	case d < 365*24*time.Hour:
+		amount = countUnit(wholeDays(d)/30, "month")
+	default:
+		amount = countUnit(wholeDays(d)/365, "year")
+	}
+
+	if future {
+		return "in " + amount
+	}
+	return amount + " ago"
+}
+
+// countUnit formats n with unit, pluralizing the unit when n is not 1
+func countUnit(n int, unit string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", unit)
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
@@ -0,0 +1,64 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRelativeTime(t *testing.T) {
+	now := time.Date(2024, 6, 20, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		at   time.Time
+		want string
+	}{
+		{now.Add(-30 * time.Second), "just now"},
+		{now.Add(-1 * time.Minute), "1 minute ago"},
+		{now.Add(-5 * time.Hour), "5 hours ago"},
+		{now.Add(-3 * 24 * time.Hour), "3 days ago"},
+		{now.Add(-75 * 24 * time.Hour), "2 months ago"},
+		{now.Add(-800 * 24 * time.Hour), "2 years ago"},
+		{now.Add(2 * 24 * time.Hour), "in 2 days"},
+	}
+	for _, tt := range tests {
+		if got := relativeTime(tt.at, now); got != tt.want {
+			t.Errorf("relativeTime(%v) = %q, want %q", tt.at, got, tt.want)
+		}
+	}
+}
+
+func TestBuildTaskTimeline(t *testing.T) {
+	now := time.Date(2024, 6, 20, 12, 0, 0, 0, time.UTC)
+	task := Task{
+		TaskID:         "task-1",
+		CreatedBy:      "alice",
+		CreationDate:   "2024-06-01T09:00:00Z",
+		StartDate:      stringPtr("2024-06-03"),
+		CompletionDate: stringPtr("2024-06-17T12:00:00Z"),
+	}
+	notes := []TaskNote{
+		{NoteID: "n2", Note: "Shipped", CreatedBy: "bob", CreationDate: "2024-06-16T08:00:00Z"},
+		{NoteID: "n1", Note: "Kicked off", CreatedBy: "alice", CreationDate: "2024-06-02T10:00:00Z"},
+		{NoteID: "n3", Note: "Imported", CreatedBy: "bot", CreationDate: "sometime"},
+	}
+
+	events := buildTaskTimeline(task, notes, now)
+
+	var order []string
+	for _, event := range events {
+		order = append(order, event.Type+":"+event.NoteID)
+	}
+	if got := strings.Join(order, ","); got != "created:,note:n1,started:,note:n2,completed:,note:n3" {
+		t.Errorf("Expected events oldest first with undated last, got %s", got)
+	}
+	if events[0].Relative != "19 days ago" || events[0].Actor != "alice" {
+		t.Errorf("Expected creation 19 days ago by alice, got %+v", events[0])
+	}
+	if events[5].Relative != "" {
+		t.Errorf("Expected no relative time for an unparseable date, got %q", events[5].Relative)
+	}
+
+	if events := buildTaskTimeline(Task{TaskID: "bare"}, nil, now); len(events) != 0 {
+		t.Errorf("Expected no events without dates, got %+v", events)
+	}
+}
@@ -0,0 +1,65 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+)
+
+// statusTransitions lists, for each status, the statuses a task may move to next
+var statusTransitions = map[string][]string{
+	"Not Started": {"In Progress", "Blocked"},
+	"In Progress": {"Not Started", "Blocked", "Review", "Complete"},
+	"Blocked":     {"Not Started", "In Progress"},
+	"Review":      {"In Progress", "Complete"},
+	"Complete":    {"In Progress"},
+}
+
+// isTransitionAllowed reports whether a task may move directly between two statuses
+func isTransitionAllowed(from, to string) bool {
+	if from == to {
+		return true
+	}
+	for _, next := range statusTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// statusNodeID turns a status into an identifier safe for diagram syntax
+func statusNodeID(status string) string {
+	return strings.ReplaceAll(status, " ", "_")
+}
+
+// mermaidStatusFlow renders the transition table as a Mermaid state diagram
+func mermaidStatusFlow() string {
+	var b strings.Builder
+	b.WriteString("stateDiagram-v2\n")
+	for _, status := range canonicalStatuses {
+		fmt.Fprintf(&b, "    %s: %s\n", statusNodeID(status), status)
+	}
+	fmt.Fprintf(&b, "    [*] --> %s\n", statusNodeID(canonicalStatuses[0]))
+	for _, from := range canonicalStatuses {
+		for _, to := range statusTransitions[from] {
+			fmt.Fprintf(&b, "    %s --> %s\n", statusNodeID(from), statusNodeID(to))
+		}
+	}
+	return b.String()
+}
+
+// graphvizStatusFlow renders the transition table as a Graphviz digraph
+func graphvizStatusFlow() string {
+	var b strings.Builder
+	b.WriteString("digraph status_flow {\n    rankdir=LR;\n")
+	for _, status := range canonicalStatuses {
+		fmt.Fprintf(&b, "    %q;\n", status)
+	}
+	for _, from := range canonicalStatuses {
+		for _, to := range statusTransitions[from] {
+			fmt.Fprintf(&b, "    %q -> %q;\n", from, to)
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
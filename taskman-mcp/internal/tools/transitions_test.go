@@ -0,0 +1,50 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestIsTransitionAllowed(t *testing.T) {
+	tests := []struct {
+		from, to string
+		want     bool
+	}{
+		{"Not Started", "In Progress", true},
+		{"In Progress", "Review", true},
+		{"Review", "Complete", true},
+		{"Complete", "In Progress", true},
+		{"Not Started", "Complete", false},
+		{"Blocked", "Review", false},
+		{"Blocked", "Blocked", true},
+	}
+
+	for _, tt := range tests {
+		if got := isTransitionAllowed(tt.from, tt.to); got != tt.want {
+			t.Errorf("isTransitionAllowed(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+		}
+	}
+}
+
+func TestStatusTransitions_CoverCanonicalStatuses(t *testing.T) {
+	for _, status := range canonicalStatuses {
+		for _, next := range statusTransitions[status] {
+			if statusRank(next) == len(canonicalStatuses) {
+				t.Errorf("Transition %s -> %s targets an unknown status", status, next)
+			}
+		}
+	}
+	if len(statusTransitions) != len(canonicalStatuses) {
+		t.Errorf("Expected transitions for %d statuses, got %d", len(canonicalStatuses), len(statusTransitions))
+	}
+}
+
+func TestGraphvizStatusFlow(t *testing.T) {
+	diagram := graphvizStatusFlow()
+	if !strings.HasPrefix(diagram, "digraph status_flow {") {
+		t.Errorf("Expected a digraph, got: %s", diagram)
+	}
+	if !strings.Contains(diagram, `"Review" -> "Complete";`) {
+		t.Errorf("Expected Review -> Complete edge, got: %s", diagram)
+	}
+}
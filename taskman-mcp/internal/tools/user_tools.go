@@ -5,33 +5,74 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"math"
 	"net/url"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/bchamber/taskman-mcp/internal/calendar"
 	"github.com/bchamber/taskman-mcp/internal/client"
+	"github.com/bchamber/taskman-mcp/internal/clock"
+	"github.com/bchamber/taskman-mcp/internal/config"
+	"github.com/bchamber/taskman-mcp/internal/identity"
+	"github.com/bchamber/taskman-mcp/internal/render"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // UserTools handles user-focused MCP tools
 type UserTools struct {
-	apiClient *client.APIClient
+	apiClient  *client.APIClient
+	settings   *config.Store
+	priorities *render.PriorityRenderer
+	assignees  *identity.Normalizer
+	calendar   *calendar.Calendar
+	clock      clock.Clock
 }
 
 // NewUserTools creates a new user tools handler
-func NewUserTools(apiClient *client.APIClient) *UserTools {
+func NewUserTools(apiClient *client.APIClient, cfg *config.Config) *UserTools {
+	if cfg == nil {
+		cfg = config.Default()
+	}
 	return &UserTools{
-		apiClient: apiClient,
+		apiClient:  apiClient,
+		settings:   config.NewStore(cfg),
+		priorities: render.NewPriorityRendererFromConfig(cfg),
+		assignees:  identity.NewNormalizerFromConfig(cfg),
+		calendar:   calendar.FromConfig(cfg),
+		clock:      clock.Default,
 	}
 }
 
-// GetMyWorkParams defines input for get_my_work tool
+// SetClock replaces the clock used for date calculations and timestamps
+func (u *UserTools) SetClock(c clock.Clock) {
+	u.clock = c
+}
+
+// SetConfigStore reads settings from store, so reloads published there apply
+func (u *UserTools) SetConfigStore(store *config.Store) {
+	u.settings = store
+}
+
+// config returns the configuration in effect
+func (u *UserTools) config() *config.Config {
+	return u.settings.Current()
+}
+
+// GetMyWorkParams defines input for get_my_work tool. SortBy is "score"
+// (the default) or "priority". The weights tune the score; an unset weight
+// uses its default: overdue 3, priority 2, due soon 1.
 type GetMyWorkParams struct {
-	UserID         string `json:"user_id"`
-	IncludeReview  bool   `json:"include_review,omitempty"`
-	IncludeBlocked bool   `json:"include_blocked,omitempty"`
-	ProjectID      string `json:"project_id,omitempty"`
-	SortBy         string `json:"sort_by,omitempty"`
-	Limit          int    `json:"limit,omitempty"`
+	UserID         string  `json:"user_id"`
+	IncludeReview  bool    `json:"include_review,omitempty"`
+	IncludeBlocked bool    `json:"include_blocked,omitempty"`
+	ProjectID      string  `json:"project_id,omitempty"`
+	SortBy         string  `json:"sort_by,omitempty"`
+	Limit          int     `json:"limit,omitempty"`
+	OverdueWeight  float64 `json:"overdue_weight,omitempty"`
+	PriorityWeight float64 `json:"priority_weight,omitempty"`
+	DueSoonWeight  float64 `json:"due_soon_weight,omitempty"`
 }
 
 // HandleGetMyWork implements the get_my_work tool
@@ -42,10 +83,16 @@ func (u *UserTools) HandleGetMyWork(
 ) (*mcp.CallToolResultFor[map[string]any], error) {
 	slog.Info("Executing get_my_work tool", "params", params.Arguments)
 
+	cfg := u.config()
+
 	// Validate required fields
 	if params.Arguments.UserID == "" {
 		return nil, fmt.Errorf("user_id is required")
 	}
+	weights, err := resolveWorkScoreWeights(params.Arguments.OverdueWeight, params.Arguments.PriorityWeight, params.Arguments.DueSoonWeight)
+	if err != nil {
+		return nil, err
+	}
 
 	// Build queries for different task categories
 	var allUserTasks []Task
@@ -54,9 +101,7 @@ func (u *UserTools) HandleGetMyWork(
 	var blockedTasks []Task
 
 	// Get In Progress tasks
-	inProgressQuery := fmt.Sprintf("?assigned_to=%s&status=%s",
-		url.QueryEscape(params.Arguments.UserID),
-		url.QueryEscape("In Progress"))
+	inProgressQuery := "?" + assigneeStatusQuery(u.assignees, params.Arguments.UserID, "In Progress")
 
 	if params.Arguments.ProjectID != "" {
 		inProgressQuery += fmt.Sprintf("&project_id=%s", url.QueryEscape(params.Arguments.ProjectID))
@@ -72,14 +117,13 @@ func (u *UserTools) HandleGetMyWork(
 		slog.Error("Failed to parse in-progress tasks", "error", err)
 		return nil, fmt.Errorf("failed to parse in-progress tasks: %w", err)
 	}
+	inProgressTasks = filterAssignedTo(u.assignees, inProgressTasks, params.Arguments.UserID)
 
 	allUserTasks = append(allUserTasks, inProgressTasks...)
 
 	// Get Review tasks if requested
 	if params.Arguments.IncludeReview {
-		reviewQuery := fmt.Sprintf("?assigned_to=%s&status=%s",
-			url.QueryEscape(params.Arguments.UserID),
-			url.QueryEscape("Review"))
+		reviewQuery := "?" + assigneeStatusQuery(u.assignees, params.Arguments.UserID, "Review")
 
 		if params.Arguments.ProjectID != "" {
 			reviewQuery += fmt.Sprintf("&project_id=%s", url.QueryEscape(params.Arguments.ProjectID))
@@ -92,6 +136,7 @@ func (u *UserTools) HandleGetMyWork(
 			if err := json.Unmarshal(reviewResp, &reviewTasks); err != nil {
 				slog.Warn("Failed to parse review tasks", "error", err)
 			} else {
+				reviewTasks = filterAssignedTo(u.assignees, reviewTasks, params.Arguments.UserID)
 				allUserTasks = append(allUserTasks, reviewTasks...)
 			}
 		}
@@ -99,9 +144,7 @@ func (u *UserTools) HandleGetMyWork(
 
 	// Get Blocked tasks if requested
 	if params.Arguments.IncludeBlocked {
-		blockedQuery := fmt.Sprintf("?assigned_to=%s&status=%s",
-			url.QueryEscape(params.Arguments.UserID),
-			url.QueryEscape("Blocked"))
+		blockedQuery := "?" + assigneeStatusQuery(u.assignees, params.Arguments.UserID, "Blocked")
 
 		if params.Arguments.ProjectID != "" {
 			blockedQuery += fmt.Sprintf("&project_id=%s", url.QueryEscape(params.Arguments.ProjectID))
@@ -114,6 +157,7 @@ func (u *UserTools) HandleGetMyWork(
 			if err := json.Unmarshal(blockedResp, &blockedTasks); err != nil {
 				slog.Warn("Failed to parse blocked tasks", "error", err)
 			} else {
+				blockedTasks = filterAssignedTo(u.assignees, blockedTasks, params.Arguments.UserID)
 				allUserTasks = append(allUserTasks, blockedTasks...)
 			}
 		}
@@ -125,8 +169,8 @@ func (u *UserTools) HandleGetMyWork(
 	overdueTasks := []Task{}
 	dueSoonTasks := []Task{}
 
-	now := time.Now()
-	dueSoonThreshold := now.Add(3 * 24 * time.Hour) // 3 days
+	now := u.clock.Now()
+	dueSoonThreshold := u.calendar.AddWorkingDays(now, 3) // 3 days
 
 	for _, task := range allUserTasks {
 		// Count by priority
@@ -144,11 +188,11 @@ func (u *UserTools) HandleGetMyWork(
 		}
 
 		// Check due dates
-		if isTaskOverdue(task) {
+		if isTaskOverdue(task, now) {
 			overdueTasks = append(overdueTasks, task)
 		} else if task.DueDate != nil {
 			if dueDate, err := time.Parse(time.RFC3339, *task.DueDate); err == nil {
-				if dueDate.Before(dueSoonThreshold) && dueDate.After(now) {
+				if u.calendar.EffectiveDue(dueDate).Before(dueSoonThreshold) && dueDate.After(now) {
 					dueSoonTasks = append(dueSoonTasks, task)
 				}
 			}
@@ -157,9 +201,12 @@ func (u *UserTools) HandleGetMyWork(
 
 	// Apply sorting and limiting
 	sortedTasks := allUserTasks
+	var scores []workScore
 
-	// Simple priority-based sorting
-	if params.Arguments.SortBy == "priority" || params.Arguments.SortBy == "" {
+	switch params.Arguments.SortBy {
+	case "score", "":
+		sortedTasks, scores = sortByWorkScore(sortedTasks, now, weights)
+	case "priority":
 		// Sort by priority: High -> Medium -> Low -> None
 		// This is a simplified sort - in real implementation, use sort.Slice
 		var highPriority, mediumPriority, lowPriority, noPriority []Task
@@ -191,6 +238,9 @@ func (u *UserTools) HandleGetMyWork(
 	// Apply limit
 	if params.Arguments.Limit > 0 && len(sortedTasks) > params.Arguments.Limit {
 		sortedTasks = sortedTasks[:params.Arguments.Limit]
+		if scores != nil {
+			scores = scores[:params.Arguments.Limit]
+		}
 	}
 
 	// Generate workload insights
@@ -198,35 +248,39 @@ func (u *UserTools) HandleGetMyWork(
 
 	totalTasks := len(allUserTasks)
 	if totalTasks == 0 {
-		insights = append(insights, "🎉 No active tasks assigned - you're all caught up!")
+		insights = appendInsight(cfg, insights, insightWorkCaughtUp, "🎉 No active tasks assigned - you're all caught up!")
 	} else if totalTasks == 1 {
-		insights = append(insights, "✅ Light workload with one active task")
+		insights = appendInsight(cfg, insights, insightWorkLight, "✅ Light workload with one active task")
 	} else if totalTasks > 10 {
-		insights = append(insights, "🔥 Heavy workload - consider prioritizing or delegating")
+		insights = appendInsight(cfg, insights, insightWorkHeavy, "🔥 Heavy workload - consider prioritizing or delegating")
 	} else if totalTasks > 5 {
-		insights = append(insights, "📊 Moderate workload - good task balance")
+		insights = appendInsight(cfg, insights, insightWorkModerate, "📊 Moderate workload - good task balance")
 	}
 
 	if len(overdueTasks) > 0 {
-		insights = append(insights, fmt.Sprintf("⚠️ %d tasks are overdue and need immediate attention", len(overdueTasks)))
+		insights = appendInsight(cfg, insights, insightWorkOverdue, fmt.Sprintf("⚠️ %d tasks are overdue and need immediate attention", len(overdueTasks)))
 	}
 
 	if len(dueSoonTasks) > 0 {
-		insights = append(insights, fmt.Sprintf("📅 %d tasks due in the next 3 days", len(dueSoonTasks)))
+		dayLabel := "days"
+		if u.calendar.BusinessDaysOnly() {
+			dayLabel = "working days"
+		}
+		insights = appendInsight(cfg, insights, insightWorkDueSoon, fmt.Sprintf("📅 %d tasks due in the next 3 %s", len(dueSoonTasks), dayLabel))
 	}
 
 	highPriorityCount := priorityCounts["High"]
 	if highPriorityCount > totalTasks/2 && totalTasks > 2 {
-		insights = append(insights, "🔥 Most tasks are high priority - focus on completion")
+		insights = appendInsight(cfg, insights, insightWorkMostlyHigh, "🔥 Most tasks are high priority - focus on completion")
 	}
 
 	if len(blockedTasks) > 0 {
-		insights = append(insights, fmt.Sprintf("🚫 %d tasks are blocked - work on unblocking", len(blockedTasks)))
+		insights = appendInsight(cfg, insights, insightWorkBlocked, fmt.Sprintf("🚫 %d tasks are blocked - work on unblocking", len(blockedTasks)))
 	}
 
 	projectCount := len(projectCounts)
 	if projectCount > 5 {
-		insights = append(insights, "📁 Working across many projects - consider context switching overhead")
+		insights = appendInsight(cfg, insights, insightWorkManyProjects, "📁 Working across many projects - consider context switching overhead")
 	}
 
 	// Generate actionable recommendations
@@ -278,6 +332,10 @@ func (u *UserTools) HandleGetMyWork(
 		"recommendations":    recommendations,
 		"user_id":            params.Arguments.UserID,
 	}
+	if scores != nil {
+		result["scores"] = scores
+		result["score_weights"] = weights
+	}
 
 	// Build detailed response text
 	responseText := fmt.Sprintf(`My Work Queue\n=============\n\nUser: %s\nActive Tasks: %d\n`,
@@ -307,10 +365,7 @@ func (u *UserTools) HandleGetMyWork(
 		responseText += fmt.Sprintf("\n⚠️ Overdue Tasks (%d):\n", len(overdueTasks))
 		for i, task := range overdueTasks {
 			if i < 5 { // Show only first 5
-				priority := "None"
-				if task.Priority != nil {
-					priority = *task.Priority
-				}
+				priority := u.priorities.Render(task.Priority)
 				responseText += fmt.Sprintf("- %s (%s) - Due: %s\n", task.TaskName, priority, *task.DueDate)
 			}
 		}
@@ -323,10 +378,7 @@ func (u *UserTools) HandleGetMyWork(
 		responseText += fmt.Sprintf("\n📅 Due Soon (%d):\n", len(dueSoonTasks))
 		for i, task := range dueSoonTasks {
 			if i < 5 { // Show only first 5
-				priority := "None"
-				if task.Priority != nil {
-					priority = *task.Priority
-				}
+				priority := u.priorities.Render(task.Priority)
 				responseText += fmt.Sprintf("- %s (%s) - Due: %s\n", task.TaskName, priority, *task.DueDate)
 			}
 		}
@@ -339,21 +391,23 @@ func (u *UserTools) HandleGetMyWork(
 		responseText += fmt.Sprintf("\n📋 Prioritized Task List (showing %d):\n", len(sortedTasks))
 		for i, task := range sortedTasks {
 			if i < 8 { // Show only first 8
-				priority := "None"
-				if task.Priority != nil {
-					priority = *task.Priority
-				}
+				priority := u.priorities.Render(task.Priority)
 
 				dueInfo := ""
 				if task.DueDate != nil {
-					if isTaskOverdue(task) {
+					if isTaskOverdue(task, u.clock.Now()) {
 						dueInfo = " - OVERDUE"
 					} else {
 						dueInfo = fmt.Sprintf(" - Due: %s", *task.DueDate)
 					}
 				}
 
-				responseText += fmt.Sprintf("%d. %s (%s, %s)%s\n", i+1, task.TaskName, task.Status, priority, dueInfo)
+				scoreInfo := ""
+				if scores != nil {
+					scoreInfo = fmt.Sprintf(" [score %.2f]", scores[i].Score)
+				}
+
+				responseText += fmt.Sprintf("%d. %s (%s, %s)%s%s\n", i+1, task.TaskName, task.Status, priority, dueInfo, scoreInfo)
 			}
 		}
 		if len(sortedTasks) > 8 {
@@ -393,3 +447,633 @@ func (u *UserTools) HandleGetMyWork(
 		Meta: result,
 	}, nil
 }
+
+// GetAssigneeVelocityParams defines input for get_assignee_velocity tool
+type GetAssigneeVelocityParams struct {
+	UserID string `json:"user_id"`
+	Weeks  int    `json:"weeks,omitempty"`
+}
+
+// HandleGetAssigneeVelocity implements the get_assignee_velocity tool
+func (u *UserTools) HandleGetAssigneeVelocity(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetAssigneeVelocityParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_assignee_velocity tool", "params", params.Arguments)
+
+	// Validate required fields
+	if params.Arguments.UserID == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+
+	weeks := params.Arguments.Weeks
+	if weeks <= 0 {
+		weeks = 4
+	}
+	if weeks > 52 {
+		return nil, fmt.Errorf("weeks must be at most 52")
+	}
+
+	completedQuery := "?" + assigneeStatusQuery(u.assignees, params.Arguments.UserID, "Complete")
+
+	tasksResp, err := u.apiClient.Get(ctx, "/api/v1/tasks"+completedQuery)
+	if err != nil {
+		slog.Error("Failed to get completed tasks", "error", err, "user_id", params.Arguments.UserID)
+		return nil, fmt.Errorf("failed to get completed tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse completed tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse completed tasks: %w", err)
+	}
+
+	// Only count tasks actually assigned to the user
+	assigned := []Task{}
+	for _, task := range tasks {
+		if u.assignees.Matches(task.AssignedTo, params.Arguments.UserID) {
+			assigned = append(assigned, task)
+		}
+	}
+
+	now := u.clock.Now()
+	series, skipped := weeklyCompletions(assigned, now, weeks)
+	average := averageVelocity(series)
+	trend := classifyVelocityTrend(series)
+
+	weekly := []map[string]any{}
+	total := 0
+	for i, count := range series {
+		weekStart := now.Add(-time.Duration(weeks-i) * 7 * 24 * time.Hour)
+		weekly = append(weekly, map[string]any{
+			"week_start": weekStart.Format("2006-01-02"),
+			"completed":  count,
+		})
+		total += count
+	}
+
+	result := map[string]any{
+		"user_id":          params.Arguments.UserID,
+		"weeks":            weeks,
+		"weekly_series":    weekly,
+		"total_completed":  total,
+		"average_velocity": average,
+		"trend":            trend,
+		"skipped_count":    skipped,
+	}
+
+	// Build response text
+	trendIcons := map[string]string{
+		trendImproving: "📈",
+		trendSteady:    "➡️",
+		trendDeclining: "📉",
+	}
+
+	responseText := fmt.Sprintf("Velocity for %s\n===============\n\nWindow: last %d weeks\nCompleted: %d tasks\nAverage: %.1f tasks/week\nTrend: %s %s\n",
+		params.Arguments.UserID, weeks, total, average, trendIcons[trend], trend)
+
+	responseText += "\n📊 Weekly Completions:\n"
+	for _, week := range weekly {
+		responseText += fmt.Sprintf("- Week of %s: %d\n", week["week_start"], week["completed"])
+	}
+
+	if skipped > 0 {
+		responseText += fmt.Sprintf("\n⚠️ Skipped %d completed tasks without a completion date\n", skipped)
+	}
+
+	slog.Info("Assignee velocity calculated", "user_id", params.Arguments.UserID, "average", average, "trend", trend, "skipped", skipped)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetMyOrphanedTasksParams defines input for get_my_orphaned_tasks tool
+type GetMyOrphanedTasksParams struct {
+	UserID string `json:"user_id"`
+}
+
+// HandleGetMyOrphanedTasks implements the get_my_orphaned_tasks tool
+func (u *UserTools) HandleGetMyOrphanedTasks(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetMyOrphanedTasksParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_my_orphaned_tasks tool", "params", params.Arguments)
+
+	// Validate required fields
+	if params.Arguments.UserID == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+
+	createdQuery := fmt.Sprintf("?created_by=%s", url.QueryEscape(params.Arguments.UserID))
+	tasksResp, err := u.apiClient.Get(ctx, "/api/v1/tasks"+createdQuery)
+	if err != nil {
+		slog.Error("Failed to get created tasks", "error", err, "user_id", params.Arguments.UserID)
+		return nil, fmt.Errorf("failed to get created tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse created tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse created tasks: %w", err)
+	}
+
+	// Incomplete tasks the user created that nobody owns; a blank assignee counts as none
+	orphaned := []Task{}
+	for _, task := range tasks {
+		if task.CreatedBy != params.Arguments.UserID || task.Status == "Complete" || task.Archived {
+			continue
+		}
+		if task.AssignedTo != nil && strings.TrimSpace(*task.AssignedTo) != "" {
+			continue
+		}
+		orphaned = append(orphaned, task)
+	}
+
+	// Oldest first; tasks with unparseable creation dates go last
+	now := u.clock.Now()
+	created := func(task Task) (time.Time, bool) {
+		parsed, err := parseDueDate(task.CreationDate)
+		if err != nil || parsed == nil {
+			return time.Time{}, false
+		}
+		return *parsed, true
+	}
+	sort.SliceStable(orphaned, func(i, j int) bool {
+		ci, okI := created(orphaned[i])
+		cj, okJ := created(orphaned[j])
+		if okI != okJ {
+			return okI
+		}
+		return ci.Before(cj)
+	})
+
+	orphanList := []map[string]any{}
+	for _, task := range orphaned {
+		entry := map[string]any{
+			"task_id":       task.TaskID,
+			"task_name":     task.TaskName,
+			"status":        task.Status,
+			"priority":      u.priorities.Render(task.Priority),
+			"creation_date": task.CreationDate,
+		}
+		if c, ok := created(task); ok {
+			entry["age_days"] = wholeDays(now.Sub(c))
+		}
+		orphanList = append(orphanList, entry)
+	}
+
+	result := map[string]any{
+		"user_id":        params.Arguments.UserID,
+		"orphaned_tasks": orphanList,
+		"orphaned_count": len(orphanList),
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Unassigned Tasks Created by %s\n==============================\n\n", params.Arguments.UserID)
+	if len(orphanList) == 0 {
+		responseText += "✅ Every open task you created has an owner\n"
+	} else {
+		responseText += fmt.Sprintf("👻 %d open tasks have no assignee (oldest first):\n", len(orphanList))
+		for _, entry := range orphanList {
+			age := ""
+			if days, ok := entry["age_days"]; ok {
+				age = fmt.Sprintf(", %d days old", days)
+			}
+			responseText += fmt.Sprintf("- %s (%s) [%s, %s%s]\n",
+				entry["task_name"], entry["task_id"], entry["status"], entry["priority"], age)
+		}
+		responseText += "\n💡 Assign these tasks or archive the ones no longer needed\n"
+	}
+
+	slog.Info("Orphaned tasks retrieved", "user_id", params.Arguments.UserID, "count", len(orphanList))
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// CompareAssigneesParams defines input for compare_assignees tool
+type CompareAssigneesParams struct {
+	UserIDs []string `json:"user_ids" validate:"required,min=2"`
+	Weeks   int      `json:"weeks,omitempty" validate:"min=1,max=52"`
+}
+
+// HandleCompareAssignees implements the compare_assignees tool. Each user's
+// tasks are fetched concurrently; note contributions count notes each user
+// wrote within the window on any of the compared users' tasks.
+func (u *UserTools) HandleCompareAssignees(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[CompareAssigneesParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing compare_assignees tool", "params", params.Arguments)
+
+	// Aliases of the same person are compared once, under the first form given
+	var userIDs []string
+	seen := make(map[string]bool)
+	for _, userID := range params.Arguments.UserIDs {
+		userID = strings.TrimSpace(userID)
+		if userID == "" || seen[u.assignees.Canonical(userID)] {
+			continue
+		}
+		seen[u.assignees.Canonical(userID)] = true
+		userIDs = append(userIDs, userID)
+	}
+	if len(userIDs) < 2 {
+		return nil, fmt.Errorf("user_ids must name at least 2 different users")
+	}
+
+	weeks := params.Arguments.Weeks
+	if weeks <= 0 {
+		weeks = 4
+	}
+	if weeks > 52 {
+		return nil, fmt.Errorf("weeks must be at most 52")
+	}
+
+	// Fetch each user's tasks concurrently
+	tasksByUser := make([][]Task, len(userIDs))
+	errs := make([]error, len(userIDs))
+	runBounded(len(userIDs), bulkConcurrency, func(i int) {
+		query := url.Values{}
+		if !u.assignees.Enabled() {
+			query.Set("assigned_to", userIDs[i])
+		}
+		endpoint := "/api/v1/tasks"
+		if len(query) > 0 {
+			endpoint += "?" + query.Encode()
+		}
+
+		tasksResp, err := u.apiClient.Get(ctx, endpoint)
+		if err != nil {
+			errs[i] = err
+			return
+		}
+		var tasks []Task
+		if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+			errs[i] = err
+			return
+		}
+		tasksByUser[i] = filterAssignedTo(u.assignees, tasks, userIDs[i])
+	})
+	for i, err := range errs {
+		if err != nil {
+			slog.Error("Failed to get tasks for assignee", "error", err, "user_id", userIDs[i])
+			return nil, fmt.Errorf("failed to get tasks for %s: %w", userIDs[i], err)
+		}
+	}
+
+	now := u.clock.Now()
+	windowStart := now.Add(-time.Duration(weeks) * 7 * 24 * time.Hour)
+
+	metrics := make([]AssigneeMetrics, len(userIDs))
+	var allTasks []Task
+	for i, userID := range userIDs {
+		metrics[i] = assigneeTaskMetrics(userID, tasksByUser[i], now, weeks)
+		allTasks = append(allTasks, tasksByUser[i]...)
+	}
+
+	// Count notes written in the window by each compared user
+	for _, notes := range fetchNotesForTasks(ctx, u.apiClient, allTasks) {
+		for _, note := range notes {
+			created, err := parseDueDate(note.CreationDate)
+			if err != nil || created == nil || created.Before(windowStart) || created.After(now) {
+				continue
+			}
+			for i, userID := range userIDs {
+				if u.assignees.Matches(&note.CreatedBy, userID) {
+					metrics[i].NoteContributions++
+					break
+				}
+			}
+		}
+	}
+
+	rankings := rankAssignees(metrics)
+
+	result := map[string]any{
+		"user_ids":     userIDs,
+		"weeks":        weeks,
+		"window_start": windowStart.UTC().Format(time.RFC3339),
+		"as_of":        now.UTC().Format(time.RFC3339),
+		"metrics":      metrics,
+		"rankings":     rankings,
+	}
+
+	// Build response text
+	responseText := fmt.Sprintf("Assignee Comparison\n===================\n\nWindow: last %d weeks\n\n", weeks)
+	responseText += fmt.Sprintf("%-20s %8s %8s %10s %8s\n", "User", "Active", "Overdue", "Velocity", "Notes")
+	for _, m := range metrics {
+		responseText += fmt.Sprintf("%-20s %8d %8d %10.1f %8d\n", m.UserID, m.ActiveLoad, m.OverdueCount, m.Velocity, m.NoteContributions)
+	}
+
+	metricLabels := map[string]string{
+		metricActiveLoad:        "Active load",
+		metricOverdue:           "Overdue",
+		metricVelocity:          "Velocity",
+		metricNoteContributions: "Note contributions",
+	}
+	responseText += "\n🏅 Rankings (highest first):\n"
+	for _, metric := range comparisonMetrics {
+		responseText += fmt.Sprintf("- %s: %s\n", metricLabels[metric], strings.Join(rankings[metric], " > "))
+	}
+
+	slog.Info("Assignees compared", "users", len(userIDs), "weeks", weeks)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// digestDeadlineWorkingDays is how far ahead get_personal_digest lists deadlines
+const digestDeadlineWorkingDays = 5
+
+// GetPersonalDigestParams defines input for get_personal_digest tool
+type GetPersonalDigestParams struct {
+	UserID string `json:"user_id" validate:"required"`
+}
+
+// HandleGetPersonalDigest implements the get_personal_digest tool: one
+// Markdown briefing of the user's attention-needed tasks, upcoming deadlines,
+// open tasks others are waiting on, and tasks that went overdue since yesterday
+func (u *UserTools) HandleGetPersonalDigest(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetPersonalDigestParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_personal_digest tool", "params", params.Arguments)
+
+	// Validate required fields
+	userID := strings.TrimSpace(params.Arguments.UserID)
+	if userID == "" {
+		return nil, fmt.Errorf("user_id is required")
+	}
+
+	// All tasks are needed to find the dependents of the user's tasks
+	tasksResp, err := u.apiClient.Get(ctx, "/api/v1/tasks")
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+
+	var myTasks []Task
+	for _, task := range tasks {
+		if !task.Archived && task.Status != "Complete" && u.assignees.Matches(task.AssignedTo, userID) {
+			myTasks = append(myTasks, task)
+		}
+	}
+
+	now := u.clock.Now()
+	yesterday := now.Add(-24 * time.Hour)
+	horizon := u.calendar.AddWorkingDays(now, digestDeadlineWorkingDays)
+	graph := newDependencyGraph(tasks)
+
+	var attention, deadlines, blocking, newOverdue []Task
+	reasonsByTask := make(map[string][]string)
+	waitingByTask := make(map[string][]Task)
+	for _, task := range myTasks {
+		if reasons := attentionReasons(task, now); len(reasons) > 0 {
+			reasonsByTask[task.TaskID] = reasons
+			attention = append(attention, task)
+		}
+
+		// Tasks due today are already in the attention section
+		if task.DueDate != nil && !isDueOnDay(task, now) {
+			if due, err := parseDueDate(*task.DueDate); err == nil && due != nil &&
+				due.After(now) && !u.calendar.EffectiveDue(*due).After(horizon) {
+				deadlines = append(deadlines, task)
+			}
+		}
+
+		var waiting []Task
+		for _, dependent := range graph.dependents[task.TaskID] {
+			if dependent.Status != "Complete" && !dependent.Archived {
+				waiting = append(waiting, dependent)
+			}
+		}
+		if len(waiting) > 0 {
+			waitingByTask[task.TaskID] = waiting
+			blocking = append(blocking, task)
+		}
+
+		if becameOverdueSince(task, yesterday, now) {
+			newOverdue = append(newOverdue, task)
+		}
+	}
+	sortTasksByPriorityAndDue(attention)
+	sort.SliceStable(deadlines, func(i, j int) bool {
+		return compareDueDates(deadlines[i], deadlines[j]) < 0
+	})
+	sortTasksByPriorityAndDue(blocking)
+	sortTasksByPriorityAndDue(newOverdue)
+
+	taskRef := func(task Task) map[string]any {
+		return map[string]any{
+			"task_id":   task.TaskID,
+			"task_name": task.TaskName,
+			"status":    task.Status,
+			"priority":  task.Priority,
+			"due_date":  task.DueDate,
+		}
+	}
+	attentionMeta := []map[string]any{}
+	for _, task := range attention {
+		entry := taskRef(task)
+		entry["reasons"] = reasonsByTask[task.TaskID]
+		attentionMeta = append(attentionMeta, entry)
+	}
+	deadlinesMeta := []map[string]any{}
+	for _, task := range deadlines {
+		deadlinesMeta = append(deadlinesMeta, taskRef(task))
+	}
+	blockingMeta := []map[string]any{}
+	for _, task := range blocking {
+		entry := taskRef(task)
+		waitingIDs := []string{}
+		for _, dependent := range waitingByTask[task.TaskID] {
+			waitingIDs = append(waitingIDs, dependent.TaskID)
+		}
+		entry["blocking_task_ids"] = waitingIDs
+		blockingMeta = append(blockingMeta, entry)
+	}
+	newOverdueMeta := []map[string]any{}
+	for _, task := range newOverdue {
+		newOverdueMeta = append(newOverdueMeta, taskRef(task))
+	}
+
+	result := map[string]any{
+		"user_id":            userID,
+		"open_task_count":    len(myTasks),
+		"attention_needed":   attentionMeta,
+		"upcoming_deadlines": deadlinesMeta,
+		"blocking":           blockingMeta,
+		"new_overdue":        newOverdueMeta,
+		"new_overdue_count":  len(newOverdue),
+		"deadline_horizon":   horizon.Format(time.RFC3339),
+		"generated_at":       now.Format(time.RFC3339),
+	}
+
+	// Build Markdown digest
+	responseText := fmt.Sprintf("# Daily Digest for %s — %s\n\n", userID, now.Format("2006-01-02"))
+	responseText += fmt.Sprintf("**Summary:** %d open · %d need attention · %d due soon · %d blocking others · %d newly overdue since yesterday\n",
+		len(myTasks), len(attention), len(deadlines), len(blocking), len(newOverdue))
+
+	responseText += fmt.Sprintf("\n## 🚨 Needs Attention (%d)\n\n", len(attention))
+	if len(attention) == 0 {
+		responseText += "Nothing overdue, blocked or due today.\n"
+	}
+	for _, task := range attention {
+		responseText += fmt.Sprintf("- **%s** (`%s`) — %s · %s\n",
+			task.TaskName, task.TaskID, strings.Join(reasonsByTask[task.TaskID], ", "), u.priorities.Render(task.Priority))
+	}
+
+	responseText += fmt.Sprintf("\n## 📅 Upcoming Deadlines (%d)\n\n", len(deadlines))
+	if len(deadlines) == 0 {
+		responseText += fmt.Sprintf("Nothing due in the next %d working days.\n", digestDeadlineWorkingDays)
+	}
+	for _, task := range deadlines {
+		responseText += fmt.Sprintf("- **%s** (`%s`) — due %s · %s\n",
+			task.TaskName, task.TaskID, *task.DueDate, task.Status)
+	}
+
+	responseText += fmt.Sprintf("\n## ⛓️ You're Blocking (%d)\n\n", len(blocking))
+	if len(blocking) == 0 {
+		responseText += "No open tasks are waiting on yours.\n"
+	}
+	for _, task := range blocking {
+		var waiting []string
+		for _, dependent := range waitingByTask[task.TaskID] {
+			waiting = append(waiting, fmt.Sprintf("%s (`%s`)", dependent.TaskName, dependent.TaskID))
+		}
+		responseText += fmt.Sprintf("- **%s** (`%s`) — blocks %s\n", task.TaskName, task.TaskID, strings.Join(waiting, ", "))
+	}
+
+	responseText += fmt.Sprintf("\n## 🔔 New Overdue Since Yesterday: %d\n", len(newOverdue))
+	for _, task := range newOverdue {
+		responseText += fmt.Sprintf("- **%s** (`%s`) — due %s\n", task.TaskName, task.TaskID, *task.DueDate)
+	}
+
+	slog.Info("Personal digest generated", "user_id", userID, "attention", len(attention), "deadlines", len(deadlines), "blocking", len(blocking), "new_overdue", len(newOverdue))
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
+
+// GetTeamWorkloadParams defines input for get_team_workload tool
+type GetTeamWorkloadParams struct {
+	IncludeComplete bool `json:"include_complete,omitempty"`
+}
+
+// HandleGetTeamWorkload implements the get_team_workload tool. It reads every
+// task in the system, so it shows who is overloaded across all projects.
+func (u *UserTools) HandleGetTeamWorkload(
+	ctx context.Context,
+	session *mcp.ServerSession,
+	params *mcp.CallToolParamsFor[GetTeamWorkloadParams],
+) (*mcp.CallToolResultFor[map[string]any], error) {
+	slog.Info("Executing get_team_workload tool", "params", params.Arguments)
+
+	tasksResp, err := u.apiClient.Get(ctx, "/api/v1/tasks")
+	if err != nil {
+		slog.Error("Failed to get tasks", "error", err)
+		return nil, fmt.Errorf("failed to get tasks: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks", "error", err)
+		return nil, fmt.Errorf("failed to parse tasks: %w", err)
+	}
+
+	now := u.clock.Now()
+	members, unassigned := teamWorkload(tasks, now, u.assignees, params.Arguments.IncludeComplete)
+
+	totalActive := unassigned.ActiveCount
+	for _, member := range members {
+		totalActive += member.ActiveCount
+	}
+	averageActive := 0.0
+	if len(members) > 0 {
+		averageActive = math.Round(float64(totalActive-unassigned.ActiveCount)/float64(len(members))*10) / 10
+	}
+
+	result := map[string]any{
+		"members":          members,
+		"unassigned":       unassigned,
+		"member_count":     len(members),
+		"total_active":     totalActive,
+		"average_active":   averageActive,
+		"include_complete": params.Arguments.IncludeComplete,
+		"as_of":            now.UTC().Format(time.RFC3339),
+	}
+
+	// Build response text
+	responseText := "Team Workload\n=============\n\n"
+	if len(members) == 0 {
+		responseText += "No assigned tasks found\n"
+	} else {
+		responseText += fmt.Sprintf("%d people, %d active tasks (%.1f each on average)\n\n", len(members), totalActive-unassigned.ActiveCount, averageActive)
+		header := fmt.Sprintf("%-4s %-20s %8s %8s %6s", "#", "Assignee", "Active", "Overdue", "High")
+		if params.Arguments.IncludeComplete {
+			header += fmt.Sprintf(" %6s", "Done")
+		}
+		responseText += header + "\n"
+		for _, member := range members {
+			marker := ""
+			if float64(member.ActiveCount) > averageActive*1.5 && member.ActiveCount > 2 {
+				marker = " 🔥"
+			}
+			line := fmt.Sprintf("%-4d %-20s %8d %8d %6d", member.Rank, member.Assignee, member.ActiveCount, member.OverdueCount, member.HighPriorityCount)
+			if params.Arguments.IncludeComplete {
+				line += fmt.Sprintf(" %6d", member.CompletedCount)
+			}
+			responseText += line + marker + "\n"
+		}
+	}
+
+	if unassigned.ActiveCount > 0 {
+		responseText += fmt.Sprintf("\n👻 Unassigned: %d active tasks (%d overdue, %d high priority)\n",
+			unassigned.ActiveCount, unassigned.OverdueCount, unassigned.HighPriorityCount)
+	}
+
+	slog.Info("Team workload computed", "members", len(members), "total_active", totalActive, "unassigned", unassigned.ActiveCount)
+
+	return &mcp.CallToolResultFor[map[string]any]{
+		Content: []mcp.Content{
+			&mcp.TextContent{
+				Text: responseText,
+			},
+		},
+		Meta: result,
+	}, nil
+}
@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/bchamber/taskman-mcp/internal/client"
+	"github.com/bchamber/taskman-mcp/internal/clock"
+	"github.com/bchamber/taskman-mcp/internal/config"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
@@ -88,7 +91,7 @@ func TestUserTools_HandleGetMyWork(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	userTools := NewUserTools(apiClient)
+	userTools := NewUserTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -163,7 +166,7 @@ func TestUserTools_HandleGetMyWork_MinimalParams(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	userTools := NewUserTools(apiClient)
+	userTools := NewUserTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -207,7 +210,7 @@ func TestUserTools_HandleGetMyWork_WithProjectFilter(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	userTools := NewUserTools(apiClient)
+	userTools := NewUserTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -242,7 +245,7 @@ func TestUserTools_HandleGetMyWork_MissingUserID(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	userTools := NewUserTools(apiClient)
+	userTools := NewUserTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -263,7 +266,7 @@ func TestUserTools_HandleGetMyWork_WithLimit(t *testing.T) {
 	defer server.Close()
 
 	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
-	userTools := NewUserTools(apiClient)
+	userTools := NewUserTools(apiClient, config.Default())
 
 	ctx := context.Background()
 	session := &mcp.ServerSession{}
@@ -293,3 +296,427 @@ func TestUserTools_HandleGetMyWork_WithLimit(t *testing.T) {
 		t.Errorf("Expected at most 1 task due to limit, got %d", len(prioritizedTasks))
 	}
 }
+
+func TestUserTools_HandleGetMyWork_ScoresTasks(t *testing.T) {
+	now := time.Date(2024, 6, 12, 12, 0, 0, 0, time.UTC)
+	tasks := []Task{
+		{TaskID: "future-low", TaskName: "Future low", Status: "In Progress", AssignedTo: stringPtr("alice"), Priority: stringPtr("Low"), DueDate: stringPtr("2024-06-14T12:00:00Z")},
+		{TaskID: "overdue-high", TaskName: "Overdue high", Status: "In Progress", AssignedTo: stringPtr("alice"), Priority: stringPtr("High"), DueDate: stringPtr("2024-06-10T12:00:00Z")},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(tasks)
+	}))
+	defer server.Close()
+
+	userTools := NewUserTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	userTools.SetClock(clock.NewFixed(now))
+
+	result, err := userTools.HandleGetMyWork(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetMyWorkParams]{
+		Arguments: GetMyWorkParams{UserID: "alice"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetMyWork failed: %v", err)
+	}
+
+	prioritized := result.Meta["prioritized_tasks"].([]Task)
+	scores := result.Meta["scores"].([]workScore)
+	if len(prioritized) != 2 || prioritized[0].TaskID != "overdue-high" {
+		t.Fatalf("Expected the overdue High task first, got %+v", prioritized)
+	}
+	if scores[0].TaskID != "overdue-high" || scores[0].Score <= scores[1].Score {
+		t.Errorf("Expected overdue-high to have the higher score, got %+v", scores)
+	}
+	if weights := result.Meta["score_weights"].(workScoreWeights); weights.Overdue != defaultOverdueWeight {
+		t.Errorf("Expected default weights, got %+v", weights)
+	}
+	text := result.Content[0].(*mcp.TextContent).Text
+	if !strings.Contains(text, "[score ") {
+		t.Errorf("Expected scores in the task list, got %q", text)
+	}
+
+	_, err = userTools.HandleGetMyWork(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetMyWorkParams]{
+		Arguments: GetMyWorkParams{UserID: "alice", PriorityWeight: -1},
+	})
+	if err == nil || !strings.Contains(err.Error(), "priority_weight") {
+		t.Errorf("Expected a negative weight error, got %v", err)
+	}
+}
+
+func TestUserTools_HandleGetAssigneeVelocity(t *testing.T) {
+	now := time.Now()
+	completedAt := func(daysAgo int) *string {
+		value := now.Add(-time.Duration(daysAgo)*24*time.Hour - time.Hour).Format(time.RFC3339)
+		return &value
+	}
+
+	tasks := []Task{
+		{TaskID: "t1", Status: "Complete", AssignedTo: stringPtr("alice"), CompletionDate: completedAt(1)},
+		{TaskID: "t2", Status: "Complete", AssignedTo: stringPtr("alice"), CompletionDate: completedAt(2)},
+		{TaskID: "t3", Status: "Complete", AssignedTo: stringPtr("alice"), CompletionDate: completedAt(8)},
+		{TaskID: "t4", Status: "Complete", AssignedTo: stringPtr("alice")},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("assigned_to") != "alice" || r.URL.Query().Get("status") != "Complete" {
+			t.Errorf("Unexpected query: %s", r.URL.RawQuery)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(tasks)
+	}))
+	defer server.Close()
+
+	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
+	userTools := NewUserTools(apiClient, config.Default())
+
+	result, err := userTools.HandleGetAssigneeVelocity(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetAssigneeVelocityParams]{
+		Arguments: GetAssigneeVelocityParams{UserID: "alice", Weeks: 2},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetAssigneeVelocity failed: %v", err)
+	}
+
+	weekly := result.Meta["weekly_series"].([]map[string]any)
+	if len(weekly) != 2 || weekly[0]["completed"] != 1 || weekly[1]["completed"] != 2 {
+		t.Errorf("Expected weekly series [1 2], got %+v", weekly)
+	}
+	if result.Meta["average_velocity"] != 1.5 {
+		t.Errorf("Expected average velocity 1.5, got %v", result.Meta["average_velocity"])
+	}
+	if result.Meta["trend"] != "improving" {
+		t.Errorf("Expected improving trend, got %v", result.Meta["trend"])
+	}
+	if result.Meta["skipped_count"] != 1 {
+		t.Errorf("Expected 1 skipped task, got %v", result.Meta["skipped_count"])
+	}
+
+	if _, err := userTools.HandleGetAssigneeVelocity(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetAssigneeVelocityParams]{}); err == nil {
+		t.Error("Expected error for missing user_id")
+	}
+}
+
+func TestUserTools_HandleGetMyOrphanedTasks(t *testing.T) {
+	empty := ""
+	tasks := []Task{
+		{TaskID: "newer", TaskName: "Newer orphan", Status: "Not Started", CreatedBy: "alice", CreationDate: "2024-03-01T00:00:00Z"},
+		{TaskID: "older", TaskName: "Older orphan", Status: "In Progress", CreatedBy: "alice", AssignedTo: &empty, CreationDate: "2024-01-01T00:00:00Z"},
+		{TaskID: "delegated", TaskName: "Given to bob", Status: "Not Started", CreatedBy: "alice", AssignedTo: stringPtr("bob"), CreationDate: "2023-12-01T00:00:00Z"},
+		{TaskID: "done", TaskName: "Finished", Status: "Complete", CreatedBy: "alice", CreationDate: "2023-11-01T00:00:00Z"},
+		{TaskID: "someone-else", TaskName: "Not mine", Status: "Not Started", CreatedBy: "carol", CreationDate: "2023-10-01T00:00:00Z"},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// Return everything to prove created_by is also enforced client-side
+		json.NewEncoder(w).Encode(tasks)
+	}))
+	defer server.Close()
+
+	userTools := NewUserTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+
+	result, err := userTools.HandleGetMyOrphanedTasks(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetMyOrphanedTasksParams]{
+		Arguments: GetMyOrphanedTasksParams{UserID: "alice"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetMyOrphanedTasks failed: %v", err)
+	}
+
+	orphans := result.Meta["orphaned_tasks"].([]map[string]any)
+	if len(orphans) != 2 {
+		t.Fatalf("Expected 2 orphaned tasks, got %d: %+v", len(orphans), orphans)
+	}
+	if orphans[0]["task_id"] != "older" || orphans[1]["task_id"] != "newer" {
+		t.Errorf("Expected oldest orphan first, got %v then %v", orphans[0]["task_id"], orphans[1]["task_id"])
+	}
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	if strings.Contains(text, "Given to bob") {
+		t.Error("Expected tasks assigned to someone else to be excluded")
+	}
+
+	if _, err := userTools.HandleGetMyOrphanedTasks(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetMyOrphanedTasksParams]{}); err == nil {
+		t.Error("Expected error for missing user_id")
+	}
+}
+
+func TestUserTools_HandleGetMyWork_AssigneeAliases(t *testing.T) {
+	var queries []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		queries = append(queries, r.URL.RawQuery)
+		status := r.URL.Query().Get("status")
+		all := []Task{
+			{TaskID: "t1", TaskName: "Alias task", Status: "In Progress", AssignedTo: stringPtr("jdoe")},
+			{TaskID: "t2", TaskName: "Spaced name task", Status: "In Progress", AssignedTo: stringPtr("john  DOE")},
+			{TaskID: "t3", TaskName: "Someone else", Status: "In Progress", AssignedTo: stringPtr("alice")},
+		}
+		tasks := []Task{}
+		for _, task := range all {
+			if task.Status == status {
+				tasks = append(tasks, task)
+			}
+		}
+		json.NewEncoder(w).Encode(tasks)
+	}))
+	defer server.Close()
+
+	cfg := config.Default()
+	cfg.AssigneeAliases = map[string]string{"jdoe": "John Doe"}
+	cfg.AssigneeNormalize = true
+	userTools := NewUserTools(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+
+	result, err := userTools.HandleGetMyWork(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetMyWorkParams]{
+		Arguments: GetMyWorkParams{UserID: "John Doe"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetMyWork failed: %v", err)
+	}
+	if len(queries) != 1 || queries[0] != "status=In+Progress" {
+		t.Errorf("Expected assignee filter to be applied locally, got queries %v", queries)
+	}
+	if result.Meta["total_tasks"] != 2 {
+		t.Errorf("Expected both aliased forms to count as the user's tasks, got %v", result.Meta["total_tasks"])
+	}
+}
+
+func TestUserTools_HandleCompareAssignees(t *testing.T) {
+	now := time.Date(2024, 6, 15, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(days int) *string {
+		value := now.Add(-time.Duration(days) * 24 * time.Hour).Format(time.RFC3339)
+		return &value
+	}
+
+	tasksByUser := map[string][]Task{
+		"alice": {
+			{TaskID: "a1", Status: "In Progress", AssignedTo: stringPtr("alice"), DueDate: daysAgo(2)},
+			{TaskID: "a2", Status: "Not Started", AssignedTo: stringPtr("alice")},
+			{TaskID: "a3", Status: "Complete", AssignedTo: stringPtr("alice"), CompletionDate: daysAgo(3)},
+		},
+		"bob": {
+			{TaskID: "b1", Status: "In Progress", AssignedTo: stringPtr("bob")},
+			{TaskID: "b2", Status: "Complete", AssignedTo: stringPtr("bob"), CompletionDate: daysAgo(1)},
+			{TaskID: "b3", Status: "Complete", AssignedTo: stringPtr("bob"), CompletionDate: daysAgo(9)},
+		},
+	}
+	notesByTask := map[string][]TaskNote{
+		"a1": {
+			{NoteID: "n1", CreatedBy: "bob", CreationDate: *daysAgo(1)},
+			{NoteID: "n2", CreatedBy: "alice", CreationDate: *daysAgo(60)},
+		},
+		"b1": {
+			{NoteID: "n3", CreatedBy: "bob", CreationDate: *daysAgo(4)},
+		},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/v1/tasks" {
+			json.NewEncoder(w).Encode(tasksByUser[r.URL.Query().Get("assigned_to")])
+			return
+		}
+		taskID := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v1/tasks/"), "/notes")
+		json.NewEncoder(w).Encode(notesByTask[taskID])
+	}))
+	defer server.Close()
+
+	userTools := NewUserTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	userTools.SetClock(clock.NewFixed(now))
+
+	result, err := userTools.HandleCompareAssignees(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[CompareAssigneesParams]{
+		Arguments: CompareAssigneesParams{UserIDs: []string{"alice", "bob"}, Weeks: 2},
+	})
+	if err != nil {
+		t.Fatalf("HandleCompareAssignees failed: %v", err)
+	}
+
+	metrics := result.Meta["metrics"].([]AssigneeMetrics)
+	alice, bob := metrics[0], metrics[1]
+	if alice.ActiveLoad != 2 || alice.OverdueCount != 1 || alice.CompletedInWindow != 1 || alice.NoteContributions != 0 {
+		t.Errorf("Unexpected metrics for alice: %+v", alice)
+	}
+	if bob.ActiveLoad != 1 || bob.OverdueCount != 0 || bob.CompletedInWindow != 2 || bob.NoteContributions != 2 {
+		t.Errorf("Unexpected metrics for bob: %+v", bob)
+	}
+
+	rankings := result.Meta["rankings"].(map[string][]string)
+	expected := map[string][]string{
+		"active_load":        {"alice", "bob"},
+		"overdue_count":      {"alice", "bob"},
+		"velocity":           {"bob", "alice"},
+		"note_contributions": {"bob", "alice"},
+	}
+	for metric, want := range expected {
+		got, ok := rankings[metric]
+		if !ok {
+			t.Errorf("Expected a ranking for %s", metric)
+			continue
+		}
+		if strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Errorf("Expected %s ranking %v, got %v", metric, want, got)
+		}
+	}
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	if !strings.Contains(text, "Velocity: bob > alice") {
+		t.Errorf("Expected per-metric rankings in text, got:\n%s", text)
+	}
+
+	if _, err := userTools.HandleCompareAssignees(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[CompareAssigneesParams]{
+		Arguments: CompareAssigneesParams{UserIDs: []string{"alice", "alice"}},
+	}); err == nil {
+		t.Error("Expected error when fewer than 2 distinct users are given")
+	}
+}
+
+func TestUserTools_HandleGetPersonalDigest(t *testing.T) {
+	// A Wednesday, so five working days ahead stays within the week after
+	now := time.Date(2024, 6, 12, 9, 0, 0, 0, time.UTC)
+	at := func(offset time.Duration) *string {
+		value := now.Add(offset).Format(time.RFC3339)
+		return &value
+	}
+
+	tasks := []Task{
+		{TaskID: "overdue", TaskName: "Overdue report", Status: "In Progress", AssignedTo: stringPtr("alice"), DueDate: at(-3 * 24 * time.Hour)},
+		{TaskID: "fresh-overdue", TaskName: "Slipped yesterday", Status: "In Progress", AssignedTo: stringPtr("alice"), DueDate: at(-12 * time.Hour)},
+		{TaskID: "soon", TaskName: "Ship API docs", Status: "Not Started", AssignedTo: stringPtr("alice"), DueDate: at(2 * 24 * time.Hour)},
+		{TaskID: "later", TaskName: "Quarterly plan", Status: "Not Started", AssignedTo: stringPtr("alice"), DueDate: at(30 * 24 * time.Hour)},
+		{TaskID: "schema", TaskName: "Design schema", Status: "In Progress", AssignedTo: stringPtr("alice")},
+		{TaskID: "migration", TaskName: "Write migration", Status: "Blocked", AssignedTo: stringPtr("bob"), BlockedBy: []string{"schema"}},
+		{TaskID: "done-dependent", TaskName: "Old dependent", Status: "Complete", AssignedTo: stringPtr("bob"), BlockedBy: []string{"soon"}},
+		{TaskID: "bobs", TaskName: "Bob's deadline", Status: "Not Started", AssignedTo: stringPtr("bob"), DueDate: at(24 * time.Hour)},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(tasks)
+	}))
+	defer server.Close()
+
+	userTools := NewUserTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+	userTools.SetClock(clock.NewFixed(now))
+
+	result, err := userTools.HandleGetPersonalDigest(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetPersonalDigestParams]{
+		Arguments: GetPersonalDigestParams{UserID: "alice"},
+	})
+	if err != nil {
+		t.Fatalf("HandleGetPersonalDigest failed: %v", err)
+	}
+
+	ids := func(key string) []string {
+		var out []string
+		for _, entry := range result.Meta[key].([]map[string]any) {
+			out = append(out, entry["task_id"].(string))
+		}
+		return out
+	}
+	if got := ids("upcoming_deadlines"); strings.Join(got, ",") != "soon" {
+		t.Errorf("Expected only the task due within the horizon, got %v", got)
+	}
+	if got := ids("blocking"); strings.Join(got, ",") != "schema" {
+		t.Errorf("Expected schema to be reported as blocking, got %v", got)
+	}
+	blocking := result.Meta["blocking"].([]map[string]any)
+	if waiting := blocking[0]["blocking_task_ids"].([]string); strings.Join(waiting, ",") != "migration" {
+		t.Errorf("Expected schema to block migration, got %v", waiting)
+	}
+	if got := ids("attention_needed"); len(got) != 2 {
+		t.Errorf("Expected both overdue tasks to need attention, got %v", got)
+	}
+	if got := ids("new_overdue"); strings.Join(got, ",") != "fresh-overdue" {
+		t.Errorf("Expected only the task that slipped since yesterday, got %v", got)
+	}
+
+	text := result.Content[0].(*mcp.TextContent).Text
+	for _, want := range []string{
+		"## 📅 Upcoming Deadlines (1)",
+		"**Ship API docs** (`soon`)",
+		"## ⛓️ You're Blocking (1)",
+		"**Design schema** (`schema`) — blocks Write migration (`migration`)",
+		"New Overdue Since Yesterday: 1",
+	} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Expected digest to contain %q, got:\n%s", want, text)
+		}
+	}
+	if strings.Contains(text, "Bob's deadline") || strings.Contains(text, "Quarterly plan") {
+		t.Errorf("Expected other users' tasks and distant deadlines to be excluded, got:\n%s", text)
+	}
+
+	if _, err := userTools.HandleGetPersonalDigest(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetPersonalDigestParams]{}); err == nil {
+		t.Error("Expected error for missing user_id")
+	}
+}
+
+func TestUserTools_HandleGetTeamWorkload(t *testing.T) {
+	tasks := []Task{
+		{TaskID: "t1", Status: "In Progress", AssignedTo: stringPtr("alice")},
+		{TaskID: "t2", Status: "In Progress", AssignedTo: stringPtr("alice")},
+		{TaskID: "t3", Status: "Not Started", AssignedTo: stringPtr("bob")},
+		{TaskID: "t4", Status: "Not Started"},
+		{TaskID: "t5", Status: "Complete", AssignedTo: stringPtr("bob")},
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/tasks" {
+			t.Errorf("Unexpected request: %s", r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(tasks)
+	}))
+	defer server.Close()
+
+	userTools := NewUserTools(client.NewAPIClient(server.URL, 30*time.Second), config.Default())
+
+	result, err := userTools.HandleGetTeamWorkload(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetTeamWorkloadParams]{})
+	if err != nil {
+		t.Fatalf("HandleGetTeamWorkload failed: %v", err)
+	}
+
+	members := result.Meta["members"].([]MemberWorkload)
+	if len(members) != 2 || members[0].Assignee != "alice" || members[0].ActiveCount != 2 || members[1].ActiveCount != 1 {
+		t.Errorf("Unexpected members: %+v", members)
+	}
+	if unassigned := result.Meta["unassigned"].(MemberWorkload); unassigned.ActiveCount != 1 {
+		t.Errorf("Expected 1 unassigned task, got %+v", unassigned)
+	}
+	if result.Meta["total_active"] != 4 || result.Meta["average_active"] != 1.5 {
+		t.Errorf("Expected 4 active tasks averaging 1.5, got %v and %v", result.Meta["total_active"], result.Meta["average_active"])
+	}
+	text := result.Content[0].(*mcp.TextContent).Text
+	if !strings.Contains(text, "Unassigned: 1 active tasks") {
+		t.Errorf("Expected the unassigned bucket in the summary, got %q", text)
+	}
+}
+
+func TestUserTools_HandleGetMyWork_SuppressedInsights(t *testing.T) {
+	server := createUserMockAPIServer()
+	defer server.Close()
+
+	myWork := func(cfg *config.Config) []string {
+		t.Helper()
+		userTools := NewUserTools(client.NewAPIClient(server.URL, 30*time.Second), cfg)
+		result, err := userTools.HandleGetMyWork(context.Background(), &mcp.ServerSession{}, &mcp.CallToolParamsFor[GetMyWorkParams]{
+			Arguments: GetMyWorkParams{UserID: "user1", IncludeReview: true, IncludeBlocked: true},
+		})
+		if err != nil {
+			t.Fatalf("HandleGetMyWork failed: %v", err)
+		}
+		insights, _ := result.Meta["insights"].([]string)
+		return insights
+	}
+
+	if len(myWork(config.Default())) == 0 {
+		t.Fatal("Expected the mock workload to produce insights")
+	}
+
+	cfg := config.Default()
+	cfg.SuppressedInsights = []string{
+		insightWorkCaughtUp, insightWorkLight, insightWorkHeavy, insightWorkModerate, insightWorkOverdue,
+		insightWorkDueSoon, insightWorkMostlyHigh, insightWorkBlocked, insightWorkManyProjects,
+	}
+	if insights := myWork(cfg); len(insights) != 0 {
+		t.Errorf("Expected every get_my_work insight to be suppressible, got %v", insights)
+	}
+}
@@ -0,0 +1,200 @@
+package tools
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bchamber/taskman-mcp/internal/config"
+	"github.com/bchamber/taskman-mcp/internal/identity"
+)
+
+// validPriorities lists the priorities the API accepts
+var validPriorities = []string{"Low", "Medium", "High"}
+
+// validateStatus rejects statuses outside canonicalStatuses
+func validateStatus(status string) error {
+	for _, validStatus := range canonicalStatuses {
+		if status == validStatus {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid status '%s'. Valid statuses are: %v", status, canonicalStatuses)
+}
+
+// validatePriority rejects priorities outside validPriorities
+func validatePriority(priority string) error {
+	for _, validPriority := range validPriorities {
+		if priority == validPriority {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid priority '%s'. Valid priorities are: %v", priority, validPriorities)
+}
+
+// validateNote rejects notes that are blank or, once trimmed, shorter than
+// the configured MinNoteLength
+func validateNote(cfg *config.Config, note, field string) error {
+	trimmed := strings.TrimSpace(note)
+	if trimmed == "" {
+		return fmt.Errorf("%s is required", field)
+	}
+	if length := utf8.RuneCountInString(trimmed); length < cfg.MinNoteLength {
+		return fmt.Errorf("%s is too short (%d characters, minimum %d); describe what changed, what's next, or what's blocking", field, length, cfg.MinNoteLength)
+	}
+	return nil
+}
+
+// taskPayloadFields lists the fields accepted in a task payload, in the order they are checked
+var taskPayloadFields = []string{
+	"task_name", "task_description", "status", "priority", "assigned_to",
+	"project_id", "due_date", "created_by", "tags", "links", "blocked_by", "archived",
+}
+
+// taskReferences holds the known projects and people a payload may refer to;
+// a nil map disables that check
+type taskReferences struct {
+	projects  map[string]bool
+	people    map[string]bool // canonical identities, see identity.Normalizer
+	assignees *identity.Normalizer
+}
+
+// validationIssue records a problem with one field of a payload
+func validationIssue(field, message string) map[string]any {
+	return map[string]any{"field": field, "message": message}
+}
+
+// payloadString reads an optional string field, reporting a type error if it holds something else
+func payloadString(payload map[string]any, field string) (string, bool, error) {
+	raw, ok := payload[field]
+	if !ok || raw == nil {
+		return "", false, nil
+	}
+	value, ok := raw.(string)
+	if !ok {
+		return "", true, fmt.Errorf("must be a string")
+	}
+	return value, true, nil
+}
+
+// validateTaskPayload applies the task write rules to a payload without
+// calling the API, returning per-field errors and warnings
+func validateTaskPayload(cfg *config.Config, payload map[string]any, refs taskReferences, now time.Time) (errs []map[string]any, warnings []map[string]any) {
+	strField := func(field string) (string, bool) {
+		value, present, err := payloadString(payload, field)
+		if err != nil {
+			errs = append(errs, validationIssue(field, err.Error()))
+			return "", false
+		}
+		return value, present && value != ""
+	}
+
+	if taskName, present, err := payloadString(payload, "task_name"); err != nil {
+		errs = append(errs, validationIssue("task_name", err.Error()))
+	} else if !present || taskName == "" {
+		errs = append(errs, validationIssue("task_name", "task_name is required"))
+	}
+
+	strField("task_description")
+
+	if status, ok := strField("status"); ok {
+		if err := validateStatus(status); err != nil {
+			errs = append(errs, validationIssue("status", err.Error()))
+		}
+	}
+
+	if priority, ok := strField("priority"); ok {
+		if err := validatePriority(priority); err != nil {
+			errs = append(errs, validationIssue("priority", err.Error()))
+		}
+	} else if _, present := payload["priority"]; !present {
+		warnings = append(warnings, validationIssue("priority", "priority is not set"))
+	}
+
+	if dueDate, ok := strField("due_date"); ok {
+		parsed, err := parseDueDate(dueDate)
+		if err != nil {
+			errs = append(errs, validationIssue("due_date", err.Error()))
+		} else if parsed != nil && parsed.Before(now) {
+			warnings = append(warnings, validationIssue("due_date", "due date is in the past"))
+		}
+	}
+
+	if createdBy, _, err := payloadString(payload, "created_by"); err != nil {
+		errs = append(errs, validationIssue("created_by", err.Error()))
+	} else if _, err := resolveActor(cfg, createdBy, "created_by"); err != nil {
+		errs = append(errs, validationIssue("created_by", err.Error()))
+	}
+
+	if projectID, ok := strField("project_id"); ok && refs.projects != nil && !refs.projects[projectID] {
+		errs = append(errs, validationIssue("project_id", fmt.Sprintf("project %s does not exist", projectID)))
+	}
+
+	if assignee, ok := strField("assigned_to"); ok && refs.people != nil && !refs.people[refs.assignees.Canonical(assignee)] {
+		warnings = append(warnings, validationIssue("assigned_to", fmt.Sprintf("%s is not assigned to or creator of any existing task", assignee)))
+	}
+
+	for _, field := range []string{"tags", "blocked_by"} {
+		raw, ok := payload[field]
+		if !ok || raw == nil {
+			continue
+		}
+		items, ok := raw.([]any)
+		if !ok {
+			errs = append(errs, validationIssue(field, "must be an array of strings"))
+			continue
+		}
+		for _, item := range items {
+			if _, ok := item.(string); !ok {
+				errs = append(errs, validationIssue(field, "must be an array of strings"))
+				break
+			}
+		}
+	}
+
+	if raw, ok := payload["links"]; ok && raw != nil {
+		items, ok := raw.([]any)
+		if !ok {
+			errs = append(errs, validationIssue("links", "must be an array of {label, url} objects"))
+		}
+		for _, item := range items {
+			link, ok := item.(map[string]any)
+			if !ok {
+				errs = append(errs, validationIssue("links", "must be an array of {label, url} objects"))
+				break
+			}
+			linkURL, _ := link["url"].(string)
+			if _, err := validateLinkURL(linkURL); err != nil {
+				errs = append(errs, validationIssue("links", err.Error()))
+			}
+		}
+	}
+
+	if raw, ok := payload["archived"]; ok && raw != nil {
+		if _, ok := raw.(bool); !ok {
+			errs = append(errs, validationIssue("archived", "must be a boolean"))
+		}
+	}
+
+	var unknown []string
+	for field := range payload {
+		known := false
+		for _, accepted := range taskPayloadFields {
+			if field == accepted {
+				known = true
+				break
+			}
+		}
+		if !known {
+			unknown = append(unknown, field)
+		}
+	}
+	sort.Strings(unknown)
+	for _, field := range unknown {
+		warnings = append(warnings, validationIssue(field, "unknown field will be ignored"))
+	}
+
+	return errs, warnings
+}
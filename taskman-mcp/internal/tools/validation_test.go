@@ -0,0 +1,152 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/bchamber/taskman-mcp/internal/client"
+	"github.com/bchamber/taskman-mcp/internal/config"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+func TestValidateStatusAndPriority(t *testing.T) {
+	if err := validateStatus("Review"); err != nil {
+		t.Errorf("Expected Review to be valid, got %v", err)
+	}
+	if err := validateStatus("review"); err == nil {
+		t.Error("Expected lowercase status to be rejected")
+	}
+	if err := validatePriority("High"); err != nil {
+		t.Errorf("Expected High to be valid, got %v", err)
+	}
+	if err := validatePriority("Critical"); err == nil {
+		t.Error("Expected unknown priority to be rejected")
+	}
+}
+
+func TestValidateTaskPayload_TypesAndUnknownFields(t *testing.T) {
+	payload := map[string]any{
+		"task_name": 42,
+		"tags":      []any{"ok", 7},
+		"archived":  "yes",
+		"colour":    "blue",
+	}
+
+	errs, warnings := validateTaskPayload(config.Default(), payload, taskReferences{}, time.Now())
+
+	fields := make(map[string]bool)
+	for _, issue := range errs {
+		fields[issue["field"].(string)] = true
+	}
+	for _, field := range []string{"task_name", "tags", "archived"} {
+		if !fields[field] {
+			t.Errorf("Expected a type error for %s, got %+v", field, errs)
+		}
+	}
+
+	var unknown bool
+	for _, issue := range warnings {
+		if issue["field"] == "colour" {
+			unknown = true
+		}
+	}
+	if !unknown {
+		t.Errorf("Expected an unknown-field warning, got %+v", warnings)
+	}
+}
+
+func TestStatusAndPriorityRejectedConsistently(t *testing.T) {
+	var mu sync.Mutex
+	var taskWrites []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == "POST" && r.URL.Path == "/api/v1/projects":
+			json.NewEncoder(w).Encode(Project{ProjectID: "proj-1", ProjectName: "Project"})
+		case r.URL.Path == "/api/v1/tasks" || strings.HasPrefix(r.URL.Path, "/api/v1/tasks/"):
+			var body map[string]any
+			json.NewDecoder(r.Body).Decode(&body)
+			if r.Method != "GET" && !strings.HasSuffix(r.URL.Path, "/notes") {
+				mu.Lock()
+				taskWrites = append(taskWrites, body)
+				mu.Unlock()
+			}
+			json.NewEncoder(w).Encode(Task{TaskID: "task-1", TaskName: "Task", Status: "Not Started"})
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	apiClient := client.NewAPIClient(server.URL, 30*time.Second)
+	taskTools := NewTaskTools(apiClient, config.Default())
+	projectTools := NewProjectTools(apiClient, config.Default())
+	ctx := context.Background()
+	session := &mcp.ServerSession{}
+	note := "Checking that invalid values are rejected"
+
+	cases := []struct {
+		name             string
+		status, priority string
+		wantError        string
+	}{
+		{"status", "Done", "", validateStatus("Done").Error()},
+		{"priority", "", "Urgent", validatePriority("Urgent").Error()},
+	}
+	for _, tc := range cases {
+		taskWrites = nil
+
+		_, err := taskTools.HandleCreateTaskWithContext(ctx, session, &mcp.CallToolParamsFor[CreateTaskWithContextParams]{
+			Arguments: CreateTaskWithContextParams{TaskName: "Task", Status: tc.status, Priority: tc.priority, InitialNote: note, CreatedBy: "alice"},
+		})
+		if err == nil || err.Error() != tc.wantError {
+			t.Errorf("%s: create_task_with_context: expected %q, got %v", tc.name, tc.wantError, err)
+		}
+
+		_, err = taskTools.HandleUpdateTaskProgress(ctx, session, &mcp.CallToolParamsFor[UpdateTaskProgressParams]{
+			Arguments: UpdateTaskProgressParams{TaskID: "task-1", Status: tc.status, Priority: tc.priority, ProgressNote: note, UpdatedBy: "alice"},
+		})
+		if err == nil || err.Error() != tc.wantError {
+			t.Errorf("%s: update_task_progress: expected %q, got %v", tc.name, tc.wantError, err)
+		}
+
+		result, err := projectTools.HandleCreateProjectWithInitialTasks(ctx, session, &mcp.CallToolParamsFor[CreateProjectWithInitialTasksParams]{
+			Arguments: CreateProjectWithInitialTasksParams{
+				ProjectName:  "Project",
+				CreatedBy:    "alice",
+				InitialTasks: []InitialTaskSpec{{TaskName: "Task", Status: tc.status, Priority: tc.priority}},
+			},
+		})
+		if err != nil {
+			t.Fatalf("%s: create_project_with_initial_tasks failed: %v", tc.name, err)
+		}
+		if failed := result.Meta["failed_tasks"].([]InitialTaskSpec); len(failed) != 1 {
+			t.Errorf("%s: create_project_with_initial_tasks: expected the task to fail, got %+v", tc.name, failed)
+		}
+
+		dryRun, err := projectTools.HandleCreateProjectWithInitialTasks(ctx, session, &mcp.CallToolParamsFor[CreateProjectWithInitialTasksParams]{
+			Arguments: CreateProjectWithInitialTasksParams{
+				ProjectName:  "Project",
+				CreatedBy:    "alice",
+				DryRun:       true,
+				InitialTasks: []InitialTaskSpec{{TaskName: "Task", Status: tc.status, Priority: tc.priority}},
+			},
+		})
+		if err != nil {
+			t.Fatalf("%s: dry run failed: %v", tc.name, err)
+		}
+		if invalid := dryRun.Meta["invalid_tasks"].([]taskCreationResult); len(invalid) != 1 || invalid[0].Error != tc.wantError {
+			t.Errorf("%s: dry run: expected %q, got %+v", tc.name, tc.wantError, invalid)
+		}
+
+		if len(taskWrites) != 0 {
+			t.Errorf("%s: expected no task writes with an invalid value, got %+v", tc.name, taskWrites)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package tools
+
+import (
+	"time"
+)
+
+// Velocity trend classifications
+const (
+	trendImproving = "improving"
+	trendSteady    = "steady"
+	trendDeclining = "declining"
+)
+
+// trendThreshold is the relative change between halves of a series that counts as a trend
+const trendThreshold = 0.2
+
+// weeklyCompletions buckets completed tasks into weekly counts over the weeks
+// ending at now, oldest week first. Completed tasks without a usable
+// completion_date are counted in skipped.
+func weeklyCompletions(tasks []Task, now time.Time, weeks int) (series []int, skipped int) {
+	series = make([]int, weeks)
+	windowStart := now.Add(-time.Duration(weeks) * 7 * 24 * time.Hour)
+
+	for _, task := range tasks {
+		if task.Status != "Complete" {
+			continue
+		}
+		if task.CompletionDate == nil {
+			skipped++
+			continue
+		}
+		completed, err := parseDueDate(*task.CompletionDate)
+		if err != nil || completed == nil {
+			skipped++
+			continue
+		}
+		if completed.Before(windowStart) || completed.After(now) {
+			continue
+		}
+
+		week := int(completed.Sub(windowStart) / (7 * 24 * time.Hour))
+		if week >= weeks {
+			week = weeks - 1
+		}
+		series[week]++
+	}
+
+	return series, skipped
+}
+
+// averageVelocity returns the mean completions per week
+func averageVelocity(series []int) float64 {
+	if len(series) == 0 {
+		return 0
+	}
+	total := 0
+	for _, count := range series {
+		total += count
+	}
+	return float64(total) / float64(len(series))
+}
+
+// classifyVelocityTrend compares the earlier and later halves of a weekly series
+func classifyVelocityTrend(series []int) string {
+	if len(series) < 2 {
+		return trendSteady
+	}
+
+	half := len(series) / 2
+	earlier := averageVelocity(series[:half])
+	later := averageVelocity(series[len(series)-half:])
+
+	switch {
+	case earlier == 0 && later == 0:
+		return trendSteady
+	case earlier == 0:
+		return trendImproving
+	case (later-earlier)/earlier >= trendThreshold:
+		return trendImproving
+	case (earlier-later)/earlier >= trendThreshold:
+		return trendDeclining
+	default:
+		return trendSteady
+	}
+}
+
+// minVelocitySpanDays is the shortest span dailyVelocity measures over, so a
+// burst of completions on a project's first day doesn't look like a pace
+const minVelocitySpanDays = 1.0
+
+// dailyVelocity returns the completed tasks per day between start and now.
+// ok is false when nothing is completed, as there is no pace to measure.
+func dailyVelocity(completed int, start, now time.Time) (perDay float64, ok bool) {
+	if completed <= 0 {
+		return 0, false
+	}
+	days := now.Sub(start).Hours() / 24
+	if days < minVelocitySpanDays {
+		days = minVelocitySpanDays
+	}
+	return float64(completed) / days, true
+}
+
+// projectedCompletion estimates when remaining tasks will be done if work
+// continues at perDay. ok is false when perDay is not a usable rate.
+func projectedCompletion(remaining int, perDay float64, now time.Time) (end time.Time, ok bool) {
+	if perDay <= 0 {
+		return time.Time{}, false
+	}
+	if remaining <= 0 {
+		return now, true
+	}
+	days := float64(remaining) / perDay
+	return now.Add(time.Duration(days * float64(24*time.Hour))), true
+}
@@ -0,0 +1,94 @@
+package tools
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestWeeklyCompletions(t *testing.T) {
+	now := time.Date(2024, 6, 29, 12, 0, 0, 0, time.UTC)
+	completedAt := func(daysAgo int) *string {
+		value := now.Add(-time.Duration(daysAgo) * 24 * time.Hour).Format(time.RFC3339)
+		return &value
+	}
+
+	tasks := []Task{
+		{TaskID: "w4a", Status: "Complete", CompletionDate: completedAt(1)},
+		{TaskID: "w4b", Status: "Complete", CompletionDate: completedAt(3)},
+		{TaskID: "w3", Status: "Complete", CompletionDate: completedAt(10)},
+		{TaskID: "w1", Status: "Complete", CompletionDate: completedAt(26)},
+		{TaskID: "old", Status: "Complete", CompletionDate: completedAt(40)},
+		{TaskID: "missing", Status: "Complete"},
+		{TaskID: "bad", Status: "Complete", CompletionDate: stringPtr("yesterday")},
+		{TaskID: "open", Status: "In Progress", CompletionDate: completedAt(2)},
+	}
+
+	series, skipped := weeklyCompletions(tasks, now, 4)
+
+	if expected := []int{1, 0, 1, 2}; !reflect.DeepEqual(series, expected) {
+		t.Errorf("Expected series %v, got %v", expected, series)
+	}
+	if skipped != 2 {
+		t.Errorf("Expected 2 skipped tasks, got %d", skipped)
+	}
+	if avg := averageVelocity(series); avg != 1 {
+		t.Errorf("Expected average velocity 1, got %v", avg)
+	}
+}
+
+func TestClassifyVelocityTrend(t *testing.T) {
+	tests := []struct {
+		series   []int
+		expected string
+	}{
+		{[]int{1, 1, 3, 4}, trendImproving},
+		{[]int{4, 3, 1, 1}, trendDeclining},
+		{[]int{2, 3, 3, 2}, trendSteady},
+		{[]int{0, 0, 0, 0}, trendSteady},
+		{[]int{0, 0, 1, 2}, trendImproving},
+		{[]int{5}, trendSteady},
+		{[]int{2, 1, 2}, trendSteady},
+	}
+
+	for _, tt := range tests {
+		if got := classifyVelocityTrend(tt.series); got != tt.expected {
+			t.Errorf("classifyVelocityTrend(%v) = %s, expected %s", tt.series, got, tt.expected)
+		}
+	}
+}
+
+func TestDailyVelocity(t *testing.T) {
+	now := time.Date(2024, 6, 29, 12, 0, 0, 0, time.UTC)
+
+	if perDay, ok := dailyVelocity(10, now.Add(-20*24*time.Hour), now); !ok || perDay != 0.5 {
+		t.Errorf("Expected 0.5 tasks per day over 20 days, got %v (ok=%v)", perDay, ok)
+	}
+	// Spans under a day are measured as one day
+	if perDay, ok := dailyVelocity(3, now.Add(-2*time.Hour), now); !ok || perDay != 3 {
+		t.Errorf("Expected 3 tasks per day for a same-day span, got %v (ok=%v)", perDay, ok)
+	}
+	if perDay, ok := dailyVelocity(2, now.Add(time.Hour), now); !ok || perDay != 2 {
+		t.Errorf("Expected a start after now to count as one day, got %v (ok=%v)", perDay, ok)
+	}
+	if _, ok := dailyVelocity(0, now.Add(-20*24*time.Hour), now); ok {
+		t.Error("Expected no velocity without completed tasks")
+	}
+}
+
+func TestProjectedCompletion(t *testing.T) {
+	now := time.Date(2024, 6, 29, 12, 0, 0, 0, time.UTC)
+
+	if end, ok := projectedCompletion(5, 0.5, now); !ok || !end.Equal(now.Add(10*24*time.Hour)) {
+		t.Errorf("Expected completion in 10 days, got %v (ok=%v)", end, ok)
+	}
+	if end, ok := projectedCompletion(1, 4, now); !ok || !end.Equal(now.Add(6*time.Hour)) {
+		t.Errorf("Expected completion in 6 hours, got %v (ok=%v)", end, ok)
+	}
+	if end, ok := projectedCompletion(0, 1, now); !ok || !end.Equal(now) {
+		t.Errorf("Expected nothing remaining to complete now, got %v (ok=%v)", end, ok)
+	}
+	if _, ok := projectedCompletion(5, 0, now); ok {
+		t.Error("Expected no projection without a velocity")
+	}
+}
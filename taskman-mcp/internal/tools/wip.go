@@ -0,0 +1,67 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"github.com/bchamber/taskman-mcp/internal/config"
+)
+
+// checkWIPLimit rejects moving task into status when the configured hard WIP
+// limit for that status is already used up by other tasks of the same
+// assignee (or project, per WIPLimitScope). Tasks with nobody to count
+// against, and statuses without a positive limit, are never limited.
+func (t *TaskTools) checkWIPLimit(ctx context.Context, cfg *config.Config, task Task, status, assignee string) error {
+	limit := cfg.WIPLimits[status]
+	if limit <= 0 {
+		return nil
+	}
+
+	var scope, owner, query string
+	if cfg.WIPLimitScope == "project" {
+		scope, owner = "project", taskProjectID(task)
+		if owner == "" {
+			return nil
+		}
+		query = url.Values{"status": {status}, "project_id": {owner}}.Encode()
+	} else {
+		scope, owner = "assignee", assignee
+		if owner == "" {
+			return nil
+		}
+		query = assigneeStatusQuery(t.assignees, owner, status)
+	}
+
+	tasksResp, err := t.apiClient.Get(ctx, "/api/v1/tasks?"+query)
+	if err != nil {
+		slog.Error("Failed to get tasks for WIP limit", "error", err, scope, owner)
+		return fmt.Errorf("failed to check WIP limit: %w", err)
+	}
+
+	var tasks []Task
+	if err := json.Unmarshal(tasksResp, &tasks); err != nil {
+		slog.Error("Failed to parse tasks for WIP limit", "error", err)
+		return fmt.Errorf("failed to check WIP limit: %w", err)
+	}
+	if scope == "assignee" {
+		tasks = filterAssignedTo(t.assignees, tasks, owner)
+	}
+
+	var occupying []string
+	for _, other := range tasks {
+		if other.TaskID != task.TaskID && other.Status == status {
+			occupying = append(occupying, fmt.Sprintf("%s (%s)", other.TaskName, other.TaskID))
+		}
+	}
+	if len(occupying) < limit {
+		return nil
+	}
+
+	slog.Warn("WIP limit reached", "status", status, scope, owner, "limit", limit, "occupied", len(occupying))
+	return fmt.Errorf("WIP limit reached: %s %s already has %d of %d %q slots taken by %s; set force to move anyway",
+		scope, owner, len(occupying), limit, status, strings.Join(occupying, ", "))
+}
@@ -0,0 +1,127 @@
+package tools
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"time"
+)
+
+// Default get_my_work score weights. With these an overdue task of any
+// priority outranks a task that is not yet due, and priority breaks ties
+// between tasks that are equally late or equally close to due.
+const (
+	defaultOverdueWeight  = 3.0
+	defaultPriorityWeight = 2.0
+	defaultDueSoonWeight  = 1.0
+)
+
+// workScoreHorizonDays is how far ahead a due date starts adding urgency, and
+// how many days overdue earn the full overdue component
+const workScoreHorizonDays = 14.0
+
+// workScoreWeights scales each component of a task's work score
+type workScoreWeights struct {
+	Overdue  float64 `json:"overdue"`
+	Priority float64 `json:"priority"`
+	DueSoon  float64 `json:"due_soon"`
+}
+
+// resolveWorkScoreWeights fills unset (zero) weights with the defaults.
+// Negative weights are rejected rather than inverting the ordering.
+func resolveWorkScoreWeights(overdue, priority, dueSoon float64) (workScoreWeights, error) {
+	weights := workScoreWeights{Overdue: defaultOverdueWeight, Priority: defaultPriorityWeight, DueSoon: defaultDueSoonWeight}
+	for _, w := range []struct {
+		name  string
+		value float64
+		dest  *float64
+	}{
+		{"overdue_weight", overdue, &weights.Overdue},
+		{"priority_weight", priority, &weights.Priority},
+		{"due_soon_weight", dueSoon, &weights.DueSoon},
+	} {
+		if w.value < 0 {
+			return workScoreWeights{}, fmt.Errorf("%s must not be negative", w.name)
+		}
+		if w.value > 0 {
+			*w.dest = w.value
+		}
+	}
+	return weights, nil
+}
+
+// workScore is one task's computed score and the components behind it, each
+// component from 0 to 1 before weighting
+type workScore struct {
+	TaskID    string  `json:"task_id"`
+	TaskName  string  `json:"task_name"`
+	Score     float64 `json:"score"`
+	Overdue   float64 `json:"overdue"`
+	Priority  float64 `json:"priority"`
+	DueSoon   float64 `json:"due_soon"`
+	IsOverdue bool    `json:"is_overdue"`
+}
+
+// scoreWork scores a task as of now. Priority scores 1 for High down to 0
+// for unset; an overdue task scores at least half the overdue component,
+// rising to all of it once it is workScoreHorizonDays late; due-date
+// proximity rises from 0 at the horizon to 1 on the due date and stays 1
+// once overdue. Tasks without a parseable due date score on priority alone.
+func scoreWork(task Task, now time.Time, weights workScoreWeights) workScore {
+	score := workScore{
+		TaskID:   task.TaskID,
+		TaskName: task.TaskName,
+		Priority: float64(3-priorityRank(task.Priority)) / 3,
+	}
+
+	if overdue, ok := overdueDuration(task, now); ok {
+		score.IsOverdue = true
+		score.Overdue = 0.5 + 0.5*math.Min(overdue.Hours()/24, workScoreHorizonDays)/workScoreHorizonDays
+		score.DueSoon = 1
+	} else if task.DueDate != nil {
+		if due, err := parseDueDate(*task.DueDate); err == nil && due != nil {
+			daysUntil := due.Sub(now).Hours() / 24
+			score.DueSoon = math.Max(0, 1-daysUntil/workScoreHorizonDays)
+		}
+	}
+
+	total := weights.Overdue*score.Overdue + weights.Priority*score.Priority + weights.DueSoon*score.DueSoon
+	score.Score = math.Round(total*100) / 100
+	score.Overdue = math.Round(score.Overdue*100) / 100
+	score.Priority = math.Round(score.Priority*100) / 100
+	score.DueSoon = math.Round(score.DueSoon*100) / 100
+	return score
+}
+
+// sortByWorkScore orders tasks by score, highest first, and returns the
+// scores in the same order. Equal scores fall back to priority, then the
+// earliest due date.
+func sortByWorkScore(tasks []Task, now time.Time, weights workScoreWeights) ([]Task, []workScore) {
+	type scored struct {
+		task  Task
+		score workScore
+	}
+	entries := make([]scored, len(tasks))
+	for i, task := range tasks {
+		entries[i] = scored{task, scoreWork(task, now, weights)}
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		a, b := entries[i], entries[j]
+		if a.score.Score != b.score.Score {
+			return a.score.Score > b.score.Score
+		}
+		if ra, rb := priorityRank(a.task.Priority), priorityRank(b.task.Priority); ra != rb {
+			return ra < rb
+		}
+		return compareDueDates(a.task, b.task) < 0
+	})
+
+	sorted := make([]Task, len(entries))
+	scores := make([]workScore, len(entries))
+	for i, entry := range entries {
+		sorted[i] = entry.task
+		scores[i] = entry.score
+	}
+	return sorted, scores
+}
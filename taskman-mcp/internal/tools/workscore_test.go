@@ -0,0 +1,66 @@
+package tools
+
+import (
+	"testing"
+	"time"
+)
+
+func TestResolveWorkScoreWeights(t *testing.T) {
+	weights, err := resolveWorkScoreWeights(0, 5, 0)
+	if err != nil {
+		t.Fatalf("resolveWorkScoreWeights failed: %v", err)
+	}
+	expected := workScoreWeights{Overdue: defaultOverdueWeight, Priority: 5, DueSoon: defaultDueSoonWeight}
+	if weights != expected {
+		t.Errorf("Expected %+v, got %+v", expected, weights)
+	}
+
+	if _, err := resolveWorkScoreWeights(0, 0, -1); err == nil {
+		t.Error("Expected an error for a negative weight")
+	}
+}
+
+func TestSortByWorkScore(t *testing.T) {
+	now := time.Date(2024, 6, 12, 12, 0, 0, 0, time.UTC)
+	dueIn := func(days int) *string {
+		value := now.Add(time.Duration(days) * 24 * time.Hour).Format(time.RFC3339)
+		return &value
+	}
+
+	tasks := []Task{
+		{TaskID: "future-low", TaskName: "Future low", Status: "In Progress", Priority: stringPtr("Low"), DueDate: dueIn(2)},
+		{TaskID: "undated-high", TaskName: "Undated high", Status: "In Progress", Priority: stringPtr("High")},
+		{TaskID: "overdue-high", TaskName: "Overdue high", Status: "In Progress", Priority: stringPtr("High"), DueDate: dueIn(-3)},
+		{TaskID: "overdue-low", TaskName: "Overdue low", Status: "In Progress", Priority: stringPtr("Low"), DueDate: dueIn(-1)},
+	}
+
+	defaults, _ := resolveWorkScoreWeights(0, 0, 0)
+	sorted, scores := sortByWorkScore(tasks, now, defaults)
+
+	order := make([]string, len(sorted))
+	for i, task := range sorted {
+		order[i] = task.TaskID
+		if scores[i].TaskID != task.TaskID {
+			t.Errorf("Score %d belongs to %s, expected %s", i, scores[i].TaskID, task.TaskID)
+		}
+	}
+	expected := []string{"overdue-high", "overdue-low", "undated-high", "future-low"}
+	for i := range expected {
+		if order[i] != expected[i] {
+			t.Fatalf("Expected order %v, got %v", expected, order)
+		}
+	}
+	if !scores[0].IsOverdue || scores[0].Priority != 1 || scores[0].DueSoon != 1 {
+		t.Errorf("Unexpected components for the overdue High task: %+v", scores[0])
+	}
+	if scores[0].Score <= scores[3].Score {
+		t.Errorf("Expected the overdue High task to outscore the future Low task, got %.2f and %.2f", scores[0].Score, scores[3].Score)
+	}
+
+	// Weighting priority heavily lets an undated High task overtake an overdue Low one
+	priorityFirst, _ := resolveWorkScoreWeights(0, 10, 0)
+	sorted, _ = sortByWorkScore(tasks, now, priorityFirst)
+	if sorted[0].TaskID != "overdue-high" || sorted[1].TaskID != "undated-high" {
+		t.Errorf("Expected overdue-high then undated-high with a heavy priority weight, got %s then %s", sorted[0].TaskID, sorted[1].TaskID)
+	}
+}